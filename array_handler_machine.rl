@@ -1,8 +1,17 @@
 package rjson
 
-func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int, []int, error) {
+// handleArrayValues is HandleArrayValues without the Buffer options. depth is the number of objects and arrays that
+// data is nested in. It counts toward the depth limit for values that are skipped. expected is set like it is by
+// skipValue when the machine finds data invalid. It is empty when the error came from handler.
+//
+// state is nil unless StreamReader is running the machine on input that arrives in pieces. Then the machine starts
+// from state and saves its state there when it gets to the end of data.
+func handleArrayValues(
+  data []byte, handler ArrayValueHandler, stack []int, depth int, state *handlerState,
+) (int, []int, string, error) {
   var top, cs, p, pp int
   var err error
+  var expected string
   pe := len(data)
   eof := len(data)
 
@@ -12,6 +21,10 @@ machine handleArrayValues;
 include skipper "skip_machine.rl";
 
 prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
   if top + 1 >= len(stack) {
     stack = append(stack, make([]int, 1 + top - len(stack))...)
   }
@@ -20,15 +33,15 @@ prepush {
 action try_handler {
   pp, err = handler.HandleArrayValue(data[p:])
   if err != nil {
-    return p + pp, stack, err
+    return p + pp, stack, "", err
   }
   if pp < 0 {
-    err = errPOutOfRange
+    err = ErrPOutOfRange
     fbreak;
   }
   if pp != 0 {
     if p + pp - 1 >= pe {
-      err = errPOutOfRange
+      err = ErrPOutOfRange
       fbreak;
     }
     fexec p + pp - 1;
@@ -38,7 +51,7 @@ action try_handler {
 action try_handler_simple {
   _, err = handler.HandleArrayValue(data[p:])
   if err != nil {
-    return p, stack, err
+    return p, stack, "", err
   }
 }
 
@@ -46,29 +59,166 @@ skip_array := skip_array_def;
 skip_object := skip_object_def;
 
 handled_value =
- json_bool >(try_handler_simple)
- | json_null >(try_handler_simple)
- | json_number >(try_handler_simple)
- | json_string >(try_handler)
- | '[' >(try_handler) @{fcall skip_array;}
- | '{' >(try_handler) @{fcall skip_object;}
+ skip_json_literal >wait_for_value >(try_handler_simple)
+ | ( json_number <>err(expect_digit) ) >wait_for_value >(try_handler_simple)
+ | skip_json_string >wait_for_value >(try_handler)
+ | '[' >wait_for_value >(try_handler) @{fcall skip_array;}
+ | '{' >wait_for_value >(try_handler) @{fcall skip_object;}
 ;
 
 main :=
   ( json_space* (
-  json_null |
+  json_null <>err(expect_null) |
   ('['
-    (
-      json_space* handled_value
-      (json_space* ',' json_space* handled_value )*
-    )?
-    json_space* ']'
-  ))) @err{
-        return p, stack, errInvalidArray
+    json_space* (
+      ']'
+      | handled_value
+        ( json_space* $err(expect_comma_or_array_end) ',' json_space* handled_value >err(expect_value) )*
+        json_space* $err(expect_comma_or_array_end) ']'
+    ) >err(expect_value_or_array_end)
+  )) >err(expect_array)) @err{
+        return p, stack, expected, ErrInvalidArray
       };
 
+write data;
+}%%
+
+%%{
+write init;
+}%%
+  if state != nil {
+    if state.cs != 0 {
+      cs, top = state.cs, state.top
+    }
+    p, stack = state.p, state.stack
+    if !state.atEOF {
+      eof = -1
+    }
+  }
+
+%%{
+write exec;
+}%%
+
+  // The machine is in its error state without an error when it read the byte after the end of the value.
+  if state != nil {
+    state.save(cs, top, p, stack, cs >= handleArrayValues_first_final || cs == handleArrayValues_error)
+  }
+return p, stack, expected, err
+}
+
+// handleArrayValuesChecked is handleArrayValues with the checks for buffer's Limits, StrictUTF8 and
+// RejectDuplicateKeys. Strings and numbers are checked before they are passed to handler. It also reads JSONC when
+// buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func handleArrayValuesChecked(
+  data []byte, handler ArrayValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+  var top, cs, p, pp, tokenStart, count int
+  var err error
+  var expected string
+  pe := buffer.documentEnd(data)
+  eof := pe
+
+%%{
+machine handleArrayValuesChecked;
+
+include skipper "skip_machine.rl";
+
+prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth + 1 >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+action check_depth {
+  if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+}
+
+action count_handled_element {
+  count++
+  if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+    return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+  }
+}
+
+action try_handler {
+  pp, err = handler.HandleArrayValue(data[p:])
+  if err != nil {
+    return p + pp, stack, "", err
+  }
+  if pp < 0 {
+    err = ErrPOutOfRange
+    fbreak;
+  }
+  if pp != 0 {
+    if p + pp - 1 >= pe {
+      if p + pp <= len(data) {
+        return pe - 1, stack, "", buffer.documentSizeError(data)
+      }
+      err = ErrPOutOfRange
+      fbreak;
+    }
+    fexec p + pp - 1;
+  }
+}
+
+action try_handler_simple {
+  _, err = handler.HandleArrayValue(data[p:])
+  if err != nil {
+    return p, stack, "", err
+  }
+}
+
+# try_handler_checked runs the handler on a string or number after it has been checked.
+action try_handler_checked {
+  _, err = handler.HandleArrayValue(data[tokenStart:])
+  if err != nil {
+    return tokenStart, stack, "", err
+  }
+}
+
+checked_array := checked_array_def;
+checked_object := checked_object_def;
+
+# Elements are counted before the handler is called.
+handled_value =
+ skip_json_literal >count_handled_element >(try_handler_simple)
+ | ( json_number <>err(expect_digit) | non_finite_number ) >start_token >count_handled_element %check_number %(try_handler_checked)
+ | checked_json_string >count_handled_element @(try_handler_checked)
+ | '[' >count_handled_element >(try_handler) @{fcall checked_array;}
+ | '{' >count_handled_element >(try_handler) @{fcall checked_object;}
+;
+
+main :=
+  ( jsonc_space* (
+  json_null <>err(expect_null) |
+  ('[' @check_depth
+    jsonc_space* (
+      ']'
+      | handled_value
+        ( jsonc_space* $err(expect_comma_or_array_end) ',' jsonc_space* handled_value >err(expect_value) )*
+        jsonc_space* $err(expect_comma_or_array_end) ( ']' | ',' jsonc_space* ( ']' when jsonc ) )
+    ) >err(expect_value_or_array_end)
+  )) >err(expect_array)) @err{
+    err = ErrInvalidArray
+    fhold; fbreak;
+  };
+
 write data; write init;  write exec;
 }%%
 
-return p, stack, err
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
 }
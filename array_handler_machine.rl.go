@@ -2,9 +2,18 @@
 
 package rjson
 
-func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int, []int, error) {
+// handleArrayValues is HandleArrayValues without the Buffer options. depth is the number of objects and arrays that
+// data is nested in. It counts toward the depth limit for values that are skipped. expected is set like it is by
+// skipValue when the machine finds data invalid. It is empty when the error came from handler.
+//
+// state is nil unless StreamReader is running the machine on input that arrives in pieces. Then the machine starts
+// from state and saves its state there when it gets to the end of data.
+func handleArrayValues(
+	data []byte, handler ArrayValueHandler, stack []int, depth int, state *handlerState,
+) (int, []int, string, error) {
 	var top, cs, p, pp int
 	var err error
+	var expected string
 	pe := len(data)
 	eof := len(data)
 
@@ -21,7 +30,18 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		top = 0
 	}
 
+	if state != nil {
+		if state.cs != 0 {
+			cs, top = state.cs, state.top
+		}
+		p, stack = state.p, state.stack
+		if !state.atEOF {
+			eof = -1
+		}
+	}
+
 	{
+		var _ps int = 0
 		if p == pe {
 			goto _test_eof
 		}
@@ -163,6 +183,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st64
 		case 65:
 			goto st65
+		case 244:
+			goto st244
 		case 66:
 			goto st66
 		case 67:
@@ -197,8 +219,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st81
 		case 82:
 			goto st82
-		case 244:
-			goto st244
+		case 245:
+			goto st245
 		case 83:
 			goto st83
 		case 84:
@@ -221,8 +243,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st92
 		case 93:
 			goto st93
-		case 245:
-			goto st245
+		case 246:
+			goto st246
 		case 94:
 			goto st94
 		case 95:
@@ -307,6 +329,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st134
 		case 135:
 			goto st135
+		case 247:
+			goto st247
 		case 136:
 			goto st136
 		case 137:
@@ -381,8 +405,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st171
 		case 172:
 			goto st172
-		case 246:
-			goto st246
+		case 248:
+			goto st248
 		case 173:
 			goto st173
 		case 174:
@@ -523,6 +547,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st241
 		case 242:
 			goto st242
+		case 249:
+			goto st249
 		}
 
 		if p++; p == pe {
@@ -664,6 +690,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_64
 		case 65:
 			goto st_case_65
+		case 244:
+			goto st_case_244
 		case 66:
 			goto st_case_66
 		case 67:
@@ -698,8 +726,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_81
 		case 82:
 			goto st_case_82
-		case 244:
-			goto st_case_244
+		case 245:
+			goto st_case_245
 		case 83:
 			goto st_case_83
 		case 84:
@@ -722,8 +750,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_92
 		case 93:
 			goto st_case_93
-		case 245:
-			goto st_case_245
+		case 246:
+			goto st_case_246
 		case 94:
 			goto st_case_94
 		case 95:
@@ -808,6 +836,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_134
 		case 135:
 			goto st_case_135
+		case 247:
+			goto st_case_247
 		case 136:
 			goto st_case_136
 		case 137:
@@ -882,8 +912,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_171
 		case 172:
 			goto st_case_172
-		case 246:
-			goto st_case_246
+		case 248:
+			goto st_case_248
 		case 173:
 			goto st_case_173
 		case 174:
@@ -1024,6 +1054,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st_case_241
 		case 242:
 			goto st_case_242
+		case 249:
+			goto st_case_249
 		}
 		goto st_out
 	st1:
@@ -1046,20 +1078,285 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		goto tr0
 	tr0:
+		expected = "array"
 
-		return p, stack, errInvalidArray
+		return p, stack, expected, ErrInvalidArray
 
 		goto st0
-	tr88:
-		err = errInvalidArray
+	tr4:
+		expected = "value or ']'"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr16:
+		expected = "string character"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr20:
+		expected = "',' or ']'"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr24:
+		expected = "value"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr38:
+		expected = "escape sequence"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr41:
+		expected = "hex digit"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr46:
+		expected = "digit"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr57:
+		expected = "false"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr62:
+		expected = "null"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr66:
+		expected = "true"
+
+		return p, stack, expected, ErrInvalidArray
+
+		goto st0
+	tr99:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
 		{
 			p++
 			cs = 0
 			goto _out
 		}
 		goto st0
-	tr156:
-		err = errInvalidObject
+	tr111:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr115:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr119:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr133:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr136:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr141:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr145:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr150:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr154:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr177:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr181:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr185:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr188:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr202:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr206:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr227:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr230:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr235:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr239:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr244:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr248:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
 		{
 			p++
 			cs = 0
@@ -1094,85 +1391,97 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto _test_eof3
 		}
 	st_case_3:
+		_ps = 3
 		switch data[p] {
 		case 13:
 			goto st4
 		case 32:
 			goto st4
 		case 34:
-			goto tr5
-		case 45:
 			goto tr6
-		case 48:
+		case 45:
 			goto tr7
+		case 48:
+			goto tr8
 		case 91:
-			goto tr9
+			goto tr10
 		case 93:
-			goto st243
+			goto st244
 		case 102:
-			goto tr11
-		case 110:
 			goto tr12
-		case 116:
+		case 110:
 			goto tr13
-		case 123:
+		case 116:
 			goto tr14
+		case 123:
+			goto tr15
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr8
+				goto tr9
 			}
 		case data[p] >= 9:
 			goto st4
 		}
-		goto tr0
+		goto tr4
 	st4:
 		if p++; p == pe {
 			goto _test_eof4
 		}
 	st_case_4:
+		_ps = 4
 		switch data[p] {
 		case 13:
 			goto st4
 		case 32:
 			goto st4
 		case 34:
-			goto tr5
-		case 45:
 			goto tr6
-		case 48:
+		case 45:
 			goto tr7
+		case 48:
+			goto tr8
 		case 91:
-			goto tr9
+			goto tr10
 		case 93:
-			goto st243
+			goto st244
 		case 102:
-			goto tr11
-		case 110:
 			goto tr12
-		case 116:
+		case 110:
 			goto tr13
-		case 123:
+		case 116:
 			goto tr14
+		case 123:
+			goto tr15
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr8
+				goto tr9
 			}
 		case data[p] >= 9:
 			goto st4
 		}
-		goto tr0
-	tr5:
+		goto tr4
+	tr6:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 5
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 5
@@ -1181,7 +1490,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 5
@@ -1205,7 +1514,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st47
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st6
 	st6:
@@ -1220,7 +1529,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st47
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st6
 	st7:
@@ -1241,7 +1550,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st8:
 		if p++; p == pe {
 			goto _test_eof8
@@ -1260,87 +1569,99 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st9:
 		if p++; p == pe {
 			goto _test_eof9
 		}
 	st_case_9:
+		_ps = 9
 		switch data[p] {
 		case 13:
 			goto st10
 		case 32:
 			goto st10
 		case 34:
-			goto tr21
+			goto tr26
 		case 45:
-			goto tr22
+			goto tr27
 		case 48:
-			goto tr23
+			goto tr28
 		case 91:
-			goto tr25
+			goto tr30
 		case 102:
-			goto tr26
+			goto tr31
 		case 110:
-			goto tr27
+			goto tr32
 		case 116:
-			goto tr28
+			goto tr33
 		case 123:
-			goto tr29
+			goto tr34
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr24
+				goto tr29
 			}
 		case data[p] >= 9:
 			goto st10
 		}
-		goto tr0
+		goto tr24
 	st10:
 		if p++; p == pe {
 			goto _test_eof10
 		}
 	st_case_10:
+		_ps = 10
 		switch data[p] {
 		case 13:
 			goto st10
 		case 32:
 			goto st10
 		case 34:
-			goto tr21
+			goto tr26
 		case 45:
-			goto tr22
+			goto tr27
 		case 48:
-			goto tr23
+			goto tr28
 		case 91:
-			goto tr25
+			goto tr30
 		case 102:
-			goto tr26
+			goto tr31
 		case 110:
-			goto tr27
+			goto tr32
 		case 116:
-			goto tr28
+			goto tr33
 		case 123:
-			goto tr29
+			goto tr34
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr24
+				goto tr29
 			}
 		case data[p] >= 9:
 			goto st10
 		}
-		goto tr0
-	tr21:
+		goto tr24
+	tr26:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 11
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 11
@@ -1349,7 +1670,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 11
@@ -1373,7 +1694,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st14
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st12
 	st12:
@@ -1388,7 +1709,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st14
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st12
 	st13:
@@ -1409,7 +1730,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st243:
 		if p++; p == pe {
 			goto _test_eof243
@@ -1441,7 +1762,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st16
 		}
-		goto tr0
+		goto tr38
 	st15:
 		if p++; p == pe {
 			goto _test_eof15
@@ -1454,7 +1775,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st14
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st12
 	st16:
@@ -1474,7 +1795,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st17
 		}
-		goto tr0
+		goto tr41
 	st17:
 		if p++; p == pe {
 			goto _test_eof17
@@ -1492,7 +1813,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st18
 		}
-		goto tr0
+		goto tr41
 	st18:
 		if p++; p == pe {
 			goto _test_eof18
@@ -1510,7 +1831,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st19
 		}
-		goto tr0
+		goto tr41
 	st19:
 		if p++; p == pe {
 			goto _test_eof19
@@ -1528,7 +1849,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st20
 		}
-		goto tr0
+		goto tr41
 	st20:
 		if p++; p == pe {
 			goto _test_eof20
@@ -1541,14 +1862,24 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st14
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st12
-	tr22:
+	tr27:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 21
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st21
@@ -1563,12 +1894,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st30
 		}
-		goto tr0
-	tr23:
+		goto tr46
+	tr28:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 22
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st22
@@ -1596,7 +1937,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st23:
 		if p++; p == pe {
 			goto _test_eof23
@@ -1605,7 +1946,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st24
 		}
-		goto tr0
+		goto tr46
 	st24:
 		if p++; p == pe {
 			goto _test_eof24
@@ -1633,7 +1974,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st25:
 		if p++; p == pe {
 			goto _test_eof25
@@ -1661,7 +2002,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st26:
 		if p++; p == pe {
 			goto _test_eof26
@@ -1676,7 +2017,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st28
 		}
-		goto tr0
+		goto tr46
 	st27:
 		if p++; p == pe {
 			goto _test_eof27
@@ -1685,7 +2026,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st28
 		}
-		goto tr0
+		goto tr46
 	st28:
 		if p++; p == pe {
 			goto _test_eof28
@@ -1709,7 +2050,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st29:
 		if p++; p == pe {
 			goto _test_eof29
@@ -1733,12 +2074,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
-	tr24:
+		goto tr20
+	tr29:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 30
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st30
@@ -1771,7 +2122,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st31:
 		if p++; p == pe {
 			goto _test_eof31
@@ -1801,15 +2152,25 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
-	tr25:
+		goto tr20
+	tr30:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 32
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 32
@@ -1818,7 +2179,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 32
@@ -1830,6 +2191,14 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -1858,12 +2227,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr26:
+		goto tr20
+	tr31:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 33
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st33
@@ -1875,7 +2254,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st34
 		}
-		goto tr0
+		goto tr57
 	st34:
 		if p++; p == pe {
 			goto _test_eof34
@@ -1884,7 +2263,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st35
 		}
-		goto tr0
+		goto tr57
 	st35:
 		if p++; p == pe {
 			goto _test_eof35
@@ -1893,7 +2272,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st36
 		}
-		goto tr0
+		goto tr57
 	st36:
 		if p++; p == pe {
 			goto _test_eof36
@@ -1902,7 +2281,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st37
 		}
-		goto tr0
+		goto tr57
 	st37:
 		if p++; p == pe {
 			goto _test_eof37
@@ -1921,12 +2300,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr27:
+		goto tr20
+	tr32:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 38
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st38
@@ -1938,7 +2327,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st39
 		}
-		goto tr0
+		goto tr62
 	st39:
 		if p++; p == pe {
 			goto _test_eof39
@@ -1947,7 +2336,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st40
 		}
-		goto tr0
+		goto tr62
 	st40:
 		if p++; p == pe {
 			goto _test_eof40
@@ -1956,7 +2345,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st41
 		}
-		goto tr0
+		goto tr62
 	st41:
 		if p++; p == pe {
 			goto _test_eof41
@@ -1975,12 +2364,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr28:
+		goto tr20
+	tr33:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 42
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st42
@@ -1992,7 +2391,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st43
 		}
-		goto tr0
+		goto tr66
 	st43:
 		if p++; p == pe {
 			goto _test_eof43
@@ -2001,7 +2400,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st44
 		}
-		goto tr0
+		goto tr66
 	st44:
 		if p++; p == pe {
 			goto _test_eof44
@@ -2010,7 +2409,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st45
 		}
-		goto tr0
+		goto tr66
 	st45:
 		if p++; p == pe {
 			goto _test_eof45
@@ -2029,15 +2428,25 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr29:
+		goto tr20
+	tr34:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 46
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 46
@@ -2046,7 +2455,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 46
@@ -2058,6 +2467,14 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -2086,7 +2503,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st47:
 		if p++; p == pe {
 			goto _test_eof47
@@ -2112,7 +2529,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st49
 		}
-		goto tr0
+		goto tr38
 	st48:
 		if p++; p == pe {
 			goto _test_eof48
@@ -2125,7 +2542,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st47
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st6
 	st49:
@@ -2145,7 +2562,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st50
 		}
-		goto tr0
+		goto tr41
 	st50:
 		if p++; p == pe {
 			goto _test_eof50
@@ -2163,7 +2580,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st51
 		}
-		goto tr0
+		goto tr41
 	st51:
 		if p++; p == pe {
 			goto _test_eof51
@@ -2181,7 +2598,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st52
 		}
-		goto tr0
+		goto tr41
 	st52:
 		if p++; p == pe {
 			goto _test_eof52
@@ -2199,7 +2616,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st53
 		}
-		goto tr0
+		goto tr41
 	st53:
 		if p++; p == pe {
 			goto _test_eof53
@@ -2212,14 +2629,24 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st47
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr16
 		}
 		goto st6
-	tr6:
+	tr7:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 54
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st54
@@ -2234,12 +2661,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st63
 		}
-		goto tr0
-	tr7:
+		goto tr46
+	tr8:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 55
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st55
@@ -2267,7 +2704,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st56:
 		if p++; p == pe {
 			goto _test_eof56
@@ -2276,7 +2713,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st57
 		}
-		goto tr0
+		goto tr46
 	st57:
 		if p++; p == pe {
 			goto _test_eof57
@@ -2304,7 +2741,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st58:
 		if p++; p == pe {
 			goto _test_eof58
@@ -2332,7 +2769,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st59:
 		if p++; p == pe {
 			goto _test_eof59
@@ -2347,7 +2784,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st61
 		}
-		goto tr0
+		goto tr46
 	st60:
 		if p++; p == pe {
 			goto _test_eof60
@@ -2356,7 +2793,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st61
 		}
-		goto tr0
+		goto tr46
 	st61:
 		if p++; p == pe {
 			goto _test_eof61
@@ -2380,7 +2817,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st62:
 		if p++; p == pe {
 			goto _test_eof62
@@ -2404,12 +2841,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
-	tr8:
+		goto tr20
+	tr9:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 63
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st63
@@ -2442,7 +2889,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st64:
 		if p++; p == pe {
 			goto _test_eof64
@@ -2472,15 +2919,25 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st8
 		}
-		goto tr0
-	tr9:
+		goto tr20
+	tr10:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 65
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 65
@@ -2489,7 +2946,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 65
@@ -2501,6 +2958,14 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -2529,12 +2994,28 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr11:
+		goto tr20
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		goto st0
+	tr12:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 66
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st66
@@ -2546,7 +3027,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st67
 		}
-		goto tr0
+		goto tr57
 	st67:
 		if p++; p == pe {
 			goto _test_eof67
@@ -2555,7 +3036,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st68
 		}
-		goto tr0
+		goto tr57
 	st68:
 		if p++; p == pe {
 			goto _test_eof68
@@ -2564,7 +3045,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st69
 		}
-		goto tr0
+		goto tr57
 	st69:
 		if p++; p == pe {
 			goto _test_eof69
@@ -2573,7 +3054,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st70
 		}
-		goto tr0
+		goto tr57
 	st70:
 		if p++; p == pe {
 			goto _test_eof70
@@ -2592,12 +3073,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr12:
+		goto tr20
+	tr13:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 71
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st71
@@ -2609,7 +3100,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st72
 		}
-		goto tr0
+		goto tr62
 	st72:
 		if p++; p == pe {
 			goto _test_eof72
@@ -2618,7 +3109,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st73
 		}
-		goto tr0
+		goto tr62
 	st73:
 		if p++; p == pe {
 			goto _test_eof73
@@ -2627,7 +3118,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st74
 		}
-		goto tr0
+		goto tr62
 	st74:
 		if p++; p == pe {
 			goto _test_eof74
@@ -2646,12 +3137,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr13:
+		goto tr20
+	tr14:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 75
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st75
@@ -2663,7 +3164,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st76
 		}
-		goto tr0
+		goto tr66
 	st76:
 		if p++; p == pe {
 			goto _test_eof76
@@ -2672,7 +3173,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st77
 		}
-		goto tr0
+		goto tr66
 	st77:
 		if p++; p == pe {
 			goto _test_eof77
@@ -2681,7 +3182,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st78
 		}
-		goto tr0
+		goto tr66
 	st78:
 		if p++; p == pe {
 			goto _test_eof78
@@ -2700,15 +3201,25 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
-	tr14:
+		goto tr20
+	tr15:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 79
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleArrayValue(data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 79
@@ -2717,7 +3228,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 79
@@ -2729,6 +3240,14 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -2757,7 +3276,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st8
 		}
-		goto tr0
+		goto tr20
 	st80:
 		if p++; p == pe {
 			goto _test_eof80
@@ -2766,7 +3285,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st81
 		}
-		goto tr0
+		goto tr62
 	st81:
 		if p++; p == pe {
 			goto _test_eof81
@@ -2775,21 +3294,21 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st82
 		}
-		goto tr0
+		goto tr62
 	st82:
 		if p++; p == pe {
 			goto _test_eof82
 		}
 	st_case_82:
 		if data[p] == 108 {
-			goto st244
+			goto st245
 		}
-		goto tr0
-	st244:
+		goto tr62
+	st245:
 		if p++; p == pe {
-			goto _test_eof244
+			goto _test_eof245
 		}
-	st_case_244:
+	st_case_245:
 		goto st0
 	st83:
 		if p++; p == pe {
@@ -2808,9 +3327,9 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st130
 		case 91:
-			goto tr94
+			goto tr105
 		case 93:
-			goto tr95
+			goto tr106
 		case 102:
 			goto st136
 		case 110:
@@ -2818,7 +3337,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st145
 		case 123:
-			goto tr99
+			goto tr110
 		}
 		switch {
 		case data[p] > 10:
@@ -2828,7 +3347,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st84
 		}
-		goto tr88
+		goto tr99
 	st84:
 		if p++; p == pe {
 			goto _test_eof84
@@ -2846,9 +3365,9 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st130
 		case 91:
-			goto tr94
+			goto tr105
 		case 93:
-			goto tr95
+			goto tr106
 		case 102:
 			goto st136
 		case 110:
@@ -2856,7 +3375,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st145
 		case 123:
-			goto tr99
+			goto tr110
 		}
 		switch {
 		case data[p] > 10:
@@ -2866,7 +3385,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st84
 		}
-		goto tr88
+		goto tr99
 	st85:
 		if p++; p == pe {
 			goto _test_eof85
@@ -2879,7 +3398,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st122
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st86
 	st86:
@@ -2894,7 +3413,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st122
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st86
 	st87:
@@ -2910,12 +3429,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st88:
 		if p++; p == pe {
 			goto _test_eof88
@@ -2929,12 +3448,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st89:
 		if p++; p == pe {
 			goto _test_eof89
@@ -2952,7 +3471,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st102
 		case 91:
-			goto tr110
+			goto tr125
 		case 102:
 			goto st108
 		case 110:
@@ -2960,7 +3479,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st117
 		case 123:
-			goto tr114
+			goto tr129
 		}
 		switch {
 		case data[p] > 10:
@@ -2970,7 +3489,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st90
 		}
-		goto tr88
+		goto tr119
 	st90:
 		if p++; p == pe {
 			goto _test_eof90
@@ -2988,7 +3507,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st102
 		case 91:
-			goto tr110
+			goto tr125
 		case 102:
 			goto st108
 		case 110:
@@ -2996,7 +3515,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st117
 		case 123:
-			goto tr114
+			goto tr129
 		}
 		switch {
 		case data[p] > 10:
@@ -3006,7 +3525,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st90
 		}
-		goto tr88
+		goto tr119
 	st91:
 		if p++; p == pe {
 			goto _test_eof91
@@ -3019,7 +3538,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st94
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st92
 	st92:
@@ -3034,7 +3553,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st94
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st92
 	st93:
@@ -3050,24 +3569,24 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr95:
+		goto tr115
+	tr118:
 		{
 			top--
 			cs = stack[top]
 			goto _again
 		}
-		goto st245
-	st245:
+		goto st246
+	st246:
 		if p++; p == pe {
-			goto _test_eof245
+			goto _test_eof246
 		}
-	st_case_245:
+	st_case_246:
 		goto st0
 	st94:
 		if p++; p == pe {
@@ -3094,7 +3613,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st96
 		}
-		goto tr88
+		goto tr133
 	st95:
 		if p++; p == pe {
 			goto _test_eof95
@@ -3107,7 +3626,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st94
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st92
 	st96:
@@ -3127,7 +3646,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st97
 		}
-		goto tr88
+		goto tr136
 	st97:
 		if p++; p == pe {
 			goto _test_eof97
@@ -3145,7 +3664,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st98
 		}
-		goto tr88
+		goto tr136
 	st98:
 		if p++; p == pe {
 			goto _test_eof98
@@ -3163,7 +3682,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st99
 		}
-		goto tr88
+		goto tr136
 	st99:
 		if p++; p == pe {
 			goto _test_eof99
@@ -3181,7 +3700,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st100
 		}
-		goto tr88
+		goto tr136
 	st100:
 		if p++; p == pe {
 			goto _test_eof100
@@ -3194,7 +3713,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st94
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st92
 	st101:
@@ -3208,7 +3727,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st105
 		}
-		goto tr88
+		goto tr141
 	st102:
 		if p++; p == pe {
 			goto _test_eof102
@@ -3222,22 +3741,23 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr124
+			goto tr142
 		case 69:
-			goto tr125
+			goto tr143
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr125
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr124:
+		goto tr115
+	tr142:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 103
@@ -3259,16 +3779,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr125:
+		goto tr115
+	tr143:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 104
@@ -3290,12 +3811,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st105:
 		if p++; p == pe {
 			goto _test_eof105
@@ -3309,13 +3830,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr124
+			goto tr142
 		case 69:
-			goto tr125
+			goto tr143
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr125
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -3325,7 +3846,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st106:
 		if p++; p == pe {
 			goto _test_eof106
@@ -3339,13 +3860,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr124
+			goto tr142
 		case 69:
-			goto tr125
+			goto tr143
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr125
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -3355,9 +3876,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st88
 		}
-		goto tr88
-	tr110:
+		goto tr115
+	tr125:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3381,12 +3910,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st108:
 		if p++; p == pe {
 			goto _test_eof108
@@ -3395,7 +3924,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st109
 		}
-		goto tr88
+		goto tr145
 	st109:
 		if p++; p == pe {
 			goto _test_eof109
@@ -3404,7 +3933,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st110
 		}
-		goto tr88
+		goto tr145
 	st110:
 		if p++; p == pe {
 			goto _test_eof110
@@ -3413,7 +3942,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st111
 		}
-		goto tr88
+		goto tr145
 	st111:
 		if p++; p == pe {
 			goto _test_eof111
@@ -3422,7 +3951,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st112
 		}
-		goto tr88
+		goto tr145
 	st112:
 		if p++; p == pe {
 			goto _test_eof112
@@ -3436,12 +3965,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st113:
 		if p++; p == pe {
 			goto _test_eof113
@@ -3450,7 +3979,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st114
 		}
-		goto tr88
+		goto tr150
 	st114:
 		if p++; p == pe {
 			goto _test_eof114
@@ -3459,7 +3988,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st115
 		}
-		goto tr88
+		goto tr150
 	st115:
 		if p++; p == pe {
 			goto _test_eof115
@@ -3468,7 +3997,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st116
 		}
-		goto tr88
+		goto tr150
 	st116:
 		if p++; p == pe {
 			goto _test_eof116
@@ -3482,12 +4011,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st117:
 		if p++; p == pe {
 			goto _test_eof117
@@ -3496,7 +4025,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st118
 		}
-		goto tr88
+		goto tr154
 	st118:
 		if p++; p == pe {
 			goto _test_eof118
@@ -3505,7 +4034,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st119
 		}
-		goto tr88
+		goto tr154
 	st119:
 		if p++; p == pe {
 			goto _test_eof119
@@ -3514,7 +4043,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st120
 		}
-		goto tr88
+		goto tr154
 	st120:
 		if p++; p == pe {
 			goto _test_eof120
@@ -3528,14 +4057,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr114:
+		goto tr115
+	tr129:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3559,12 +4096,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st122:
 		if p++; p == pe {
 			goto _test_eof122
@@ -3590,7 +4127,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st124
 		}
-		goto tr88
+		goto tr133
 	st123:
 		if p++; p == pe {
 			goto _test_eof123
@@ -3603,7 +4140,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st122
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st86
 	st124:
@@ -3623,7 +4160,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st125
 		}
-		goto tr88
+		goto tr136
 	st125:
 		if p++; p == pe {
 			goto _test_eof125
@@ -3641,7 +4178,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st126
 		}
-		goto tr88
+		goto tr136
 	st126:
 		if p++; p == pe {
 			goto _test_eof126
@@ -3659,7 +4196,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st127
 		}
-		goto tr88
+		goto tr136
 	st127:
 		if p++; p == pe {
 			goto _test_eof127
@@ -3677,7 +4214,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st128
 		}
-		goto tr88
+		goto tr136
 	st128:
 		if p++; p == pe {
 			goto _test_eof128
@@ -3690,7 +4227,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st122
 		}
 		if data[p] <= 31 {
-			goto tr88
+			goto tr111
 		}
 		goto st86
 	st129:
@@ -3704,7 +4241,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st133
 		}
-		goto tr88
+		goto tr141
 	st130:
 		if p++; p == pe {
 			goto _test_eof130
@@ -3718,22 +4255,23 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr143
+			goto tr164
 		case 69:
-			goto tr144
+			goto tr165
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr144
+			goto tr165
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr143:
+		goto tr115
+	tr164:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 131
@@ -3755,16 +4293,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr144:
+		goto tr115
+	tr165:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 132
@@ -3786,12 +4325,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st133:
 		if p++; p == pe {
 			goto _test_eof133
@@ -3805,13 +4344,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr143
+			goto tr164
 		case 69:
-			goto tr144
+			goto tr165
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr144
+			goto tr165
 		}
 		switch {
 		case data[p] > 10:
@@ -3821,7 +4360,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st134:
 		if p++; p == pe {
 			goto _test_eof134
@@ -3835,13 +4374,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 46:
-			goto tr143
+			goto tr164
 		case 69:
-			goto tr144
+			goto tr165
 		case 93:
-			goto tr95
+			goto tr118
 		case 101:
-			goto tr144
+			goto tr165
 		}
 		switch {
 		case data[p] > 10:
@@ -3851,9 +4390,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st88
 		}
-		goto tr88
-	tr94:
+		goto tr115
+	tr105:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3877,12 +4424,25 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
+	tr106:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st247
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		goto st0
 	st136:
 		if p++; p == pe {
 			goto _test_eof136
@@ -3891,7 +4451,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st137
 		}
-		goto tr88
+		goto tr145
 	st137:
 		if p++; p == pe {
 			goto _test_eof137
@@ -3900,7 +4460,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st138
 		}
-		goto tr88
+		goto tr145
 	st138:
 		if p++; p == pe {
 			goto _test_eof138
@@ -3909,7 +4469,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st139
 		}
-		goto tr88
+		goto tr145
 	st139:
 		if p++; p == pe {
 			goto _test_eof139
@@ -3918,7 +4478,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st140
 		}
-		goto tr88
+		goto tr145
 	st140:
 		if p++; p == pe {
 			goto _test_eof140
@@ -3932,12 +4492,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st141:
 		if p++; p == pe {
 			goto _test_eof141
@@ -3946,7 +4506,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st142
 		}
-		goto tr88
+		goto tr150
 	st142:
 		if p++; p == pe {
 			goto _test_eof142
@@ -3955,7 +4515,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st143
 		}
-		goto tr88
+		goto tr150
 	st143:
 		if p++; p == pe {
 			goto _test_eof143
@@ -3964,7 +4524,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st144
 		}
-		goto tr88
+		goto tr150
 	st144:
 		if p++; p == pe {
 			goto _test_eof144
@@ -3978,12 +4538,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st145:
 		if p++; p == pe {
 			goto _test_eof145
@@ -3992,7 +4552,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st146
 		}
-		goto tr88
+		goto tr154
 	st146:
 		if p++; p == pe {
 			goto _test_eof146
@@ -4001,7 +4561,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st147
 		}
-		goto tr88
+		goto tr154
 	st147:
 		if p++; p == pe {
 			goto _test_eof147
@@ -4010,7 +4570,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st148
 		}
-		goto tr88
+		goto tr154
 	st148:
 		if p++; p == pe {
 			goto _test_eof148
@@ -4024,14 +4584,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
-	tr99:
+		goto tr115
+	tr110:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -4055,12 +4623,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st89
 		case 93:
-			goto tr95
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st88
 		}
-		goto tr88
+		goto tr115
 	st150:
 		if p++; p == pe {
 			goto _test_eof150
@@ -4074,12 +4642,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 34:
 			goto st152
 		case 125:
-			goto tr159
+			goto tr180
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st151
 		}
-		goto tr156
+		goto tr177
 	st151:
 		if p++; p == pe {
 			goto _test_eof151
@@ -4093,12 +4661,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 34:
 			goto st152
 		case 125:
-			goto tr159
+			goto tr180
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st151
 		}
-		goto tr156
+		goto tr177
 	st152:
 		if p++; p == pe {
 			goto _test_eof152
@@ -4111,7 +4679,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st236
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st153
 	st153:
@@ -4126,7 +4694,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st236
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st153
 	st154:
@@ -4145,7 +4713,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st155
 		}
-		goto tr156
+		goto tr185
 	st155:
 		if p++; p == pe {
 			goto _test_eof155
@@ -4162,7 +4730,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st155
 		}
-		goto tr156
+		goto tr185
 	st156:
 		if p++; p == pe {
 			goto _test_eof156
@@ -4180,7 +4748,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st216
 		case 91:
-			goto tr170
+			goto tr194
 		case 102:
 			goto st222
 		case 110:
@@ -4188,7 +4756,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st231
 		case 123:
-			goto tr174
+			goto tr198
 		}
 		switch {
 		case data[p] > 10:
@@ -4198,7 +4766,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st157
 		}
-		goto tr156
+		goto tr188
 	st157:
 		if p++; p == pe {
 			goto _test_eof157
@@ -4216,7 +4784,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st216
 		case 91:
-			goto tr170
+			goto tr194
 		case 102:
 			goto st222
 		case 110:
@@ -4224,7 +4792,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st231
 		case 123:
-			goto tr174
+			goto tr198
 		}
 		switch {
 		case data[p] > 10:
@@ -4234,7 +4802,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st157
 		}
-		goto tr156
+		goto tr188
 	st158:
 		if p++; p == pe {
 			goto _test_eof158
@@ -4247,7 +4815,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st208
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st159
 	st159:
@@ -4262,7 +4830,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st208
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st159
 	st160:
@@ -4278,12 +4846,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st161:
 		if p++; p == pe {
 			goto _test_eof161
@@ -4297,12 +4865,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st162:
 		if p++; p == pe {
 			goto _test_eof162
@@ -4319,7 +4887,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st163
 		}
-		goto tr156
+		goto tr206
 	st163:
 		if p++; p == pe {
 			goto _test_eof163
@@ -4336,7 +4904,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st163
 		}
-		goto tr156
+		goto tr206
 	st164:
 		if p++; p == pe {
 			goto _test_eof164
@@ -4349,7 +4917,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st201
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st165
 	st165:
@@ -4364,7 +4932,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st201
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st165
 	st166:
@@ -4383,7 +4951,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st167
 		}
-		goto tr156
+		goto tr185
 	st167:
 		if p++; p == pe {
 			goto _test_eof167
@@ -4400,7 +4968,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st167
 		}
-		goto tr156
+		goto tr185
 	st168:
 		if p++; p == pe {
 			goto _test_eof168
@@ -4418,7 +4986,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st181
 		case 91:
-			goto tr192
+			goto tr219
 		case 102:
 			goto st187
 		case 110:
@@ -4426,7 +4994,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st196
 		case 123:
-			goto tr196
+			goto tr223
 		}
 		switch {
 		case data[p] > 10:
@@ -4436,7 +5004,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st169
 		}
-		goto tr156
+		goto tr188
 	st169:
 		if p++; p == pe {
 			goto _test_eof169
@@ -4454,7 +5022,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 48:
 			goto st181
 		case 91:
-			goto tr192
+			goto tr219
 		case 102:
 			goto st187
 		case 110:
@@ -4462,7 +5030,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 116:
 			goto st196
 		case 123:
-			goto tr196
+			goto tr223
 		}
 		switch {
 		case data[p] > 10:
@@ -4472,7 +5040,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st169
 		}
-		goto tr156
+		goto tr188
 	st170:
 		if p++; p == pe {
 			goto _test_eof170
@@ -4485,7 +5053,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st173
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st171
 	st171:
@@ -4500,7 +5068,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st173
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st171
 	st172:
@@ -4516,24 +5084,24 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr159:
+		goto tr202
+	tr205:
 		{
 			top--
 			cs = stack[top]
 			goto _again
 		}
-		goto st246
-	st246:
+		goto st248
+	st248:
 		if p++; p == pe {
-			goto _test_eof246
+			goto _test_eof248
 		}
-	st_case_246:
+	st_case_248:
 		goto st0
 	st173:
 		if p++; p == pe {
@@ -4560,7 +5128,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st175
 		}
-		goto tr156
+		goto tr227
 	st174:
 		if p++; p == pe {
 			goto _test_eof174
@@ -4573,7 +5141,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st173
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st171
 	st175:
@@ -4593,7 +5161,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st176
 		}
-		goto tr156
+		goto tr230
 	st176:
 		if p++; p == pe {
 			goto _test_eof176
@@ -4611,7 +5179,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st177
 		}
-		goto tr156
+		goto tr230
 	st177:
 		if p++; p == pe {
 			goto _test_eof177
@@ -4629,7 +5197,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st178
 		}
-		goto tr156
+		goto tr230
 	st178:
 		if p++; p == pe {
 			goto _test_eof178
@@ -4647,7 +5215,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st179
 		}
-		goto tr156
+		goto tr230
 	st179:
 		if p++; p == pe {
 			goto _test_eof179
@@ -4660,7 +5228,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st173
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st171
 	st180:
@@ -4674,7 +5242,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st184
 		}
-		goto tr156
+		goto tr235
 	st181:
 		if p++; p == pe {
 			goto _test_eof181
@@ -4688,22 +5256,23 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr206
+			goto tr236
 		case 69:
-			goto tr207
+			goto tr237
 		case 101:
-			goto tr207
+			goto tr237
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr206:
+		goto tr202
+	tr236:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 182
@@ -4725,16 +5294,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr207:
+		goto tr202
+	tr237:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 183
@@ -4756,12 +5326,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st184:
 		if p++; p == pe {
 			goto _test_eof184
@@ -4775,13 +5345,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr206
+			goto tr236
 		case 69:
-			goto tr207
+			goto tr237
 		case 101:
-			goto tr207
+			goto tr237
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		switch {
 		case data[p] > 10:
@@ -4791,7 +5361,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st185:
 		if p++; p == pe {
 			goto _test_eof185
@@ -4805,13 +5375,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr206
+			goto tr236
 		case 69:
-			goto tr207
+			goto tr237
 		case 101:
-			goto tr207
+			goto tr237
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		switch {
 		case data[p] > 10:
@@ -4821,9 +5391,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st161
 		}
-		goto tr156
-	tr192:
+		goto tr202
+	tr219:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -4847,12 +5425,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st187:
 		if p++; p == pe {
 			goto _test_eof187
@@ -4861,7 +5439,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st188
 		}
-		goto tr156
+		goto tr239
 	st188:
 		if p++; p == pe {
 			goto _test_eof188
@@ -4870,7 +5448,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st189
 		}
-		goto tr156
+		goto tr239
 	st189:
 		if p++; p == pe {
 			goto _test_eof189
@@ -4879,7 +5457,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st190
 		}
-		goto tr156
+		goto tr239
 	st190:
 		if p++; p == pe {
 			goto _test_eof190
@@ -4888,7 +5466,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st191
 		}
-		goto tr156
+		goto tr239
 	st191:
 		if p++; p == pe {
 			goto _test_eof191
@@ -4902,12 +5480,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st192:
 		if p++; p == pe {
 			goto _test_eof192
@@ -4916,7 +5494,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st193
 		}
-		goto tr156
+		goto tr244
 	st193:
 		if p++; p == pe {
 			goto _test_eof193
@@ -4925,7 +5503,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st194
 		}
-		goto tr156
+		goto tr244
 	st194:
 		if p++; p == pe {
 			goto _test_eof194
@@ -4934,7 +5512,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st195
 		}
-		goto tr156
+		goto tr244
 	st195:
 		if p++; p == pe {
 			goto _test_eof195
@@ -4948,12 +5526,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st196:
 		if p++; p == pe {
 			goto _test_eof196
@@ -4962,7 +5540,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st197
 		}
-		goto tr156
+		goto tr248
 	st197:
 		if p++; p == pe {
 			goto _test_eof197
@@ -4971,7 +5549,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st198
 		}
-		goto tr156
+		goto tr248
 	st198:
 		if p++; p == pe {
 			goto _test_eof198
@@ -4980,7 +5558,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st199
 		}
-		goto tr156
+		goto tr248
 	st199:
 		if p++; p == pe {
 			goto _test_eof199
@@ -4994,14 +5572,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr196:
+		goto tr202
+	tr223:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -5025,12 +5611,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st201:
 		if p++; p == pe {
 			goto _test_eof201
@@ -5056,7 +5642,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st203
 		}
-		goto tr156
+		goto tr227
 	st202:
 		if p++; p == pe {
 			goto _test_eof202
@@ -5069,7 +5655,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st201
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st165
 	st203:
@@ -5089,7 +5675,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st204
 		}
-		goto tr156
+		goto tr230
 	st204:
 		if p++; p == pe {
 			goto _test_eof204
@@ -5107,7 +5693,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st205
 		}
-		goto tr156
+		goto tr230
 	st205:
 		if p++; p == pe {
 			goto _test_eof205
@@ -5125,7 +5711,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st206
 		}
-		goto tr156
+		goto tr230
 	st206:
 		if p++; p == pe {
 			goto _test_eof206
@@ -5143,7 +5729,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st207
 		}
-		goto tr156
+		goto tr230
 	st207:
 		if p++; p == pe {
 			goto _test_eof207
@@ -5156,7 +5742,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st201
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st165
 	st208:
@@ -5184,7 +5770,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st210
 		}
-		goto tr156
+		goto tr227
 	st209:
 		if p++; p == pe {
 			goto _test_eof209
@@ -5197,7 +5783,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st208
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st159
 	st210:
@@ -5217,7 +5803,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st211
 		}
-		goto tr156
+		goto tr230
 	st211:
 		if p++; p == pe {
 			goto _test_eof211
@@ -5235,7 +5821,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st212
 		}
-		goto tr156
+		goto tr230
 	st212:
 		if p++; p == pe {
 			goto _test_eof212
@@ -5253,7 +5839,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st213
 		}
-		goto tr156
+		goto tr230
 	st213:
 		if p++; p == pe {
 			goto _test_eof213
@@ -5271,7 +5857,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st214
 		}
-		goto tr156
+		goto tr230
 	st214:
 		if p++; p == pe {
 			goto _test_eof214
@@ -5284,7 +5870,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st208
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st159
 	st215:
@@ -5298,7 +5884,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st219
 		}
-		goto tr156
+		goto tr235
 	st216:
 		if p++; p == pe {
 			goto _test_eof216
@@ -5312,22 +5898,23 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr231
+			goto tr264
 		case 69:
-			goto tr232
+			goto tr265
 		case 101:
-			goto tr232
+			goto tr265
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr231:
+		goto tr202
+	tr264:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 217
@@ -5349,16 +5936,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr232:
+		goto tr202
+	tr265:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 218
@@ -5380,12 +5968,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st219:
 		if p++; p == pe {
 			goto _test_eof219
@@ -5399,13 +5987,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr231
+			goto tr264
 		case 69:
-			goto tr232
+			goto tr265
 		case 101:
-			goto tr232
+			goto tr265
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		switch {
 		case data[p] > 10:
@@ -5415,7 +6003,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st220:
 		if p++; p == pe {
 			goto _test_eof220
@@ -5429,13 +6017,13 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 46:
-			goto tr231
+			goto tr264
 		case 69:
-			goto tr232
+			goto tr265
 		case 101:
-			goto tr232
+			goto tr265
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		switch {
 		case data[p] > 10:
@@ -5445,9 +6033,17 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case data[p] >= 9:
 			goto st161
 		}
-		goto tr156
-	tr170:
+		goto tr202
+	tr194:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -5471,12 +6067,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st222:
 		if p++; p == pe {
 			goto _test_eof222
@@ -5485,7 +6081,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 97 {
 			goto st223
 		}
-		goto tr156
+		goto tr239
 	st223:
 		if p++; p == pe {
 			goto _test_eof223
@@ -5494,7 +6090,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st224
 		}
-		goto tr156
+		goto tr239
 	st224:
 		if p++; p == pe {
 			goto _test_eof224
@@ -5503,7 +6099,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 115 {
 			goto st225
 		}
-		goto tr156
+		goto tr239
 	st225:
 		if p++; p == pe {
 			goto _test_eof225
@@ -5512,7 +6108,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st226
 		}
-		goto tr156
+		goto tr239
 	st226:
 		if p++; p == pe {
 			goto _test_eof226
@@ -5526,12 +6122,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st227:
 		if p++; p == pe {
 			goto _test_eof227
@@ -5540,7 +6136,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st228
 		}
-		goto tr156
+		goto tr244
 	st228:
 		if p++; p == pe {
 			goto _test_eof228
@@ -5549,7 +6145,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st229
 		}
-		goto tr156
+		goto tr244
 	st229:
 		if p++; p == pe {
 			goto _test_eof229
@@ -5558,7 +6154,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 108 {
 			goto st230
 		}
-		goto tr156
+		goto tr244
 	st230:
 		if p++; p == pe {
 			goto _test_eof230
@@ -5572,12 +6168,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st231:
 		if p++; p == pe {
 			goto _test_eof231
@@ -5586,7 +6182,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 114 {
 			goto st232
 		}
-		goto tr156
+		goto tr248
 	st232:
 		if p++; p == pe {
 			goto _test_eof232
@@ -5595,7 +6191,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 117 {
 			goto st233
 		}
-		goto tr156
+		goto tr248
 	st233:
 		if p++; p == pe {
 			goto _test_eof233
@@ -5604,7 +6200,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		if data[p] == 101 {
 			goto st234
 		}
-		goto tr156
+		goto tr248
 	st234:
 		if p++; p == pe {
 			goto _test_eof234
@@ -5618,14 +6214,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
-	tr174:
+		goto tr202
+	tr198:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -5649,12 +6253,12 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 44:
 			goto st162
 		case 125:
-			goto tr159
+			goto tr205
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st161
 		}
-		goto tr156
+		goto tr202
 	st236:
 		if p++; p == pe {
 			goto _test_eof236
@@ -5680,7 +6284,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		case 117:
 			goto st238
 		}
-		goto tr156
+		goto tr227
 	st237:
 		if p++; p == pe {
 			goto _test_eof237
@@ -5693,7 +6297,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st236
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st153
 	st238:
@@ -5713,7 +6317,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st239
 		}
-		goto tr156
+		goto tr230
 	st239:
 		if p++; p == pe {
 			goto _test_eof239
@@ -5731,7 +6335,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st240
 		}
-		goto tr156
+		goto tr230
 	st240:
 		if p++; p == pe {
 			goto _test_eof240
@@ -5749,7 +6353,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st241
 		}
-		goto tr156
+		goto tr230
 	st241:
 		if p++; p == pe {
 			goto _test_eof241
@@ -5767,7 +6371,7 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 		default:
 			goto st242
 		}
-		goto tr156
+		goto tr230
 	st242:
 		if p++; p == pe {
 			goto _test_eof242
@@ -5780,9 +6384,22 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 			goto st236
 		}
 		if data[p] <= 31 {
-			goto tr156
+			goto tr181
 		}
 		goto st153
+	tr180:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st249
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		goto st0
 	st_out:
 	_test_eof1:
 		cs = 1
@@ -5982,6 +6599,9 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof65:
 		cs = 65
 		goto _test_eof
+	_test_eof244:
+		cs = 244
+		goto _test_eof
 	_test_eof66:
 		cs = 66
 		goto _test_eof
@@ -6033,8 +6653,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof82:
 		cs = 82
 		goto _test_eof
-	_test_eof244:
-		cs = 244
+	_test_eof245:
+		cs = 245
 		goto _test_eof
 	_test_eof83:
 		cs = 83
@@ -6069,8 +6689,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof93:
 		cs = 93
 		goto _test_eof
-	_test_eof245:
-		cs = 245
+	_test_eof246:
+		cs = 246
 		goto _test_eof
 	_test_eof94:
 		cs = 94
@@ -6198,6 +6818,9 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof135:
 		cs = 135
 		goto _test_eof
+	_test_eof247:
+		cs = 247
+		goto _test_eof
 	_test_eof136:
 		cs = 136
 		goto _test_eof
@@ -6309,8 +6932,8 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof172:
 		cs = 172
 		goto _test_eof
-	_test_eof246:
-		cs = 246
+	_test_eof248:
+		cs = 248
 		goto _test_eof
 	_test_eof173:
 		cs = 173
@@ -6522,49 +7145,13062 @@ func handleArrayValues(data []byte, handler ArrayValueHandler, stack []int) (int
 	_test_eof242:
 		cs = 242
 		goto _test_eof
+	_test_eof249:
+		cs = 249
+		goto _test_eof
 
 	_test_eof:
 		{
 		}
 		if p == eof {
 			switch cs {
-			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82:
+			case 9, 10:
+				expected = "value"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 3, 4:
+				expected = "value or ']'"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 7, 8, 13, 22, 24, 25, 28, 29, 30, 31, 32, 37, 41, 45, 46, 55, 57, 58, 61, 62, 63, 64, 65, 70, 74, 78, 79:
+				expected = "',' or ']'"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 14, 47:
+				expected = "escape sequence"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 16, 17, 18, 19, 49, 50, 51, 52:
+				expected = "hex digit"
 
-				return p, stack, errInvalidArray
+				return p, stack, expected, ErrInvalidArray
 
-			case 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149:
-				err = errUnexpectedEOF
+			case 21, 23, 26, 27, 54, 56, 59, 60:
+				expected = "digit"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 42, 43, 44, 75, 76, 77:
+				expected = "true"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 33, 34, 35, 36, 66, 67, 68, 69:
+				expected = "false"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 38, 39, 40, 71, 72, 73, 80, 81, 82:
+				expected = "null"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 1, 2:
+				expected = "array"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 89, 90:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidArray
+				err = ErrInvalidArray
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-			case 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242:
-				err = errUnexpectedEOF
+			case 156, 157, 168, 169:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidObject
+				err = ErrInvalidObject
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-			}
-		}
-
-	_out:
-		{
-		}
-	}
-
-	return p, stack, err
+			case 83, 84:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 87, 88, 93, 102, 103, 104, 105, 106, 107, 112, 116, 120, 121, 130, 131, 132, 133, 134, 135, 140, 144, 148, 149:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 150, 151:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 162, 163:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 154, 155, 166, 167:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 160, 161, 172, 181, 182, 183, 184, 185, 186, 191, 195, 199, 200, 216, 217, 218, 219, 220, 221, 226, 230, 234, 235:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 5, 6, 11, 12, 15, 20, 48, 53:
+				expected = "string character"
+				expected = "'\"'"
+
+				return p, stack, expected, ErrInvalidArray
+
+			case 94, 122:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 173, 201, 208, 236:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 96, 97, 98, 99, 124, 125, 126, 127:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 175, 176, 177, 178, 203, 204, 205, 206, 210, 211, 212, 213, 238, 239, 240, 241:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 101, 129:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 180, 215:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 117, 118, 119, 145, 146, 147:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 196, 197, 198, 231, 232, 233:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 108, 109, 110, 111, 136, 137, 138, 139:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 187, 188, 189, 190, 222, 223, 224, 225:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 113, 114, 115, 141, 142, 143:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 192, 193, 194, 227, 228, 229:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 85, 86, 91, 92, 95, 100, 123, 128:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 152, 153, 158, 159, 164, 165, 170, 171, 174, 179, 202, 207, 209, 214, 237, 242:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	// The machine is in its error state without an error when it read the byte after the end of the value.
+	if state != nil {
+		state.save(cs, top, p, stack, cs >= handleArrayValues_first_final || cs == handleArrayValues_error)
+	}
+	return p, stack, expected, err
+}
+
+// handleArrayValuesChecked is handleArrayValues with the checks for buffer's Limits, StrictUTF8 and
+// RejectDuplicateKeys. Strings and numbers are checked before they are passed to handler. It also reads JSONC when
+// buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func handleArrayValuesChecked(
+	data []byte, handler ArrayValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+	var top, cs, p, pp, tokenStart, count int
+	var err error
+	var expected string
+	pe := buffer.documentEnd(data)
+	eof := pe
+
+	const handleArrayValuesChecked_start int = 1
+	const handleArrayValuesChecked_first_final int = 345
+	const handleArrayValuesChecked_error int = 0
+
+	const handleArrayValuesChecked_en_checked_array int = 115
+	const handleArrayValuesChecked_en_checked_object int = 212
+	const handleArrayValuesChecked_en_main int = 1
+
+	{
+		cs = handleArrayValuesChecked_start
+		top = 0
+	}
+
+	{
+		var _widec int16
+		if p == pe {
+			goto _test_eof
+		}
+		goto _resume
+
+	_again:
+		switch cs {
+		case 1:
+			goto st1
+		case 0:
+			goto st0
+		case 2:
+			goto st2
+		case 3:
+			goto st3
+		case 4:
+			goto st4
+		case 5:
+			goto st5
+		case 6:
+			goto st6
+		case 7:
+			goto st7
+		case 8:
+			goto st8
+		case 9:
+			goto st9
+		case 10:
+			goto st10
+		case 11:
+			goto st11
+		case 12:
+			goto st12
+		case 13:
+			goto st13
+		case 345:
+			goto st345
+		case 14:
+			goto st14
+		case 15:
+			goto st15
+		case 16:
+			goto st16
+		case 17:
+			goto st17
+		case 18:
+			goto st18
+		case 19:
+			goto st19
+		case 20:
+			goto st20
+		case 21:
+			goto st21
+		case 22:
+			goto st22
+		case 23:
+			goto st23
+		case 24:
+			goto st24
+		case 25:
+			goto st25
+		case 26:
+			goto st26
+		case 27:
+			goto st27
+		case 28:
+			goto st28
+		case 29:
+			goto st29
+		case 30:
+			goto st30
+		case 31:
+			goto st31
+		case 32:
+			goto st32
+		case 33:
+			goto st33
+		case 34:
+			goto st34
+		case 35:
+			goto st35
+		case 36:
+			goto st36
+		case 37:
+			goto st37
+		case 38:
+			goto st38
+		case 39:
+			goto st39
+		case 40:
+			goto st40
+		case 41:
+			goto st41
+		case 42:
+			goto st42
+		case 43:
+			goto st43
+		case 44:
+			goto st44
+		case 45:
+			goto st45
+		case 46:
+			goto st46
+		case 47:
+			goto st47
+		case 48:
+			goto st48
+		case 49:
+			goto st49
+		case 50:
+			goto st50
+		case 51:
+			goto st51
+		case 52:
+			goto st52
+		case 53:
+			goto st53
+		case 54:
+			goto st54
+		case 55:
+			goto st55
+		case 56:
+			goto st56
+		case 57:
+			goto st57
+		case 58:
+			goto st58
+		case 59:
+			goto st59
+		case 346:
+			goto st346
+		case 60:
+			goto st60
+		case 61:
+			goto st61
+		case 62:
+			goto st62
+		case 63:
+			goto st63
+		case 64:
+			goto st64
+		case 65:
+			goto st65
+		case 66:
+			goto st66
+		case 67:
+			goto st67
+		case 68:
+			goto st68
+		case 69:
+			goto st69
+		case 70:
+			goto st70
+		case 71:
+			goto st71
+		case 72:
+			goto st72
+		case 73:
+			goto st73
+		case 74:
+			goto st74
+		case 75:
+			goto st75
+		case 76:
+			goto st76
+		case 77:
+			goto st77
+		case 78:
+			goto st78
+		case 79:
+			goto st79
+		case 80:
+			goto st80
+		case 81:
+			goto st81
+		case 82:
+			goto st82
+		case 83:
+			goto st83
+		case 84:
+			goto st84
+		case 85:
+			goto st85
+		case 86:
+			goto st86
+		case 87:
+			goto st87
+		case 88:
+			goto st88
+		case 89:
+			goto st89
+		case 90:
+			goto st90
+		case 347:
+			goto st347
+		case 91:
+			goto st91
+		case 92:
+			goto st92
+		case 93:
+			goto st93
+		case 94:
+			goto st94
+		case 95:
+			goto st95
+		case 96:
+			goto st96
+		case 97:
+			goto st97
+		case 98:
+			goto st98
+		case 99:
+			goto st99
+		case 100:
+			goto st100
+		case 101:
+			goto st101
+		case 102:
+			goto st102
+		case 103:
+			goto st103
+		case 104:
+			goto st104
+		case 105:
+			goto st105
+		case 106:
+			goto st106
+		case 107:
+			goto st107
+		case 108:
+			goto st108
+		case 109:
+			goto st109
+		case 110:
+			goto st110
+		case 111:
+			goto st111
+		case 112:
+			goto st112
+		case 348:
+			goto st348
+		case 113:
+			goto st113
+		case 114:
+			goto st114
+		case 115:
+			goto st115
+		case 116:
+			goto st116
+		case 117:
+			goto st117
+		case 118:
+			goto st118
+		case 119:
+			goto st119
+		case 120:
+			goto st120
+		case 121:
+			goto st121
+		case 122:
+			goto st122
+		case 123:
+			goto st123
+		case 124:
+			goto st124
+		case 125:
+			goto st125
+		case 349:
+			goto st349
+		case 126:
+			goto st126
+		case 127:
+			goto st127
+		case 128:
+			goto st128
+		case 129:
+			goto st129
+		case 130:
+			goto st130
+		case 131:
+			goto st131
+		case 132:
+			goto st132
+		case 133:
+			goto st133
+		case 134:
+			goto st134
+		case 135:
+			goto st135
+		case 136:
+			goto st136
+		case 137:
+			goto st137
+		case 138:
+			goto st138
+		case 139:
+			goto st139
+		case 140:
+			goto st140
+		case 141:
+			goto st141
+		case 142:
+			goto st142
+		case 143:
+			goto st143
+		case 144:
+			goto st144
+		case 145:
+			goto st145
+		case 146:
+			goto st146
+		case 147:
+			goto st147
+		case 148:
+			goto st148
+		case 149:
+			goto st149
+		case 150:
+			goto st150
+		case 151:
+			goto st151
+		case 152:
+			goto st152
+		case 153:
+			goto st153
+		case 154:
+			goto st154
+		case 155:
+			goto st155
+		case 156:
+			goto st156
+		case 157:
+			goto st157
+		case 158:
+			goto st158
+		case 159:
+			goto st159
+		case 160:
+			goto st160
+		case 161:
+			goto st161
+		case 162:
+			goto st162
+		case 163:
+			goto st163
+		case 164:
+			goto st164
+		case 165:
+			goto st165
+		case 166:
+			goto st166
+		case 350:
+			goto st350
+		case 167:
+			goto st167
+		case 168:
+			goto st168
+		case 169:
+			goto st169
+		case 170:
+			goto st170
+		case 171:
+			goto st171
+		case 172:
+			goto st172
+		case 173:
+			goto st173
+		case 174:
+			goto st174
+		case 175:
+			goto st175
+		case 176:
+			goto st176
+		case 177:
+			goto st177
+		case 178:
+			goto st178
+		case 179:
+			goto st179
+		case 180:
+			goto st180
+		case 181:
+			goto st181
+		case 182:
+			goto st182
+		case 183:
+			goto st183
+		case 184:
+			goto st184
+		case 185:
+			goto st185
+		case 186:
+			goto st186
+		case 187:
+			goto st187
+		case 188:
+			goto st188
+		case 189:
+			goto st189
+		case 190:
+			goto st190
+		case 191:
+			goto st191
+		case 192:
+			goto st192
+		case 351:
+			goto st351
+		case 193:
+			goto st193
+		case 194:
+			goto st194
+		case 195:
+			goto st195
+		case 196:
+			goto st196
+		case 197:
+			goto st197
+		case 198:
+			goto st198
+		case 199:
+			goto st199
+		case 200:
+			goto st200
+		case 201:
+			goto st201
+		case 202:
+			goto st202
+		case 203:
+			goto st203
+		case 204:
+			goto st204
+		case 205:
+			goto st205
+		case 206:
+			goto st206
+		case 207:
+			goto st207
+		case 208:
+			goto st208
+		case 209:
+			goto st209
+		case 210:
+			goto st210
+		case 211:
+			goto st211
+		case 212:
+			goto st212
+		case 213:
+			goto st213
+		case 214:
+			goto st214
+		case 215:
+			goto st215
+		case 216:
+			goto st216
+		case 217:
+			goto st217
+		case 218:
+			goto st218
+		case 219:
+			goto st219
+		case 220:
+			goto st220
+		case 221:
+			goto st221
+		case 222:
+			goto st222
+		case 223:
+			goto st223
+		case 224:
+			goto st224
+		case 225:
+			goto st225
+		case 226:
+			goto st226
+		case 227:
+			goto st227
+		case 228:
+			goto st228
+		case 229:
+			goto st229
+		case 230:
+			goto st230
+		case 231:
+			goto st231
+		case 232:
+			goto st232
+		case 233:
+			goto st233
+		case 234:
+			goto st234
+		case 352:
+			goto st352
+		case 235:
+			goto st235
+		case 236:
+			goto st236
+		case 237:
+			goto st237
+		case 238:
+			goto st238
+		case 239:
+			goto st239
+		case 240:
+			goto st240
+		case 241:
+			goto st241
+		case 242:
+			goto st242
+		case 243:
+			goto st243
+		case 244:
+			goto st244
+		case 245:
+			goto st245
+		case 246:
+			goto st246
+		case 247:
+			goto st247
+		case 248:
+			goto st248
+		case 249:
+			goto st249
+		case 250:
+			goto st250
+		case 251:
+			goto st251
+		case 252:
+			goto st252
+		case 253:
+			goto st253
+		case 254:
+			goto st254
+		case 255:
+			goto st255
+		case 256:
+			goto st256
+		case 257:
+			goto st257
+		case 258:
+			goto st258
+		case 259:
+			goto st259
+		case 260:
+			goto st260
+		case 261:
+			goto st261
+		case 262:
+			goto st262
+		case 263:
+			goto st263
+		case 264:
+			goto st264
+		case 265:
+			goto st265
+		case 266:
+			goto st266
+		case 267:
+			goto st267
+		case 268:
+			goto st268
+		case 269:
+			goto st269
+		case 270:
+			goto st270
+		case 271:
+			goto st271
+		case 272:
+			goto st272
+		case 273:
+			goto st273
+		case 274:
+			goto st274
+		case 275:
+			goto st275
+		case 276:
+			goto st276
+		case 277:
+			goto st277
+		case 278:
+			goto st278
+		case 279:
+			goto st279
+		case 280:
+			goto st280
+		case 281:
+			goto st281
+		case 282:
+			goto st282
+		case 283:
+			goto st283
+		case 284:
+			goto st284
+		case 285:
+			goto st285
+		case 286:
+			goto st286
+		case 287:
+			goto st287
+		case 288:
+			goto st288
+		case 289:
+			goto st289
+		case 290:
+			goto st290
+		case 353:
+			goto st353
+		case 291:
+			goto st291
+		case 292:
+			goto st292
+		case 293:
+			goto st293
+		case 294:
+			goto st294
+		case 295:
+			goto st295
+		case 296:
+			goto st296
+		case 297:
+			goto st297
+		case 298:
+			goto st298
+		case 299:
+			goto st299
+		case 300:
+			goto st300
+		case 301:
+			goto st301
+		case 302:
+			goto st302
+		case 303:
+			goto st303
+		case 304:
+			goto st304
+		case 305:
+			goto st305
+		case 306:
+			goto st306
+		case 307:
+			goto st307
+		case 308:
+			goto st308
+		case 309:
+			goto st309
+		case 310:
+			goto st310
+		case 311:
+			goto st311
+		case 312:
+			goto st312
+		case 313:
+			goto st313
+		case 314:
+			goto st314
+		case 315:
+			goto st315
+		case 316:
+			goto st316
+		case 317:
+			goto st317
+		case 318:
+			goto st318
+		case 319:
+			goto st319
+		case 320:
+			goto st320
+		case 321:
+			goto st321
+		case 322:
+			goto st322
+		case 323:
+			goto st323
+		case 324:
+			goto st324
+		case 325:
+			goto st325
+		case 326:
+			goto st326
+		case 327:
+			goto st327
+		case 328:
+			goto st328
+		case 329:
+			goto st329
+		case 330:
+			goto st330
+		case 331:
+			goto st331
+		case 332:
+			goto st332
+		case 333:
+			goto st333
+		case 334:
+			goto st334
+		case 335:
+			goto st335
+		case 336:
+			goto st336
+		case 337:
+			goto st337
+		case 338:
+			goto st338
+		case 339:
+			goto st339
+		case 340:
+			goto st340
+		case 341:
+			goto st341
+		case 342:
+			goto st342
+		case 354:
+			goto st354
+		case 343:
+			goto st343
+		case 344:
+			goto st344
+		}
+
+		if p++; p == pe {
+			goto _test_eof
+		}
+	_resume:
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 13:
+			goto st_case_13
+		case 345:
+			goto st_case_345
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 19:
+			goto st_case_19
+		case 20:
+			goto st_case_20
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 28:
+			goto st_case_28
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 34:
+			goto st_case_34
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		case 51:
+			goto st_case_51
+		case 52:
+			goto st_case_52
+		case 53:
+			goto st_case_53
+		case 54:
+			goto st_case_54
+		case 55:
+			goto st_case_55
+		case 56:
+			goto st_case_56
+		case 57:
+			goto st_case_57
+		case 58:
+			goto st_case_58
+		case 59:
+			goto st_case_59
+		case 346:
+			goto st_case_346
+		case 60:
+			goto st_case_60
+		case 61:
+			goto st_case_61
+		case 62:
+			goto st_case_62
+		case 63:
+			goto st_case_63
+		case 64:
+			goto st_case_64
+		case 65:
+			goto st_case_65
+		case 66:
+			goto st_case_66
+		case 67:
+			goto st_case_67
+		case 68:
+			goto st_case_68
+		case 69:
+			goto st_case_69
+		case 70:
+			goto st_case_70
+		case 71:
+			goto st_case_71
+		case 72:
+			goto st_case_72
+		case 73:
+			goto st_case_73
+		case 74:
+			goto st_case_74
+		case 75:
+			goto st_case_75
+		case 76:
+			goto st_case_76
+		case 77:
+			goto st_case_77
+		case 78:
+			goto st_case_78
+		case 79:
+			goto st_case_79
+		case 80:
+			goto st_case_80
+		case 81:
+			goto st_case_81
+		case 82:
+			goto st_case_82
+		case 83:
+			goto st_case_83
+		case 84:
+			goto st_case_84
+		case 85:
+			goto st_case_85
+		case 86:
+			goto st_case_86
+		case 87:
+			goto st_case_87
+		case 88:
+			goto st_case_88
+		case 89:
+			goto st_case_89
+		case 90:
+			goto st_case_90
+		case 347:
+			goto st_case_347
+		case 91:
+			goto st_case_91
+		case 92:
+			goto st_case_92
+		case 93:
+			goto st_case_93
+		case 94:
+			goto st_case_94
+		case 95:
+			goto st_case_95
+		case 96:
+			goto st_case_96
+		case 97:
+			goto st_case_97
+		case 98:
+			goto st_case_98
+		case 99:
+			goto st_case_99
+		case 100:
+			goto st_case_100
+		case 101:
+			goto st_case_101
+		case 102:
+			goto st_case_102
+		case 103:
+			goto st_case_103
+		case 104:
+			goto st_case_104
+		case 105:
+			goto st_case_105
+		case 106:
+			goto st_case_106
+		case 107:
+			goto st_case_107
+		case 108:
+			goto st_case_108
+		case 109:
+			goto st_case_109
+		case 110:
+			goto st_case_110
+		case 111:
+			goto st_case_111
+		case 112:
+			goto st_case_112
+		case 348:
+			goto st_case_348
+		case 113:
+			goto st_case_113
+		case 114:
+			goto st_case_114
+		case 115:
+			goto st_case_115
+		case 116:
+			goto st_case_116
+		case 117:
+			goto st_case_117
+		case 118:
+			goto st_case_118
+		case 119:
+			goto st_case_119
+		case 120:
+			goto st_case_120
+		case 121:
+			goto st_case_121
+		case 122:
+			goto st_case_122
+		case 123:
+			goto st_case_123
+		case 124:
+			goto st_case_124
+		case 125:
+			goto st_case_125
+		case 349:
+			goto st_case_349
+		case 126:
+			goto st_case_126
+		case 127:
+			goto st_case_127
+		case 128:
+			goto st_case_128
+		case 129:
+			goto st_case_129
+		case 130:
+			goto st_case_130
+		case 131:
+			goto st_case_131
+		case 132:
+			goto st_case_132
+		case 133:
+			goto st_case_133
+		case 134:
+			goto st_case_134
+		case 135:
+			goto st_case_135
+		case 136:
+			goto st_case_136
+		case 137:
+			goto st_case_137
+		case 138:
+			goto st_case_138
+		case 139:
+			goto st_case_139
+		case 140:
+			goto st_case_140
+		case 141:
+			goto st_case_141
+		case 142:
+			goto st_case_142
+		case 143:
+			goto st_case_143
+		case 144:
+			goto st_case_144
+		case 145:
+			goto st_case_145
+		case 146:
+			goto st_case_146
+		case 147:
+			goto st_case_147
+		case 148:
+			goto st_case_148
+		case 149:
+			goto st_case_149
+		case 150:
+			goto st_case_150
+		case 151:
+			goto st_case_151
+		case 152:
+			goto st_case_152
+		case 153:
+			goto st_case_153
+		case 154:
+			goto st_case_154
+		case 155:
+			goto st_case_155
+		case 156:
+			goto st_case_156
+		case 157:
+			goto st_case_157
+		case 158:
+			goto st_case_158
+		case 159:
+			goto st_case_159
+		case 160:
+			goto st_case_160
+		case 161:
+			goto st_case_161
+		case 162:
+			goto st_case_162
+		case 163:
+			goto st_case_163
+		case 164:
+			goto st_case_164
+		case 165:
+			goto st_case_165
+		case 166:
+			goto st_case_166
+		case 350:
+			goto st_case_350
+		case 167:
+			goto st_case_167
+		case 168:
+			goto st_case_168
+		case 169:
+			goto st_case_169
+		case 170:
+			goto st_case_170
+		case 171:
+			goto st_case_171
+		case 172:
+			goto st_case_172
+		case 173:
+			goto st_case_173
+		case 174:
+			goto st_case_174
+		case 175:
+			goto st_case_175
+		case 176:
+			goto st_case_176
+		case 177:
+			goto st_case_177
+		case 178:
+			goto st_case_178
+		case 179:
+			goto st_case_179
+		case 180:
+			goto st_case_180
+		case 181:
+			goto st_case_181
+		case 182:
+			goto st_case_182
+		case 183:
+			goto st_case_183
+		case 184:
+			goto st_case_184
+		case 185:
+			goto st_case_185
+		case 186:
+			goto st_case_186
+		case 187:
+			goto st_case_187
+		case 188:
+			goto st_case_188
+		case 189:
+			goto st_case_189
+		case 190:
+			goto st_case_190
+		case 191:
+			goto st_case_191
+		case 192:
+			goto st_case_192
+		case 351:
+			goto st_case_351
+		case 193:
+			goto st_case_193
+		case 194:
+			goto st_case_194
+		case 195:
+			goto st_case_195
+		case 196:
+			goto st_case_196
+		case 197:
+			goto st_case_197
+		case 198:
+			goto st_case_198
+		case 199:
+			goto st_case_199
+		case 200:
+			goto st_case_200
+		case 201:
+			goto st_case_201
+		case 202:
+			goto st_case_202
+		case 203:
+			goto st_case_203
+		case 204:
+			goto st_case_204
+		case 205:
+			goto st_case_205
+		case 206:
+			goto st_case_206
+		case 207:
+			goto st_case_207
+		case 208:
+			goto st_case_208
+		case 209:
+			goto st_case_209
+		case 210:
+			goto st_case_210
+		case 211:
+			goto st_case_211
+		case 212:
+			goto st_case_212
+		case 213:
+			goto st_case_213
+		case 214:
+			goto st_case_214
+		case 215:
+			goto st_case_215
+		case 216:
+			goto st_case_216
+		case 217:
+			goto st_case_217
+		case 218:
+			goto st_case_218
+		case 219:
+			goto st_case_219
+		case 220:
+			goto st_case_220
+		case 221:
+			goto st_case_221
+		case 222:
+			goto st_case_222
+		case 223:
+			goto st_case_223
+		case 224:
+			goto st_case_224
+		case 225:
+			goto st_case_225
+		case 226:
+			goto st_case_226
+		case 227:
+			goto st_case_227
+		case 228:
+			goto st_case_228
+		case 229:
+			goto st_case_229
+		case 230:
+			goto st_case_230
+		case 231:
+			goto st_case_231
+		case 232:
+			goto st_case_232
+		case 233:
+			goto st_case_233
+		case 234:
+			goto st_case_234
+		case 352:
+			goto st_case_352
+		case 235:
+			goto st_case_235
+		case 236:
+			goto st_case_236
+		case 237:
+			goto st_case_237
+		case 238:
+			goto st_case_238
+		case 239:
+			goto st_case_239
+		case 240:
+			goto st_case_240
+		case 241:
+			goto st_case_241
+		case 242:
+			goto st_case_242
+		case 243:
+			goto st_case_243
+		case 244:
+			goto st_case_244
+		case 245:
+			goto st_case_245
+		case 246:
+			goto st_case_246
+		case 247:
+			goto st_case_247
+		case 248:
+			goto st_case_248
+		case 249:
+			goto st_case_249
+		case 250:
+			goto st_case_250
+		case 251:
+			goto st_case_251
+		case 252:
+			goto st_case_252
+		case 253:
+			goto st_case_253
+		case 254:
+			goto st_case_254
+		case 255:
+			goto st_case_255
+		case 256:
+			goto st_case_256
+		case 257:
+			goto st_case_257
+		case 258:
+			goto st_case_258
+		case 259:
+			goto st_case_259
+		case 260:
+			goto st_case_260
+		case 261:
+			goto st_case_261
+		case 262:
+			goto st_case_262
+		case 263:
+			goto st_case_263
+		case 264:
+			goto st_case_264
+		case 265:
+			goto st_case_265
+		case 266:
+			goto st_case_266
+		case 267:
+			goto st_case_267
+		case 268:
+			goto st_case_268
+		case 269:
+			goto st_case_269
+		case 270:
+			goto st_case_270
+		case 271:
+			goto st_case_271
+		case 272:
+			goto st_case_272
+		case 273:
+			goto st_case_273
+		case 274:
+			goto st_case_274
+		case 275:
+			goto st_case_275
+		case 276:
+			goto st_case_276
+		case 277:
+			goto st_case_277
+		case 278:
+			goto st_case_278
+		case 279:
+			goto st_case_279
+		case 280:
+			goto st_case_280
+		case 281:
+			goto st_case_281
+		case 282:
+			goto st_case_282
+		case 283:
+			goto st_case_283
+		case 284:
+			goto st_case_284
+		case 285:
+			goto st_case_285
+		case 286:
+			goto st_case_286
+		case 287:
+			goto st_case_287
+		case 288:
+			goto st_case_288
+		case 289:
+			goto st_case_289
+		case 290:
+			goto st_case_290
+		case 353:
+			goto st_case_353
+		case 291:
+			goto st_case_291
+		case 292:
+			goto st_case_292
+		case 293:
+			goto st_case_293
+		case 294:
+			goto st_case_294
+		case 295:
+			goto st_case_295
+		case 296:
+			goto st_case_296
+		case 297:
+			goto st_case_297
+		case 298:
+			goto st_case_298
+		case 299:
+			goto st_case_299
+		case 300:
+			goto st_case_300
+		case 301:
+			goto st_case_301
+		case 302:
+			goto st_case_302
+		case 303:
+			goto st_case_303
+		case 304:
+			goto st_case_304
+		case 305:
+			goto st_case_305
+		case 306:
+			goto st_case_306
+		case 307:
+			goto st_case_307
+		case 308:
+			goto st_case_308
+		case 309:
+			goto st_case_309
+		case 310:
+			goto st_case_310
+		case 311:
+			goto st_case_311
+		case 312:
+			goto st_case_312
+		case 313:
+			goto st_case_313
+		case 314:
+			goto st_case_314
+		case 315:
+			goto st_case_315
+		case 316:
+			goto st_case_316
+		case 317:
+			goto st_case_317
+		case 318:
+			goto st_case_318
+		case 319:
+			goto st_case_319
+		case 320:
+			goto st_case_320
+		case 321:
+			goto st_case_321
+		case 322:
+			goto st_case_322
+		case 323:
+			goto st_case_323
+		case 324:
+			goto st_case_324
+		case 325:
+			goto st_case_325
+		case 326:
+			goto st_case_326
+		case 327:
+			goto st_case_327
+		case 328:
+			goto st_case_328
+		case 329:
+			goto st_case_329
+		case 330:
+			goto st_case_330
+		case 331:
+			goto st_case_331
+		case 332:
+			goto st_case_332
+		case 333:
+			goto st_case_333
+		case 334:
+			goto st_case_334
+		case 335:
+			goto st_case_335
+		case 336:
+			goto st_case_336
+		case 337:
+			goto st_case_337
+		case 338:
+			goto st_case_338
+		case 339:
+			goto st_case_339
+		case 340:
+			goto st_case_340
+		case 341:
+			goto st_case_341
+		case 342:
+			goto st_case_342
+		case 354:
+			goto st_case_354
+		case 343:
+			goto st_case_343
+		case 344:
+			goto st_case_344
+		}
+		goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 91:
+			goto tr2
+		case 110:
+			goto st110
+		case 559:
+			goto st113
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	tr0:
+		expected = "array"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr5:
+		expected = "value or ']'"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr20:
+		expected = "string character"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr25:
+		expected = "',' or ']'"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr30:
+		expected = "value"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr49:
+		expected = "'/' or '*'"
+		expected = "',' or ']'"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr51:
+		expected = "escape sequence"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr54:
+		expected = "hex digit"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr59:
+		expected = "digit"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr75:
+		expected = "Infinity"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr83:
+		expected = "false"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr88:
+		expected = "null"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr92:
+		expected = "true"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr96:
+		expected = "'/' or '*'"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr98:
+		expected = "NaN"
+
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr142:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr157:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr162:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr167:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr186:
+		expected = "'/' or '*'"
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr188:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr191:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr196:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr207:
+		expected = "Infinity"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr215:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr220:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr224:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr228:
+		expected = "'/' or '*'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr230:
+		expected = "NaN"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr265:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr270:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr275:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr279:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr297:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr302:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr331:
+		expected = "'/' or '*'"
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr333:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr336:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr341:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr352:
+		expected = "Infinity"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr360:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr365:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr369:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr373:
+		expected = "'/' or '*'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr375:
+		expected = "NaN"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr378:
+		expected = "'/' or '*'"
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 91:
+			goto tr2
+		case 110:
+			goto st110
+		case 559:
+			goto st113
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	tr2:
+
+		if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+			return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+		}
+
+		goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st4
+		case 32:
+			goto st4
+		case 34:
+			goto tr7
+		case 45:
+			goto tr8
+		case 48:
+			goto tr9
+		case 91:
+			goto tr11
+		case 93:
+			goto st347
+		case 102:
+			goto tr13
+		case 110:
+			goto tr14
+		case 116:
+			goto tr15
+		case 123:
+			goto tr16
+		case 559:
+			goto st105
+		case 1097:
+			goto tr18
+		case 1102:
+			goto tr19
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr10
+			}
+		case _widec >= 9:
+			goto st4
+		}
+		goto tr5
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st4
+		case 32:
+			goto st4
+		case 34:
+			goto tr7
+		case 45:
+			goto tr8
+		case 48:
+			goto tr9
+		case 91:
+			goto tr11
+		case 93:
+			goto st347
+		case 102:
+			goto tr13
+		case 110:
+			goto tr14
+		case 116:
+			goto tr15
+		case 123:
+			goto tr16
+		case 559:
+			goto st105
+		case 1097:
+			goto tr18
+		case 1102:
+			goto tr19
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr10
+			}
+		case _widec >= 9:
+			goto st4
+		}
+		goto tr5
+	tr7:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto st63
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st6
+			}
+		default:
+			goto tr20
+		}
+		goto tr24
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto st63
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st6
+			}
+		default:
+			goto tr20
+		}
+		goto tr24
+	tr22:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr63:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr64:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st9
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st10
+		case 32:
+			goto st10
+		case 34:
+			goto tr32
+		case 45:
+			goto tr33
+		case 48:
+			goto tr34
+		case 91:
+			goto tr36
+		case 102:
+			goto tr37
+		case 110:
+			goto tr38
+		case 116:
+			goto tr39
+		case 123:
+			goto tr40
+		case 559:
+			goto st58
+		case 605:
+			goto st346
+		case 1097:
+			goto tr43
+		case 1102:
+			goto tr44
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr35
+			}
+		case _widec >= 9:
+			goto st10
+		}
+		goto tr30
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st10
+		case 32:
+			goto st10
+		case 34:
+			goto tr32
+		case 45:
+			goto tr33
+		case 48:
+			goto tr34
+		case 91:
+			goto tr36
+		case 102:
+			goto tr37
+		case 110:
+			goto tr38
+		case 116:
+			goto tr39
+		case 123:
+			goto tr40
+		case 559:
+			goto st58
+		case 605:
+			goto st346
+		case 1097:
+			goto tr43
+		case 1102:
+			goto tr44
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr35
+			}
+		case _widec >= 9:
+			goto st10
+		}
+		goto tr30
+	tr32:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 34:
+			goto tr46
+		case 92:
+			goto st16
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st12
+			}
+		default:
+			goto tr20
+		}
+		goto tr48
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 34:
+			goto tr46
+		case 92:
+			goto st16
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st12
+			}
+		default:
+			goto tr20
+		}
+		goto tr48
+	tr46:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr67:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st345
+	st345:
+		if p++; p == pe {
+			goto _test_eof345
+		}
+	st_case_345:
+		goto st0
+	tr68:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch data[p] {
+		case 42:
+			goto tr50
+		case 47:
+			goto tr50
+		}
+		goto tr49
+	tr50:
+		p = skipComment(data, p, pe)
+		goto st15
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		switch data[p] {
+		case 34:
+			goto st17
+		case 47:
+			goto st17
+		case 92:
+			goto st17
+		case 98:
+			goto st17
+		case 102:
+			goto st17
+		case 110:
+			goto st17
+		case 114:
+			goto st17
+		case 116:
+			goto st17
+		case 117:
+			goto st19
+		}
+		goto tr51
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		switch data[p] {
+		case 34:
+			goto tr46
+		case 92:
+			goto st16
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st12
+			}
+		default:
+			goto tr20
+		}
+		goto tr48
+	tr48:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 18
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		switch data[p] {
+		case 34:
+			goto tr46
+		case 92:
+			goto st16
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st12
+			}
+		default:
+			goto tr20
+		}
+		goto tr48
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st20
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st20
+			}
+		default:
+			goto st20
+		}
+		goto tr54
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st21
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st21
+			}
+		default:
+			goto st21
+		}
+		goto tr54
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st22
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st22
+			}
+		default:
+			goto st22
+		}
+		goto tr54
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr58
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr58
+			}
+		default:
+			goto tr58
+		}
+		goto tr54
+	tr58:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 23
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 34:
+			goto tr46
+		case 92:
+			goto st16
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st12
+			}
+		default:
+			goto tr20
+		}
+		goto tr48
+	tr33:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st25
+		case 1097:
+			goto st35
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st33
+		}
+		goto tr59
+	tr34:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st26
+		case 69:
+			goto st29
+		case 93:
+			goto tr67
+		case 101:
+			goto st29
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st27
+		}
+		goto tr59
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 69:
+			goto st29
+		case 93:
+			goto tr67
+		case 101:
+			goto st29
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st28
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 69:
+			goto st29
+		case 93:
+			goto tr67
+		case 101:
+			goto st29
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st28
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 43:
+			goto st30
+		case 45:
+			goto st30
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st31
+		}
+		goto tr59
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st31
+		}
+		goto tr59
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st32
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st32
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	tr35:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st26
+		case 69:
+			goto st29
+		case 93:
+			goto tr67
+		case 101:
+			goto st29
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st34
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st26
+		case 69:
+			goto st29
+		case 93:
+			goto tr67
+		case 101:
+			goto st29
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st34
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	tr43:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		if data[p] == 110 {
+			goto st36
+		}
+		goto tr75
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		if data[p] == 102 {
+			goto st37
+		}
+		goto tr75
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		if data[p] == 105 {
+			goto st38
+		}
+		goto tr75
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		if data[p] == 110 {
+			goto st39
+		}
+		goto tr75
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		if data[p] == 105 {
+			goto st40
+		}
+		goto tr75
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		if data[p] == 116 {
+			goto st41
+		}
+		goto tr75
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 121 {
+			goto st42
+		}
+		goto tr75
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	tr36:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		pp, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 43
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 43
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 43
+				top++
+				goto st115
+			}
+		}
+		goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr37:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 97 {
+			goto st45
+		}
+		goto tr83
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 108 {
+			goto st46
+		}
+		goto tr83
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		if data[p] == 115 {
+			goto st47
+		}
+		goto tr83
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		if data[p] == 101 {
+			goto st48
+		}
+		goto tr83
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr38:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st49
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 117 {
+			goto st50
+		}
+		goto tr88
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 108 {
+			goto st51
+		}
+		goto tr88
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 108 {
+			goto st52
+		}
+		goto tr88
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr39:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 114 {
+			goto st54
+		}
+		goto tr92
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		if data[p] == 117 {
+			goto st55
+		}
+		goto tr92
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 101 {
+			goto st56
+		}
+		goto tr92
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr40:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		pp, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 57
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 57
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 57
+				top++
+				goto st212
+			}
+		}
+		goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		switch data[p] {
+		case 42:
+			goto tr97
+		case 47:
+			goto tr97
+		}
+		goto tr96
+	tr97:
+		p = skipComment(data, p, pe)
+		goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st10
+		case 32:
+			goto st10
+		case 34:
+			goto tr32
+		case 45:
+			goto tr33
+		case 48:
+			goto tr34
+		case 91:
+			goto tr36
+		case 102:
+			goto tr37
+		case 110:
+			goto tr38
+		case 116:
+			goto tr39
+		case 123:
+			goto tr40
+		case 559:
+			goto st58
+		case 605:
+			goto st346
+		case 1097:
+			goto tr43
+		case 1102:
+			goto tr44
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr35
+			}
+		case _widec >= 9:
+			goto st10
+		}
+		goto tr30
+	st346:
+		if p++; p == pe {
+			goto _test_eof346
+		}
+	st_case_346:
+		goto st0
+	tr44:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 97 {
+			goto st61
+		}
+		goto tr98
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 78 {
+			goto st62
+		}
+		goto tr98
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 34:
+			goto st64
+		case 47:
+			goto st64
+		case 92:
+			goto st64
+		case 98:
+			goto st64
+		case 102:
+			goto st64
+		case 110:
+			goto st64
+		case 114:
+			goto st64
+		case 116:
+			goto st64
+		case 117:
+			goto st66
+		}
+		goto tr51
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto st63
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st6
+			}
+		default:
+			goto tr20
+		}
+		goto tr24
+	tr24:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 65
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st65
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto st63
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st6
+			}
+		default:
+			goto tr20
+		}
+		goto tr24
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto tr54
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st68
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto tr54
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st69
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st69
+			}
+		default:
+			goto st69
+		}
+		goto tr54
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr106
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr106
+			}
+		default:
+			goto tr106
+		}
+		goto tr54
+	tr106:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 70
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st70
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 34:
+			goto tr22
+		case 92:
+			goto st63
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st6
+			}
+		default:
+			goto tr20
+		}
+		goto tr24
+	tr8:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st72
+		case 1097:
+			goto st82
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st80
+		}
+		goto tr59
+	tr9:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st73
+		case 69:
+			goto st76
+		case 93:
+			goto tr67
+		case 101:
+			goto st76
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st74
+		}
+		goto tr59
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 69:
+			goto st76
+		case 93:
+			goto tr67
+		case 101:
+			goto st76
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st75
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 69:
+			goto st76
+		case 93:
+			goto tr67
+		case 101:
+			goto st76
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st75
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		switch data[p] {
+		case 43:
+			goto st77
+		case 45:
+			goto st77
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st78
+		}
+		goto tr59
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st78
+		}
+		goto tr59
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st79
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st79
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	tr10:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st80
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st73
+		case 69:
+			goto st76
+		case 93:
+			goto tr67
+		case 101:
+			goto st76
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st81
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 46:
+			goto st73
+		case 69:
+			goto st76
+		case 93:
+			goto tr67
+		case 101:
+			goto st76
+		case 559:
+			goto tr68
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st81
+			}
+		case _widec >= 9:
+			goto tr63
+		}
+		goto tr25
+	tr18:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st82
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 110 {
+			goto st83
+		}
+		goto tr75
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 102 {
+			goto st84
+		}
+		goto tr75
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		if data[p] == 105 {
+			goto st85
+		}
+		goto tr75
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		if data[p] == 110 {
+			goto st86
+		}
+		goto tr75
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 105 {
+			goto st87
+		}
+		goto tr75
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 116 {
+			goto st88
+		}
+		goto tr75
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 121 {
+			goto st89
+		}
+		goto tr75
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	tr11:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		pp, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 90
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 90
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 90
+				top++
+				goto st115
+			}
+		}
+		goto st90
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	st347:
+		if p++; p == pe {
+			goto _test_eof347
+		}
+	st_case_347:
+		goto st0
+	tr13:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st91
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		if data[p] == 97 {
+			goto st92
+		}
+		goto tr83
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		if data[p] == 108 {
+			goto st93
+		}
+		goto tr83
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		if data[p] == 115 {
+			goto st94
+		}
+		goto tr83
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		if data[p] == 101 {
+			goto st95
+		}
+		goto tr83
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr14:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st96
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		if data[p] == 117 {
+			goto st97
+		}
+		goto tr88
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		if data[p] == 108 {
+			goto st98
+		}
+		goto tr88
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		if data[p] == 108 {
+			goto st99
+		}
+		goto tr88
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr15:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		_, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st100
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if data[p] == 114 {
+			goto st101
+		}
+		goto tr92
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		if data[p] == 117 {
+			goto st102
+		}
+		goto tr92
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		if data[p] == 101 {
+			goto st103
+		}
+		goto tr92
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	tr16:
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		pp, err = handler.HandleArrayValue(data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 104
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 104
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 104
+				top++
+				goto st212
+			}
+		}
+		goto st104
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st8
+		case 32:
+			goto st8
+		case 44:
+			goto st9
+		case 93:
+			goto st345
+		case 559:
+			goto st14
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st8
+		}
+		goto tr25
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch data[p] {
+		case 42:
+			goto tr135
+		case 47:
+			goto tr135
+		}
+		goto tr96
+	tr135:
+		p = skipComment(data, p, pe)
+		goto st106
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st4
+		case 32:
+			goto st4
+		case 34:
+			goto tr7
+		case 45:
+			goto tr8
+		case 48:
+			goto tr9
+		case 91:
+			goto tr11
+		case 93:
+			goto st347
+		case 102:
+			goto tr13
+		case 110:
+			goto tr14
+		case 116:
+			goto tr15
+		case 123:
+			goto tr16
+		case 559:
+			goto st105
+		case 1097:
+			goto tr18
+		case 1102:
+			goto tr19
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr10
+			}
+		case _widec >= 9:
+			goto st4
+		}
+		goto tr5
+	tr19:
+		tokenStart = p
+
+		count++
+		if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st107
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 97 {
+			goto st108
+		}
+		goto tr98
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 78 {
+			goto st109
+		}
+		goto tr98
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr63
+		case 32:
+			goto tr63
+		case 44:
+			goto tr64
+		case 93:
+			goto tr67
+		case 559:
+			goto tr68
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr63
+		}
+		goto tr25
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 117 {
+			goto st111
+		}
+		goto tr88
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 108 {
+			goto st112
+		}
+		goto tr88
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 108 {
+			goto st348
+		}
+		goto tr88
+	st348:
+		if p++; p == pe {
+			goto _test_eof348
+		}
+	st_case_348:
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		switch data[p] {
+		case 42:
+			goto tr141
+		case 47:
+			goto tr141
+		}
+		goto tr96
+	tr141:
+		p = skipComment(data, p, pe)
+		goto st114
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 91:
+			goto tr2
+		case 110:
+			goto st110
+		case 559:
+			goto st113
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st116
+		case 32:
+			goto st116
+		case 34:
+			goto tr144
+		case 45:
+			goto tr145
+		case 48:
+			goto tr146
+		case 91:
+			goto tr148
+		case 93:
+			goto tr149
+		case 102:
+			goto tr150
+		case 110:
+			goto tr151
+		case 116:
+			goto tr152
+		case 123:
+			goto tr153
+		case 559:
+			goto st207
+		case 1097:
+			goto tr155
+		case 1102:
+			goto tr156
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr147
+			}
+		case _widec >= 9:
+			goto st116
+		}
+		goto tr142
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st116
+		case 32:
+			goto st116
+		case 34:
+			goto tr144
+		case 45:
+			goto tr145
+		case 48:
+			goto tr146
+		case 91:
+			goto tr148
+		case 93:
+			goto tr149
+		case 102:
+			goto tr150
+		case 110:
+			goto tr151
+		case 116:
+			goto tr152
+		case 123:
+			goto tr153
+		case 559:
+			goto st207
+		case 1097:
+			goto tr155
+		case 1102:
+			goto tr156
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr147
+			}
+		case _widec >= 9:
+			goto st116
+		}
+		goto tr142
+	tr144:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st117
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		switch data[p] {
+		case 34:
+			goto tr159
+		case 92:
+			goto st170
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st118
+			}
+		default:
+			goto tr157
+		}
+		goto tr161
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		switch data[p] {
+		case 34:
+			goto tr159
+		case 92:
+			goto st170
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st118
+			}
+		default:
+			goto tr157
+		}
+		goto tr161
+	tr159:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st119
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr200:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st120
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr201:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st121
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st122
+		case 32:
+			goto st122
+		case 34:
+			goto tr169
+		case 45:
+			goto tr170
+		case 48:
+			goto tr171
+		case 91:
+			goto tr173
+		case 102:
+			goto tr174
+		case 110:
+			goto tr175
+		case 116:
+			goto tr176
+		case 123:
+			goto tr177
+		case 559:
+			goto st165
+		case 605:
+			goto tr179
+		case 1097:
+			goto tr180
+		case 1102:
+			goto tr181
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr172
+			}
+		case _widec >= 9:
+			goto st122
+		}
+		goto tr167
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st122
+		case 32:
+			goto st122
+		case 34:
+			goto tr169
+		case 45:
+			goto tr170
+		case 48:
+			goto tr171
+		case 91:
+			goto tr173
+		case 102:
+			goto tr174
+		case 110:
+			goto tr175
+		case 116:
+			goto tr176
+		case 123:
+			goto tr177
+		case 559:
+			goto st165
+		case 605:
+			goto tr179
+		case 1097:
+			goto tr180
+		case 1102:
+			goto tr181
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr172
+			}
+		case _widec >= 9:
+			goto st122
+		}
+		goto tr167
+	tr169:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st123
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		switch data[p] {
+		case 34:
+			goto tr183
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st124
+			}
+		default:
+			goto tr157
+		}
+		goto tr185
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		switch data[p] {
+		case 34:
+			goto tr183
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st124
+			}
+		default:
+			goto tr157
+		}
+		goto tr185
+	tr183:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st125
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr165:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st349
+	tr204:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st349
+	st349:
+		if p++; p == pe {
+			goto _test_eof349
+		}
+	st_case_349:
+		goto st0
+	tr205:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st126
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		switch data[p] {
+		case 42:
+			goto tr187
+		case 47:
+			goto tr187
+		}
+		goto tr186
+	tr187:
+		p = skipComment(data, p, pe)
+		goto st127
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		switch data[p] {
+		case 34:
+			goto st129
+		case 47:
+			goto st129
+		case 92:
+			goto st129
+		case 98:
+			goto st129
+		case 102:
+			goto st129
+		case 110:
+			goto st129
+		case 114:
+			goto st129
+		case 116:
+			goto st129
+		case 117:
+			goto st131
+		}
+		goto tr188
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		switch data[p] {
+		case 34:
+			goto tr183
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st124
+			}
+		default:
+			goto tr157
+		}
+		goto tr185
+	tr185:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 130
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st130
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		switch data[p] {
+		case 34:
+			goto tr183
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st124
+			}
+		default:
+			goto tr157
+		}
+		goto tr185
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st132
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st132
+			}
+		default:
+			goto st132
+		}
+		goto tr191
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st133
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st133
+			}
+		default:
+			goto st133
+		}
+		goto tr191
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st134
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st134
+			}
+		default:
+			goto st134
+		}
+		goto tr191
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr195
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr195
+			}
+		default:
+			goto tr195
+		}
+		goto tr191
+	tr195:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 135
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st135
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		switch data[p] {
+		case 34:
+			goto tr183
+		case 92:
+			goto st128
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st124
+			}
+		default:
+			goto tr157
+		}
+		goto tr185
+	tr170:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st136
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st137
+		case 1097:
+			goto st142
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st140
+		}
+		goto tr196
+	tr171:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st137
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 93:
+			goto tr204
+		case 101:
+			goto tr203
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr202:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 138
+				goto _out
+			}
+		}
+
+		goto st138
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr203:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 139
+				goto _out
+			}
+		}
+
+		goto st139
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr172:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st140
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 93:
+			goto tr204
+		case 101:
+			goto tr203
+		case 559:
+			goto tr205
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st141
+			}
+		case _widec >= 9:
+			goto tr200
+		}
+		goto tr162
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 93:
+			goto tr204
+		case 101:
+			goto tr203
+		case 559:
+			goto tr205
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st141
+			}
+		case _widec >= 9:
+			goto tr200
+		}
+		goto tr162
+	tr180:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st142
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		if data[p] == 110 {
+			goto st143
+		}
+		goto tr207
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		if data[p] == 102 {
+			goto st144
+		}
+		goto tr207
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		if data[p] == 105 {
+			goto st145
+		}
+		goto tr207
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		if data[p] == 110 {
+			goto st146
+		}
+		goto tr207
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		if data[p] == 105 {
+			goto st147
+		}
+		goto tr207
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		if data[p] == 116 {
+			goto st148
+		}
+		goto tr207
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		if data[p] == 121 {
+			goto st149
+		}
+		goto tr207
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr173:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 150
+				top++
+				goto st115
+			}
+		}
+		goto st150
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr174:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st151
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		if data[p] == 97 {
+			goto st152
+		}
+		goto tr215
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		if data[p] == 108 {
+			goto st153
+		}
+		goto tr215
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		if data[p] == 115 {
+			goto st154
+		}
+		goto tr215
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		if data[p] == 101 {
+			goto st155
+		}
+		goto tr215
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr175:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st156
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		if data[p] == 117 {
+			goto st157
+		}
+		goto tr220
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		if data[p] == 108 {
+			goto st158
+		}
+		goto tr220
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		if data[p] == 108 {
+			goto st159
+		}
+		goto tr220
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr176:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st160
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		if data[p] == 114 {
+			goto st161
+		}
+		goto tr224
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		if data[p] == 117 {
+			goto st162
+		}
+		goto tr224
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		if data[p] == 101 {
+			goto st163
+		}
+		goto tr224
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr177:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 164
+				top++
+				goto st212
+			}
+		}
+		goto st164
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		switch data[p] {
+		case 42:
+			goto tr229
+		case 47:
+			goto tr229
+		}
+		goto tr228
+	tr229:
+		p = skipComment(data, p, pe)
+		goto st166
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st122
+		case 32:
+			goto st122
+		case 34:
+			goto tr169
+		case 45:
+			goto tr170
+		case 48:
+			goto tr171
+		case 91:
+			goto tr173
+		case 102:
+			goto tr174
+		case 110:
+			goto tr175
+		case 116:
+			goto tr176
+		case 123:
+			goto tr177
+		case 559:
+			goto st165
+		case 605:
+			goto tr179
+		case 1097:
+			goto tr180
+		case 1102:
+			goto tr181
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr172
+			}
+		case _widec >= 9:
+			goto st122
+		}
+		goto tr167
+	tr179:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st350
+	st350:
+		if p++; p == pe {
+			goto _test_eof350
+		}
+	st_case_350:
+		goto st0
+	tr181:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st167
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 97 {
+			goto st168
+		}
+		goto tr230
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 78 {
+			goto st169
+		}
+		goto tr230
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 34:
+			goto st171
+		case 47:
+			goto st171
+		case 92:
+			goto st171
+		case 98:
+			goto st171
+		case 102:
+			goto st171
+		case 110:
+			goto st171
+		case 114:
+			goto st171
+		case 116:
+			goto st171
+		case 117:
+			goto st173
+		}
+		goto tr188
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		switch data[p] {
+		case 34:
+			goto tr159
+		case 92:
+			goto st170
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st118
+			}
+		default:
+			goto tr157
+		}
+		goto tr161
+	tr161:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 172
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st172
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		switch data[p] {
+		case 34:
+			goto tr159
+		case 92:
+			goto st170
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st118
+			}
+		default:
+			goto tr157
+		}
+		goto tr161
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st174
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st174
+			}
+		default:
+			goto st174
+		}
+		goto tr191
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st175
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st175
+			}
+		default:
+			goto st175
+		}
+		goto tr191
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st176
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st176
+			}
+		default:
+			goto st176
+		}
+		goto tr191
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr238
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr238
+			}
+		default:
+			goto tr238
+		}
+		goto tr191
+	tr238:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 177
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st177
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		switch data[p] {
+		case 34:
+			goto tr159
+		case 92:
+			goto st170
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st118
+			}
+		default:
+			goto tr157
+		}
+		goto tr161
+	tr145:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st178
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st179
+		case 1097:
+			goto st184
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st182
+		}
+		goto tr196
+	tr146:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st179
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr242
+		case 69:
+			goto tr243
+		case 93:
+			goto tr204
+		case 101:
+			goto tr243
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr242:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 180
+				goto _out
+			}
+		}
+
+		goto st180
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr243:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 181
+				goto _out
+			}
+		}
+
+		goto st181
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr147:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st182
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr242
+		case 69:
+			goto tr243
+		case 93:
+			goto tr204
+		case 101:
+			goto tr243
+		case 559:
+			goto tr205
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st183
+			}
+		case _widec >= 9:
+			goto tr200
+		}
+		goto tr162
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 46:
+			goto tr242
+		case 69:
+			goto tr243
+		case 93:
+			goto tr204
+		case 101:
+			goto tr243
+		case 559:
+			goto tr205
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st183
+			}
+		case _widec >= 9:
+			goto tr200
+		}
+		goto tr162
+	tr155:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st184
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		if data[p] == 110 {
+			goto st185
+		}
+		goto tr207
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		if data[p] == 102 {
+			goto st186
+		}
+		goto tr207
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		if data[p] == 105 {
+			goto st187
+		}
+		goto tr207
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 110 {
+			goto st188
+		}
+		goto tr207
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		if data[p] == 105 {
+			goto st189
+		}
+		goto tr207
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		if data[p] == 116 {
+			goto st190
+		}
+		goto tr207
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		if data[p] == 121 {
+			goto st191
+		}
+		goto tr207
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	tr148:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 192
+				top++
+				goto st115
+			}
+		}
+		goto st192
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr149:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st351
+	st351:
+		if p++; p == pe {
+			goto _test_eof351
+		}
+	st_case_351:
+		goto st0
+	tr150:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st193
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		if data[p] == 97 {
+			goto st194
+		}
+		goto tr215
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		if data[p] == 108 {
+			goto st195
+		}
+		goto tr215
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		if data[p] == 115 {
+			goto st196
+		}
+		goto tr215
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		if data[p] == 101 {
+			goto st197
+		}
+		goto tr215
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr151:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st198
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		if data[p] == 117 {
+			goto st199
+		}
+		goto tr220
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		if data[p] == 108 {
+			goto st200
+		}
+		goto tr220
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		if data[p] == 108 {
+			goto st201
+		}
+		goto tr220
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr152:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st202
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		if data[p] == 114 {
+			goto st203
+		}
+		goto tr224
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		if data[p] == 117 {
+			goto st204
+		}
+		goto tr224
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		if data[p] == 101 {
+			goto st205
+		}
+		goto tr224
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	tr153:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 206
+				top++
+				goto st212
+			}
+		}
+		goto st206
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st120
+		case 32:
+			goto st120
+		case 44:
+			goto st121
+		case 93:
+			goto tr165
+		case 559:
+			goto st126
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st120
+		}
+		goto tr162
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch data[p] {
+		case 42:
+			goto tr262
+		case 47:
+			goto tr262
+		}
+		goto tr228
+	tr262:
+		p = skipComment(data, p, pe)
+		goto st208
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st116
+		case 32:
+			goto st116
+		case 34:
+			goto tr144
+		case 45:
+			goto tr145
+		case 48:
+			goto tr146
+		case 91:
+			goto tr148
+		case 93:
+			goto tr149
+		case 102:
+			goto tr150
+		case 110:
+			goto tr151
+		case 116:
+			goto tr152
+		case 123:
+			goto tr153
+		case 559:
+			goto st207
+		case 1097:
+			goto tr155
+		case 1102:
+			goto tr156
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr147
+			}
+		case _widec >= 9:
+			goto st116
+		}
+		goto tr142
+	tr156:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st209
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		if data[p] == 97 {
+			goto st210
+		}
+		goto tr230
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		if data[p] == 78 {
+			goto st211
+		}
+		goto tr230
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr200
+		case 32:
+			goto tr200
+		case 44:
+			goto tr201
+		case 93:
+			goto tr204
+		case 559:
+			goto tr205
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr200
+		}
+		goto tr162
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st213
+		case 32:
+			goto st213
+		case 34:
+			goto tr267
+		case 125:
+			goto tr268
+		case 559:
+			goto st343
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st213
+		}
+		goto tr265
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st213
+		case 32:
+			goto st213
+		case 34:
+			goto tr267
+		case 125:
+			goto tr268
+		case 559:
+			goto st343
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st213
+		}
+		goto tr265
+	tr267:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st214
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		switch data[p] {
+		case 34:
+			goto tr272
+		case 92:
+			goto st335
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st215
+			}
+		default:
+			goto tr270
+		}
+		goto tr274
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		switch data[p] {
+		case 34:
+			goto tr272
+		case 92:
+			goto st335
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st215
+			}
+		default:
+			goto tr270
+		}
+		goto tr274
+	tr272:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st216
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st217
+		case 32:
+			goto st217
+		case 58:
+			goto st218
+		case 559:
+			goto st333
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st217
+		}
+		goto tr275
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st217
+		case 32:
+			goto st217
+		case 58:
+			goto st218
+		case 559:
+			goto st333
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st217
+		}
+		goto tr275
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st219
+		case 32:
+			goto st219
+		case 34:
+			goto tr281
+		case 45:
+			goto tr282
+		case 48:
+			goto tr283
+		case 91:
+			goto tr285
+		case 102:
+			goto st314
+		case 110:
+			goto st319
+		case 116:
+			goto st323
+		case 123:
+			goto tr289
+		case 559:
+			goto st328
+		case 1097:
+			goto tr291
+		case 1102:
+			goto tr292
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr284
+			}
+		case _widec >= 9:
+			goto st219
+		}
+		goto tr279
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st219
+		case 32:
+			goto st219
+		case 34:
+			goto tr281
+		case 45:
+			goto tr282
+		case 48:
+			goto tr283
+		case 91:
+			goto tr285
+		case 102:
+			goto st314
+		case 110:
+			goto st319
+		case 116:
+			goto st323
+		case 123:
+			goto tr289
+		case 559:
+			goto st328
+		case 1097:
+			goto tr291
+		case 1102:
+			goto tr292
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr284
+			}
+		case _widec >= 9:
+			goto st219
+		}
+		goto tr279
+	tr281:
+		tokenStart = p
+		goto st220
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		switch data[p] {
+		case 34:
+			goto tr294
+		case 92:
+			goto st291
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st221
+			}
+		default:
+			goto tr270
+		}
+		goto tr296
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		switch data[p] {
+		case 34:
+			goto tr294
+		case 92:
+			goto st291
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st221
+			}
+		default:
+			goto tr270
+		}
+		goto tr296
+	tr294:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st222
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	tr345:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st223
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	tr346:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st224
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st225
+		case 32:
+			goto st225
+		case 34:
+			goto tr304
+		case 559:
+			goto st289
+		case 637:
+			goto tr306
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st225
+		}
+		goto tr302
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st225
+		case 32:
+			goto st225
+		case 34:
+			goto tr304
+		case 559:
+			goto st289
+		case 637:
+			goto tr306
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st225
+		}
+		goto tr302
+	tr304:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st226
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		switch data[p] {
+		case 34:
+			goto tr308
+		case 92:
+			goto st281
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st227
+			}
+		default:
+			goto tr270
+		}
+		goto tr310
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		switch data[p] {
+		case 34:
+			goto tr308
+		case 92:
+			goto st281
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st227
+			}
+		default:
+			goto tr270
+		}
+		goto tr310
+	tr308:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st228
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st229
+		case 32:
+			goto st229
+		case 58:
+			goto st230
+		case 559:
+			goto st279
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st229
+		}
+		goto tr275
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st229
+		case 32:
+			goto st229
+		case 58:
+			goto st230
+		case 559:
+			goto st279
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st229
+		}
+		goto tr275
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st231
+		case 32:
+			goto st231
+		case 34:
+			goto tr315
+		case 45:
+			goto tr316
+		case 48:
+			goto tr317
+		case 91:
+			goto tr319
+		case 102:
+			goto st260
+		case 110:
+			goto st265
+		case 116:
+			goto st269
+		case 123:
+			goto tr323
+		case 559:
+			goto st274
+		case 1097:
+			goto tr325
+		case 1102:
+			goto tr326
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr318
+			}
+		case _widec >= 9:
+			goto st231
+		}
+		goto tr279
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st231
+		case 32:
+			goto st231
+		case 34:
+			goto tr315
+		case 45:
+			goto tr316
+		case 48:
+			goto tr317
+		case 91:
+			goto tr319
+		case 102:
+			goto st260
+		case 110:
+			goto st265
+		case 116:
+			goto st269
+		case 123:
+			goto tr323
+		case 559:
+			goto st274
+		case 1097:
+			goto tr325
+		case 1102:
+			goto tr326
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr318
+			}
+		case _widec >= 9:
+			goto st231
+		}
+		goto tr279
+	tr315:
+		tokenStart = p
+		goto st232
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		switch data[p] {
+		case 34:
+			goto tr328
+		case 92:
+			goto st237
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st233
+			}
+		default:
+			goto tr270
+		}
+		goto tr330
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		switch data[p] {
+		case 34:
+			goto tr328
+		case 92:
+			goto st237
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st233
+			}
+		default:
+			goto tr270
+		}
+		goto tr330
+	tr328:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st234
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	tr300:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st352
+	tr349:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st352
+	st352:
+		if p++; p == pe {
+			goto _test_eof352
+		}
+	st_case_352:
+		goto st0
+	tr350:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st235
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		switch data[p] {
+		case 42:
+			goto tr332
+		case 47:
+			goto tr332
+		}
+		goto tr331
+	tr332:
+		p = skipComment(data, p, pe)
+		goto st236
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+		switch data[p] {
+		case 34:
+			goto st238
+		case 47:
+			goto st238
+		case 92:
+			goto st238
+		case 98:
+			goto st238
+		case 102:
+			goto st238
+		case 110:
+			goto st238
+		case 114:
+			goto st238
+		case 116:
+			goto st238
+		case 117:
+			goto st240
+		}
+		goto tr333
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		switch data[p] {
+		case 34:
+			goto tr328
+		case 92:
+			goto st237
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st233
+			}
+		default:
+			goto tr270
+		}
+		goto tr330
+	tr330:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 239
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st239
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		switch data[p] {
+		case 34:
+			goto tr328
+		case 92:
+			goto st237
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st233
+			}
+		default:
+			goto tr270
+		}
+		goto tr330
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st241
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st241
+			}
+		default:
+			goto st241
+		}
+		goto tr336
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st242
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st242
+			}
+		default:
+			goto st242
+		}
+		goto tr336
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st243
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st243
+			}
+		default:
+			goto st243
+		}
+		goto tr336
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr340
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr340
+			}
+		default:
+			goto tr340
+		}
+		goto tr336
+	tr340:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 244
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st244
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		switch data[p] {
+		case 34:
+			goto tr328
+		case 92:
+			goto st237
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st233
+			}
+		default:
+			goto tr270
+		}
+		goto tr330
+	tr316:
+		tokenStart = p
+		goto st245
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st246
+		case 1097:
+			goto st251
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st249
+		}
+		goto tr341
+	tr317:
+		tokenStart = p
+		goto st246
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr347
+		case 69:
+			goto tr348
+		case 101:
+			goto tr348
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr347:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 247
+				goto _out
+			}
+		}
+
+		goto st247
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr348:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 248
+				goto _out
+			}
+		}
+
+		goto st248
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr318:
+		tokenStart = p
+		goto st249
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr347
+		case 69:
+			goto tr348
+		case 101:
+			goto tr348
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st250
+			}
+		case _widec >= 9:
+			goto tr345
+		}
+		goto tr297
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr347
+		case 69:
+			goto tr348
+		case 101:
+			goto tr348
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st250
+			}
+		case _widec >= 9:
+			goto tr345
+		}
+		goto tr297
+	tr325:
+		tokenStart = p
+		goto st251
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		if data[p] == 110 {
+			goto st252
+		}
+		goto tr352
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		if data[p] == 102 {
+			goto st253
+		}
+		goto tr352
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		if data[p] == 105 {
+			goto st254
+		}
+		goto tr352
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		if data[p] == 110 {
+			goto st255
+		}
+		goto tr352
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		if data[p] == 105 {
+			goto st256
+		}
+		goto tr352
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		if data[p] == 116 {
+			goto st257
+		}
+		goto tr352
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+		if data[p] == 121 {
+			goto st258
+		}
+		goto tr352
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr319:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 259
+				top++
+				goto st115
+			}
+		}
+		goto st259
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		if data[p] == 97 {
+			goto st261
+		}
+		goto tr360
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		if data[p] == 108 {
+			goto st262
+		}
+		goto tr360
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+		if data[p] == 115 {
+			goto st263
+		}
+		goto tr360
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		if data[p] == 101 {
+			goto st264
+		}
+		goto tr360
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		if data[p] == 117 {
+			goto st266
+		}
+		goto tr365
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+		if data[p] == 108 {
+			goto st267
+		}
+		goto tr365
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+		if data[p] == 108 {
+			goto st268
+		}
+		goto tr365
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		if data[p] == 114 {
+			goto st270
+		}
+		goto tr369
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		if data[p] == 117 {
+			goto st271
+		}
+		goto tr369
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		if data[p] == 101 {
+			goto st272
+		}
+		goto tr369
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	tr323:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 273
+				top++
+				goto st212
+			}
+		}
+		goto st273
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		switch data[p] {
+		case 42:
+			goto tr374
+		case 47:
+			goto tr374
+		}
+		goto tr373
+	tr374:
+		p = skipComment(data, p, pe)
+		goto st275
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st231
+		case 32:
+			goto st231
+		case 34:
+			goto tr315
+		case 45:
+			goto tr316
+		case 48:
+			goto tr317
+		case 91:
+			goto tr319
+		case 102:
+			goto st260
+		case 110:
+			goto st265
+		case 116:
+			goto st269
+		case 123:
+			goto tr323
+		case 559:
+			goto st274
+		case 1097:
+			goto tr325
+		case 1102:
+			goto tr326
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr318
+			}
+		case _widec >= 9:
+			goto st231
+		}
+		goto tr279
+	tr326:
+		tokenStart = p
+		goto st276
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		if data[p] == 97 {
+			goto st277
+		}
+		goto tr375
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		if data[p] == 78 {
+			goto st278
+		}
+		goto tr375
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		switch data[p] {
+		case 42:
+			goto tr379
+		case 47:
+			goto tr379
+		}
+		goto tr378
+	tr379:
+		p = skipComment(data, p, pe)
+		goto st280
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st229
+		case 32:
+			goto st229
+		case 58:
+			goto st230
+		case 559:
+			goto st279
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st229
+		}
+		goto tr275
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		switch data[p] {
+		case 34:
+			goto st282
+		case 47:
+			goto st282
+		case 92:
+			goto st282
+		case 98:
+			goto st282
+		case 102:
+			goto st282
+		case 110:
+			goto st282
+		case 114:
+			goto st282
+		case 116:
+			goto st282
+		case 117:
+			goto st284
+		}
+		goto tr333
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		switch data[p] {
+		case 34:
+			goto tr308
+		case 92:
+			goto st281
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st227
+			}
+		default:
+			goto tr270
+		}
+		goto tr310
+	tr310:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 283
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st283
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		switch data[p] {
+		case 34:
+			goto tr308
+		case 92:
+			goto st281
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st227
+			}
+		default:
+			goto tr270
+		}
+		goto tr310
+	st284:
+		if p++; p == pe {
+			goto _test_eof284
+		}
+	st_case_284:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st285
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st285
+			}
+		default:
+			goto st285
+		}
+		goto tr336
+	st285:
+		if p++; p == pe {
+			goto _test_eof285
+		}
+	st_case_285:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st286
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st286
+			}
+		default:
+			goto st286
+		}
+		goto tr336
+	st286:
+		if p++; p == pe {
+			goto _test_eof286
+		}
+	st_case_286:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st287
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st287
+			}
+		default:
+			goto st287
+		}
+		goto tr336
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr385
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr385
+			}
+		default:
+			goto tr385
+		}
+		goto tr336
+	tr385:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 288
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st288
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+		switch data[p] {
+		case 34:
+			goto tr308
+		case 92:
+			goto st281
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st227
+			}
+		default:
+			goto tr270
+		}
+		goto tr310
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+		switch data[p] {
+		case 42:
+			goto tr386
+		case 47:
+			goto tr386
+		}
+		goto tr373
+	tr386:
+		p = skipComment(data, p, pe)
+		goto st290
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st225
+		case 32:
+			goto st225
+		case 34:
+			goto tr304
+		case 559:
+			goto st289
+		case 637:
+			goto tr306
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st225
+		}
+		goto tr302
+	tr306:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st353
+	st353:
+		if p++; p == pe {
+			goto _test_eof353
+		}
+	st_case_353:
+		goto st0
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+		switch data[p] {
+		case 34:
+			goto st292
+		case 47:
+			goto st292
+		case 92:
+			goto st292
+		case 98:
+			goto st292
+		case 102:
+			goto st292
+		case 110:
+			goto st292
+		case 114:
+			goto st292
+		case 116:
+			goto st292
+		case 117:
+			goto st294
+		}
+		goto tr333
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+		switch data[p] {
+		case 34:
+			goto tr294
+		case 92:
+			goto st291
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st221
+			}
+		default:
+			goto tr270
+		}
+		goto tr296
+	tr296:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 293
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st293
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		switch data[p] {
+		case 34:
+			goto tr294
+		case 92:
+			goto st291
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st221
+			}
+		default:
+			goto tr270
+		}
+		goto tr296
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st295
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st295
+			}
+		default:
+			goto st295
+		}
+		goto tr336
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st296
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st296
+			}
+		default:
+			goto st296
+		}
+		goto tr336
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st297
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st297
+			}
+		default:
+			goto st297
+		}
+		goto tr336
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr392
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr392
+			}
+		default:
+			goto tr392
+		}
+		goto tr336
+	tr392:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 298
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st298
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		switch data[p] {
+		case 34:
+			goto tr294
+		case 92:
+			goto st291
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st221
+			}
+		default:
+			goto tr270
+		}
+		goto tr296
+	tr282:
+		tokenStart = p
+		goto st299
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st300
+		case 1097:
+			goto st305
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st303
+		}
+		goto tr341
+	tr283:
+		tokenStart = p
+		goto st300
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr396
+		case 69:
+			goto tr397
+		case 101:
+			goto tr397
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr396:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 301
+				goto _out
+			}
+		}
+
+		goto st301
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr397:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 302
+				goto _out
+			}
+		}
+
+		goto st302
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr284:
+		tokenStart = p
+		goto st303
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr396
+		case 69:
+			goto tr397
+		case 101:
+			goto tr397
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st304
+			}
+		case _widec >= 9:
+			goto tr345
+		}
+		goto tr297
+	st304:
+		if p++; p == pe {
+			goto _test_eof304
+		}
+	st_case_304:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 46:
+			goto tr396
+		case 69:
+			goto tr397
+		case 101:
+			goto tr397
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st304
+			}
+		case _widec >= 9:
+			goto tr345
+		}
+		goto tr297
+	tr291:
+		tokenStart = p
+		goto st305
+	st305:
+		if p++; p == pe {
+			goto _test_eof305
+		}
+	st_case_305:
+		if data[p] == 110 {
+			goto st306
+		}
+		goto tr352
+	st306:
+		if p++; p == pe {
+			goto _test_eof306
+		}
+	st_case_306:
+		if data[p] == 102 {
+			goto st307
+		}
+		goto tr352
+	st307:
+		if p++; p == pe {
+			goto _test_eof307
+		}
+	st_case_307:
+		if data[p] == 105 {
+			goto st308
+		}
+		goto tr352
+	st308:
+		if p++; p == pe {
+			goto _test_eof308
+		}
+	st_case_308:
+		if data[p] == 110 {
+			goto st309
+		}
+		goto tr352
+	st309:
+		if p++; p == pe {
+			goto _test_eof309
+		}
+	st_case_309:
+		if data[p] == 105 {
+			goto st310
+		}
+		goto tr352
+	st310:
+		if p++; p == pe {
+			goto _test_eof310
+		}
+	st_case_310:
+		if data[p] == 116 {
+			goto st311
+		}
+		goto tr352
+	st311:
+		if p++; p == pe {
+			goto _test_eof311
+		}
+	st_case_311:
+		if data[p] == 121 {
+			goto st312
+		}
+		goto tr352
+	st312:
+		if p++; p == pe {
+			goto _test_eof312
+		}
+	st_case_312:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	tr285:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 313
+				top++
+				goto st115
+			}
+		}
+		goto st313
+	st313:
+		if p++; p == pe {
+			goto _test_eof313
+		}
+	st_case_313:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st314:
+		if p++; p == pe {
+			goto _test_eof314
+		}
+	st_case_314:
+		if data[p] == 97 {
+			goto st315
+		}
+		goto tr360
+	st315:
+		if p++; p == pe {
+			goto _test_eof315
+		}
+	st_case_315:
+		if data[p] == 108 {
+			goto st316
+		}
+		goto tr360
+	st316:
+		if p++; p == pe {
+			goto _test_eof316
+		}
+	st_case_316:
+		if data[p] == 115 {
+			goto st317
+		}
+		goto tr360
+	st317:
+		if p++; p == pe {
+			goto _test_eof317
+		}
+	st_case_317:
+		if data[p] == 101 {
+			goto st318
+		}
+		goto tr360
+	st318:
+		if p++; p == pe {
+			goto _test_eof318
+		}
+	st_case_318:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st319:
+		if p++; p == pe {
+			goto _test_eof319
+		}
+	st_case_319:
+		if data[p] == 117 {
+			goto st320
+		}
+		goto tr365
+	st320:
+		if p++; p == pe {
+			goto _test_eof320
+		}
+	st_case_320:
+		if data[p] == 108 {
+			goto st321
+		}
+		goto tr365
+	st321:
+		if p++; p == pe {
+			goto _test_eof321
+		}
+	st_case_321:
+		if data[p] == 108 {
+			goto st322
+		}
+		goto tr365
+	st322:
+		if p++; p == pe {
+			goto _test_eof322
+		}
+	st_case_322:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st323:
+		if p++; p == pe {
+			goto _test_eof323
+		}
+	st_case_323:
+		if data[p] == 114 {
+			goto st324
+		}
+		goto tr369
+	st324:
+		if p++; p == pe {
+			goto _test_eof324
+		}
+	st_case_324:
+		if data[p] == 117 {
+			goto st325
+		}
+		goto tr369
+	st325:
+		if p++; p == pe {
+			goto _test_eof325
+		}
+	st_case_325:
+		if data[p] == 101 {
+			goto st326
+		}
+		goto tr369
+	st326:
+		if p++; p == pe {
+			goto _test_eof326
+		}
+	st_case_326:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	tr289:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 327
+				top++
+				goto st212
+			}
+		}
+		goto st327
+	st327:
+		if p++; p == pe {
+			goto _test_eof327
+		}
+	st_case_327:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st223
+		case 32:
+			goto st223
+		case 44:
+			goto st224
+		case 125:
+			goto tr300
+		case 559:
+			goto st235
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st223
+		}
+		goto tr297
+	st328:
+		if p++; p == pe {
+			goto _test_eof328
+		}
+	st_case_328:
+		switch data[p] {
+		case 42:
+			goto tr416
+		case 47:
+			goto tr416
+		}
+		goto tr373
+	tr416:
+		p = skipComment(data, p, pe)
+		goto st329
+	st329:
+		if p++; p == pe {
+			goto _test_eof329
+		}
+	st_case_329:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st219
+		case 32:
+			goto st219
+		case 34:
+			goto tr281
+		case 45:
+			goto tr282
+		case 48:
+			goto tr283
+		case 91:
+			goto tr285
+		case 102:
+			goto st314
+		case 110:
+			goto st319
+		case 116:
+			goto st323
+		case 123:
+			goto tr289
+		case 559:
+			goto st328
+		case 1097:
+			goto tr291
+		case 1102:
+			goto tr292
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr284
+			}
+		case _widec >= 9:
+			goto st219
+		}
+		goto tr279
+	tr292:
+		tokenStart = p
+		goto st330
+	st330:
+		if p++; p == pe {
+			goto _test_eof330
+		}
+	st_case_330:
+		if data[p] == 97 {
+			goto st331
+		}
+		goto tr375
+	st331:
+		if p++; p == pe {
+			goto _test_eof331
+		}
+	st_case_331:
+		if data[p] == 78 {
+			goto st332
+		}
+		goto tr375
+	st332:
+		if p++; p == pe {
+			goto _test_eof332
+		}
+	st_case_332:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr345
+		case 32:
+			goto tr345
+		case 44:
+			goto tr346
+		case 125:
+			goto tr349
+		case 559:
+			goto tr350
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr345
+		}
+		goto tr297
+	st333:
+		if p++; p == pe {
+			goto _test_eof333
+		}
+	st_case_333:
+		switch data[p] {
+		case 42:
+			goto tr419
+		case 47:
+			goto tr419
+		}
+		goto tr378
+	tr419:
+		p = skipComment(data, p, pe)
+		goto st334
+	st334:
+		if p++; p == pe {
+			goto _test_eof334
+		}
+	st_case_334:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st217
+		case 32:
+			goto st217
+		case 58:
+			goto st218
+		case 559:
+			goto st333
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st217
+		}
+		goto tr275
+	st335:
+		if p++; p == pe {
+			goto _test_eof335
+		}
+	st_case_335:
+		switch data[p] {
+		case 34:
+			goto st336
+		case 47:
+			goto st336
+		case 92:
+			goto st336
+		case 98:
+			goto st336
+		case 102:
+			goto st336
+		case 110:
+			goto st336
+		case 114:
+			goto st336
+		case 116:
+			goto st336
+		case 117:
+			goto st338
+		}
+		goto tr333
+	st336:
+		if p++; p == pe {
+			goto _test_eof336
+		}
+	st_case_336:
+		switch data[p] {
+		case 34:
+			goto tr272
+		case 92:
+			goto st335
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st215
+			}
+		default:
+			goto tr270
+		}
+		goto tr274
+	tr274:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 337
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st337
+	st337:
+		if p++; p == pe {
+			goto _test_eof337
+		}
+	st_case_337:
+		switch data[p] {
+		case 34:
+			goto tr272
+		case 92:
+			goto st335
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st215
+			}
+		default:
+			goto tr270
+		}
+		goto tr274
+	st338:
+		if p++; p == pe {
+			goto _test_eof338
+		}
+	st_case_338:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st339
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st339
+			}
+		default:
+			goto st339
+		}
+		goto tr336
+	st339:
+		if p++; p == pe {
+			goto _test_eof339
+		}
+	st_case_339:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st340
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st340
+			}
+		default:
+			goto st340
+		}
+		goto tr336
+	st340:
+		if p++; p == pe {
+			goto _test_eof340
+		}
+	st_case_340:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st341
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st341
+			}
+		default:
+			goto st341
+		}
+		goto tr336
+	st341:
+		if p++; p == pe {
+			goto _test_eof341
+		}
+	st_case_341:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr425
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr425
+			}
+		default:
+			goto tr425
+		}
+		goto tr336
+	tr425:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 342
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st342
+	st342:
+		if p++; p == pe {
+			goto _test_eof342
+		}
+	st_case_342:
+		switch data[p] {
+		case 34:
+			goto tr272
+		case 92:
+			goto st335
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st215
+			}
+		default:
+			goto tr270
+		}
+		goto tr274
+	tr268:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st354
+	st354:
+		if p++; p == pe {
+			goto _test_eof354
+		}
+	st_case_354:
+		goto st0
+	st343:
+		if p++; p == pe {
+			goto _test_eof343
+		}
+	st_case_343:
+		switch data[p] {
+		case 42:
+			goto tr426
+		case 47:
+			goto tr426
+		}
+		goto tr373
+	tr426:
+		p = skipComment(data, p, pe)
+		goto st344
+	st344:
+		if p++; p == pe {
+			goto _test_eof344
+		}
+	st_case_344:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st213
+		case 32:
+			goto st213
+		case 34:
+			goto tr267
+		case 125:
+			goto tr268
+		case 559:
+			goto st343
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st213
+		}
+		goto tr265
+	st_out:
+	_test_eof1:
+		cs = 1
+		goto _test_eof
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof345:
+		cs = 345
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+	_test_eof18:
+		cs = 18
+		goto _test_eof
+	_test_eof19:
+		cs = 19
+		goto _test_eof
+	_test_eof20:
+		cs = 20
+		goto _test_eof
+	_test_eof21:
+		cs = 21
+		goto _test_eof
+	_test_eof22:
+		cs = 22
+		goto _test_eof
+	_test_eof23:
+		cs = 23
+		goto _test_eof
+	_test_eof24:
+		cs = 24
+		goto _test_eof
+	_test_eof25:
+		cs = 25
+		goto _test_eof
+	_test_eof26:
+		cs = 26
+		goto _test_eof
+	_test_eof27:
+		cs = 27
+		goto _test_eof
+	_test_eof28:
+		cs = 28
+		goto _test_eof
+	_test_eof29:
+		cs = 29
+		goto _test_eof
+	_test_eof30:
+		cs = 30
+		goto _test_eof
+	_test_eof31:
+		cs = 31
+		goto _test_eof
+	_test_eof32:
+		cs = 32
+		goto _test_eof
+	_test_eof33:
+		cs = 33
+		goto _test_eof
+	_test_eof34:
+		cs = 34
+		goto _test_eof
+	_test_eof35:
+		cs = 35
+		goto _test_eof
+	_test_eof36:
+		cs = 36
+		goto _test_eof
+	_test_eof37:
+		cs = 37
+		goto _test_eof
+	_test_eof38:
+		cs = 38
+		goto _test_eof
+	_test_eof39:
+		cs = 39
+		goto _test_eof
+	_test_eof40:
+		cs = 40
+		goto _test_eof
+	_test_eof41:
+		cs = 41
+		goto _test_eof
+	_test_eof42:
+		cs = 42
+		goto _test_eof
+	_test_eof43:
+		cs = 43
+		goto _test_eof
+	_test_eof44:
+		cs = 44
+		goto _test_eof
+	_test_eof45:
+		cs = 45
+		goto _test_eof
+	_test_eof46:
+		cs = 46
+		goto _test_eof
+	_test_eof47:
+		cs = 47
+		goto _test_eof
+	_test_eof48:
+		cs = 48
+		goto _test_eof
+	_test_eof49:
+		cs = 49
+		goto _test_eof
+	_test_eof50:
+		cs = 50
+		goto _test_eof
+	_test_eof51:
+		cs = 51
+		goto _test_eof
+	_test_eof52:
+		cs = 52
+		goto _test_eof
+	_test_eof53:
+		cs = 53
+		goto _test_eof
+	_test_eof54:
+		cs = 54
+		goto _test_eof
+	_test_eof55:
+		cs = 55
+		goto _test_eof
+	_test_eof56:
+		cs = 56
+		goto _test_eof
+	_test_eof57:
+		cs = 57
+		goto _test_eof
+	_test_eof58:
+		cs = 58
+		goto _test_eof
+	_test_eof59:
+		cs = 59
+		goto _test_eof
+	_test_eof346:
+		cs = 346
+		goto _test_eof
+	_test_eof60:
+		cs = 60
+		goto _test_eof
+	_test_eof61:
+		cs = 61
+		goto _test_eof
+	_test_eof62:
+		cs = 62
+		goto _test_eof
+	_test_eof63:
+		cs = 63
+		goto _test_eof
+	_test_eof64:
+		cs = 64
+		goto _test_eof
+	_test_eof65:
+		cs = 65
+		goto _test_eof
+	_test_eof66:
+		cs = 66
+		goto _test_eof
+	_test_eof67:
+		cs = 67
+		goto _test_eof
+	_test_eof68:
+		cs = 68
+		goto _test_eof
+	_test_eof69:
+		cs = 69
+		goto _test_eof
+	_test_eof70:
+		cs = 70
+		goto _test_eof
+	_test_eof71:
+		cs = 71
+		goto _test_eof
+	_test_eof72:
+		cs = 72
+		goto _test_eof
+	_test_eof73:
+		cs = 73
+		goto _test_eof
+	_test_eof74:
+		cs = 74
+		goto _test_eof
+	_test_eof75:
+		cs = 75
+		goto _test_eof
+	_test_eof76:
+		cs = 76
+		goto _test_eof
+	_test_eof77:
+		cs = 77
+		goto _test_eof
+	_test_eof78:
+		cs = 78
+		goto _test_eof
+	_test_eof79:
+		cs = 79
+		goto _test_eof
+	_test_eof80:
+		cs = 80
+		goto _test_eof
+	_test_eof81:
+		cs = 81
+		goto _test_eof
+	_test_eof82:
+		cs = 82
+		goto _test_eof
+	_test_eof83:
+		cs = 83
+		goto _test_eof
+	_test_eof84:
+		cs = 84
+		goto _test_eof
+	_test_eof85:
+		cs = 85
+		goto _test_eof
+	_test_eof86:
+		cs = 86
+		goto _test_eof
+	_test_eof87:
+		cs = 87
+		goto _test_eof
+	_test_eof88:
+		cs = 88
+		goto _test_eof
+	_test_eof89:
+		cs = 89
+		goto _test_eof
+	_test_eof90:
+		cs = 90
+		goto _test_eof
+	_test_eof347:
+		cs = 347
+		goto _test_eof
+	_test_eof91:
+		cs = 91
+		goto _test_eof
+	_test_eof92:
+		cs = 92
+		goto _test_eof
+	_test_eof93:
+		cs = 93
+		goto _test_eof
+	_test_eof94:
+		cs = 94
+		goto _test_eof
+	_test_eof95:
+		cs = 95
+		goto _test_eof
+	_test_eof96:
+		cs = 96
+		goto _test_eof
+	_test_eof97:
+		cs = 97
+		goto _test_eof
+	_test_eof98:
+		cs = 98
+		goto _test_eof
+	_test_eof99:
+		cs = 99
+		goto _test_eof
+	_test_eof100:
+		cs = 100
+		goto _test_eof
+	_test_eof101:
+		cs = 101
+		goto _test_eof
+	_test_eof102:
+		cs = 102
+		goto _test_eof
+	_test_eof103:
+		cs = 103
+		goto _test_eof
+	_test_eof104:
+		cs = 104
+		goto _test_eof
+	_test_eof105:
+		cs = 105
+		goto _test_eof
+	_test_eof106:
+		cs = 106
+		goto _test_eof
+	_test_eof107:
+		cs = 107
+		goto _test_eof
+	_test_eof108:
+		cs = 108
+		goto _test_eof
+	_test_eof109:
+		cs = 109
+		goto _test_eof
+	_test_eof110:
+		cs = 110
+		goto _test_eof
+	_test_eof111:
+		cs = 111
+		goto _test_eof
+	_test_eof112:
+		cs = 112
+		goto _test_eof
+	_test_eof348:
+		cs = 348
+		goto _test_eof
+	_test_eof113:
+		cs = 113
+		goto _test_eof
+	_test_eof114:
+		cs = 114
+		goto _test_eof
+	_test_eof115:
+		cs = 115
+		goto _test_eof
+	_test_eof116:
+		cs = 116
+		goto _test_eof
+	_test_eof117:
+		cs = 117
+		goto _test_eof
+	_test_eof118:
+		cs = 118
+		goto _test_eof
+	_test_eof119:
+		cs = 119
+		goto _test_eof
+	_test_eof120:
+		cs = 120
+		goto _test_eof
+	_test_eof121:
+		cs = 121
+		goto _test_eof
+	_test_eof122:
+		cs = 122
+		goto _test_eof
+	_test_eof123:
+		cs = 123
+		goto _test_eof
+	_test_eof124:
+		cs = 124
+		goto _test_eof
+	_test_eof125:
+		cs = 125
+		goto _test_eof
+	_test_eof349:
+		cs = 349
+		goto _test_eof
+	_test_eof126:
+		cs = 126
+		goto _test_eof
+	_test_eof127:
+		cs = 127
+		goto _test_eof
+	_test_eof128:
+		cs = 128
+		goto _test_eof
+	_test_eof129:
+		cs = 129
+		goto _test_eof
+	_test_eof130:
+		cs = 130
+		goto _test_eof
+	_test_eof131:
+		cs = 131
+		goto _test_eof
+	_test_eof132:
+		cs = 132
+		goto _test_eof
+	_test_eof133:
+		cs = 133
+		goto _test_eof
+	_test_eof134:
+		cs = 134
+		goto _test_eof
+	_test_eof135:
+		cs = 135
+		goto _test_eof
+	_test_eof136:
+		cs = 136
+		goto _test_eof
+	_test_eof137:
+		cs = 137
+		goto _test_eof
+	_test_eof138:
+		cs = 138
+		goto _test_eof
+	_test_eof139:
+		cs = 139
+		goto _test_eof
+	_test_eof140:
+		cs = 140
+		goto _test_eof
+	_test_eof141:
+		cs = 141
+		goto _test_eof
+	_test_eof142:
+		cs = 142
+		goto _test_eof
+	_test_eof143:
+		cs = 143
+		goto _test_eof
+	_test_eof144:
+		cs = 144
+		goto _test_eof
+	_test_eof145:
+		cs = 145
+		goto _test_eof
+	_test_eof146:
+		cs = 146
+		goto _test_eof
+	_test_eof147:
+		cs = 147
+		goto _test_eof
+	_test_eof148:
+		cs = 148
+		goto _test_eof
+	_test_eof149:
+		cs = 149
+		goto _test_eof
+	_test_eof150:
+		cs = 150
+		goto _test_eof
+	_test_eof151:
+		cs = 151
+		goto _test_eof
+	_test_eof152:
+		cs = 152
+		goto _test_eof
+	_test_eof153:
+		cs = 153
+		goto _test_eof
+	_test_eof154:
+		cs = 154
+		goto _test_eof
+	_test_eof155:
+		cs = 155
+		goto _test_eof
+	_test_eof156:
+		cs = 156
+		goto _test_eof
+	_test_eof157:
+		cs = 157
+		goto _test_eof
+	_test_eof158:
+		cs = 158
+		goto _test_eof
+	_test_eof159:
+		cs = 159
+		goto _test_eof
+	_test_eof160:
+		cs = 160
+		goto _test_eof
+	_test_eof161:
+		cs = 161
+		goto _test_eof
+	_test_eof162:
+		cs = 162
+		goto _test_eof
+	_test_eof163:
+		cs = 163
+		goto _test_eof
+	_test_eof164:
+		cs = 164
+		goto _test_eof
+	_test_eof165:
+		cs = 165
+		goto _test_eof
+	_test_eof166:
+		cs = 166
+		goto _test_eof
+	_test_eof350:
+		cs = 350
+		goto _test_eof
+	_test_eof167:
+		cs = 167
+		goto _test_eof
+	_test_eof168:
+		cs = 168
+		goto _test_eof
+	_test_eof169:
+		cs = 169
+		goto _test_eof
+	_test_eof170:
+		cs = 170
+		goto _test_eof
+	_test_eof171:
+		cs = 171
+		goto _test_eof
+	_test_eof172:
+		cs = 172
+		goto _test_eof
+	_test_eof173:
+		cs = 173
+		goto _test_eof
+	_test_eof174:
+		cs = 174
+		goto _test_eof
+	_test_eof175:
+		cs = 175
+		goto _test_eof
+	_test_eof176:
+		cs = 176
+		goto _test_eof
+	_test_eof177:
+		cs = 177
+		goto _test_eof
+	_test_eof178:
+		cs = 178
+		goto _test_eof
+	_test_eof179:
+		cs = 179
+		goto _test_eof
+	_test_eof180:
+		cs = 180
+		goto _test_eof
+	_test_eof181:
+		cs = 181
+		goto _test_eof
+	_test_eof182:
+		cs = 182
+		goto _test_eof
+	_test_eof183:
+		cs = 183
+		goto _test_eof
+	_test_eof184:
+		cs = 184
+		goto _test_eof
+	_test_eof185:
+		cs = 185
+		goto _test_eof
+	_test_eof186:
+		cs = 186
+		goto _test_eof
+	_test_eof187:
+		cs = 187
+		goto _test_eof
+	_test_eof188:
+		cs = 188
+		goto _test_eof
+	_test_eof189:
+		cs = 189
+		goto _test_eof
+	_test_eof190:
+		cs = 190
+		goto _test_eof
+	_test_eof191:
+		cs = 191
+		goto _test_eof
+	_test_eof192:
+		cs = 192
+		goto _test_eof
+	_test_eof351:
+		cs = 351
+		goto _test_eof
+	_test_eof193:
+		cs = 193
+		goto _test_eof
+	_test_eof194:
+		cs = 194
+		goto _test_eof
+	_test_eof195:
+		cs = 195
+		goto _test_eof
+	_test_eof196:
+		cs = 196
+		goto _test_eof
+	_test_eof197:
+		cs = 197
+		goto _test_eof
+	_test_eof198:
+		cs = 198
+		goto _test_eof
+	_test_eof199:
+		cs = 199
+		goto _test_eof
+	_test_eof200:
+		cs = 200
+		goto _test_eof
+	_test_eof201:
+		cs = 201
+		goto _test_eof
+	_test_eof202:
+		cs = 202
+		goto _test_eof
+	_test_eof203:
+		cs = 203
+		goto _test_eof
+	_test_eof204:
+		cs = 204
+		goto _test_eof
+	_test_eof205:
+		cs = 205
+		goto _test_eof
+	_test_eof206:
+		cs = 206
+		goto _test_eof
+	_test_eof207:
+		cs = 207
+		goto _test_eof
+	_test_eof208:
+		cs = 208
+		goto _test_eof
+	_test_eof209:
+		cs = 209
+		goto _test_eof
+	_test_eof210:
+		cs = 210
+		goto _test_eof
+	_test_eof211:
+		cs = 211
+		goto _test_eof
+	_test_eof212:
+		cs = 212
+		goto _test_eof
+	_test_eof213:
+		cs = 213
+		goto _test_eof
+	_test_eof214:
+		cs = 214
+		goto _test_eof
+	_test_eof215:
+		cs = 215
+		goto _test_eof
+	_test_eof216:
+		cs = 216
+		goto _test_eof
+	_test_eof217:
+		cs = 217
+		goto _test_eof
+	_test_eof218:
+		cs = 218
+		goto _test_eof
+	_test_eof219:
+		cs = 219
+		goto _test_eof
+	_test_eof220:
+		cs = 220
+		goto _test_eof
+	_test_eof221:
+		cs = 221
+		goto _test_eof
+	_test_eof222:
+		cs = 222
+		goto _test_eof
+	_test_eof223:
+		cs = 223
+		goto _test_eof
+	_test_eof224:
+		cs = 224
+		goto _test_eof
+	_test_eof225:
+		cs = 225
+		goto _test_eof
+	_test_eof226:
+		cs = 226
+		goto _test_eof
+	_test_eof227:
+		cs = 227
+		goto _test_eof
+	_test_eof228:
+		cs = 228
+		goto _test_eof
+	_test_eof229:
+		cs = 229
+		goto _test_eof
+	_test_eof230:
+		cs = 230
+		goto _test_eof
+	_test_eof231:
+		cs = 231
+		goto _test_eof
+	_test_eof232:
+		cs = 232
+		goto _test_eof
+	_test_eof233:
+		cs = 233
+		goto _test_eof
+	_test_eof234:
+		cs = 234
+		goto _test_eof
+	_test_eof352:
+		cs = 352
+		goto _test_eof
+	_test_eof235:
+		cs = 235
+		goto _test_eof
+	_test_eof236:
+		cs = 236
+		goto _test_eof
+	_test_eof237:
+		cs = 237
+		goto _test_eof
+	_test_eof238:
+		cs = 238
+		goto _test_eof
+	_test_eof239:
+		cs = 239
+		goto _test_eof
+	_test_eof240:
+		cs = 240
+		goto _test_eof
+	_test_eof241:
+		cs = 241
+		goto _test_eof
+	_test_eof242:
+		cs = 242
+		goto _test_eof
+	_test_eof243:
+		cs = 243
+		goto _test_eof
+	_test_eof244:
+		cs = 244
+		goto _test_eof
+	_test_eof245:
+		cs = 245
+		goto _test_eof
+	_test_eof246:
+		cs = 246
+		goto _test_eof
+	_test_eof247:
+		cs = 247
+		goto _test_eof
+	_test_eof248:
+		cs = 248
+		goto _test_eof
+	_test_eof249:
+		cs = 249
+		goto _test_eof
+	_test_eof250:
+		cs = 250
+		goto _test_eof
+	_test_eof251:
+		cs = 251
+		goto _test_eof
+	_test_eof252:
+		cs = 252
+		goto _test_eof
+	_test_eof253:
+		cs = 253
+		goto _test_eof
+	_test_eof254:
+		cs = 254
+		goto _test_eof
+	_test_eof255:
+		cs = 255
+		goto _test_eof
+	_test_eof256:
+		cs = 256
+		goto _test_eof
+	_test_eof257:
+		cs = 257
+		goto _test_eof
+	_test_eof258:
+		cs = 258
+		goto _test_eof
+	_test_eof259:
+		cs = 259
+		goto _test_eof
+	_test_eof260:
+		cs = 260
+		goto _test_eof
+	_test_eof261:
+		cs = 261
+		goto _test_eof
+	_test_eof262:
+		cs = 262
+		goto _test_eof
+	_test_eof263:
+		cs = 263
+		goto _test_eof
+	_test_eof264:
+		cs = 264
+		goto _test_eof
+	_test_eof265:
+		cs = 265
+		goto _test_eof
+	_test_eof266:
+		cs = 266
+		goto _test_eof
+	_test_eof267:
+		cs = 267
+		goto _test_eof
+	_test_eof268:
+		cs = 268
+		goto _test_eof
+	_test_eof269:
+		cs = 269
+		goto _test_eof
+	_test_eof270:
+		cs = 270
+		goto _test_eof
+	_test_eof271:
+		cs = 271
+		goto _test_eof
+	_test_eof272:
+		cs = 272
+		goto _test_eof
+	_test_eof273:
+		cs = 273
+		goto _test_eof
+	_test_eof274:
+		cs = 274
+		goto _test_eof
+	_test_eof275:
+		cs = 275
+		goto _test_eof
+	_test_eof276:
+		cs = 276
+		goto _test_eof
+	_test_eof277:
+		cs = 277
+		goto _test_eof
+	_test_eof278:
+		cs = 278
+		goto _test_eof
+	_test_eof279:
+		cs = 279
+		goto _test_eof
+	_test_eof280:
+		cs = 280
+		goto _test_eof
+	_test_eof281:
+		cs = 281
+		goto _test_eof
+	_test_eof282:
+		cs = 282
+		goto _test_eof
+	_test_eof283:
+		cs = 283
+		goto _test_eof
+	_test_eof284:
+		cs = 284
+		goto _test_eof
+	_test_eof285:
+		cs = 285
+		goto _test_eof
+	_test_eof286:
+		cs = 286
+		goto _test_eof
+	_test_eof287:
+		cs = 287
+		goto _test_eof
+	_test_eof288:
+		cs = 288
+		goto _test_eof
+	_test_eof289:
+		cs = 289
+		goto _test_eof
+	_test_eof290:
+		cs = 290
+		goto _test_eof
+	_test_eof353:
+		cs = 353
+		goto _test_eof
+	_test_eof291:
+		cs = 291
+		goto _test_eof
+	_test_eof292:
+		cs = 292
+		goto _test_eof
+	_test_eof293:
+		cs = 293
+		goto _test_eof
+	_test_eof294:
+		cs = 294
+		goto _test_eof
+	_test_eof295:
+		cs = 295
+		goto _test_eof
+	_test_eof296:
+		cs = 296
+		goto _test_eof
+	_test_eof297:
+		cs = 297
+		goto _test_eof
+	_test_eof298:
+		cs = 298
+		goto _test_eof
+	_test_eof299:
+		cs = 299
+		goto _test_eof
+	_test_eof300:
+		cs = 300
+		goto _test_eof
+	_test_eof301:
+		cs = 301
+		goto _test_eof
+	_test_eof302:
+		cs = 302
+		goto _test_eof
+	_test_eof303:
+		cs = 303
+		goto _test_eof
+	_test_eof304:
+		cs = 304
+		goto _test_eof
+	_test_eof305:
+		cs = 305
+		goto _test_eof
+	_test_eof306:
+		cs = 306
+		goto _test_eof
+	_test_eof307:
+		cs = 307
+		goto _test_eof
+	_test_eof308:
+		cs = 308
+		goto _test_eof
+	_test_eof309:
+		cs = 309
+		goto _test_eof
+	_test_eof310:
+		cs = 310
+		goto _test_eof
+	_test_eof311:
+		cs = 311
+		goto _test_eof
+	_test_eof312:
+		cs = 312
+		goto _test_eof
+	_test_eof313:
+		cs = 313
+		goto _test_eof
+	_test_eof314:
+		cs = 314
+		goto _test_eof
+	_test_eof315:
+		cs = 315
+		goto _test_eof
+	_test_eof316:
+		cs = 316
+		goto _test_eof
+	_test_eof317:
+		cs = 317
+		goto _test_eof
+	_test_eof318:
+		cs = 318
+		goto _test_eof
+	_test_eof319:
+		cs = 319
+		goto _test_eof
+	_test_eof320:
+		cs = 320
+		goto _test_eof
+	_test_eof321:
+		cs = 321
+		goto _test_eof
+	_test_eof322:
+		cs = 322
+		goto _test_eof
+	_test_eof323:
+		cs = 323
+		goto _test_eof
+	_test_eof324:
+		cs = 324
+		goto _test_eof
+	_test_eof325:
+		cs = 325
+		goto _test_eof
+	_test_eof326:
+		cs = 326
+		goto _test_eof
+	_test_eof327:
+		cs = 327
+		goto _test_eof
+	_test_eof328:
+		cs = 328
+		goto _test_eof
+	_test_eof329:
+		cs = 329
+		goto _test_eof
+	_test_eof330:
+		cs = 330
+		goto _test_eof
+	_test_eof331:
+		cs = 331
+		goto _test_eof
+	_test_eof332:
+		cs = 332
+		goto _test_eof
+	_test_eof333:
+		cs = 333
+		goto _test_eof
+	_test_eof334:
+		cs = 334
+		goto _test_eof
+	_test_eof335:
+		cs = 335
+		goto _test_eof
+	_test_eof336:
+		cs = 336
+		goto _test_eof
+	_test_eof337:
+		cs = 337
+		goto _test_eof
+	_test_eof338:
+		cs = 338
+		goto _test_eof
+	_test_eof339:
+		cs = 339
+		goto _test_eof
+	_test_eof340:
+		cs = 340
+		goto _test_eof
+	_test_eof341:
+		cs = 341
+		goto _test_eof
+	_test_eof342:
+		cs = 342
+		goto _test_eof
+	_test_eof354:
+		cs = 354
+		goto _test_eof
+	_test_eof343:
+		cs = 343
+		goto _test_eof
+	_test_eof344:
+		cs = 344
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 58, 105, 113:
+				expected = "'/' or '*'"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 9, 10, 59:
+				expected = "value"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 3, 4, 106:
+				expected = "value or ']'"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 7, 8, 13, 15, 25, 27, 28, 31, 32, 33, 34, 42, 43, 48, 52, 56, 57, 62, 72, 74, 75, 78, 79, 80, 81, 89, 90, 95, 99, 103, 104, 109:
+				expected = "',' or ']'"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 16, 63:
+				expected = "escape sequence"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 19, 20, 21, 22, 66, 67, 68, 69:
+				expected = "hex digit"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 24, 26, 29, 30, 71, 73, 76, 77:
+				expected = "digit"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 53, 54, 55, 100, 101, 102:
+				expected = "true"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 44, 45, 46, 47, 91, 92, 93, 94:
+				expected = "false"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 49, 50, 51, 96, 97, 98, 110, 111, 112:
+				expected = "null"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 1, 2, 114:
+				expected = "array"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 60, 61, 107, 108:
+				expected = "NaN"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 35, 36, 37, 38, 39, 40, 41, 82, 83, 84, 85, 86, 87, 88:
+				expected = "Infinity"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 14:
+				expected = "'/' or '*'"
+				expected = "',' or ']'"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 165, 207:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 274, 289, 328, 343:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 121, 122, 166:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 218, 219, 230, 231, 275, 329:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 115, 116, 208:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 119, 120, 125, 127, 137, 138, 139, 140, 141, 149, 150, 155, 159, 163, 164, 169, 179, 180, 181, 182, 183, 191, 192, 197, 201, 205, 206, 211:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 212, 213, 344:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 224, 225, 290:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 216, 217, 228, 229, 280, 334:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 222, 223, 234, 236, 246, 247, 248, 249, 250, 258, 259, 264, 268, 272, 273, 278, 300, 301, 302, 303, 304, 312, 313, 318, 322, 326, 327, 332:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 5, 6, 11, 12, 17, 18, 23, 64, 65, 70:
+				expected = "string character"
+				expected = "'\"'"
+
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 128, 170:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 237, 281, 291, 335:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 131, 132, 133, 134, 173, 174, 175, 176:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 240, 241, 242, 243, 284, 285, 286, 287, 294, 295, 296, 297, 338, 339, 340, 341:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 136, 178:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 245, 299:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 160, 161, 162, 202, 203, 204:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 269, 270, 271, 323, 324, 325:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 151, 152, 153, 154, 193, 194, 195, 196:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 260, 261, 262, 263, 314, 315, 316, 317:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 156, 157, 158, 198, 199, 200:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 265, 266, 267, 319, 320, 321:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 167, 168, 209, 210:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 276, 277, 330, 331:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 142, 143, 144, 145, 146, 147, 148, 184, 185, 186, 187, 188, 189, 190:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 251, 252, 253, 254, 255, 256, 257, 305, 306, 307, 308, 309, 310, 311:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 126:
+				expected = "'/' or '*'"
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 279, 333:
+				expected = "'/' or '*'"
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 235:
+				expected = "'/' or '*'"
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 117, 118, 123, 124, 129, 130, 135, 171, 172, 177:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 214, 215, 220, 221, 226, 227, 232, 233, 238, 239, 244, 282, 283, 288, 292, 293, 298, 336, 337, 342:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	if pe < len(data) && p >= pe {
+		return pe - 1, stack, "", buffer.documentSizeError(data)
+	}
+	return p, stack, expected, err
 }
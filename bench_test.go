@@ -1,6 +1,8 @@
 package rjson
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -42,6 +44,16 @@ func BenchmarkSkip(b *testing.B) {
 				}
 				require.NoError(b, err)
 			})
+
+			b.Run("StreamReader", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(size)
+				var err error
+				for i := 0; i < b.N; i++ {
+					err = NewStreamReader(bytes.NewReader(data)).SkipValue()
+				}
+				require.NoError(b, err)
+			})
 		})
 	}
 }
@@ -127,6 +139,94 @@ func BenchmarkGetValuesFromObject(b *testing.B) {
 	require.EqualError(b, err, "done")
 }
 
+func BenchmarkHandlePathValues(b *testing.B) {
+	type resType struct {
+		Archived bool   `json:"archived"`
+		Forks    int64  `json:"forks"`
+		FullName string `json:"full_name"`
+	}
+
+	wantRes := resType{
+		Archived: false,
+		Forks:    12162,
+		FullName: "golang/go",
+	}
+
+	data := getTestdataJSONGz(b, "github_repo.json")
+	paths, err := NewPathSet("/archived", "/forks", "/full_name")
+	require.NoError(b, err)
+	var res resType
+	buffer := &Buffer{}
+	var stringBuf []byte
+	handler := PathValueHandlerFunc(func(idx int, data []byte) (p int, err error) {
+		switch idx {
+		case 0:
+			res.Archived, p, err = ReadBool(data)
+		case 1:
+			res.Forks, p, err = ReadInt64(data)
+		case 2:
+			stringBuf, p, err = ReadStringBytes(data, stringBuf[:0])
+			res.FullName = string(stringBuf)
+		}
+		return p, err
+	})
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err = HandlePathValues(data, paths, handler, buffer)
+	}
+	require.NoError(b, err)
+	require.Equal(b, wantRes, res)
+}
+
+type benchRepo struct {
+	Archived    bool   `json:"archived"`
+	Forks       int64  `json:"forks"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	Owner       struct {
+		Login string `json:"login"`
+		ID    int64  `json:"id"`
+	} `json:"owner"`
+	License *struct {
+		Key  string `json:"key"`
+		Name string `json:"name"`
+	} `json:"license"`
+	Permissions map[string]bool `json:"permissions"`
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	data := getTestdataJSONGz(b, "github_repo.json")
+	var want benchRepo
+	require.NoError(b, json.Unmarshal(data, &want))
+
+	b.Run("rjson", func(b *testing.B) {
+		var res benchRepo
+		var err error
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			res = benchRepo{}
+			err = Unmarshal(data, &res)
+		}
+		require.NoError(b, err)
+		require.Equal(b, want, res)
+	})
+
+	b.Run("encoding_json", func(b *testing.B) {
+		var res benchRepo
+		var err error
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			res = benchRepo{}
+			err = json.Unmarshal(data, &res)
+		}
+		require.NoError(b, err)
+		require.Equal(b, want, res)
+	})
+}
+
 func BenchmarkReadFloat64(b *testing.B) {
 	datas := [][]byte{
 		[]byte(`-123456789`),
@@ -0,0 +1,473 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var decodeFuncs = map[string]string{
+	"bool":    "DecodeBool",
+	"byte":    "DecodeUint8",
+	"float32": "DecodeFloat32",
+	"float64": "DecodeFloat64",
+	"int":     "DecodeInt",
+	"int8":    "DecodeInt8",
+	"int16":   "DecodeInt16",
+	"int32":   "DecodeInt32",
+	"int64":   "DecodeInt64",
+	"uint":    "DecodeUint",
+	"uint8":   "DecodeUint8",
+	"uint16":  "DecodeUint16",
+	"uint32":  "DecodeUint32",
+	"uint64":  "DecodeUint64",
+	"string":  "DecodeString",
+}
+
+// typeInfo is a type declared in the package being generated.
+type typeInfo struct {
+	name    string
+	expr    ast.Expr
+	imports map[string]string
+}
+
+type generator struct {
+	pkgName string
+	types   map[string]*typeInfo
+	imports map[string]string
+	queue   []string
+	queued  map[string]bool
+	names   map[string]bool // type and import names declared in the package's files
+	buf     bytes.Buffer
+	tmp     int
+}
+
+// generate returns the source of HandleObjectValue methods for typeNames and the local struct types they use.
+func generate(dir string, typeNames []string) ([]byte, error) {
+	g := &generator{
+		types:   map[string]*typeInfo{},
+		imports: map[string]string{},
+		queued:  map[string]bool{},
+		names:   map[string]bool{},
+	}
+	err := g.parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range typeNames {
+		err = g.enqueue(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < len(g.queue); i++ {
+		err = g.genType(g.types[g.queue[i]])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return g.source()
+}
+
+func (g *generator) parseDir(dir string) error {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return err
+	}
+	if len(pkgs) != 1 {
+		return fmt.Errorf("expected one package in %s but found %d", dir, len(pkgs))
+	}
+	for _, pkg := range pkgs {
+		g.pkgName = pkg.Name
+		for _, file := range pkg.Files {
+			imports := fileImports(file)
+			for name := range imports {
+				g.names[name] = true
+			}
+			for _, decl := range file.Decls {
+				genDecl, ok := decl.(*ast.GenDecl)
+				if !ok || genDecl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range genDecl.Specs {
+					typeSpec := spec.(*ast.TypeSpec)
+					g.names[typeSpec.Name.Name] = true
+					g.types[typeSpec.Name.Name] = &typeInfo{
+						name:    typeSpec.Name.Name,
+						expr:    typeSpec.Type,
+						imports: imports,
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fileImports maps the names that file uses for its imports to their paths.
+func fileImports(file *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+func (g *generator) enqueue(name string) error {
+	info := g.types[name]
+	if info == nil {
+		return fmt.Errorf("type %s not found", name)
+	}
+	if _, ok := info.expr.(*ast.StructType); !ok {
+		return fmt.Errorf("type %s is not a struct", name)
+	}
+	if !g.queued[name] {
+		g.queued[name] = true
+		g.queue = append(g.queue, name)
+	}
+	return nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) source() ([]byte, error) {
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by rjsongen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", g.pkgName)
+	g.imports["rjson"] = "github.com/willabides/rjson"
+	names := make([]string, 0, len(g.imports))
+	for name := range g.imports {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		a, b := g.imports[names[i]], g.imports[names[j]]
+		if strings.Contains(a, ".") != strings.Contains(b, ".") {
+			return !strings.Contains(a, ".")
+		}
+		return a < b
+	})
+	std := true
+	for _, name := range names {
+		importPath := g.imports[name]
+		// standard library imports go in their own group
+		if std && strings.Contains(importPath, ".") {
+			std = false
+			src.WriteString("\n")
+		}
+		if path.Base(importPath) == name {
+			fmt.Fprintf(&src, "%q\n", importPath)
+			continue
+		}
+		fmt.Fprintf(&src, "%s %q\n", name, importPath)
+	}
+	src.WriteString(")\n")
+	src.Write(g.buf.Bytes())
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return out, nil
+}
+
+// field is a json field of a struct.
+type field struct {
+	name  string
+	path  string
+	expr  ast.Expr
+	depth int
+}
+
+func (g *generator) genType(info *typeInfo) error {
+	fields, err := g.structFields(info, "x", 0)
+	if err != nil {
+		return err
+	}
+	g.printf("\n// HandleObjectValue implements rjson.ObjectValueHandler.HandleObjectValue\n")
+	g.printf("func (x *%s) HandleObjectValue(fieldname, data []byte) (p int, err error) {\n", info.name)
+	if len(fields) == 0 {
+		g.printf("return 0, nil\n}\n")
+		return nil
+	}
+	// field names with escapes like "\u0061" are matched by their unescaped value the way encoding/json does
+	g.imports["bytes"] = "bytes"
+	g.printf("if bytes.IndexByte(fieldname, '\\\\') != -1 {\n")
+	g.printf("if fieldname, _, err = rjson.UnescapeStringContent(fieldname, nil); err != nil {\nreturn 0, err\n}\n}\n")
+	g.printf("switch string(fieldname) {\n")
+	for _, f := range fields {
+		g.printf("case %q:\n", f.name)
+		g.tmp = 0
+		err = g.decode("&"+f.path, f.expr, info)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %v", info.name, strings.TrimPrefix(f.path, "x."), err)
+		}
+	}
+	g.printf("}\nreturn p, err\n}\n")
+	return nil
+}
+
+// structFields returns the json fields of the struct type info. It follows encoding/json's rules for embedded structs
+// except that embedded pointers are ignored.
+func (g *generator) structFields(info *typeInfo, prefix string, depth int) ([]field, error) {
+	var fields []field
+	for _, f := range info.expr.(*ast.StructType).Fields.List {
+		name := ""
+		if f.Tag != nil {
+			tag, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			name = reflect.StructTag(tag).Get("json")
+			if name == "-" {
+				continue
+			}
+			if i := strings.IndexByte(name, ','); i != -1 {
+				name = name[:i]
+			}
+		}
+		if len(f.Names) == 0 {
+			ident, ok := f.Type.(*ast.Ident)
+			if !ok {
+				continue
+			}
+			embedded := g.types[ident.Name]
+			if embedded != nil && name == "" {
+				if _, ok = embedded.expr.(*ast.StructType); ok {
+					promoted, err := g.structFields(embedded, prefix+"."+ident.Name, depth+1)
+					if err != nil {
+						return nil, err
+					}
+					fields = append(fields, promoted...)
+					continue
+				}
+			}
+			if !ast.IsExported(ident.Name) {
+				continue
+			}
+			if name == "" {
+				name = ident.Name
+			}
+			fields = append(fields, field{name: name, path: prefix + "." + ident.Name, expr: f.Type, depth: depth})
+			continue
+		}
+		for _, fieldName := range f.Names {
+			if !fieldName.IsExported() {
+				continue
+			}
+			jsonName := name
+			if jsonName == "" {
+				jsonName = fieldName.Name
+			}
+			fields = append(fields, field{name: jsonName, path: prefix + "." + fieldName.Name, expr: f.Type, depth: depth})
+		}
+	}
+	return dominantFields(fields), nil
+}
+
+// dominantFields removes fields that are hidden by a shallower field with the same name or that conflict with another
+// field at the same depth.
+func dominantFields(fields []field) []field {
+	byName := map[string][]field{}
+	for _, f := range fields {
+		byName[f.name] = append(byName[f.name], f)
+	}
+	result := fields[:0]
+	for _, f := range fields {
+		others := byName[f.name]
+		ok := true
+		for _, other := range others {
+			if other.path == f.path {
+				continue
+			}
+			if other.depth <= f.depth {
+				ok = false
+			}
+		}
+		if ok {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// tmpVar returns a new variable name that doesn't hide a type or package used by the generated code.
+func (g *generator) tmpVar() string {
+	for {
+		g.tmp++
+		name := fmt.Sprintf("v%d", g.tmp)
+		if !g.names[name] {
+			return name
+		}
+	}
+}
+
+// typeString returns the source for expr as it is used in the generated file.
+func (g *generator) typeString(expr ast.Expr, info *typeInfo) (string, error) {
+	var err error
+	ast.Inspect(expr, func(node ast.Node) bool {
+		sel, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || info.imports[pkg.Name] == "" {
+			err = fmt.Errorf("unknown package for %s", types.ExprString(sel))
+			return false
+		}
+		g.imports[pkg.Name] = info.imports[pkg.Name]
+		return false
+	})
+	return types.ExprString(expr), err
+}
+
+// deref returns the source for the value ptr points to.
+func deref(ptr string) string {
+	if strings.HasPrefix(ptr, "&") {
+		return ptr[1:]
+	}
+	return "(*" + ptr + ")"
+}
+
+// decode writes statements that decode data into the value that ptr points to.
+func (g *generator) decode(ptr string, expr ast.Expr, info *typeInfo) error {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return g.decodeIdent(ptr, t, info)
+	case *ast.SelectorExpr:
+		_, err := g.typeString(t, info)
+		if err != nil {
+			return err
+		}
+		g.decodeObject(ptr)
+		return nil
+	case *ast.InterfaceType:
+		if t.Methods != nil && len(t.Methods.List) > 0 {
+			return fmt.Errorf("unsupported type %s", types.ExprString(t))
+		}
+		v := g.tmpVar()
+		g.printf("var %s interface{}\n", v)
+		g.printf("%s, p, err = rjson.ReadValue(data)\n", v)
+		g.printf("if err == nil {\n%s = %s\n}\n", deref(ptr), v)
+		return nil
+	case *ast.StarExpr:
+		elem, err := g.typeString(t.X, info)
+		if err != nil {
+			return err
+		}
+		dst := deref(ptr)
+		g.printf("if p, err = rjson.ReadNull(data); err == nil {\n%s = nil\n} else {\n", dst)
+		g.printf("if %s == nil {\n%s = new(%s)\n}\n", dst, dst, elem)
+		if local := g.localStruct(t.X); local != "" {
+			g.printf("p, err = rjson.HandleObjectValues(data, %s, nil)\n", dst)
+			err = g.enqueue(local)
+		} else {
+			err = g.decode(dst, t.X, info)
+		}
+		g.printf("}\n")
+		return err
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return fmt.Errorf("unsupported type %s", types.ExprString(t))
+		}
+		if ident, ok := t.Elt.(*ast.Ident); ok && (ident.Name == "byte" || ident.Name == "uint8") {
+			return fmt.Errorf("unsupported type %s", types.ExprString(t))
+		}
+		elem, err := g.typeString(t.Elt, info)
+		if err != nil {
+			return err
+		}
+		dst := deref(ptr)
+		v := g.tmpVar()
+		g.printf("if p, err = rjson.ReadNull(data); err == nil {\n%s = nil\n} else {\n", dst)
+		g.printf("%s = %s[:0]\n", dst, dst)
+		g.printf("p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {\n")
+		g.printf("var %s %s\n", v, elem)
+		err = g.decode("&"+v, t.Elt, info)
+		g.printf("%s = append(%s, %s)\nreturn p, err\n}), nil)\n}\n", dst, dst, v)
+		return err
+	case *ast.MapType:
+		if key, ok := t.Key.(*ast.Ident); !ok || key.Name != "string" {
+			return fmt.Errorf("unsupported type %s", types.ExprString(t))
+		}
+		elem, err := g.typeString(t.Value, info)
+		if err != nil {
+			return err
+		}
+		dst := deref(ptr)
+		v := g.tmpVar()
+		g.printf("if p, err = rjson.ReadNull(data); err == nil {\n%s = nil\n} else {\n", dst)
+		g.printf("if %s == nil {\n%s = map[string]%s{}\n}\n", dst, dst, elem)
+		g.printf("p, err = rjson.HandleObjectValues(data, rjson.ObjectValueHandlerFunc(func(fieldname, data []byte) (p int, err error) {\n")
+		g.printf("var %s %s\n", v, elem)
+		err = g.decode("&"+v, t.Value, info)
+		g.printf("if err != nil {\nreturn p, err\n}\n")
+		g.printf("key, _, err := rjson.UnescapeStringContent(fieldname, nil)\n")
+		g.printf("%s[string(key)] = %s\nreturn p, err\n}), nil)\n}\n", dst, v)
+		return err
+	default:
+		return fmt.Errorf("unsupported type %s", types.ExprString(t))
+	}
+}
+
+func (g *generator) decodeIdent(ptr string, ident *ast.Ident, info *typeInfo) error {
+	local := g.types[ident.Name]
+	if local == nil {
+		fn, ok := decodeFuncs[ident.Name]
+		if !ok {
+			return fmt.Errorf("unsupported type %s", ident.Name)
+		}
+		if ident.Name == "string" {
+			g.printf("p, err = rjson.%s(data, %s, nil)\n", fn, ptr)
+			return nil
+		}
+		g.printf("p, err = rjson.%s(data, %s)\n", fn, ptr)
+		return nil
+	}
+	if _, ok := local.expr.(*ast.StructType); ok {
+		g.decodeObject(ptr)
+		return g.enqueue(local.name)
+	}
+	underlying, err := g.typeString(local.expr, local)
+	if err != nil {
+		return err
+	}
+	return g.decode(fmt.Sprintf("(*%s)(%s)", underlying, ptr), local.expr, local)
+}
+
+func (g *generator) decodeObject(ptr string) {
+	g.printf("if p, err = rjson.ReadNull(data); err != nil {\n")
+	g.printf("p, err = rjson.HandleObjectValues(data, %s, nil)\n}\n", ptr)
+}
+
+// localStruct returns the name of the struct type declared in the package when expr refers to one.
+func (g *generator) localStruct(expr ast.Expr) string {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || g.types[ident.Name] == nil {
+		return ""
+	}
+	if _, ok = g.types[ident.Name].expr.(*ast.StructType); !ok {
+		return ""
+	}
+	return ident.Name
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_generate(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("internal", "example")
+	want, err := ioutil.ReadFile(filepath.Join(dir, "repo_rjson.go"))
+	require.NoError(t, err)
+	got, err := generate(dir, []string{"Repo"})
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(got), "run go generate ./... to update generated files")
+}
+
+func Test_generate_errors(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("internal", "example")
+	_, err := generate(dir, []string{"Missing"})
+	require.EqualError(t, err, "type Missing not found")
+	_, err = generate(dir, []string{"ID"})
+	require.EqualError(t, err, "type ID is not a struct")
+}
+
+func Test_generate_cases(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		name string
+		src  string
+		want []string
+		omit []string
+	}{
+		{
+			name: "pointer fields",
+			src: `type T struct {
+	N   *int      ` + "`json:\"n\"`" + `
+	S   *S        ` + "`json:\"s\"`" + `
+	PP  **string  ` + "`json:\"pp\"`" + `
+	Arr *[]string ` + "`json:\"arr\"`" + `
+}
+type S struct{ A int }`,
+			want: []string{
+				"x.N = new(int)\n",
+				"p, err = rjson.DecodeInt(data, x.N)\n",
+				"x.S = new(S)\n",
+				"p, err = rjson.HandleObjectValues(data, x.S, nil)\n",
+				"x.PP = new(*string)\n",
+				"(*x.PP) = new(string)\n",
+				"p, err = rjson.DecodeString(data, (*x.PP), nil)\n",
+				"(*x.Arr) = (*x.Arr)[:0]\n",
+				"func (x *S) HandleObjectValue(",
+			},
+		},
+		{
+			name: "embedded structs",
+			src: `type T struct {
+	A
+	*B
+	C ` + "`json:\"c\"`" + `
+	Name string ` + "`json:\"name\"`" + `
+}
+type A struct {
+	Name string ` + "`json:\"name\"`" + `
+	Deep
+}
+type Deep struct{ Depth int }
+type B struct{ Skipped int }
+type C struct{ Tagged int }`,
+			want: []string{
+				"case \"name\":\n\t\tp, err = rjson.DecodeString(data, &x.Name, nil)\n",
+				"case \"Depth\":\n\t\tp, err = rjson.DecodeInt(data, &x.A.Deep.Depth)\n",
+				"case \"c\":\n",
+				"p, err = rjson.HandleObjectValues(data, &x.C, nil)\n",
+			},
+			omit: []string{
+				"x.A.Name",
+				"Skipped",
+				"func (x *A)",
+			},
+		},
+		{
+			name: "temporary variable names",
+			src: `import v3 "strings"
+var _ = v3.ToLower
+type T struct {
+	List []v1                    ` + "`json:\"list\"`" + `
+	Map  map[string][]v2         ` + "`json:\"map\"`" + `
+	Any  []interface{}           ` + "`json:\"any\"`" + `
+}
+type v1 struct{ A int }
+type v2 int`,
+			want: []string{
+				"var v4 v1\n",
+				"var v4 []v2\n",
+				"var v5 v2\n",
+				"p, err = rjson.DecodeInt(data, (*int)(&v5))\n",
+				"var v4 interface{}\n",
+				"var v5 interface{}\n",
+			},
+			omit: []string{
+				"var v1 ",
+				"var v2 ",
+				"var v3 ",
+			},
+		},
+	} {
+		td := td
+		t.Run(td.name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			err := ioutil.WriteFile(filepath.Join(dir, "t.go"), []byte("package p\n"+td.src+"\n"), 0o600)
+			require.NoError(t, err)
+			got, err := generate(dir, []string{"T"})
+			require.NoError(t, err)
+			_, err = parser.ParseFile(token.NewFileSet(), "t_rjson.go", got, 0)
+			require.NoError(t, err)
+			for _, want := range td.want {
+				require.Contains(t, string(got), want)
+			}
+			for _, omit := range td.omit {
+				require.NotContains(t, string(got), omit)
+			}
+		})
+	}
+}
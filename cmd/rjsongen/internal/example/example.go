@@ -0,0 +1,49 @@
+// Package example has types for testing rjsongen.
+package example
+
+//go:generate go run ../.. -type Repo
+
+// ID is a named non-struct type.
+type ID int64
+
+// Owner is used as a nested struct.
+type Owner struct {
+	Login     string `json:"login"`
+	ID        ID     `json:"id"`
+	SiteAdmin bool   `json:"site_admin"`
+}
+
+// Timestamps is embedded in Repo.
+type Timestamps struct {
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// v1 has the name of the generator's first temporary variable.
+type v1 struct {
+	Name string `json:"name"`
+}
+
+// Repo is the type the generator is run on.
+type Repo struct {
+	Timestamps
+	ID           int64          `json:"id"`
+	Name         string         `json:"name"`
+	FullName     string         `json:"full_name,omitempty"`
+	Private      bool           `json:"private"`
+	Owner        Owner          `json:"owner"`
+	Parent       *Repo          `json:"parent"`
+	Description  *string        `json:"description"`
+	Size         uint32         `json:"size"`
+	Score        float64        `json:"score"`
+	Topics       []string       `json:"topics"`
+	Contributors []Owner        `json:"contributors"`
+	Maintainers  []*Owner       `json:"maintainers"`
+	Releases     []v1           `json:"releases"`
+	Matrix       [][]int        `json:"matrix"`
+	Labels       map[string]int `json:"labels"`
+	Extra        interface{}    `json:"extra"`
+	Ignored      string         `json:"-"`
+	NoTag        string
+	unexported   string
+}
@@ -0,0 +1,76 @@
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/willabides/rjson"
+)
+
+func TestRepo_HandleObjectValue(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+  "id": 1,
+  "name": "rjson",
+  "full_name": "willabides/rjson",
+  "private": false,
+  "created_at": "2021-01-01T00:00:00Z",
+  "owner": {"login": "willabides", "id": 2, "site_admin": false, "unknown": [1, {}]},
+  "parent": {"id": 3, "name": "parent", "parent": null, "description": "the parent", "owner": null},
+  "description": null,
+  "size": 12,
+  "score": 1.5e3,
+  "topics": ["json", "ragel"],
+  "contributors": [{"login": "a", "id": 4}, {"login": "b", "id": 5, "site_admin": true}],
+  "maintainers": [{"login": "c", "id": 6}, null],
+  "releases": [{"name": "v1.0.0"}, null],
+  "matrix": [[1, 2], null, [3]],
+  "labels": {"bug": 1, "enhancement!": 2},
+  "extra": {"a": [1, "b", null, true]},
+  "Ignored": "ignored",
+  "-": "ignored",
+  "NoTag": "no tag",
+  "unexported": "ignored"
+}`)
+	var want Repo
+	err := json.Unmarshal(data, &want)
+	require.NoError(t, err)
+	var got Repo
+	p, err := rjson.HandleObjectValues(data, &got, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, want, got)
+}
+
+func TestRepo_HandleObjectValue_escapedNames(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"\u0069d": 1, "n\u0061me": "rjson", "full\u005fname": "a\"b", "labels": {"\u0062ug": 1}}`)
+	var want Repo
+	err := json.Unmarshal(data, &want)
+	require.NoError(t, err)
+	var got Repo
+	p, err := rjson.HandleObjectValues(data, &got, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, want, got)
+	require.Equal(t, int64(1), got.ID)
+	require.Equal(t, "rjson", got.Name)
+}
+
+func TestRepo_HandleObjectValue_errors(t *testing.T) {
+	t.Parallel()
+	for _, data := range []string{
+		`{"id": "1"}`,
+		`{"owner": {"login": 1}}`,
+		`{"topics": ["a", 1]}`,
+		`{"matrix": [[1, "2"]]}`,
+		`{"labels": {"a": true}}`,
+		`{"parent": {"parent": {"id": []}}}`,
+		`{"extra": [}`,
+	} {
+		var got Repo
+		_, err := rjson.HandleObjectValues([]byte(data), &got, nil)
+		require.Error(t, err, data)
+	}
+}
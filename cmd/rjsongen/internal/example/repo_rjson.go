@@ -0,0 +1,198 @@
+// Code generated by rjsongen. DO NOT EDIT.
+
+package example
+
+import (
+	"bytes"
+
+	"github.com/willabides/rjson"
+)
+
+// HandleObjectValue implements rjson.ObjectValueHandler.HandleObjectValue
+func (x *Repo) HandleObjectValue(fieldname, data []byte) (p int, err error) {
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		if fieldname, _, err = rjson.UnescapeStringContent(fieldname, nil); err != nil {
+			return 0, err
+		}
+	}
+	switch string(fieldname) {
+	case "created_at":
+		p, err = rjson.DecodeString(data, &x.Timestamps.CreatedAt, nil)
+	case "updated_at":
+		p, err = rjson.DecodeString(data, &x.Timestamps.UpdatedAt, nil)
+	case "id":
+		p, err = rjson.DecodeInt64(data, &x.ID)
+	case "name":
+		p, err = rjson.DecodeString(data, &x.Name, nil)
+	case "full_name":
+		p, err = rjson.DecodeString(data, &x.FullName, nil)
+	case "private":
+		p, err = rjson.DecodeBool(data, &x.Private)
+	case "owner":
+		if p, err = rjson.ReadNull(data); err != nil {
+			p, err = rjson.HandleObjectValues(data, &x.Owner, nil)
+		}
+	case "parent":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Parent = nil
+		} else {
+			if x.Parent == nil {
+				x.Parent = new(Repo)
+			}
+			p, err = rjson.HandleObjectValues(data, x.Parent, nil)
+		}
+	case "description":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Description = nil
+		} else {
+			if x.Description == nil {
+				x.Description = new(string)
+			}
+			p, err = rjson.DecodeString(data, x.Description, nil)
+		}
+	case "size":
+		p, err = rjson.DecodeUint32(data, &x.Size)
+	case "score":
+		p, err = rjson.DecodeFloat64(data, &x.Score)
+	case "topics":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Topics = nil
+		} else {
+			x.Topics = x.Topics[:0]
+			p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+				var v2 string
+				p, err = rjson.DecodeString(data, &v2, nil)
+				x.Topics = append(x.Topics, v2)
+				return p, err
+			}), nil)
+		}
+	case "contributors":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Contributors = nil
+		} else {
+			x.Contributors = x.Contributors[:0]
+			p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+				var v2 Owner
+				if p, err = rjson.ReadNull(data); err != nil {
+					p, err = rjson.HandleObjectValues(data, &v2, nil)
+				}
+				x.Contributors = append(x.Contributors, v2)
+				return p, err
+			}), nil)
+		}
+	case "maintainers":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Maintainers = nil
+		} else {
+			x.Maintainers = x.Maintainers[:0]
+			p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+				var v2 *Owner
+				if p, err = rjson.ReadNull(data); err == nil {
+					v2 = nil
+				} else {
+					if v2 == nil {
+						v2 = new(Owner)
+					}
+					p, err = rjson.HandleObjectValues(data, v2, nil)
+				}
+				x.Maintainers = append(x.Maintainers, v2)
+				return p, err
+			}), nil)
+		}
+	case "releases":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Releases = nil
+		} else {
+			x.Releases = x.Releases[:0]
+			p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+				var v2 v1
+				if p, err = rjson.ReadNull(data); err != nil {
+					p, err = rjson.HandleObjectValues(data, &v2, nil)
+				}
+				x.Releases = append(x.Releases, v2)
+				return p, err
+			}), nil)
+		}
+	case "matrix":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Matrix = nil
+		} else {
+			x.Matrix = x.Matrix[:0]
+			p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+				var v2 []int
+				if p, err = rjson.ReadNull(data); err == nil {
+					v2 = nil
+				} else {
+					v2 = v2[:0]
+					p, err = rjson.HandleArrayValues(data, rjson.ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+						var v3 int
+						p, err = rjson.DecodeInt(data, &v3)
+						v2 = append(v2, v3)
+						return p, err
+					}), nil)
+				}
+				x.Matrix = append(x.Matrix, v2)
+				return p, err
+			}), nil)
+		}
+	case "labels":
+		if p, err = rjson.ReadNull(data); err == nil {
+			x.Labels = nil
+		} else {
+			if x.Labels == nil {
+				x.Labels = map[string]int{}
+			}
+			p, err = rjson.HandleObjectValues(data, rjson.ObjectValueHandlerFunc(func(fieldname, data []byte) (p int, err error) {
+				var v2 int
+				p, err = rjson.DecodeInt(data, &v2)
+				if err != nil {
+					return p, err
+				}
+				key, _, err := rjson.UnescapeStringContent(fieldname, nil)
+				x.Labels[string(key)] = v2
+				return p, err
+			}), nil)
+		}
+	case "extra":
+		var v2 interface{}
+		v2, p, err = rjson.ReadValue(data)
+		if err == nil {
+			x.Extra = v2
+		}
+	case "NoTag":
+		p, err = rjson.DecodeString(data, &x.NoTag, nil)
+	}
+	return p, err
+}
+
+// HandleObjectValue implements rjson.ObjectValueHandler.HandleObjectValue
+func (x *Owner) HandleObjectValue(fieldname, data []byte) (p int, err error) {
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		if fieldname, _, err = rjson.UnescapeStringContent(fieldname, nil); err != nil {
+			return 0, err
+		}
+	}
+	switch string(fieldname) {
+	case "login":
+		p, err = rjson.DecodeString(data, &x.Login, nil)
+	case "id":
+		p, err = rjson.DecodeInt64(data, (*int64)(&x.ID))
+	case "site_admin":
+		p, err = rjson.DecodeBool(data, &x.SiteAdmin)
+	}
+	return p, err
+}
+
+// HandleObjectValue implements rjson.ObjectValueHandler.HandleObjectValue
+func (x *v1) HandleObjectValue(fieldname, data []byte) (p int, err error) {
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		if fieldname, _, err = rjson.UnescapeStringContent(fieldname, nil); err != nil {
+			return 0, err
+		}
+	}
+	switch string(fieldname) {
+	case "name":
+		p, err = rjson.DecodeString(data, &x.Name, nil)
+	}
+	return p, err
+}
@@ -0,0 +1,65 @@
+// Command rjsongen generates rjson.ObjectValueHandler implementations for structs so they can be decoded with
+// rjson.HandleObjectValues without reflection.
+//
+// Usage:
+//
+//	rjsongen -type Foo,Bar [-output file] [dir]
+//
+// It is meant to be run with go generate:
+//
+//	//go:generate go run github.com/willabides/rjson/cmd/rjsongen -type Foo,Bar
+//
+// The generated HandleObjectValue method dispatches on the json field name and decodes the value with the matching
+// rjson.Decode* function. Field names come from json struct tags the same way encoding/json does it. They are matched
+// exactly after unescaping instead of case-insensitively. Struct types from the same package that are used as fields
+// are generated too. Struct types from other packages must implement rjson.ObjectValueHandler.
+//
+// Nested objects and arrays are decoded with a nil *rjson.Buffer because HandleObjectValue isn't given the caller's
+// Buffer. Buffer options like JSONC, Limits and TrackPath don't reach them.
+//
+// Supported field types are bool, string, all sized and unsized ints and uints, float32, float64, interface{},
+// structs, and pointers, slices and string-keyed maps of supported types.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("rjsongen: ")
+	typeNames := flag.String("type", "", "comma-separated list of struct type names; required")
+	output := flag.String("output", "", "output file name; default <dir>/<type>_rjson.go")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: rjsongen -type Foo,Bar [-output file] [dir]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *typeNames == "" || flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() == 1 {
+		dir = flag.Arg(0)
+	}
+	types := strings.Split(*typeNames, ",")
+	src, err := generate(dir, types)
+	if err != nil {
+		log.Fatal(err)
+	}
+	outputName := *output
+	if outputName == "" {
+		outputName = filepath.Join(dir, strings.ToLower(types[0])+"_rjson.go")
+	}
+	err = ioutil.WriteFile(outputName, src, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,18 +6,52 @@ double_quote = '"';
 escaped_unicode = '\\u' xdigit{4};
 
 not_double_quote_or_escape = ([^"\\] - (0x00 .. 0x1f));
-escaped_char = '\\' (["/\\bfnrt] | 'u' xdigit{4}) ;
+escape_code = ["/\\bfnrt] | 'u' xdigit{4};
+escaped_char = '\\' escape_code;
 
 json_space = [ \t\r\n];
+
+# jsonc_space is json_space plus the // and /* */ comments of JSONC. Comments are only accepted when buffer.JSONC is
+# set, so the same machine reads json and JSONC. skipComment moves p to the end of the comment from the action on its
+# second byte. An unclosed block comment runs to the end of data, where the machine finds the EOF. Machines that use
+# jsonc_space have buffer and expected in scope.
+action jsonc { buffer.JSONC }
+action skip_comment { p = skipComment(data, p, pe) }
+action expect_comment { expected = "'/' or '*'" }
+
+jsonc_space = json_space | ( '/' when jsonc ) ( [/*] >err(expect_comment) @skip_comment );
 json_true = 'true';
 json_false = 'false';
 json_null = 'null';
 
 json_uint = [0] | [1-9][0-9]*;
 json_int = '-'? json_uint;
-json_number = json_int ('.'[0-9]+)? ([eE][+\-]?[0-9]+)?;
+json_frac = '.'[0-9]+;
+json_exp = [eE][+\-]?[0-9]+;
+json_number = json_int json_frac? json_exp?;
 
 json_string = double_quote ( not_double_quote_or_escape | escaped_char )* double_quote;
+
+# The utf8_ rules are the string rules for strings that must be valid utf-8. utf8_char is a character outside ascii.
+# Overlong forms and encoded surrogates aren't valid utf-8.
+utf8_cont = 0x80..0xbf;
+utf8_char = 0xc2..0xdf utf8_cont
+  | 0xe0 0xa0..0xbf utf8_cont
+  | ( 0xe1..0xec | 0xee..0xef ) utf8_cont utf8_cont
+  | 0xed 0x80..0x9f utf8_cont
+  | 0xf0 0x90..0xbf utf8_cont utf8_cont
+  | 0xf1..0xf3 utf8_cont utf8_cont utf8_cont
+  | 0xf4 0x80..0x8f utf8_cont utf8_cont;
+
+utf8_ascii_char = 0x20..0x7f - ["\\];
+
+high_surrogate = [dD] [89abAB] xdigit{2};
+low_surrogate = [dD] [c-fC-F] xdigit{2};
+
+# utf8_escaped_unicode is a \u escape without the backslash. Surrogates must be part of a high-low pair.
+utf8_escaped_unicode = 'u' ( ( xdigit{4} - high_surrogate - low_surrogate ) | high_surrogate '\\u' low_surrogate );
+utf8_escape_code = ["/\\bfnrt] | utf8_escaped_unicode;
+
 json_bool = json_true | json_false;
 
 }%%
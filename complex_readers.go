@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"sync"
 )
 
@@ -13,6 +14,25 @@ const valueReaderMaxDepth = 10_000
 
 // ValueReader is a handler for reading complex json data types (Objects and Arrays).
 type ValueReader struct {
+	// UseNumber makes the ValueReader return numbers as Number instead of float64 the same way
+	// json.Decoder.UseNumber does.
+	UseNumber bool
+
+	// Limits are checked while reading a value. The default is no limits.
+	Limits Limits
+
+	// StrictUTF8 makes the ValueReader return ErrInvalidUTF8 for strings that aren't valid utf-8 or that have an
+	// unpaired surrogate escape like \ud800.
+	StrictUTF8 bool
+
+	// DuplicateKeys is what to do when an object has the same key more than once. The default is to keep the last
+	// value.
+	DuplicateKeys DuplicateKeyPolicy
+
+	// AllowNonFinite makes the ValueReader accept NaN, Infinity and -Infinity as numbers the way Python's json module
+	// writes them. They are read as float64 or as a Number when UseNumber is set.
+	AllowNonFinite bool
+
 	buf          Buffer
 	pool         sync.Pool
 	objVal       map[string]interface{}
@@ -21,6 +41,10 @@ type ValueReader struct {
 	stringBuf    []byte
 	depth        int
 
+	// rangeError is called with numbers that are out of float64 range. They are read as infinities instead of
+	// returning an error when it is set. Unmarshal uses it to record a type error and keep going like encoding/json.
+	rangeError func(data []byte)
+
 	newMapSize  int
 	lastMapSize int
 	maxMapSize  int
@@ -38,6 +62,12 @@ func (h *ValueReader) borrowValueReader() *ValueReader {
 	}
 	x.newMapSize = 0
 	x.depth = h.depth + 1
+	x.UseNumber = h.UseNumber
+	x.Limits = h.Limits
+	x.StrictUTF8 = h.StrictUTF8
+	x.DuplicateKeys = h.DuplicateKeys
+	x.AllowNonFinite = h.AllowNonFinite
+	x.rangeError = h.rangeError
 	return x
 }
 
@@ -49,7 +79,7 @@ func (h *ValueReader) returnValueReader(x *ValueReader) {
 // HandleArrayValue implements ArrayValueHandler.HandleArrayValue
 func (h *ValueReader) HandleArrayValue(data []byte) (p int, err error) {
 	var tknType TokenType
-	tknType, p, err = NextTokenType(data)
+	tknType, p, err = h.nextTokenType(data)
 	if err != nil {
 		return p, err
 	}
@@ -61,7 +91,7 @@ func (h *ValueReader) HandleArrayValue(data []byte) (p int, err error) {
 	case ObjectStartType:
 		h2 := h.borrowValueReader()
 		if h2.depth > valueReaderMaxDepth {
-			return p, errMaxDepth
+			return p, ErrMaxDepth
 		}
 		h2.newMapSize = h.maxMapSize
 		val, pp, err = h2.ReadObject(data)
@@ -73,7 +103,7 @@ func (h *ValueReader) HandleArrayValue(data []byte) (p int, err error) {
 	case ArrayStartType:
 		h2 := h.borrowValueReader()
 		if h2.depth > valueReaderMaxDepth {
-			return p, errMaxDepth
+			return p, ErrMaxDepth
 		}
 		val, pp, err = h2.ReadArray(data)
 		h.returnValueReader(h2)
@@ -98,9 +128,14 @@ func (h *ValueReader) HandleObjectValue(fieldname, data []byte) (p int, err erro
 			break
 		}
 	}
+	if h.DuplicateKeys == DuplicateKeysFirstWins {
+		if _, ok := h.objVal[string(fieldname)]; ok {
+			return 0, nil
+		}
+	}
 
 	var tknType TokenType
-	tknType, p, err = NextTokenType(data)
+	tknType, p, err = h.nextTokenType(data)
 	if err != nil {
 		return p, err
 	}
@@ -112,7 +147,7 @@ func (h *ValueReader) HandleObjectValue(fieldname, data []byte) (p int, err erro
 	case ObjectStartType:
 		h2 := h.borrowValueReader()
 		if h2.depth > valueReaderMaxDepth {
-			return p, errMaxDepth
+			return p, ErrMaxDepth
 		}
 		h2.newMapSize = h.maxMapSize
 		val, pp, err = h2.ReadObject(data)
@@ -124,7 +159,7 @@ func (h *ValueReader) HandleObjectValue(fieldname, data []byte) (p int, err erro
 	case ArrayStartType:
 		h2 := h.borrowValueReader()
 		if h2.depth > valueReaderMaxDepth {
-			return p, errMaxDepth
+			return p, ErrMaxDepth
 		}
 		val, pp, err = h2.ReadArray(data)
 		h.returnValueReader(h2)
@@ -135,16 +170,51 @@ func (h *ValueReader) HandleObjectValue(fieldname, data []byte) (p int, err erro
 	return p + pp, err
 }
 
+// readRangeError reads a number that ReadFloat64 couldn't read. A number that is out of range is read as the infinity
+// with its sign and passed to h.rangeError.
+func (h *ValueReader) readRangeError(data []byte) (val interface{}, p int, err error) {
+	raw, p, err := ReadNumberBytes(data)
+	if err != nil {
+		return nil, p, err
+	}
+	f, _ := strconv.ParseFloat(string(raw), 64)
+	h.rangeError(data)
+	return f, p, nil
+}
+
 func (h *ValueReader) readSimpleValue(data []byte, tknType TokenType) (val interface{}, p int, err error) {
 	switch tknType {
 	case NullType:
 		p, err = ReadNull(data)
 		return nil, p, err
 	case StringType:
+		if h.StrictUTF8 && h.depth == 0 {
+			// strings in objects and arrays are checked by the state machines
+			h.stringBuf, p, err = ReadStringBytesStrict(data, h.stringBuf[:0])
+			return string(h.stringBuf), p, err
+		}
 		h.stringBuf, p, err = ReadStringBytes(data, h.stringBuf[:0])
 		return string(h.stringBuf), p, err
 	case NumberType:
-		return ReadFloat64(data)
+		if h.AllowNonFinite {
+			if h.UseNumber {
+				return readNumberNonFinite(data)
+			}
+			return ReadFloat64NonFinite(data)
+		}
+		if h.UseNumber {
+			var raw []byte
+			raw, p, err = ReadNumberBytes(data)
+			if err != nil {
+				return nil, p, err
+			}
+			return Number(raw), p, nil
+		}
+		val, p, err = ReadFloat64(data)
+		if err != nil && h.rangeError != nil {
+			return h.readRangeError(data)
+		}
+		return val, p, err
 	case TrueType, FalseType:
 		return ReadBool(data)
 	default:
@@ -159,38 +229,92 @@ func ReadValue(data []byte) (val interface{}, p int, err error) {
 	return h.ReadValue(data)
 }
 
+// setBufferChecks sets the checks on h.buf from h so HandleObjectValues and HandleArrayValues make them. It must be
+// called after h.depth is set for the object or array h is reading.
+func (h *ValueReader) setBufferChecks() {
+	h.buf.Limits = h.Limits
+	h.buf.StrictUTF8 = h.StrictUTF8
+	h.buf.RejectDuplicateKeys = h.DuplicateKeys == DuplicateKeysError
+	h.buf.AllowNonFinite = h.AllowNonFinite
+	h.buf.depth = h.depth - 1
+}
+
+// checkSimpleValue checks the string or number ReadValue read from data[start:end] against h.Limits. Values in
+// objects and arrays are checked by the state machines instead.
+func (h *ValueReader) checkSimpleValue(data []byte, start, end int) error {
+	switch {
+	case h.Limits.MaxDocumentSize > 0 && end > h.Limits.MaxDocumentSize:
+		return limitError("MaxDocumentSize", h.Limits.MaxDocumentSize, h.Limits.MaxDocumentSize, data)
+	case data[start] == '"':
+		if h.Limits.MaxStringLen > 0 && end-start-2 > h.Limits.MaxStringLen {
+			return limitError("MaxStringLen", h.Limits.MaxStringLen, start, data)
+		}
+	case data[start] == '-' || digits[data[start]] || data[start] == 'N' || data[start] == 'I':
+		if h.Limits.MaxNumberLen > 0 && end-start > h.Limits.MaxNumberLen {
+			return limitError("MaxNumberLen", h.Limits.MaxNumberLen, start, data)
+		}
+	}
+	return nil
+}
+
+// nextTokenType is NextTokenType with NaN, Infinity and -Infinity as NumberType when h.AllowNonFinite is set.
+func (h *ValueReader) nextTokenType(data []byte) (TokenType, int, error) {
+	if !h.AllowNonFinite {
+		return NextTokenType(data)
+	}
+	buffer := Buffer{AllowNonFinite: true}
+	return buffer.NextTokenType(data)
+}
+
 // ReadValue reads a value at the beginning of data. The result will be a string, bool, nil, float64, []interface{}
-// or map[string]interface{} depending on the json data type. p is the first position in data after the value.
+// or map[string]interface{} depending on the json data type. Numbers are Number instead of float64 when UseNumber is
+// set. p is the first position in data after the value.
 func (h *ValueReader) ReadValue(data []byte) (val interface{}, p int, err error) {
 	var tknType TokenType
-	tknType, p, err = NextTokenType(data)
+	tknType, p, err = h.nextTokenType(data)
 	if err != nil {
-		return nil, p, err
+		return nil, p, toSyntaxError(data, p, err, "")
 	}
 	p--
-	data = data[p:]
 
-	var pp int
+	// containers are given all of data so MaxDocumentSize includes the whitespace before them
+	start := p
 	switch tknType {
 	case ObjectStartType:
 		h2 := h.borrowValueReader()
-		val, pp, err = h2.ReadObject(data)
+		val, p, err = h2.ReadObject(data)
 		h.returnValueReader(h2)
 	case ArrayStartType:
 		h2 := h.borrowValueReader()
-		val, pp, err = h2.ReadArray(data)
+		val, p, err = h2.ReadArray(data)
 		h.returnValueReader(h2)
 	default:
-		val, pp, err = h.readSimpleValue(data, tknType)
+		var pp int
+		val, pp, err = h.readSimpleValue(data[p:], tknType)
+		p += pp
+		if err == nil {
+			err = h.checkSimpleValue(data, start, p)
+		}
 	}
 	if err != nil {
-		return nil, p + pp, err
+		return nil, p, toSyntaxError(data, p, err, "")
 	}
-	return val, p + pp, err
+	return val, p, err
 }
 
 func readValueCompat(data []byte) (val interface{}, p int, err error) {
+	return readValueCompatHelper(data, false)
+}
+
+func readValueUseNumberCompat(data []byte) (val interface{}, p int, err error) {
+	return readValueCompatHelper(data, true)
+}
+
+func readValueCompatHelper(data []byte, useNumber bool) (val interface{}, p int, err error) {
 	decoder := json.NewDecoder(bytes.NewReader(data))
+	if useNumber {
+		decoder.UseNumber()
+	}
 	err = decoder.Decode(&val)
 	if err != nil {
 		return nil, int(decoder.InputOffset()), fmt.Errorf("invalid json")
@@ -212,6 +336,7 @@ func (h *ValueReader) ReadObject(data []byte) (val map[string]interface{}, p int
 		h.depth = 1
 		defer func() { h.depth = 0 }()
 	}
+	h.setBufferChecks()
 	mapSize := h.newMapSize
 	if mapSize == 0 {
 		mapSize = h.lastMapSize
@@ -225,9 +350,9 @@ func (h *ValueReader) ReadObject(data []byte) (val map[string]interface{}, p int
 
 	// make sure to return err for null
 	if valLen == 0 {
-		tknType, _, tknErr := NextTokenType(data)
+		tknType, tknP, tknErr := NextTokenType(data)
 		if tknErr == nil && tknType == NullType {
-			return nil, p, errInvalidObject
+			return nil, p, toSyntaxError(data, tknP-1, ErrInvalidObject, "")
 		}
 	}
 
@@ -239,10 +364,10 @@ func readObjectCompat(data []byte) (val map[string]interface{}, p int, err error
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	tkn, err := decoder.Token()
 	if err != nil {
-		return nil, int(decoder.InputOffset()), errInvalidObject
+		return nil, int(decoder.InputOffset()), ErrInvalidObject
 	}
 	if tkn != json.Delim('{') {
-		return nil, int(decoder.InputOffset()), errInvalidObject
+		return nil, int(decoder.InputOffset()), ErrInvalidObject
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -264,10 +389,9 @@ func ReadArray(data []byte) (val []interface{}, p int, err error) {
 func (h *ValueReader) ReadArray(data []byte) (val []interface{}, p int, err error) {
 	if h.depth == 0 {
 		h.depth = 1
-		defer func() {
-			h.depth = 0
-		}()
+		defer func() { h.depth = 0 }()
 	}
+	h.setBufferChecks()
 	sliceSize := h.newSliceSize
 	if sliceSize == 0 {
 		sliceSize = h.lastSliceSize
@@ -282,9 +406,9 @@ func (h *ValueReader) ReadArray(data []byte) (val []interface{}, p int, err erro
 
 	// make sure to return err for null
 	if valLen == 0 {
-		tknType, _, tknErr := NextTokenType(data)
+		tknType, tknP, tknErr := NextTokenType(data)
 		if tknErr == nil && tknType == NullType {
-			return nil, p, errInvalidArray
+			return nil, p, toSyntaxError(data, tknP-1, ErrInvalidArray, "")
 		}
 	}
 
@@ -296,10 +420,10 @@ func readArrayCompat(data []byte) (val []interface{}, p int, err error) {
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	tkn, err := decoder.Token()
 	if err != nil {
-		return nil, int(decoder.InputOffset()), errInvalidArray
+		return nil, int(decoder.InputOffset()), ErrInvalidArray
 	}
 	if tkn != json.Delim('[') {
-		return nil, int(decoder.InputOffset()), errInvalidArray
+		return nil, int(decoder.InputOffset()), ErrInvalidArray
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
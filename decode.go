@@ -35,6 +35,27 @@ func decodeFloat64Compat(data []byte, v *float64) (p int, err error) {
 	return decodeCompatHelper(data, v)
 }
 
+// DecodeFloat32 reads a float32 value at the beginning of data. If data begins with null, v is untouched.
+func DecodeFloat32(data []byte, v *float32) (p int, err error) {
+	var val float32
+	val, p, err = ReadFloat32(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeFloat32Compat(data []byte, v *float32) (p int, err error) {
+	// encoding/json sets v to an infinity when the number is out of range, but DecodeFloat32 leaves it untouched
+	val := *v
+	p, err = decodeCompatHelper(data, &val)
+	if err == nil {
+		*v = val
+	}
+	return p, err
+}
+
 // DecodeInt64 reads an int64 value at the beginning of data. If data begins with null, v is untouched.
 func DecodeInt64(data []byte, v *int64) (p int, err error) {
 	var val int64
@@ -65,6 +86,36 @@ func decodeInt32Compat(data []byte, v *int32) (p int, err error) {
 	return decodeCompatHelper(data, v)
 }
 
+// DecodeInt16 reads an int16 value at the beginning of data. If data begins with null, v is untouched.
+func DecodeInt16(data []byte, v *int16) (p int, err error) {
+	var val int16
+	val, p, err = ReadInt16(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeInt16Compat(data []byte, v *int16) (p int, err error) {
+	return decodeCompatHelper(data, v)
+}
+
+// DecodeInt8 reads an int8 value at the beginning of data. If data begins with null, v is untouched.
+func DecodeInt8(data []byte, v *int8) (p int, err error) {
+	var val int8
+	val, p, err = ReadInt8(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeInt8Compat(data []byte, v *int8) (p int, err error) {
+	return decodeCompatHelper(data, v)
+}
+
 // DecodeInt reads an int value at the beginning of data. If data begins with null, v is untouched.
 func DecodeInt(data []byte, v *int) (p int, err error) {
 	var val int
@@ -110,6 +161,36 @@ func decodeUint32Compat(data []byte, v *uint32) (p int, err error) {
 	return decodeCompatHelper(data, v)
 }
 
+// DecodeUint16 reads a uint16 value at the beginning of data. If data begins with null, v is untouched.
+func DecodeUint16(data []byte, v *uint16) (p int, err error) {
+	var val uint16
+	val, p, err = ReadUint16(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeUint16Compat(data []byte, v *uint16) (p int, err error) {
+	return decodeCompatHelper(data, v)
+}
+
+// DecodeUint8 reads a uint8 value at the beginning of data. If data begins with null, v is untouched.
+func DecodeUint8(data []byte, v *uint8) (p int, err error) {
+	var val uint8
+	val, p, err = ReadUint8(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeUint8Compat(data []byte, v *uint8) (p int, err error) {
+	return decodeCompatHelper(data, v)
+}
+
 // DecodeUint reads a uint value at the beginning of data. If data begins with null, v is untouched.
 func DecodeUint(data []byte, v *uint) (p int, err error) {
 	var val uint
@@ -0,0 +1,134 @@
+package rjson
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned when duplicate keys are rejected and an object has the same key more than once. Keys are
+// compared after they are unescaped, so "a" and "\u0061" are the same key.
+var ErrDuplicateKey = fmt.Errorf("duplicate object key")
+
+// DuplicateKeyPolicy is what a ValueReader does when an object has the same key more than once.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateKeysLastWins keeps the last value for the key. This is the default and matches encoding/json.
+	DuplicateKeysLastWins DuplicateKeyPolicy = iota
+
+	// DuplicateKeysFirstWins keeps the first value for the key and skips the rest.
+	DuplicateKeysFirstWins
+
+	// DuplicateKeysError returns ErrDuplicateKey before reading anything from the value.
+	DuplicateKeysError
+)
+
+// keyTable is a hash table of object keys for finding duplicates. Keys from every object in a value go in the same
+// table along with a number for the object they belong to, so nothing needs to be removed when an object ends. It is
+// reset for each value and reuses its memory.
+type keyTable struct {
+	keys    []tableKey
+	slots   []int32 // index in keys plus one, 0 is empty
+	names   []byte  // unescaped copies of keys that have escapes
+	objects int
+}
+
+type tableKey struct {
+	object     int
+	hash       uint64
+	start, end int
+	inNames    bool // start and end are in names instead of data
+}
+
+func (t *keyTable) reset() {
+	t.keys = t.keys[:0]
+	t.names = t.names[:0]
+	t.objects = 0
+	for i := range t.slots {
+		t.slots[i] = 0
+	}
+}
+
+// newObject returns the number for the next object.
+func (t *keyTable) newObject() int {
+	t.objects++
+	return t.objects
+}
+
+func (t *keyTable) name(data []byte, k *tableKey) []byte {
+	if k.inNames {
+		return t.names[k.start:k.end]
+	}
+	return data[k.start:k.end]
+}
+
+// add adds the raw key data[start:end] to object and returns false when object already has the key.
+func (t *keyTable) add(data []byte, object, start, end int) bool {
+	return t.addKey(data, object, start, end, false)
+}
+
+// addJSON5 is add for a JSON5 key, which can be an identifier and can have escapes that json strings can't.
+func (t *keyTable) addJSON5(data []byte, object, start, end int) bool {
+	return t.addKey(data, object, start, end, true)
+}
+
+// addKey is add for a json key or a JSON5 key when json5 is true.
+func (t *keyTable) addKey(data []byte, object, start, end int, json5 bool) bool {
+	k := tableKey{
+		object: object,
+		start:  start,
+		end:    end,
+	}
+	if bytes.IndexByte(data[start:end], '\\') != -1 {
+		var err error
+		k.start = len(t.names)
+		if json5 {
+			t.names, _, err = json5UnescapeStringContent(data[start:end], t.names)
+		} else {
+			t.names, _, err = unescapeStringContent(data[start:end], t.names)
+		}
+		if err != nil {
+			// invalid strings are left for the state machines to report
+			return true
+		}
+		k.end, k.inNames = len(t.names), true
+	}
+	name := t.name(data, &k)
+	k.hash = uint64(object) * 0x9e3779b97f4a7c15
+	for _, c := range name {
+		k.hash = (k.hash ^ uint64(c)) * 0x100000001b3
+	}
+
+	if 2*(len(t.keys)+1) > len(t.slots) {
+		t.grow()
+	}
+	mask := uint64(len(t.slots) - 1)
+	i := k.hash & mask
+	for t.slots[i] != 0 {
+		other := &t.keys[t.slots[i]-1]
+		if other.hash == k.hash && other.object == object && bytes.Equal(t.name(data, other), name) {
+			return false
+		}
+		i = (i + 1) & mask
+	}
+	t.keys = append(t.keys, k)
+	t.slots[i] = int32(len(t.keys))
+	return true
+}
+
+// grow doubles the number of slots and puts the keys back in them.
+func (t *keyTable) grow() {
+	size := 2 * len(t.slots)
+	if size < 16 {
+		size = 16
+	}
+	t.slots = make([]int32, size)
+	mask := uint64(size - 1)
+	for n := range t.keys {
+		i := t.keys[n].hash & mask
+		for t.slots[i] != 0 {
+			i = (i + 1) & mask
+		}
+		t.slots[i] = int32(n + 1)
+	}
+}
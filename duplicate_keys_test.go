@@ -0,0 +1,99 @@
+package rjson
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectDuplicateKeys(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data   string
+		offset int
+	}{
+		{data: `{"a": 1, "b": 2}`, offset: -1},
+		{data: `{"a": 1, "a": 2}`, offset: 9},
+		{data: `{"\u0061": 1, "a": 2}`, offset: 14},
+		{data: `{"a\u0022": 1, "a\"": 2}`, offset: 15},
+		{data: `{"a": {"a": 1}, "b": {"a": 2}}`, offset: -1},
+		{data: `{"a": {"b": 1}, "b": {"b": 2, "c": [{"b": 1}, {"b": 2, "b": 3}]}}`, offset: 55},
+		{data: `[{"a": 1}, {"a": 2, "a": 3}]`, offset: 20},
+		{data: `[{"x": 1 "x": 2}]`, offset: -2},
+	} {
+		buf := &Buffer{RejectDuplicateKeys: true}
+		_, err := SkipValue([]byte(td.data), buf)
+		require.Equal(t, td.offset == -1, Valid([]byte(td.data), buf), td.data)
+		switch td.offset {
+		case -1:
+			require.NoError(t, err, td.data)
+			require.True(t, Valid([]byte(td.data), nil), td.data)
+		case -2:
+			require.True(t, errors.Is(err, ErrInvalidObject), td.data)
+		default:
+			require.True(t, errors.Is(err, ErrDuplicateKey), td.data)
+			var syntaxErr *SyntaxError
+			require.True(t, errors.As(err, &syntaxErr), td.data)
+			require.Equal(t, td.offset, syntaxErr.Offset, td.data)
+			require.True(t, Valid([]byte(td.data), nil), td.data)
+		}
+	}
+}
+
+func TestRejectDuplicateKeys_manyKeys(t *testing.T) {
+	t.Parallel()
+	var sb strings.Builder
+	sb.WriteString(`{`)
+	for i := 0; i < 10_000; i++ {
+		fmt.Fprintf(&sb, `"key%d": {"key%d": %d}, `, i, i, i)
+	}
+	sb.WriteString(`"key0": 1}`)
+	data := []byte(sb.String())
+	buf := &Buffer{RejectDuplicateKeys: true}
+	_, err := SkipValue(data, buf)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, len(data)-10, syntaxErr.Offset)
+
+	data = append(data[:len(data)-12], '}')
+	_, err = SkipValue(data, buf)
+	require.NoError(t, err)
+}
+
+func TestValueReader_DuplicateKeys(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"a": 1, "b": {"c": 1, "c": 2}, "a": 3}`)
+
+	var vr ValueReader
+	val, _, err := vr.ReadValue(data)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": 3.0, "b": map[string]interface{}{"c": 2.0}}, val)
+
+	vr = ValueReader{DuplicateKeys: DuplicateKeysFirstWins}
+	val, _, err = vr.ReadValue(data)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": 1.0, "b": map[string]interface{}{"c": 1.0}}, val)
+
+	vr = ValueReader{DuplicateKeys: DuplicateKeysError}
+	_, _, err = vr.ReadValue(data)
+	require.True(t, errors.Is(err, ErrDuplicateKey))
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 23, syntaxErr.Offset)
+	_, _, err = vr.ReadObject([]byte(`{"a": {"a": 1}}`))
+	require.NoError(t, err)
+}
+
+func TestRejectDuplicateKeys_allocs(t *testing.T) {
+	data := getTestdataJSONGz(t, "twitter.json")
+	buf := &Buffer{RejectDuplicateKeys: true}
+	_, err := SkipValue(data, buf)
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = SkipValue(data, buf) //nolint:errcheck // checked above
+	})
+	require.Zero(t, allocs)
+}
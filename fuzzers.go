@@ -1,8 +1,15 @@
 package rjson
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
 )
 
 type fuzzer struct {
@@ -12,35 +19,72 @@ type fuzzer struct {
 
 var fuzzers = []fuzzer{
 	{name: "fuzzReadFloat64", fn: fuzzReadFloat64},
+	{name: "fuzzReadFloat64NonFinite", fn: fuzzReadFloat64NonFinite},
+	{name: "fuzzReadFloat32", fn: fuzzReadFloat32},
 	{name: "fuzzReadUint64", fn: fuzzReadUint64},
 	{name: "fuzzReadUint32", fn: fuzzReadUint32},
+	{name: "fuzzReadUint16", fn: fuzzReadUint16},
+	{name: "fuzzReadUint8", fn: fuzzReadUint8},
 	{name: "fuzzReadUint", fn: fuzzReadUint},
 	{name: "fuzzReadInt64", fn: fuzzReadInt64},
 	{name: "fuzzReadInt32", fn: fuzzReadInt32},
+	{name: "fuzzReadInt16", fn: fuzzReadInt16},
+	{name: "fuzzReadInt8", fn: fuzzReadInt8},
 	{name: "fuzzReadInt", fn: fuzzReadInt},
+	{name: "fuzzReadBigInt", fn: fuzzReadBigInt},
+	{name: "fuzzReadBigFloat", fn: fuzzReadBigFloat},
+	{name: "fuzzReadDecimal", fn: fuzzReadDecimal},
+	{name: "fuzzReadNumberBytes", fn: fuzzReadNumberBytes},
+	{name: "fuzzReadIntegralInt64", fn: fuzzReadIntegralInt64},
+	{name: "fuzzReadIntegralUint64", fn: fuzzReadIntegralUint64},
+	{name: "fuzzReadIntegralInt", fn: fuzzReadIntegralInt},
+	{name: "fuzzReadIntegralUint", fn: fuzzReadIntegralUint},
+	{name: "fuzzReadQuotedInt64", fn: fuzzReadQuotedInt64},
+	{name: "fuzzReadQuotedUint64", fn: fuzzReadQuotedUint64},
+	{name: "fuzzReadQuotedInt", fn: fuzzReadQuotedInt},
+	{name: "fuzzReadQuotedUint", fn: fuzzReadQuotedUint},
+	{name: "fuzzReadQuotedFloat64", fn: fuzzReadQuotedFloat64},
 	{name: "fuzzReadString", fn: fuzzReadString},
 	{name: "fuzzReadStringBytes", fn: fuzzReadStringBytes},
 	{name: "fuzzReadBool", fn: fuzzReadBool},
 	{name: "fuzzReadNull", fn: fuzzReadNull},
 	{name: "fuzzSkipValue", fn: fuzzSkipValue},
 	{name: "fuzzValid", fn: fuzzValid},
+	{name: "fuzzValidIJSON", fn: fuzzValidIJSON},
+	{name: "fuzzJSON5", fn: fuzzJSON5},
 	{name: "fuzzNextToken", fn: fuzzNextToken},
 	{name: "fuzzReadArray", fn: fuzzReadArray},
 	{name: "fuzzReadObject", fn: fuzzReadObject},
 	{name: "fuzzReadValue", fn: fuzzReadValue},
 
 	{name: "fuzzDecodeFloat64", fn: fuzzDecodeFloat64},
+	{name: "fuzzDecodeFloat32", fn: fuzzDecodeFloat32},
 	{name: "fuzzDecodeUint64", fn: fuzzDecodeUint64},
 	{name: "fuzzDecodeUint32", fn: fuzzDecodeUint32},
+	{name: "fuzzDecodeUint16", fn: fuzzDecodeUint16},
+	{name: "fuzzDecodeUint8", fn: fuzzDecodeUint8},
 	{name: "fuzzDecodeUint", fn: fuzzDecodeUint},
 	{name: "fuzzDecodeInt64", fn: fuzzDecodeInt64},
 	{name: "fuzzDecodeInt32", fn: fuzzDecodeInt32},
+	{name: "fuzzDecodeInt16", fn: fuzzDecodeInt16},
+	{name: "fuzzDecodeInt8", fn: fuzzDecodeInt8},
 	{name: "fuzzDecodeInt", fn: fuzzDecodeInt},
+	{name: "fuzzDecodeIntegralInt64", fn: fuzzDecodeIntegralInt64},
+	{name: "fuzzDecodeIntegralUint64", fn: fuzzDecodeIntegralUint64},
+	{name: "fuzzDecodeIntegralInt", fn: fuzzDecodeIntegralInt},
+	{name: "fuzzDecodeIntegralUint", fn: fuzzDecodeIntegralUint},
+	{name: "fuzzDecodeQuotedInt64", fn: fuzzDecodeQuotedInt64},
+	{name: "fuzzDecodeQuotedUint64", fn: fuzzDecodeQuotedUint64},
+	{name: "fuzzDecodeQuotedInt", fn: fuzzDecodeQuotedInt},
+	{name: "fuzzDecodeQuotedUint", fn: fuzzDecodeQuotedUint},
+	{name: "fuzzDecodeQuotedFloat64", fn: fuzzDecodeQuotedFloat64},
 	{name: "fuzzDecodeString", fn: fuzzDecodeString},
 	{name: "fuzzDecodeBool", fn: fuzzDecodeBool},
 
 	{name: "fuzzHandleArrayValues", fn: fuzzHandleArrayValues},
 	{name: "fuzzHandleObjectValues", fn: fuzzHandleObjectValues},
+
+	{name: "fuzzUnmarshal", fn: fuzzUnmarshal},
 }
 
 func fuzzHandleArrayValues(data []byte) (int, error) {
@@ -189,6 +233,104 @@ func fuzzDecodeInt(data []byte) (int, error) {
 	return 0, err
 }
 
+func fuzzReadBigInt(data []byte) (int, error) {
+	want, wantP, wantErr := readBigIntCompat(data)
+	got, gotP, gotErr := ReadBigInt(data)
+	var wantStr, gotStr string
+	if want != nil && wantErr == nil {
+		wantStr = want.String()
+	}
+	if got != nil {
+		gotStr = got.String()
+	}
+	err := checkFuzzResults(wantStr, gotStr, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadBigFloat(data []byte) (int, error) {
+	want, wantP, wantErr := readBigFloatCompat(data)
+	got, gotP, gotErr := ReadBigFloat(data)
+	var wantStr, gotStr string
+	if want != nil {
+		wantStr = want.Text('p', 0)
+	}
+	if got != nil {
+		gotStr = got.Text('p', 0)
+	}
+	err := checkFuzzResults(wantStr, gotStr, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadDecimal(data []byte) (int, error) {
+	want, wantP, wantErr := readDecimalCompat(data)
+	got, gotP, gotErr := ReadDecimal(data)
+	var wantStr, gotStr string
+	if wantErr == nil {
+		wantStr = want.String()
+	}
+	if gotErr == nil {
+		gotStr = got.String()
+	}
+	err := checkFuzzResults(wantStr, gotStr, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadNumberBytes(data []byte) (int, error) {
+	want, wantP, wantErr := readNumberBytesCompat(data)
+	got, gotP, gotErr := ReadNumberBytes(data)
+	err := checkFuzzResults(string(want), string(got), wantP, gotP, wantErr, gotErr)
+	if err != nil || gotErr != nil {
+		return 0, err
+	}
+	// scanNumber and skipValue have to agree on where the number ends
+	skipP, _, _, skipErr := skipValue(data, nil, 0)
+	if skipErr != nil || skipP != gotP {
+		return 0, fmt.Errorf("ReadNumberBytes got p=%d, but skipValue got p=%d, err=%v", gotP, skipP, skipErr)
+	}
+	isInteger := !bytes.ContainsAny(got, ".eE")
+	if NumberIsInteger(got) != isInteger {
+		return 0, fmt.Errorf("NumberIsInteger(%q) should be %v", got, isInteger)
+	}
+	hasExp := bytes.ContainsAny(got, "eE")
+	if NumberHasExponent(got) != hasExp {
+		return 0, fmt.Errorf("NumberHasExponent(%q) should be %v", got, hasExp)
+	}
+	_, parseErr := strconv.ParseInt(string(got), 10, 64)
+	if NumberFitsInt64(got) != (parseErr == nil) {
+		return 0, fmt.Errorf("NumberFitsInt64(%q) should be %v", got, parseErr == nil)
+	}
+	_, parseErr = strconv.ParseUint(string(got), 10, 64)
+	if NumberFitsUint64(got) != (parseErr == nil) {
+		return 0, fmt.Errorf("NumberFitsUint64(%q) should be %v", got, parseErr == nil)
+	}
+	fitsFloat := numberFitsFloat64Compat(got)
+	if NumberFitsFloat64(got) != fitsFloat {
+		return 0, fmt.Errorf("NumberFitsFloat64(%q) should be %v", got, fitsFloat)
+	}
+	return 0, nil
+}
+
+// numberFitsFloat64Compat compares the exact values of raw and the shortest formatting of raw as a float64.
+func numberFitsFloat64Compat(raw []byte) bool {
+	f, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil {
+		return false
+	}
+	if f == 0 {
+		mantissa := raw
+		if i := bytes.IndexAny(raw, "eE"); i != -1 {
+			mantissa = raw[:i]
+		}
+		return len(bytes.Trim(mantissa, "-0.")) == 0
+	}
+	want, ok := new(big.Rat).SetString(string(raw))
+	if !ok {
+		return false
+	}
+	got, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'e', -1, 64))
+	return ok && want.Cmp(got) == 0
+}
+
 func fuzzReadFloat64(data []byte) (int, error) {
 	want, wantP, wantErr := readFloat64Compat(data)
 	got, gotP, gotErr := ReadFloat64(data)
@@ -196,6 +338,19 @@ func fuzzReadFloat64(data []byte) (int, error) {
 	return 0, err
 }
 
+func fuzzReadFloat64NonFinite(data []byte) (int, error) {
+	got, gotP, gotErr := ReadFloat64NonFinite(data)
+	want, wantP, wantErr := ReadFloat64(data)
+	if wantErr != nil {
+		if gotErr == nil && !math.IsNaN(got) && !math.IsInf(got, 0) {
+			return 0, fmt.Errorf("ReadFloat64NonFinite(%q) = %v, but ReadFloat64 failed: %v", data, got, wantErr)
+		}
+		return 0, nil
+	}
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
 func fuzzDecodeFloat64(data []byte) (int, error) {
 	var want, got float64
 	wantP, wantErr := decodeFloat64Compat(data, &want)
@@ -204,6 +359,216 @@ func fuzzDecodeFloat64(data []byte) (int, error) {
 	return 0, err
 }
 
+func fuzzReadFloat32(data []byte) (int, error) {
+	want, wantP, wantErr := readFloat32Compat(data)
+	got, gotP, gotErr := ReadFloat32(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeFloat32(data []byte) (int, error) {
+	var want, got float32
+	wantP, wantErr := decodeFloat32Compat(data, &want)
+	gotP, gotErr := DecodeFloat32(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadUint16(data []byte) (int, error) {
+	want, wantP, wantErr := readUint16Compat(data)
+	got, gotP, gotErr := ReadUint16(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeUint16(data []byte) (int, error) {
+	var want, got uint16
+	wantP, wantErr := decodeUint16Compat(data, &want)
+	gotP, gotErr := DecodeUint16(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadUint8(data []byte) (int, error) {
+	want, wantP, wantErr := readUint8Compat(data)
+	got, gotP, gotErr := ReadUint8(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeUint8(data []byte) (int, error) {
+	var want, got uint8
+	wantP, wantErr := decodeUint8Compat(data, &want)
+	gotP, gotErr := DecodeUint8(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadInt16(data []byte) (int, error) {
+	want, wantP, wantErr := readInt16Compat(data)
+	got, gotP, gotErr := ReadInt16(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeInt16(data []byte) (int, error) {
+	var want, got int16
+	wantP, wantErr := decodeInt16Compat(data, &want)
+	gotP, gotErr := DecodeInt16(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadInt8(data []byte) (int, error) {
+	want, wantP, wantErr := readInt8Compat(data)
+	got, gotP, gotErr := ReadInt8(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeInt8(data []byte) (int, error) {
+	var want, got int8
+	wantP, wantErr := decodeInt8Compat(data, &want)
+	gotP, gotErr := DecodeInt8(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadIntegralInt64(data []byte) (int, error) {
+	want, wantP, wantErr := readIntegralInt64Compat(data)
+	got, gotP, gotErr := ReadIntegralInt64(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadIntegralUint64(data []byte) (int, error) {
+	want, wantP, wantErr := readIntegralUint64Compat(data)
+	got, gotP, gotErr := ReadIntegralUint64(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadIntegralInt(data []byte) (int, error) {
+	want, wantP, wantErr := readIntegralIntCompat(data)
+	got, gotP, gotErr := ReadIntegralInt(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadIntegralUint(data []byte) (int, error) {
+	want, wantP, wantErr := readIntegralUintCompat(data)
+	got, gotP, gotErr := ReadIntegralUint(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadQuotedInt64(data []byte) (int, error) {
+	want, wantP, wantErr := readQuotedInt64Compat(data)
+	got, gotP, gotErr := ReadQuotedInt64(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadQuotedUint64(data []byte) (int, error) {
+	want, wantP, wantErr := readQuotedUint64Compat(data)
+	got, gotP, gotErr := ReadQuotedUint64(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadQuotedInt(data []byte) (int, error) {
+	want, wantP, wantErr := readQuotedIntCompat(data)
+	got, gotP, gotErr := ReadQuotedInt(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadQuotedUint(data []byte) (int, error) {
+	want, wantP, wantErr := readQuotedUintCompat(data)
+	got, gotP, gotErr := ReadQuotedUint(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzReadQuotedFloat64(data []byte) (int, error) {
+	want, wantP, wantErr := readQuotedFloat64Compat(data)
+	got, gotP, gotErr := ReadQuotedFloat64(data)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeIntegralInt64(data []byte) (int, error) {
+	var want, got int64
+	wantP, wantErr := decodeIntegralInt64Compat(data, &want)
+	gotP, gotErr := DecodeIntegralInt64(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeIntegralUint64(data []byte) (int, error) {
+	var want, got uint64
+	wantP, wantErr := decodeIntegralUint64Compat(data, &want)
+	gotP, gotErr := DecodeIntegralUint64(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeIntegralInt(data []byte) (int, error) {
+	var want, got int
+	wantP, wantErr := decodeIntegralIntCompat(data, &want)
+	gotP, gotErr := DecodeIntegralInt(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeIntegralUint(data []byte) (int, error) {
+	var want, got uint
+	wantP, wantErr := decodeIntegralUintCompat(data, &want)
+	gotP, gotErr := DecodeIntegralUint(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeQuotedInt64(data []byte) (int, error) {
+	var want, got int64
+	wantP, wantErr := decodeQuotedInt64Compat(data, &want)
+	gotP, gotErr := DecodeQuotedInt64(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeQuotedUint64(data []byte) (int, error) {
+	var want, got uint64
+	wantP, wantErr := decodeQuotedUint64Compat(data, &want)
+	gotP, gotErr := DecodeQuotedUint64(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeQuotedInt(data []byte) (int, error) {
+	var want, got int
+	wantP, wantErr := decodeQuotedIntCompat(data, &want)
+	gotP, gotErr := DecodeQuotedInt(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeQuotedUint(data []byte) (int, error) {
+	var want, got uint
+	wantP, wantErr := decodeQuotedUintCompat(data, &want)
+	gotP, gotErr := DecodeQuotedUint(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
+func fuzzDecodeQuotedFloat64(data []byte) (int, error) {
+	var want, got float64
+	wantP, wantErr := decodeQuotedFloat64Compat(data, &want)
+	gotP, gotErr := DecodeQuotedFloat64(data, &got)
+	err := checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	return 0, err
+}
+
 func fuzzReadString(data []byte) (int, error) {
 	want, wantP, wantErr := readStringCompat(data)
 	got, gotP, gotErr := ReadString(data, nil)
@@ -293,6 +658,45 @@ func fuzzValid(data []byte) (int, error) {
 	return 0, err
 }
 
+func fuzzValidIJSON(data []byte) (int, error) {
+	report := ValidIJSON(data, nil)
+	if (report.Err == nil) != json.Valid(data) {
+		return 0, fmt.Errorf("ValidIJSON(%q).Err should be nil: %v", data, json.Valid(data))
+	}
+	if report.Err != nil {
+		return 0, nil
+	}
+	var stringViolations bool
+	for _, v := range report.Violations {
+		if v.Err != ErrNumberPrecision {
+			stringViolations = true
+		}
+	}
+	strict := Valid(data, &Buffer{StrictUTF8: true, RejectDuplicateKeys: true})
+	if strict == stringViolations {
+		return 0, fmt.Errorf("ValidIJSON(%q) has string violations %v but strict Valid is %v", data, report.Violations, strict)
+	}
+	return 0, nil
+}
+
+func fuzzJSON5(data []byte) (int, error) {
+	got, _, gotErr := JSON5.ReadValue(data)
+	if !Valid(data, nil) {
+		return 0, nil
+	}
+	if !JSON5.Valid(data, nil) {
+		return 0, fmt.Errorf("JSON5 should accept json %q", data)
+	}
+	want, _, wantErr := ReadValue(data)
+	if (wantErr == nil) != (gotErr == nil) {
+		return 0, fmt.Errorf("JSON5.ReadValue(%q) err = %v, want %v", data, gotErr, wantErr)
+	}
+	if !reflect.DeepEqual(want, got) {
+		return 0, fmt.Errorf("JSON5.ReadValue(%q) = %v, want %v", data, got, want)
+	}
+	return 0, nil
+}
+
 func fuzzNextToken(data []byte) (int, error) {
 	want, wantP, wantErr := nextTokenCompat(data)
 	got, gotP, gotErr := NextToken(data)
@@ -349,9 +753,43 @@ func fuzzReadValue(data []byte) (int, error) {
 	got, gotP, gotErr = (&ValueReader{}).ReadValue(data)
 	got = stdLibCompatibleValue(got)
 	err = checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
+	if err != nil {
+		return 0, err
+	}
+	// and again with UseNumber
+	want, wantP, wantErr = readValueUseNumberCompat(data)
+	got, gotP, gotErr = (&ValueReader{UseNumber: true}).ReadValue(data)
+	got = stdLibNumbers(stdLibCompatibleValue(got))
+	err = checkFuzzResults(want, got, wantP, gotP, wantErr, gotErr)
 	return 0, err
 }
 
+func fuzzUnmarshal(data []byte) (int, error) {
+	for _, newTarget := range unmarshalTargets {
+		want, got := newTarget(), newTarget()
+		wantErr := json.Unmarshal(data, want)
+		gotErr := Unmarshal(data, got)
+		err := checkFuzzErrors(wantErr, gotErr)
+		if err != nil {
+			return 0, fmt.Errorf("%T: %v", want, err)
+		}
+		// both keep decoding after a type error, so values are compared when that is the only error
+		var wantTypeErr *json.UnmarshalTypeError
+		var gotTypeErr *UnmarshalTypeError
+		if wantErr != nil && !(errors.As(wantErr, &wantTypeErr) && errors.As(gotErr, &gotTypeErr)) {
+			continue
+		}
+		// rjson keeps invalid utf8 in strings, so only compare values when data is valid utf8
+		if !utf8.Valid(data) {
+			continue
+		}
+		if !reflect.DeepEqual(want, got) {
+			return 0, fmt.Errorf("%T: expected %+v but got %+v", want, want, got)
+		}
+	}
+	return 0, nil
+}
+
 func checkFuzzResults(want, got interface{}, wantP, gotP int, wantErr, gotErr error) error {
 	err := checkFuzzErrors(wantErr, gotErr)
 	if err != nil {
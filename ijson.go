@@ -0,0 +1,175 @@
+package rjson
+
+import "fmt"
+
+// ErrNumberPrecision is reported by ValidIJSON for numbers with more magnitude or precision than a float64 has. See
+// NumberFitsFloat64.
+var ErrNumberPrecision = fmt.Errorf("number out of float64 range or precision")
+
+// IJSONReport is the result of ValidIJSON.
+type IJSONReport struct {
+	// Err is set when data isn't a single valid json value. It is the error SkipValue returns or a *SyntaxError for
+	// ErrTrailingData. The I-JSON checks aren't done when Err is set.
+	Err error
+
+	// Violations are the ways data doesn't conform to I-JSON in the order they appear in data.
+	Violations []IJSONViolation
+}
+
+// Valid returns true when data is valid I-JSON.
+func (r *IJSONReport) Valid() bool {
+	return r.Err == nil && len(r.Violations) == 0
+}
+
+// IJSONViolation is one way a document doesn't conform to I-JSON.
+type IJSONViolation struct {
+	// Offset is the position in data of the problem. It is the byte that isn't valid utf-8 or the unpaired surrogate
+	// escape in a string, the second occurrence of a duplicate key or the start of a number.
+	Offset int
+
+	// Err is ErrInvalidUTF8, ErrDuplicateKey or ErrNumberPrecision.
+	Err error
+}
+
+func (v IJSONViolation) Error() string {
+	return fmt.Sprintf("%v at offset %d", v.Err, v.Offset)
+}
+
+// ValidIJSON checks that data holds a single json value that conforms to I-JSON as defined in RFC 7493. Strings and
+// object keys must be valid utf-8 without unpaired surrogate escapes, objects must not have duplicate keys, and
+// numbers must fit in a float64 without losing magnitude or precision. Instead of stopping at the first problem like
+// Valid, it reports every violation. Each string has at most one utf-8 violation. When buffer.AllowNonFinite is set,
+// NaN, Infinity and -Infinity are accepted without a violation because a float64 holds them.
+// buffer is optional. Reusing a buffer can reduce memory allocations.
+func ValidIJSON(data []byte, buffer *Buffer) IJSONReport {
+	var report IJSONReport
+	p, err := SkipValue(data, buffer)
+	if err == nil && p+buffer.countWhitespace(data[p:]) != len(data) {
+		err = toSyntaxError(data, p+buffer.countWhitespace(data[p:]), ErrTrailingData, "")
+	}
+	if err != nil {
+		report.Err = err
+		return report
+	}
+	c := ijsonChecker{
+		data:      data,
+		report:    &report,
+		jsonc:     buffer != nil && buffer.JSONC,
+		nonFinite: buffer != nil && buffer.AllowNonFinite,
+	}
+	if buffer != nil {
+		c.keys = &buffer.keys
+		c.scratch = &buffer.strictStrings
+	} else {
+		c.keys = new(keyTable)
+		c.scratch = new([]byte)
+	}
+	c.keys.reset()
+	c.value(0)
+	return report
+}
+
+// ijsonChecker walks a valid json value and adds the I-JSON violations it finds to report.
+type ijsonChecker struct {
+	data      []byte
+	keys      *keyTable
+	report    *IJSONReport
+	scratch   *[]byte // reused for the unescaped strings appendStringStrict returns
+	jsonc     bool    // data can have comments and trailing commas
+	nonFinite bool    // data can have NaN, Infinity and -Infinity
+}
+
+func (c *ijsonChecker) violation(offset int, err error) {
+	c.report.Violations = append(c.report.Violations, IJSONViolation{
+		Offset: offset,
+		Err:    err,
+	})
+}
+
+func (c *ijsonChecker) space(p int) int {
+	if c.jsonc {
+		return p + countWhitespaceComments(c.data[p:])
+	}
+	return p + countWhitespace(c.data[p:])
+}
+
+// value returns the position after the value at p.
+func (c *ijsonChecker) value(p int) int {
+	p = c.space(p)
+	switch c.data[p] {
+	case '{':
+		return c.object(p)
+	case '[':
+		return c.array(p)
+	case '"':
+		return c.string(p)
+	case 't', 'n':
+		return p + 4
+	case 'f':
+		return p + 5
+	default:
+		if _, n := nonFiniteLiteral(c.data[p:]); c.nonFinite && n > 0 {
+			return p + n
+		}
+		raw, pp, _ := ReadNumberBytes(c.data[p:])
+		if !NumberFitsFloat64(raw) {
+			c.violation(p, ErrNumberPrecision)
+		}
+		return p + pp
+	}
+}
+
+func (c *ijsonChecker) string(p int) int {
+	var pp int
+	var err error
+	*c.scratch, pp, err = appendStringStrict(c.data[p+1:], (*c.scratch)[:0])
+	if err == nil {
+		return p + 1 + pp
+	}
+	c.violation(p+1+pp, ErrInvalidUTF8)
+	pp, _ = skipStringFast(c.data[p:])
+	return p + pp
+}
+
+func (c *ijsonChecker) array(p int) int {
+	p = c.space(p + 1)
+	if c.data[p] == ']' {
+		return p + 1
+	}
+	for {
+		p = c.space(c.value(p))
+		if c.data[p] == ']' {
+			return p + 1
+		}
+		p = c.space(p + 1)
+		if c.data[p] == ']' {
+			// a JSONC trailing comma
+			return p + 1
+		}
+	}
+}
+
+func (c *ijsonChecker) object(p int) int {
+	p = c.space(p + 1)
+	if c.data[p] == '}' {
+		return p + 1
+	}
+	object := c.keys.newObject()
+	for {
+		keyStart := p
+		p = c.string(p)
+		if !c.keys.add(c.data, object, keyStart+1, p-1) {
+			c.violation(keyStart, ErrDuplicateKey)
+		}
+		p = c.space(p) + 1
+		p = c.space(c.value(p))
+		if c.data[p] == '}' {
+			return p + 1
+		}
+		p = c.space(p + 1)
+		if c.data[p] == '}' {
+			// a JSONC trailing comma
+			return p + 1
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package rjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidIJSON(t *testing.T) {
+	t.Parallel()
+	data := []byte("{\"a\": [1, 1e400, \"\\ud800\"], \"b\": {\"c\": \"\xff\", \"c\": 2}, \"a\": 9007199254740993}")
+	report := ValidIJSON(data, nil)
+	require.NoError(t, report.Err)
+	require.False(t, report.Valid())
+	require.Equal(t, []IJSONViolation{
+		{Offset: 10, Err: ErrNumberPrecision},
+		{Offset: 18, Err: ErrInvalidUTF8},
+		{Offset: 40, Err: ErrInvalidUTF8},
+		{Offset: 44, Err: ErrDuplicateKey},
+		{Offset: 53, Err: ErrDuplicateKey},
+		{Offset: 58, Err: ErrNumberPrecision},
+	}, report.Violations)
+	require.EqualError(t, report.Violations[0], "number out of float64 range or precision at offset 10")
+
+	var buf Buffer
+	report = ValidIJSON([]byte(`{"a": [1, 0.1, "\ud83d\ude00"], "b": {"a": 2}}`), &buf)
+	require.True(t, report.Valid())
+	report = ValidIJSON([]byte(` "x" `), &buf)
+	require.True(t, report.Valid())
+
+	report = ValidIJSON([]byte(`{"a": 1} {}`), &buf)
+	require.False(t, report.Valid())
+	require.True(t, errors.Is(report.Err, ErrTrailingData))
+
+	report = ValidIJSON([]byte(`{"a": 1, "a": 2 "a": 3}`), &buf)
+	require.True(t, errors.Is(report.Err, ErrInvalidObject))
+	require.Empty(t, report.Violations)
+}
+
+func TestValidIJSON_JSONC(t *testing.T) {
+	t.Parallel()
+	buf := Buffer{JSONC: true}
+	report := ValidIJSON([]byte(`{"a":1,}`), &buf)
+	require.True(t, report.Valid())
+	report = ValidIJSON([]byte(`[1 /* x */, 2] // end`), &buf)
+	require.True(t, report.Valid())
+	report = ValidIJSON([]byte(`{"a": [1e400, /* c */], /* c */ "a": 2,}`), &buf)
+	require.NoError(t, report.Err)
+	require.Equal(t, []IJSONViolation{
+		{Offset: 7, Err: ErrNumberPrecision},
+		{Offset: 32, Err: ErrDuplicateKey},
+	}, report.Violations)
+	report = ValidIJSON([]byte(`[1 /* x */, 2]`), nil)
+	require.Error(t, report.Err)
+}
+
+func TestValidIJSON_allocs(t *testing.T) {
+	data := getTestdataJSONGz(t, "twitter.json")
+	var buf Buffer
+	report := ValidIJSON(data, &buf)
+	require.True(t, report.Valid())
+	allocs := testing.AllocsPerRun(10, func() {
+		_ = ValidIJSON(data, &buf)
+	})
+	require.Zero(t, allocs)
+}
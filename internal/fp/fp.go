@@ -6,8 +6,9 @@ import (
 )
 
 var (
-	errSyntax = fmt.Errorf("syntax error")
-	errRange  = fmt.Errorf("number out of float64 range")
+	errSyntax  = fmt.Errorf("syntax error")
+	errRange   = fmt.Errorf("number out of float64 range")
+	errRange32 = fmt.Errorf("number out of float32 range")
 )
 
 // ParseJSONFloatPrefix is a bit like strconv.ParseFloat but it deals in byte slices instead of strings and
@@ -52,7 +53,7 @@ func ParseJSONFloatPrefix(data []byte) (f float64, n int, err error) {
 	if !d.set(data[:n]) {
 		return 0, n, errSyntax
 	}
-	b, ovf := d.floatBits()
+	b, ovf := d.floatBits(&float64info)
 	f = math.Float64frombits(b)
 	if ovf {
 		err = errRange
@@ -63,6 +64,56 @@ func ParseJSONFloatPrefix(data []byte) (f float64, n int, err error) {
 	return f, n, err
 }
 
+// ParseJSONFloat32Prefix is ParseJSONFloatPrefix for float32. The result is correctly rounded to float32 instead of
+// being rounded to float64 first.
+func ParseJSONFloat32Prefix(data []byte) (f float32, n int, err error) {
+	var mantissa uint64
+	var exp int
+	var neg, trunc, ok bool
+	mantissa, exp, neg, trunc, n, ok = readFloat(data)
+	if !ok {
+		return 0, 0, errSyntax
+	}
+
+	// to match json behavior: a '.' at the end of the parsed data is an error.
+	if n > 0 && data[n-1] == '.' {
+		return 0, 0, errSyntax
+	}
+
+	// Try pure floating-point arithmetic conversion, and if that fails,
+	// the Eisel-Lemire algorithm.
+	if !trunc {
+		if f2, ok := atof32exact(mantissa, exp, neg); ok {
+			return f2, n, nil
+		}
+	}
+
+	if f2, ok := eiselLemire32(mantissa, exp, neg); ok {
+		if !trunc {
+			return f2, n, nil
+		}
+		// Even if the mantissa was truncated, we may
+		// have found the correct result. Confirm by
+		// converting the upper mantissa bound.
+		fUp, ok := eiselLemire32(mantissa+1, exp, neg)
+		if ok && f2 == fUp {
+			return f2, n, nil
+		}
+	}
+
+	// Slow fallback.
+	var d decimal
+	if !d.set(data[:n]) {
+		return 0, n, errSyntax
+	}
+	b, ovf := d.floatBits(&float32info)
+	f = math.Float32frombits(uint32(b))
+	if ovf {
+		return 0, n, errRange32
+	}
+	return f, n, nil
+}
+
 // readFloat reads a decimal mantissa and exponent from a float
 // string representation in s; the number may be followed by other characters.
 // readFloat reports the number of bytes consumed (i), and whether the number
@@ -319,14 +370,14 @@ func (a *decimal) set(data []byte) (ok bool) {
 // decimal power of ten to binary power of two.
 var powtab = []int{1, 3, 6, 9, 13, 16, 19, 23, 26}
 
-func (a *decimal) floatBits() (b uint64, overflow bool) {
+func (a *decimal) floatBits(flt *floatInfo) (b uint64, overflow bool) {
 	var exp int
 	var mant uint64
 
 	// Zero is always a special case.
 	if a.nd == 0 {
 		mant = 0
-		exp = bias
+		exp = flt.bias
 		goto out
 	}
 
@@ -339,7 +390,7 @@ func (a *decimal) floatBits() (b uint64, overflow bool) {
 	if a.dp < -330 {
 		// zero
 		mant = 0
-		exp = bias
+		exp = flt.bias
 		goto out
 	}
 
@@ -369,58 +420,63 @@ func (a *decimal) floatBits() (b uint64, overflow bool) {
 	// Our range is [0.5,1) but floating point range is [1,2).
 	exp--
 
-	// Minimum representable exponent is bias+1.
+	// Minimum representable exponent is flt.bias+1.
 	// If the exponent is smaller, move it up and
 	// adjust a accordingly.
-	if exp < bias+1 {
-		n := bias + 1 - exp
+	if exp < flt.bias+1 {
+		n := flt.bias + 1 - exp
 		a.Shift(-n)
 		exp += n
 	}
 
-	if exp-bias >= 1<<expbits-1 {
+	if exp-flt.bias >= 1<<flt.expbits-1 {
 		goto overflow
 	}
 
 	// Extract 1+flt.mantbits bits.
-	a.Shift(int(1 + mantbits))
+	a.Shift(int(1 + flt.mantbits))
 	mant = a.RoundedInteger()
 
 	// Rounding might have added a bit; shift down.
-	if mant == 2<<mantbits {
+	if mant == 2<<flt.mantbits {
 		mant >>= 1
 		exp++
-		if exp-bias >= 1<<expbits-1 {
+		if exp-flt.bias >= 1<<flt.expbits-1 {
 			goto overflow
 		}
 	}
 
 	// Denormalized?
-	if mant&(1<<mantbits) == 0 {
-		exp = bias
+	if mant&(1<<flt.mantbits) == 0 {
+		exp = flt.bias
 	}
 	goto out
 
 overflow:
 	// ±Inf
 	mant = 0
-	exp = 1<<expbits - 1 + bias
+	exp = 1<<flt.expbits - 1 + flt.bias
 	overflow = true
 
 out:
 	// Assemble bits.
-	bits := mant & (uint64(1)<<mantbits - 1)
-	bits |= uint64((exp-bias)&(1<<expbits-1)) << mantbits
+	bits := mant & (uint64(1)<<flt.mantbits - 1)
+	bits |= uint64((exp-flt.bias)&(1<<flt.expbits-1)) << flt.mantbits
 	if a.neg {
-		bits |= 1 << mantbits << expbits
+		bits |= 1 << flt.mantbits << flt.expbits
 	}
 	return bits, overflow
 }
 
-const (
-	mantbits uint = 52
-	expbits  uint = 11
-	bias     int  = -1023
+type floatInfo struct {
+	mantbits uint
+	expbits  uint
+	bias     int
+}
+
+var (
+	float32info = floatInfo{23, 8, -127}
+	float64info = floatInfo{52, 11, -1023}
 )
 
 // Exact powers of 10.
@@ -438,7 +494,7 @@ var float64pow10 = []float64{
 //	value is exact integer / exact power of ten
 // These all produce potentially inexact but correctly rounded answers.
 func atof64exact(mantissa uint64, exp int, neg bool) (f float64, ok bool) {
-	if mantissa>>mantbits != 0 {
+	if mantissa>>float64info.mantbits != 0 {
 		return
 	}
 	f = float64(mantissa)
@@ -468,3 +524,38 @@ func atof64exact(mantissa uint64, exp int, neg bool) (f float64, ok bool) {
 	}
 	return
 }
+
+// Exact powers of 10.
+var float32pow10 = []float32{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10}
+
+// atof32exact is atof64exact for float32.
+func atof32exact(mantissa uint64, exp int, neg bool) (f float32, ok bool) {
+	if mantissa>>float32info.mantbits != 0 {
+		return
+	}
+	f = float32(mantissa)
+	if neg {
+		f = -f
+	}
+	switch {
+	case exp == 0:
+		return f, true
+	// Exact integers are <= 10^7.
+	// Exact powers of ten are <= 10^10.
+	case exp > 0 && exp <= 7+10: // int * 10^k
+		// If exponent is big but number of digits is not,
+		// can move a few zeros into the integer part.
+		if exp > 10 {
+			f *= float32pow10[exp-10]
+			exp = 10
+		}
+		if f > 1e7 || f < -1e7 {
+			// the exponent was really too large.
+			return
+		}
+		return f * float32pow10[exp], true
+	case exp < 0 && exp >= -10: // int / 10^k
+		return f / float32pow10[-exp], true
+	}
+	return
+}
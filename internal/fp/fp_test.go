@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -148,3 +149,53 @@ func TestParseJSONFloatPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJSONFloat32Prefix(t *testing.T) {
+	for _, s := range []string{
+		"0",
+		"-0",
+		"1",
+		"-1.5",
+		"0.1",
+		"1e10",
+		"1e17",
+		"16777216",
+		"16777217",
+		"16777219",
+		"1.00000017881393432617187499",
+		"1.000000178813934326171875",
+		"1.000000178813934326171875001",
+		"3.4028234663852886e38",
+		"3.4028235677973366e38",
+		"3.4028236e38",
+		"1.17549435e-38",
+		"1.4e-45",
+		"7e-46",
+		"1e-46",
+		"811296384146066816958.48",
+		"0.000000000000000000000000000000000000000000001401298464324817070923729583289916131280",
+		"123456789012345678901234567890",
+		"1e39",
+		"-1e39",
+		"1.",
+		"-",
+		"",
+	} {
+		t.Run(s, func(t *testing.T) {
+			_, wantOffset, wantErr := jsonEquiv([]byte(s))
+			got, gotOffset, gotErr := ParseJSONFloat32Prefix([]byte(s))
+			if wantErr != nil {
+				assert.Error(t, gotErr)
+				return
+			}
+			want, err := strconv.ParseFloat(s, 32)
+			if err != nil {
+				assert.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+			assert.Equal(t, wantOffset, gotOffset)
+			assert.Equal(t, float32(want), got)
+		})
+	}
+}
@@ -0,0 +1,407 @@
+package rjson
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/willabides/rjson/internal/fp"
+)
+
+// JSON5 reads JSON5 documents with the same handlers as the rest of rjson. See JSON5Parser.
+var JSON5 JSON5Parser
+
+// JSON5Parser reads JSON5 (https://spec.json5.org) documents. JSON5 adds comments, trailing commas, single-quoted
+// strings, unquoted keys, hex numbers, leading and trailing decimal points, NaN, Infinity and a few more escape
+// sequences to json. Use it through the JSON5 variable like rjson.JSON5.HandleObjectValues(data, handler, &buffer).
+//
+// JSON5 is read by its own set of state machines that work like the ones for json, so handlers are given the JSON5
+// the way it is written in data. They can read it with JSON5Parser's Read methods or make nested calls to its Handle
+// methods. The Buffer options are checked the same way they are for json. JSONC and AllowNonFinite don't apply to
+// JSON5, which always has comments, trailing commas, NaN and Infinity.
+type JSON5Parser struct{}
+
+// HandleObjectValues is like HandleObjectValues for a JSON5 object. handler is given keys without their quotes and
+// with escapes the way they are written in data. UnescapeStringContent unescapes them. buffer is optional. Reusing a
+// buffer can reduce memory allocations.
+func (JSON5Parser) HandleObjectValues(data []byte, handler ObjectValueHandler, buffer *Buffer) (p int, err error) {
+	if buffer == nil {
+		buffer = new(Buffer)
+	}
+	if buffer.TrackPath {
+		return handleObjectValuesWithPath(data, handler, buffer, true)
+	}
+	p, expected, err := buffer.handleObjectValues(data, handler, true)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
+	}
+	return p, nil
+}
+
+// HandleArrayValues is like HandleArrayValues for a JSON5 array. buffer is optional. Reusing a buffer can reduce
+// memory allocations.
+func (JSON5Parser) HandleArrayValues(data []byte, handler ArrayValueHandler, buffer *Buffer) (p int, err error) {
+	if buffer == nil {
+		buffer = new(Buffer)
+	}
+	if buffer.TrackPath {
+		return handleArrayValuesWithPath(data, handler, buffer, true)
+	}
+	p, expected, err := buffer.handleArrayValues(data, handler, true)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
+	}
+	return p, nil
+}
+
+// SkipValue is like SkipValue for a JSON5 value. buffer is optional. Reusing a buffer can reduce memory allocations.
+func (JSON5Parser) SkipValue(data []byte, buffer *Buffer) (p int, err error) {
+	if buffer == nil {
+		buffer = new(Buffer)
+	}
+	p, expected, err := buffer.json5SkipValue(data)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
+	}
+	return p, nil
+}
+
+// Valid returns true if data contains a single valid JSON5 value. buffer is optional. Reusing a buffer can reduce
+// memory allocations.
+func (JSON5Parser) Valid(data []byte, buffer *Buffer) bool {
+	if buffer == nil {
+		buffer = new(Buffer)
+	}
+	p, _, err := buffer.json5SkipValue(data)
+	if err != nil {
+		return false
+	}
+	if p > len(data) {
+		return true
+	}
+	n, err := skipJSON5Space(data[p:])
+	return err == nil && p+n == len(data)
+}
+
+func (buffer *Buffer) json5SkipValue(data []byte) (p int, expected string, err error) {
+	buffer.beginCheck()
+	p, buffer.stackBuf, expected, err = json5SkipValue(data, buffer.stackBuf, buffer.depth, buffer)
+	buffer.endCheck()
+	return p, expected, err
+}
+
+// UnescapeStringContent is like UnescapeStringContent for the content of a JSON5 string or an unquoted key. data
+// must not include the quotes.
+func (JSON5Parser) UnescapeStringContent(data, dst []byte) (val []byte, p int, err error) {
+	return json5UnescapeStringContent(data, dst)
+}
+
+// ReadStringBytes is like ReadStringBytes for a JSON5 string with either kind of quote.
+func (JSON5Parser) ReadStringBytes(data, buf []byte) (val []byte, p int, err error) {
+	p, err = skipJSON5Space(data)
+	if err != nil {
+		return buf, p, err
+	}
+	if p == len(data) || data[p] != '"' && data[p] != '\'' {
+		return buf, p, fmt.Errorf("not a string")
+	}
+	val, pp, err := json5AppendString(data[p:], buf)
+	if err != nil {
+		return buf, p + pp, err
+	}
+	return val, p + pp, nil
+}
+
+// ReadString is like ReadString for a JSON5 string with either kind of quote.
+func (JSON5Parser) ReadString(data []byte, buf *[]byte) (val string, p int, err error) {
+	var bBuf []byte
+	if buf != nil {
+		bBuf = (*buf)[:0]
+	}
+	bBuf, p, err = JSON5.ReadStringBytes(data, bBuf)
+	if buf != nil {
+		*buf = bBuf
+	}
+	return string(bBuf), p, err
+}
+
+// ReadFloat64 is like ReadFloat64 for a JSON5 number, which can be a hex number, NaN or Infinity.
+func (JSON5Parser) ReadFloat64(data []byte) (val float64, p int, err error) {
+	p, err = skipJSON5Space(data)
+	if err != nil {
+		return 0, p, err
+	}
+	hex, n, err := scanJSON5Number(data[p:])
+	if err != nil {
+		return 0, p + n, err
+	}
+	num := data[p : p+n]
+	p += n
+	neg := num[0] == '-'
+	if num[0] == '-' || num[0] == '+' {
+		num = num[1:]
+	}
+	switch {
+	case hex:
+		val = json5HexFloat(num[2:])
+	case num[0] == 'I':
+		val = math.Inf(1)
+	case num[0] == 'N':
+		val = math.NaN()
+	default:
+		var pp int
+		val, pp, err = fp.ParseJSONFloatPrefix(num)
+		if pp == len(num) && err != nil {
+			return 0, p, err
+		}
+		if pp != len(num) {
+			// leading and trailing decimal points aren't json
+			val, err = strconv.ParseFloat(string(num), 64)
+			if err != nil {
+				return 0, p, ErrNumberRange
+			}
+		}
+	}
+	if neg {
+		val = -val
+	}
+	return val, p, nil
+}
+
+// ReadInt64 is like ReadInt64 for a JSON5 number, which can be a hex number.
+func (JSON5Parser) ReadInt64(data []byte) (val int64, p int, err error) {
+	p, err = skipJSON5Space(data)
+	if err != nil {
+		return 0, p, err
+	}
+	hex, n, err := scanJSON5Number(data[p:])
+	if err != nil {
+		return 0, p + n, ErrInvalidInt
+	}
+	end := p + n
+	if data[p] == '+' {
+		p++
+	}
+	if !hex {
+		var pp int
+		val, pp, err = ReadInt64(data[p:end])
+		p += pp
+		if err == nil && p != end {
+			err = ErrInvalidInt
+		}
+		return val, p, err
+	}
+	neg := data[p] == '-'
+	if neg {
+		p++
+	}
+	var u64 uint64
+	for _, c := range data[p+2 : end] {
+		if u64 > math.MaxUint64>>4 {
+			return 0, end, ErrNumberRange
+		}
+		u64 = u64<<4 | uint64(getu4Digit(c))
+	}
+	const cutoff = uint64(1 << 63)
+	switch {
+	case neg && u64 > cutoff, !neg && u64 >= cutoff:
+		return 0, end, ErrNumberRange
+	case neg:
+		return -int64(u64), end, nil
+	default:
+		return int64(u64), end, nil
+	}
+}
+
+// ReadValue is like ReadValue for a JSON5 value. Numbers are float64.
+func (JSON5Parser) ReadValue(data []byte) (val interface{}, p int, err error) {
+	var r json5ValueReader
+	return r.readValue(data)
+}
+
+// json5ValueReader reads JSON5 values for JSON5.ReadValue. Nested objects and arrays share its Buffer.
+type json5ValueReader struct {
+	buffer Buffer
+	strBuf []byte
+}
+
+func (r *json5ValueReader) readValue(data []byte) (val interface{}, p int, err error) {
+	p, err = skipJSON5Space(data)
+	if err != nil {
+		return nil, p, err
+	}
+	if p == len(data) {
+		return nil, p, ErrUnexpectedEOF
+	}
+	var pp int
+	switch data[p] {
+	case '{':
+		obj := map[string]interface{}{}
+		pp, err = JSON5.HandleObjectValues(data[p:], ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+			var err error
+			r.strBuf, _, err = json5UnescapeStringContent(fieldname, r.strBuf[:0])
+			if err != nil {
+				return 0, err
+			}
+			key := string(r.strBuf)
+			fieldVal, p, err := r.readValue(data)
+			obj[key] = fieldVal
+			return p, err
+		}), &r.buffer)
+		val = obj
+	case '[':
+		arr := []interface{}{}
+		pp, err = JSON5.HandleArrayValues(data[p:], ArrayValueHandlerFunc(func(data []byte) (int, error) {
+			elem, p, err := r.readValue(data)
+			arr = append(arr, elem)
+			return p, err
+		}), &r.buffer)
+		val = arr
+	case '"', '\'':
+		r.strBuf, pp, err = JSON5.ReadStringBytes(data[p:], r.strBuf[:0])
+		val = string(r.strBuf)
+	case 't', 'f':
+		val, pp, err = ReadBool(data[p:])
+	case 'n':
+		pp, err = ReadNull(data[p:])
+	default:
+		val, pp, err = JSON5.ReadFloat64(data[p:])
+	}
+	if err != nil {
+		return nil, p + pp, err
+	}
+	return val, p + pp, nil
+}
+
+// json5HexFloat returns the value of the hex digits in hex.
+func json5HexFloat(hex []byte) float64 {
+	if len(hex) <= 16 {
+		var u64 uint64
+		for _, c := range hex {
+			u64 = u64<<4 | uint64(getu4Digit(c))
+		}
+		return float64(u64)
+	}
+	i, _ := new(big.Int).SetString(string(hex), 16)
+	val, _ := new(big.Float).SetInt(i).Float64()
+	return val
+}
+
+// skipJSON5Comment returns the position of the last byte of the comment whose second byte is at p. A line comment ends
+// before its line terminator. A block comment that isn't closed runs to pe.
+func skipJSON5Comment(data []byte, p, pe int) int {
+	if data[p] == '*' {
+		end := bytes.Index(data[p+1:pe], []byte("*/"))
+		if end == -1 {
+			return pe - 1
+		}
+		return p + end + 2
+	}
+	for p+1 < pe && data[p+1] != '\n' && data[p+1] != '\r' && !isJSON5LineSeparator(data[p+1:pe]) {
+		p++
+	}
+	return p
+}
+
+func isJSON5LineSeparator(data []byte) bool {
+	return len(data) >= 3 && data[0] == 0xe2 && data[1] == 0x80 && (data[2] == 0xa8 || data[2] == 0xa9)
+}
+
+// json5SpaceLen returns the length of the whitespace character outside ascii at the start of data or 0 when data doesn't
+// start with one.
+func json5SpaceLen(data []byte) int {
+	r, size := utf8.DecodeRune(data)
+	if r == '\u2028' || r == '\u2029' || r == '\ufeff' || r >= utf8.RuneSelf && unicode.Is(unicode.Zs, r) {
+		return size
+	}
+	return 0
+}
+
+// json5EscapeLen returns the length of the escape sequence at the start of data. When it isn't valid, n is the position
+// of the problem. A \u escape that is a surrogate must be part of a pair when strictUTF8 is true.
+func json5EscapeLen(data []byte, strictUTF8 bool) (n int, err error) {
+	if len(data) < 2 {
+		return len(data), ErrUnexpectedEOF
+	}
+	switch c := data[1]; {
+	case c == 'x':
+		if len(data) < 4 || getu4Digit(data[2]) == -1 || getu4Digit(data[3]) == -1 {
+			return 2, ErrInvalidString
+		}
+		return 4, nil
+	case c == 'u':
+		if getu4(data) == -1 {
+			return 2, ErrInvalidString
+		}
+		if !strictUTF8 {
+			return 6, nil
+		}
+		if n = unicodeEscapeLen(data); n == 0 {
+			return 0, ErrInvalidUTF8
+		}
+		return n, nil
+	case c == '0':
+		if len(data) > 2 && digits[data[2]] {
+			return 2, ErrInvalidString
+		}
+		return 2, nil
+	case digits[c]:
+		return 1, ErrInvalidString
+	case c == '\r' && len(data) > 2 && data[2] == '\n':
+		return 3, nil
+	case c >= utf8.RuneSelf && strictUTF8:
+		if n = utf8CharLen(data[1:]); n == 0 {
+			return 1, ErrInvalidUTF8
+		}
+		return 1 + n, nil
+	default:
+		// any other character stands for itself
+		return 2, nil
+	}
+}
+
+// scanJSON5Identifier returns the length of the unquoted key at the start of data or 0 when data doesn't start with
+// one.
+func scanJSON5Identifier(data []byte) int {
+	p := 0
+	for p < len(data) {
+		r, size := rune(data[p]), 1
+		switch {
+		case r == '\\':
+			r, size = getu4(data[p:]), 6
+		case r >= utf8.RuneSelf:
+			r, size = utf8.DecodeRune(data[p:])
+		}
+		if !isJSON5IdentifierRune(r, p == 0) {
+			return p
+		}
+		p += size
+	}
+	return p
+}
+
+func isJSON5IdentifierRune(r rune, first bool) bool {
+	switch {
+	case r == '$' || r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z':
+		return true
+	case r < utf8.RuneSelf:
+		return !first && '0' <= r && r <= '9'
+	case unicode.In(r, unicode.L, unicode.Nl):
+		return true
+	case first:
+		return false
+	default:
+		return r == '\u200c' || r == '\u200d' || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc)
+	}
+}
+
+// appendRune appends the utf-8 encoding of r to dst.
+func appendRune(dst []byte, r rune) []byte {
+	var b [utf8.UTFMax]byte
+	n := utf8.EncodeRune(b[:], r)
+	return append(dst, b[:n]...)
+}
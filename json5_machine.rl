@@ -0,0 +1,579 @@
+package rjson
+
+%%{
+machine json5;
+
+include skipper "skip_machine.rl";
+
+# The json5_ definitions are the grammar from https://spec.json5.org. They use the expect and checked actions from
+# skipper, so the machines that use them have the same variables in scope as the checked machines.
+action expect_key { expected = "key" }
+action expect_key_or_object_end { expected = "key or '}'" }
+
+json5_line_separator = 0xe2 0x80 ( 0xa8 | 0xa9 );
+json5_line_terminator = [\n\r] | json5_line_separator;
+
+# json5_unicode_space is the whitespace outside ascii: the Zs characters, the line separators and the byte order mark.
+json5_unicode_space = 0xc2 0xa0
+  | 0xe1 0x9a 0x80
+  | 0xe2 0x80 ( 0x80..0x8a | 0xa8 | 0xa9 | 0xaf )
+  | 0xe2 0x81 0x9f
+  | 0xe3 0x80 0x80
+  | 0xef 0xbb 0xbf;
+
+# Comments are skipped by skipJSON5Comment from the action on their second byte, so they don't add states everywhere
+# json5_space is used. A block comment that isn't closed runs to the end of data, where the machine finds the EOF.
+action skip_json5_comment { p = skipJSON5Comment(data, p, pe) }
+
+# Whitespace outside ascii is checked by json5SpaceLen. Identifiers can start with the same bytes, so the conditions
+# decide which one a character is before anything else runs.
+action unicode_space { json5SpaceLen(data[p:pe]) > 0 }
+action not_unicode_space { json5SpaceLen(data[p:pe]) == 0 }
+action skip_unicode_space { p += json5SpaceLen(data[p:pe]) - 1 }
+
+json5_space = [ \t\n\r\v\f]
+  | '/' [/*] @skip_json5_comment
+  | ( 0xc2 | 0xe1..0xe3 | 0xef ) when unicode_space @skip_unicode_space;
+
+# Escape sequences are checked by json5EscapeLen from the action on the backslash.
+action scan_escape {
+  if n, escErr := json5EscapeLen(data[p:pe], buffer.StrictUTF8); escErr != nil {
+    p += n
+    err, expected = escErr, "escape sequence"
+    fhold; fbreak;
+  } else {
+    p += n - 1
+  }
+}
+
+json5_string_char = (
+    ( 0x00..0x7f - [\\\n\r] )
+    | 0x80..0xff @check_utf8
+    | '\\' @scan_escape
+  ) >err(expect_string_char) >eof(expect_quote);
+
+json5_string = (
+  '"' ( json5_string_char - '"' )* '"'
+  | "'" ( json5_string_char - "'" )* "'"
+) >start_token @check_string;
+
+# Numbers are scanned by scanJSON5Number from the action on their first byte like the fractions and exponents in
+# skip_json_number.
+action scan_number {
+  if _, n, numErr := scanJSON5Number(data[p:pe]); numErr != nil {
+    p += n
+    err, expected = numErr, "number"
+    fhold; fbreak;
+  } else {
+    p += n - 1
+  }
+}
+
+json5_number = [+\-.0-9IN] >start_token @scan_number %check_number;
+
+# Identifiers are scanned by scanJSON5Identifier from the action on their first byte.
+action scan_identifier {
+  if n := scanJSON5Identifier(data[p:pe]); n == 0 {
+    err, expected = ErrInvalidObject, "key"
+    fhold; fbreak;
+  } else {
+    p += n - 1
+  }
+}
+
+action check_identifier {
+  if buffer.Limits.MaxStringLen > 0 && p - tokenStart > buffer.Limits.MaxStringLen {
+    return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+  }
+}
+
+json5_identifier = ( [$_a-zA-Z\\] | 0x80..0xff when not_unicode_space ) >start_token @scan_identifier %check_identifier;
+
+# keyStart and keyEnd are the bounds of a key without its quotes.
+action string_key { keyStart, keyEnd = tokenStart + 1, p }
+action identifier_key { keyStart, keyEnd = tokenStart, p }
+
+action add_json5_key {
+  if buffer.RejectDuplicateKeys && !buffer.keys.addJSON5(data, buffer.checkLevels[top].object, keyStart, keyEnd) {
+    return tokenStart, stack, "unique object key", ErrDuplicateKey
+  }
+}
+
+json5_scalar = skip_json_literal | json5_string | json5_number;
+
+json5_value = ( json5_scalar
+  | '['@{fcall json5_array;}
+  | '{'@{fcall json5_object;}
+  );
+
+# Elements are counted before a nested array or object is called.
+json5_array_element = ( json5_scalar >count_element
+  | '[' >count_element @{fcall json5_array;}
+  | '{' >count_element @{fcall json5_object;}
+  );
+
+json5_array_def = (
+  json5_space* (
+    ']'
+    | json5_array_element
+      ( json5_space* $err(expect_comma_or_array_end) ',' json5_space* json5_array_element >err(expect_value) )*
+      json5_space* $err(expect_comma_or_array_end) ( ',' json5_space* )? ']'
+  ) >err(expect_value_or_array_end)
+  ) @{fret;}
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidArray; fhold; fbreak;};
+
+json5_object_key = ( json5_string @string_key @add_json5_key
+  | json5_identifier %identifier_key %add_json5_key
+  ) >count_key;
+
+json5_object_member = json5_space* $err(expect_colon) ':' json5_space* json5_value >err(expect_value);
+
+json5_object_def = (
+  json5_space* (
+    '}'
+    | json5_object_key json5_object_member
+      (
+        json5_space* $err(expect_comma_or_object_end) ','
+        json5_space* json5_object_key >err(expect_key) json5_object_member
+      )*
+      json5_space* $err(expect_comma_or_object_end) ( ',' json5_space* )? '}'
+  ) >err(expect_key_or_object_end)
+  ) @{fret;}
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidObject; fhold; fbreak;};
+
+# json5_unescape appends the character an escape sequence stands for to dst. Machines that use it have dst and n in
+# scope.
+json5_unescape = '\\' (
+    'b' @{dst = append(dst, '\b')}
+    | 'f' @{dst = append(dst, '\f')}
+    | 'n' @{dst = append(dst, '\n')}
+    | 'r' @{dst = append(dst, '\r')}
+    | 't' @{dst = append(dst, '\t')}
+    | 'v' @{dst = append(dst, '\v')}
+    | '0' @{
+      if p + 1 < pe && digits[data[p+1]] {
+        return nil, p + 1, ErrInvalidString
+      }
+      dst = append(dst, 0)
+    }
+    | 'x' xdigit{2} @{dst = appendRune(dst, rune(getu4Digit(data[p-1]) << 4 | getu4Digit(data[p])))}
+    | 'u' xdigit{4} @{
+      dst, n, _ = unescapeUnicodeChar(data[p-5:], dst)
+      p += n - 6
+    }
+    | '\r' '\n'?
+    | '\n'
+    | ( any - [0-9bfnrtvxu\r\n] ) @{
+      if isJSON5LineSeparator(data[p:pe]) {
+        p += 2
+      } else {
+        dst = append(dst, data[p])
+      }
+    }
+  );
+
+}%%
+
+// json5SkipValue is skipValueChecked for JSON5.
+func json5SkipValue(data []byte, stack []int, depth int, buffer *Buffer) (int, []int, string, error) {
+  var top, tokenStart, keyStart, keyEnd int
+  cs, p := 0, 0
+	pe := buffer.documentEnd(data)
+	eof := pe
+	var err error
+	var expected string
+
+%%{
+machine json5SkipValue;
+
+include json5 "json5_machine.rl";
+
+json5_array := json5_array_def;
+json5_object := json5_object_def;
+
+prepush {
+  if top + depth == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+main := json5_space* json5_value >err(expect_value) @err{
+  err = ErrNoValidToken
+  fhold; fbreak;
+};
+
+write data; write init;  write exec;
+
+}%%
+
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
+}
+
+// json5HandleObjectValues is handleObjectValuesChecked for JSON5. handler is given keys without their quotes and with
+// escapes the way they are written in data.
+func json5HandleObjectValues(
+  data []byte, handler ObjectValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+  var top, cs, p, pp, tokenStart, keyStart, keyEnd, count int
+  var err error
+  var expected string
+  pe := buffer.documentEnd(data)
+  eof := pe
+  var object int
+  if buffer.RejectDuplicateKeys {
+    object = buffer.keys.newObject()
+  }
+
+%%{
+machine json5HandleObjectValues;
+
+include json5 "json5_machine.rl";
+
+prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth + 1 >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+action check_depth {
+  if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+}
+
+action count_handled_key {
+  count++
+  if buffer.Limits.MaxObjectKeys > 0 && count > buffer.Limits.MaxObjectKeys {
+    return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+  }
+}
+
+action add_handled_key {
+  if buffer.RejectDuplicateKeys && !buffer.keys.addJSON5(data, object, keyStart, keyEnd) {
+    return tokenStart, stack, "unique object key", ErrDuplicateKey
+  }
+}
+
+action try_handler {
+  pp, err = handler.HandleObjectValue(data[keyStart:keyEnd], data[p:])
+  if err != nil {
+    return p + pp, stack, "", err
+  }
+  if pp < 0 {
+    err = ErrPOutOfRange
+    fbreak;
+  }
+  if pp != 0 {
+    if p + pp - 1 >= pe {
+      if p + pp <= len(data) {
+        return pe - 1, stack, "", buffer.documentSizeError(data)
+      }
+      err = ErrPOutOfRange
+      fbreak;
+    }
+    fexec p + pp - 1;
+  }
+}
+
+action try_handler_simple {
+  _, err = handler.HandleObjectValue(data[keyStart:keyEnd], data[p:])
+  if err != nil {
+    return p, stack, "", err
+  }
+}
+
+# try_handler_checked runs the handler on a string or number after it has been checked.
+action try_handler_checked {
+  _, err = handler.HandleObjectValue(data[keyStart:keyEnd], data[tokenStart:])
+  if err != nil {
+    return tokenStart, stack, "", err
+  }
+}
+
+json5_array := json5_array_def;
+json5_object := json5_object_def;
+
+handled_value =
+ skip_json_literal >(try_handler_simple)
+ | json5_number %(try_handler_checked)
+ | json5_string @(try_handler_checked)
+ | '[' >(try_handler) @{fcall json5_array;}
+ | '{' >(try_handler) @{fcall json5_object;}
+;
+
+handled_object_key = ( json5_string @string_key @add_handled_key
+  | json5_identifier %identifier_key %add_handled_key
+  ) >count_handled_key;
+
+handled_object_member = json5_space* $err(expect_colon) ':' json5_space* handled_value >err(expect_value);
+
+main :=
+  ( json5_space* (
+  json_null <>err(expect_null) |
+  ('{' @check_depth (
+    json5_space* (
+      '}'
+      | handled_object_key handled_object_member
+        (
+          json5_space* $err(expect_comma_or_object_end) ','
+          json5_space* handled_object_key >err(expect_key) handled_object_member
+        )*
+        json5_space* $err(expect_comma_or_object_end) ( ',' json5_space* )? '}'
+    ) >err(expect_key_or_object_end)
+  ) @eof{err = ErrUnexpectedEOF; fhold; fbreak;} )) >err(expect_object)) @err{
+    err = ErrInvalidObject
+    fhold; fbreak;
+  };
+
+write data; write init;  write exec;
+}%%
+
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
+}
+
+// json5HandleArrayValues is handleArrayValuesChecked for JSON5.
+func json5HandleArrayValues(
+  data []byte, handler ArrayValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+  var top, cs, p, pp, tokenStart, keyStart, keyEnd, count int
+  var err error
+  var expected string
+  pe := buffer.documentEnd(data)
+  eof := pe
+
+%%{
+machine json5HandleArrayValues;
+
+include json5 "json5_machine.rl";
+
+prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth + 1 >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+action check_depth {
+  if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+}
+
+action count_handled_element {
+  count++
+  if buffer.Limits.MaxArrayElements > 0 && count > buffer.Limits.MaxArrayElements {
+    return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+  }
+}
+
+action try_handler {
+  pp, err = handler.HandleArrayValue(data[p:])
+  if err != nil {
+    return p + pp, stack, "", err
+  }
+  if pp < 0 {
+    err = ErrPOutOfRange
+    fbreak;
+  }
+  if pp != 0 {
+    if p + pp - 1 >= pe {
+      if p + pp <= len(data) {
+        return pe - 1, stack, "", buffer.documentSizeError(data)
+      }
+      err = ErrPOutOfRange
+      fbreak;
+    }
+    fexec p + pp - 1;
+  }
+}
+
+action try_handler_simple {
+  _, err = handler.HandleArrayValue(data[p:])
+  if err != nil {
+    return p, stack, "", err
+  }
+}
+
+# try_handler_checked runs the handler on a string or number after it has been checked.
+action try_handler_checked {
+  _, err = handler.HandleArrayValue(data[tokenStart:])
+  if err != nil {
+    return tokenStart, stack, "", err
+  }
+}
+
+json5_array := json5_array_def;
+json5_object := json5_object_def;
+
+# Elements are counted before the handler is called.
+handled_value =
+ skip_json_literal >count_handled_element >(try_handler_simple)
+ | json5_number >count_handled_element %(try_handler_checked)
+ | json5_string >count_handled_element @(try_handler_checked)
+ | '[' >count_handled_element >(try_handler) @{fcall json5_array;}
+ | '{' >count_handled_element >(try_handler) @{fcall json5_object;}
+;
+
+main :=
+  ( json5_space* (
+  json_null <>err(expect_null) |
+  ('[' @check_depth (
+    json5_space* (
+      ']'
+      | handled_value
+        ( json5_space* $err(expect_comma_or_array_end) ',' json5_space* handled_value >err(expect_value) )*
+        json5_space* $err(expect_comma_or_array_end) ( ',' json5_space* )? ']'
+    ) >err(expect_value_or_array_end)
+  ) @eof{err = ErrUnexpectedEOF; fhold; fbreak;} )) >err(expect_array)) @err{
+    err = ErrInvalidArray
+    fhold; fbreak;
+  };
+
+write data; write init;  write exec;
+}%%
+
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
+}
+
+// skipJSON5Space returns the length of the JSON5 whitespace and comments at the beginning of data. A line comment can
+// end at the end of data, but a block comment can't.
+func skipJSON5Space(data []byte) (int, error) {
+  cs, p := 0, 0
+  pe := len(data)
+  eof := len(data)
+
+%%{
+machine skipJSON5Space;
+
+include json5 "json5_machine.rl";
+
+json5_block_comment = '/*' ( any* -- '*/' ) '*/';
+json5_line_comment = '//' ( any* -- json5_line_terminator );
+
+main := ( [ \t\n\r\v\f] | json5_unicode_space | json5_line_comment | json5_block_comment )* @eof{
+  return pe, ErrUnexpectedEOF
+};
+
+write data; write init; write exec;
+}%%
+
+  return p, nil
+}
+
+// json5AppendString appends the unescaped content of the JSON5 string at the beginning of data to dst. p is the
+// position after the string.
+func json5AppendString(data []byte, dst []byte) ([]byte, int, error) {
+  cs, p := 0, 0
+  pe := len(data)
+  eof := len(data)
+  var n int
+  dst = growBytesSliceCapacity(dst, len(dst) + len(data))
+
+%%{
+machine json5AppendString;
+
+include json5 "json5_machine.rl";
+
+main := (
+  '"' ( ( any - ["\\\n\r] ) @{dst = append(dst, data[p])} | json5_unescape )* '"'
+  | "'" ( ( any - ['\\\n\r] ) @{dst = append(dst, data[p])} | json5_unescape )* "'"
+) @err{
+  return nil, p, ErrInvalidString
+};
+
+write data; write init; write exec;
+}%%
+
+  return dst, p, nil
+}
+
+// json5UnescapeStringContent is unescapeStringContent for the content of a JSON5 string or an identifier.
+func json5UnescapeStringContent(data []byte, dst []byte) ([]byte, int, error) {
+  cs, p := 0, 0
+  pe := len(data)
+  eof := len(data)
+  var n int
+  dst = growBytesSliceCapacity(dst, len(dst) + len(data))
+
+%%{
+machine json5UnescapeStringContent;
+
+include json5 "json5_machine.rl";
+
+main := (
+  ( any - [\\\n\r] ) @{dst = append(dst, data[p])}
+  | json5_unescape
+)* @err{
+  return nil, p, ErrInvalidString
+};
+
+write data; write init; write exec;
+}%%
+
+  return dst, p, nil
+}
+
+// scanJSON5Number returns the length of the JSON5 number at the beginning of data. hex is true for hex numbers.
+func scanJSON5Number(data []byte) (hex bool, p int, err error) {
+  cs := 0
+  pe := len(data)
+  eof := len(data)
+  end := -1
+
+%%{
+machine scanJSON5Number;
+
+include json5 "json5_machine.rl";
+
+main := (
+  [+\-]? (
+    ( '0' | [1-9] [0-9]* ) ( '.' [0-9]* )? ( [eE] [+\-]? [0-9]+ )?
+    | '.' [0-9]+ ( [eE] [+\-]? [0-9]+ )?
+    | '0' [xX] @{hex = true} xdigit+
+    | 'Infinity'
+    | 'NaN'
+  )
+) %err{end = p} @err{return hex, p, ErrInvalidNumber};
+
+write data; write init; write exec;
+}%%
+
+  if end == -1 {
+    end = p
+  }
+  return hex, end, nil
+}
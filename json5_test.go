@@ -0,0 +1,190 @@
+package rjson
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSON5_ReadValue(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data, want string
+		p          int
+	}{
+		{data: `{"a": [1, 2]}`, want: `{"a": [1, 2]}`, p: 13},
+		{data: ` /* c */ {a: 1,} // end`, want: `{"a": 1 }`, p: 16},
+		{data: `['a', 'it\'s', 'say "hi"',]`, want: `["a", "it's", "say \"hi\"" ]`, p: 27},
+		{data: `{$id: 1, _x2: 2, café: 3, \u0061b: 4}`, want: `{"$id": 1, "_x2": 2, "café": 3, "ab": 4}`, p: 38},
+		{data: `[0x1F, -0xff, +1, .5, 5., -.5e3, 0X0]`, want: `[31, -255, 1, 0.5, 5, -0.5e3, 0]`, p: 37},
+		{data: `0x10000000000000000`, want: `18446744073709551616`, p: 19},
+		{data: `'\x41\v\0\a\
+b'`, want: `"\u0041\u000b\u0000ab"`, p: 15},
+		{data: "\"tab\there\"", want: `"tab\u0009here"`, p: 10},
+		{data: "\ufeff\u00a0 [true,\u2028false,null]", want: `[true,false,null]`, p: 26},
+		{data: "[1, // one\n2 /* two */]", want: "[1, \n2 ]", p: 23},
+		{data: "{a\u00a0: 1}", want: `{"a": 1}`, p: 8},
+	} {
+		want, _, err := ReadValue([]byte(td.want))
+		require.NoError(t, err, td.want)
+		got, p, err := JSON5.ReadValue([]byte(td.data))
+		require.NoError(t, err, td.data)
+		require.Equal(t, want, got, td.data)
+		require.Equal(t, td.p, p, td.data)
+		require.True(t, JSON5.Valid([]byte(td.data), nil), td.data)
+	}
+
+	got, _, err := JSON5.ReadValue([]byte(`[NaN, Infinity, -Infinity, +Infinity]`))
+	require.NoError(t, err)
+	require.Len(t, got, 4)
+	require.True(t, math.IsNaN(got.([]interface{})[0].(float64)))
+	require.Equal(t, []interface{}{math.Inf(1), math.Inf(-1), math.Inf(1)}, got.([]interface{})[1:])
+}
+
+func TestJSON5_errors(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data   string
+		offset int
+		err    error
+	}{
+		{data: `{a: 1,, b: 2}`, offset: 6, err: ErrInvalidObject},
+		{data: `[1,,]`, offset: 3, err: ErrInvalidArray},
+		{data: `{a b: 1}`, offset: 3, err: ErrInvalidObject},
+		{data: `{1a: 1}`, offset: 1, err: ErrInvalidObject},
+		{data: `[01]`, offset: 2, err: ErrInvalidArray},
+		{data: `[.]`, offset: 2, err: ErrInvalidNumber},
+		{data: `[0x]`, offset: 3, err: ErrInvalidNumber},
+		{data: "['a\nb']", offset: 3, err: ErrInvalidArray},
+		{data: `['\01']`, offset: 4, err: ErrInvalidString},
+		{data: `['\x4']`, offset: 4, err: ErrInvalidString},
+		{data: `[1 /* open`, offset: 10, err: ErrUnexpectedEOF},
+		{data: `{a: 'b`, offset: 6, err: ErrUnexpectedEOF},
+		{data: `[tru]`, offset: 4, err: ErrInvalidArray},
+		{data: `[Infinit]`, offset: 8, err: ErrInvalidNumber},
+	} {
+		_, err := JSON5.SkipValue([]byte(td.data), nil)
+		require.True(t, errors.Is(err, td.err), "%s: %v", td.data, err)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), td.data)
+		require.Equal(t, td.offset, syntaxErr.Offset, td.data)
+		require.False(t, JSON5.Valid([]byte(td.data), nil), td.data)
+	}
+	require.False(t, JSON5.Valid([]byte(`{} x`), nil))
+	require.True(t, JSON5.Valid([]byte("{} // done\n"), nil))
+}
+
+func TestJSON5_truncated(t *testing.T) {
+	t.Parallel()
+	arrayHandler := ArrayValueHandlerFunc(func(data []byte) (int, error) {
+		return JSON5.SkipValue(data, nil)
+	})
+	objectHandler := ObjectValueHandlerFunc(func(_, data []byte) (int, error) {
+		return JSON5.SkipValue(data, nil)
+	})
+	for _, td := range []struct {
+		data   string
+		offset int
+	}{
+		{data: `[1`, offset: 2},
+		{data: `[1 /* open`, offset: 10},
+		{data: `[1, /* open`, offset: 11},
+		{data: `[/* open`, offset: 8},
+		{data: `{a: 1`, offset: 5},
+		{data: `{a: 1 /* open`, offset: 13},
+		{data: `{a /* open`, offset: 10},
+		{data: `{a: /* open`, offset: 11},
+		{data: `{/* open`, offset: 8},
+	} {
+		var err error
+		if td.data[0] == '[' {
+			_, err = JSON5.HandleArrayValues([]byte(td.data), arrayHandler, nil)
+		} else {
+			_, err = JSON5.HandleObjectValues([]byte(td.data), objectHandler, nil)
+		}
+		require.True(t, errors.Is(err, ErrUnexpectedEOF), "%s: %v", td.data, err)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), td.data)
+		require.Equal(t, td.offset, syntaxErr.Offset, td.data)
+
+		_, _, err = JSON5.ReadValue([]byte(td.data))
+		require.True(t, errors.Is(err, ErrUnexpectedEOF), "%s: %v", td.data, err)
+	}
+}
+
+func TestJSON5_HandleObjectValues(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+  // comment
+  name: 'rjson',
+  size: 0x10,
+  tags: ['a', 'b',],
+}`)
+	var buf Buffer
+	got := map[string]interface{}{}
+	p, err := JSON5.HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+		if string(fieldname) == "tags" {
+			var tags []string
+			p, err := JSON5.HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+				tag, p, err := JSON5.ReadString(data, nil)
+				tags = append(tags, tag)
+				return p, err
+			}), &buf)
+			got["tags"] = tags
+			return p, err
+		}
+		val, p, err := JSON5.ReadValue(data)
+		got[string(fieldname)] = val
+		return p, err
+	}), &buf)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, map[string]interface{}{
+		"name": "rjson",
+		"size": 16.0,
+		"tags": []string{"a", "b"},
+	}, got)
+
+	// errors from handlers are located in data
+	data = []byte(`{/* c */ a: 'x', b: ['y', 0x1]}`)
+	_, err = JSON5.HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+		if string(fieldname) == "b" {
+			return JSON5.SkipValue(data, &Buffer{Limits: Limits{MaxArrayElements: 1}})
+		}
+		return JSON5.SkipValue(data, nil)
+	}), nil)
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, 26, limitErr.Offset)
+
+	buf.TrackPath = true
+	_, err = JSON5.HandleArrayValues([]byte(`[1, 'x', /* c */ {a: 1},]`), ArrayValueHandlerFunc(func(data []byte) (int, error) {
+		_, p, err := JSON5.ReadInt64(data)
+		return p, err
+	}), &buf)
+	var pathErr *PathError
+	require.True(t, errors.As(err, &pathErr))
+	require.Equal(t, "$[1]", pathErr.Path)
+	require.Equal(t, 4, pathErr.Offset)
+}
+
+func TestJSON5_buffer(t *testing.T) {
+	buf := &Buffer{RejectDuplicateKeys: true}
+	data := []byte(`{a: 1, 'a': 2}`)
+	require.False(t, JSON5.Valid(data, buf))
+	_, err := JSON5.SkipValue(data, buf)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.True(t, errors.Is(err, ErrDuplicateKey))
+	require.Equal(t, 7, syntaxErr.Offset)
+
+	data = []byte(`{a: [1, 2, 3,], b: 'c'}`)
+	require.True(t, JSON5.Valid(data, buf))
+	allocs := testing.AllocsPerRun(10, func() {
+		_, err = JSON5.SkipValue(data, buf)
+	})
+	require.NoError(t, err)
+	require.Zero(t, allocs)
+}
@@ -0,0 +1,136 @@
+package rjson
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// JSONSeqRecordSeparator is the byte that starts each record in a json text sequence.
+const JSONSeqRecordSeparator = 0x1e
+
+var (
+	errTruncatedRecord        = fmt.Errorf("truncated json text sequence record")
+	errMissingRecordSeparator = fmt.Errorf("data before first record separator")
+)
+
+// JSONSeqReader iterates over the values in a json text sequence as defined by RFC 7464 (application/json-seq). Each
+// record starts with a record separator (0x1E) and contains a single json value. Empty records are skipped.
+//
+// A record that doesn't contain a valid json value is reported as damaged, and reading continues at the next record
+// separator. Records with a top-level number, true, false or null that isn't followed by whitespace are treated as
+// truncated as the RFC requires.
+type JSONSeqReader struct {
+	// OnError is called for each damaged record. record is the 1-based record number, offset is the position of the
+	// record's content in data and value is the record's content with surrounding whitespace removed. When OnError
+	// returns nil, Next continues with the following record. When OnError returns an error, Next stops and Err
+	// returns that error. The Offset of a *SyntaxError in err is relative to data, not to the record.
+	//
+	// When OnError is nil, damaged records are skipped silently.
+	OnError func(record, offset int, value []byte, err error) error
+
+	data   []byte
+	p      int
+	record int
+	value  []byte
+	offset int
+	err    error
+	buffer Buffer
+}
+
+// NewJSONSeqReader returns a new JSONSeqReader that reads from data.
+func NewJSONSeqReader(data []byte) *JSONSeqReader {
+	return &JSONSeqReader{
+		data: data,
+	}
+}
+
+// Next advances to the next valid record. It returns false when there are no more records or when OnError returns an
+// error.
+func (r *JSONSeqReader) Next() bool {
+	for r.err == nil && r.p < len(r.data) {
+		recStart := r.p
+		if r.data[recStart] == JSONSeqRecordSeparator {
+			recStart++
+			r.record++
+		}
+		recEnd := bytes.IndexByte(r.data[recStart:], JSONSeqRecordSeparator)
+		if recEnd == -1 {
+			recEnd = len(r.data)
+		} else {
+			recEnd += recStart
+		}
+		r.p = recEnd
+
+		start := recStart + countWhitespace(r.data[recStart:recEnd])
+		if start == recEnd {
+			continue
+		}
+		rec := r.data[start:recEnd]
+		var p int
+		var err error
+		if recStart == 0 {
+			err = errMissingRecordSeparator
+		} else {
+			p, err = SkipValue(rec, &r.buffer)
+		}
+		switch {
+		case err != nil:
+		case p == len(rec) && jsonSeqMaybeTruncated[rec[0]]:
+			err = errTruncatedRecord
+		case p+countWhitespace(rec[p:]) != len(rec):
+			err = ErrTrailingData
+		}
+		if err != nil {
+			// The record runs to recEnd, so this moves the offset of a *SyntaxError from the record to data.
+			moveError(err, recEnd)
+			if r.OnError != nil {
+				r.err = r.OnError(r.record, start, rec[:trimTrailingWhitespace(rec)], err)
+			}
+			continue
+		}
+		r.value = rec[:p]
+		r.offset = start
+		return true
+	}
+	r.value = nil
+	return false
+}
+
+// Value returns the current value. It is a subslice of data.
+func (r *JSONSeqReader) Value() []byte {
+	return r.value
+}
+
+// Offset returns the position of the current value in data.
+func (r *JSONSeqReader) Offset() int {
+	return r.offset
+}
+
+// Record returns the 1-based number of the current record.
+func (r *JSONSeqReader) Record() int {
+	return r.record
+}
+
+// Err returns the error that stopped Next.
+func (r *JSONSeqReader) Err() error {
+	return r.err
+}
+
+// jsonSeqMaybeTruncated has the first bytes of the values that can't be known to be complete unless they are
+// followed by whitespace.
+var jsonSeqMaybeTruncated = [256]bool{
+	't': true,
+	'f': true,
+	'n': true,
+	'-': true,
+	'0': true,
+	'1': true,
+	'2': true,
+	'3': true,
+	'4': true,
+	'5': true,
+	'6': true,
+	'7': true,
+	'8': true,
+	'9': true,
+}
@@ -0,0 +1,57 @@
+package rjson
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONSeqReader(t *testing.T) {
+	t.Parallel()
+	data := []byte(strings.Join([]string{
+		"junk",
+		"{\"a\":1}\n",
+		"\n",
+		"[1, 2\n",
+		"123\n",
+		"12",
+		"{\"b\":2} x\n",
+		"true",
+		"\"foo\"",
+		"null\n",
+	}, "\x1e"))
+
+	r := NewJSONSeqReader(data)
+	var errs []string
+	r.OnError = func(record, offset int, value []byte, err error) error {
+		errs = append(errs, fmt.Sprintf("%d:%d:%s:%v", record, offset, value, err))
+		return nil
+	}
+	var got []string
+	for r.Next() {
+		got = append(got, fmt.Sprintf("%d:%d:%s", r.Record(), r.Offset(), r.Value()))
+	}
+	require.NoError(t, r.Err())
+	require.Equal(t, []string{
+		`1:5:{"a":1}`,
+		`4:23:123`,
+		`8:47:"foo"`,
+		`9:53:null`,
+	}, got)
+	require.Equal(t, []string{
+		"0:0:junk:data before first record separator",
+		"3:16:[1, 2:unexpected end of json at offset 22: expected ',' or ']' but found end of data",
+		"5:28:12:truncated json text sequence record",
+		`6:31:{"b":2} x:unexpected data after json value`,
+		"7:42:true:truncated json text sequence record",
+	}, errs)
+
+	r = NewJSONSeqReader(data)
+	r.OnError = func(record, offset int, value []byte, err error) error {
+		return fmt.Errorf("damaged record %d", record)
+	}
+	require.False(t, r.Next())
+	require.EqualError(t, r.Err(), "damaged record 0")
+}
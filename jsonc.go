@@ -0,0 +1,58 @@
+package rjson
+
+import "bytes"
+
+// commentLen returns the length of the // or /* */ comment at the start of data or 0 when data doesn't start with a
+// complete comment. A // comment runs to the end of the line without the newline.
+func commentLen(data []byte) int {
+	if len(data) < 2 || data[0] != '/' {
+		return 0
+	}
+	switch data[1] {
+	case '/':
+		end := bytes.IndexByte(data, '\n')
+		if end == -1 {
+			return len(data)
+		}
+		return end
+	case '*':
+		end := bytes.Index(data[2:], []byte("*/"))
+		if end == -1 {
+			return 0
+		}
+		return end + 4
+	default:
+		return 0
+	}
+}
+
+// skipComment returns the position of the last byte of the comment whose second byte is at p. It is for the
+// skip_comment action in common.rl. A block comment that isn't closed runs to pe.
+func skipComment(data []byte, p, pe int) int {
+	n := commentLen(data[p-1 : pe])
+	if n == 0 {
+		return pe - 1
+	}
+	return p + n - 2
+}
+
+// countWhitespaceComments is like countWhitespace but it also counts comments.
+func countWhitespaceComments(data []byte) int {
+	p := 0
+	for {
+		p += countWhitespace(data[p:])
+		n := commentLen(data[p:])
+		if n == 0 {
+			return p
+		}
+		p += n
+	}
+}
+
+// countWhitespace is countWhitespace that also counts comments when buffer.JSONC is set. buffer may be nil.
+func (buffer *Buffer) countWhitespace(data []byte) int {
+	if buffer != nil && buffer.JSONC {
+		return countWhitespaceComments(data)
+	}
+	return countWhitespace(data)
+}
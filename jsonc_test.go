@@ -0,0 +1,154 @@
+package rjson
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuffer_JSONC(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data  string
+		valid bool
+		json  bool
+		p     int
+	}{
+		{data: `{"a": 1}`, valid: true, json: true, p: 8},
+		{data: `/* c */ {"a": 1, /* c */ "b": [1, 2,],} // end`, valid: true, p: 39},
+		{data: "[1, // one\n2, // two\n]", valid: true, p: 22},
+		{data: `["//", "/*", "\" // ,]"]`, valid: true, json: true, p: 24},
+		{data: `[1,,]`},
+		{data: `[,]`},
+		{data: `{,}`},
+		{data: `{"a":,}`},
+		{data: `[1 /* open`},
+		{data: `[1 / 2]`},
+	} {
+		buf := &Buffer{JSONC: true}
+		p, err := SkipValue([]byte(td.data), buf)
+		require.Equal(t, td.valid, err == nil, td.data)
+		require.Equal(t, td.valid, Valid([]byte(td.data), buf), td.data)
+		if !td.valid {
+			continue
+		}
+		require.Equal(t, td.p, p, td.data)
+		p, err = SkipValueFast([]byte(td.data), buf)
+		require.NoError(t, err, td.data)
+		require.Equal(t, td.p, p, td.data)
+		require.Equal(t, td.json, Valid([]byte(td.data), nil), td.data)
+	}
+
+	// the checked machines only accept comments and trailing commas with JSONC
+	for _, data := range []string{"[1 // c\n]", `[1,]`, `{"a": 1,}`, `/* c */ 1`, `{"a" /* c */: 1}`} {
+		_, want := SkipValue([]byte(data), nil)
+		_, got := SkipValue([]byte(data), &Buffer{StrictUTF8: true})
+		require.Error(t, got, data)
+		require.Equal(t, want.Error(), got.Error(), data)
+	}
+}
+
+func TestBuffer_JSONC_handlers(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{
+  // compiler options
+  "compilerOptions": {
+    "strict": true, /* for now */
+    "paths": ["a", "b",],
+  },
+  "extra": {"x": 1 /* 2 */},
+}`)
+	original := string(data)
+	buf := &Buffer{JSONC: true}
+	var paths []string
+	var extra int
+	p, err := HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+		switch string(fieldname) {
+		case "compilerOptions":
+			return HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+				if string(fieldname) != "paths" {
+					return SkipValue(data, buf)
+				}
+				return HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+					val, p, err := ReadString(data, nil)
+					paths = append(paths, val)
+					return p, err
+				}), buf)
+			}), buf)
+		case "extra":
+			// a different document with the same buffer
+			other := []byte(`[1, /* c */ 2,]`)
+			p, err := HandleArrayValues(other, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+				_, p, err := ReadInt64(data)
+				return p, err
+			}), buf)
+			require.NoError(t, err)
+			require.Equal(t, len(other), p)
+			return HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+				var p int
+				extra, p, err = ReadInt(data)
+				return p, err
+			}), buf)
+		}
+		return 0, errors.New("unexpected field")
+	}), buf)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, []string{"a", "b"}, paths)
+	require.Equal(t, 1, extra)
+	require.Equal(t, original, string(data))
+
+	_, err = HandleObjectValues([]byte(`{"a": 1, /* c */ "b": x}`), ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+		return SkipValue(data, buf)
+	}), buf)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 22, syntaxErr.Offset)
+}
+
+func TestBuffer_JSONC_allocs(t *testing.T) {
+	buf := &Buffer{JSONC: true}
+	data := []byte(`[{"a": [1, 2,]}, /* c */ {"a": [3]},]`)
+	var handler ArrayValueHandlerFunc
+	objectHandler := ObjectValueHandlerFunc(func(_, data []byte) (int, error) {
+		return HandleArrayValues(data, handler, buf)
+	})
+	handler = func(data []byte) (int, error) {
+		if data[0] == '{' {
+			return HandleObjectValues(data, objectHandler, buf)
+		}
+		return SkipValue(data, buf)
+	}
+	_, err := HandleArrayValues(data, handler, buf)
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(10, func() {
+		_, err = HandleArrayValues(data, handler, buf)
+	})
+	require.NoError(t, err)
+	require.Zero(t, allocs)
+}
+
+func TestBuffer_NextToken(t *testing.T) {
+	t.Parallel()
+	buf := &Buffer{JSONC: true}
+	data := []byte(" // comment\n /* c */ [")
+	token, p, err := buf.NextToken(data)
+	require.NoError(t, err)
+	require.Equal(t, byte('['), token)
+	require.Equal(t, len(data), p)
+	tp, p, err := buf.NextTokenType(data)
+	require.NoError(t, err)
+	require.Equal(t, ArrayStartType, tp)
+	require.Equal(t, len(data), p)
+
+	_, _, err = buf.NextToken([]byte(" /* c */ "))
+	require.Equal(t, io.EOF, err)
+	_, _, err = buf.NextToken([]byte(" /* c "))
+	require.Equal(t, ErrNoValidToken, err)
+
+	var strict *Buffer
+	_, _, err = strict.NextToken(data)
+	require.Equal(t, ErrNoValidToken, err)
+}
@@ -0,0 +1,533 @@
+package jsonpath
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/willabides/rjson"
+)
+
+var errStop = fmt.Errorf("stop")
+
+type member struct {
+	name  []byte
+	value []byte
+}
+
+// level holds the members of one node while its selectors are applied.
+type level struct {
+	tknType    rjson.TokenType
+	members    []member
+	buffer     rjson.Buffer
+	skipBuffer rjson.Buffer
+	nameBuf    []byte
+}
+
+// HandleObjectValue implements rjson.ObjectValueHandler.HandleObjectValue
+func (l *level) HandleObjectValue(fieldname, data []byte) (int, error) {
+	return l.add(fieldname, data)
+}
+
+// HandleArrayValue implements rjson.ArrayValueHandler.HandleArrayValue
+func (l *level) HandleArrayValue(data []byte) (int, error) {
+	return l.add(nil, data)
+}
+
+func (l *level) add(name, data []byte) (int, error) {
+	start := countWhitespace(data)
+	p, err := rjson.SkipValueFast(data[start:], &l.skipBuffer)
+	if err != nil {
+		return start + p, err
+	}
+	l.members = append(l.members, member{
+		name:  name,
+		value: data[start : start+p],
+	})
+	return start + p, nil
+}
+
+// nameIs returns true when the raw member name equals name.
+func (l *level) nameIs(raw []byte, name string) bool {
+	if bytes.IndexByte(raw, '\\') == -1 {
+		return string(raw) == name
+	}
+	var err error
+	l.nameBuf, _, err = rjson.UnescapeStringContent(raw, l.nameBuf[:0])
+	return err == nil && string(l.nameBuf) == name
+}
+
+type evaluator struct {
+	root    []byte
+	buffer  rjson.Buffer
+	levels  []*level
+	depth   int
+	regexps map[string]*regexp.Regexp
+}
+
+// enter collects the members of node. Every call must be followed by a call to leave.
+func (e *evaluator) enter(node []byte) (*level, error) {
+	if e.depth == len(e.levels) {
+		e.levels = append(e.levels, &level{})
+	}
+	lvl := e.levels[e.depth]
+	e.depth++
+	lvl.members = lvl.members[:0]
+	lvl.tknType, _, _ = rjson.NextTokenType(node)
+	var err error
+	switch lvl.tknType {
+	case rjson.ObjectStartType:
+		_, err = rjson.HandleObjectValues(node, lvl, &lvl.buffer)
+	case rjson.ArrayStartType:
+		_, err = rjson.HandleArrayValues(node, lvl, &lvl.buffer)
+	}
+	return lvl, err
+}
+
+func (e *evaluator) leave() {
+	e.depth--
+}
+
+// eval applies segments to node and calls fn with each resulting node.
+func (e *evaluator) eval(segments []segment, node []byte, fn func([]byte) error) error {
+	if len(segments) == 0 {
+		return fn(node)
+	}
+	seg := segments[0]
+	lvl, err := e.enter(node)
+	defer e.leave()
+	if err != nil {
+		return err
+	}
+	err = e.selectMembers(seg.selectors, lvl, segments[1:], fn)
+	if err != nil || !seg.descendant {
+		return err
+	}
+	for _, m := range lvl.members {
+		err = e.eval(segments, m.value, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) selectMembers(selectors []selector, lvl *level, rest []segment, fn func([]byte) error) error {
+	members := lvl.members
+	isArray := lvl.tknType == rjson.ArrayStartType
+	for i := range selectors {
+		sel := &selectors[i]
+		switch sel.kind {
+		case nameSelector:
+			if lvl.tknType != rjson.ObjectStartType {
+				continue
+			}
+			for _, m := range members {
+				if !lvl.nameIs(m.name, sel.name) {
+					continue
+				}
+				if err := e.eval(rest, m.value, fn); err != nil {
+					return err
+				}
+			}
+		case wildcardSelector:
+			for _, m := range members {
+				if err := e.eval(rest, m.value, fn); err != nil {
+					return err
+				}
+			}
+		case indexSelector:
+			if !isArray {
+				continue
+			}
+			idx := sel.index
+			if idx < 0 {
+				idx += len(members)
+			}
+			if idx < 0 || idx >= len(members) {
+				continue
+			}
+			if err := e.eval(rest, members[idx].value, fn); err != nil {
+				return err
+			}
+		case sliceSelector:
+			if !isArray {
+				continue
+			}
+			err := sliceIndexes(sel, len(members), func(idx int) error {
+				return e.eval(rest, members[idx].value, fn)
+			})
+			if err != nil {
+				return err
+			}
+		case filterSelector:
+			for _, m := range members {
+				ok, err := e.test(sel.filter, m.value)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				if err = e.eval(rest, m.value, fn); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sliceIndexes calls fn with the indexes selected by a slice selector on an array of length n.
+func sliceIndexes(sel *selector, n int, fn func(idx int) error) error {
+	step := sel.step
+	if step == 0 {
+		return nil
+	}
+	normalize := func(i, lower, upper int) int {
+		if i < 0 {
+			i += n
+		}
+		if i < lower {
+			return lower
+		}
+		if i > upper {
+			return upper
+		}
+		return i
+	}
+	if step > 0 {
+		start, end := 0, n
+		if sel.hasStart {
+			start = normalize(sel.start, 0, n)
+		}
+		if sel.hasEnd {
+			end = normalize(sel.end, 0, n)
+		}
+		for i := start; i < end; i += step {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	start, end := n-1, -1
+	if sel.hasStart {
+		start = normalize(sel.start, -1, n-1)
+	}
+	if sel.hasEnd {
+		end = normalize(sel.end, -1, n-1)
+	}
+	for i := start; i > end; i += step {
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// test evaluates a filter expression with current as '@'.
+func (e *evaluator) test(x *expr, current []byte) (bool, error) {
+	switch x.kind {
+	case exprOr, exprAnd:
+		want := x.kind == exprOr
+		for _, arg := range x.args {
+			ok, err := e.test(arg, current)
+			if err != nil || ok == want {
+				return ok, err
+			}
+		}
+		return !want, nil
+	case exprNot:
+		ok, err := e.test(x.args[0], current)
+		return !ok, err
+	case exprTest:
+		if x.left.kind == queryOperand {
+			return e.exists(x.left, current)
+		}
+		return e.callLogical(x.left.fn, current)
+	default:
+		left, err := e.value(x.left, current)
+		if err != nil {
+			return false, err
+		}
+		right, err := e.value(x.right, current)
+		if err != nil {
+			return false, err
+		}
+		return compare(x.op, left, right)
+	}
+}
+
+func (e *evaluator) queryStart(o *operand, current []byte) []byte {
+	if o.relative {
+		return current
+	}
+	return e.root
+}
+
+func (e *evaluator) exists(o *operand, current []byte) (bool, error) {
+	found := false
+	err := e.eval(o.segments, e.queryStart(o, current), func([]byte) error {
+		found = true
+		return errStop
+	})
+	if err == errStop {
+		err = nil
+	}
+	return found, err
+}
+
+// nodes returns the values matched by a query operand.
+func (e *evaluator) nodes(o *operand, current []byte) ([][]byte, error) {
+	var nodes [][]byte
+	err := e.eval(o.segments, e.queryStart(o, current), func(node []byte) error {
+		nodes = append(nodes, node)
+		return nil
+	})
+	return nodes, err
+}
+
+// value is the result of evaluating a comparable. A value from the document is kept raw until it is needed.
+type value struct {
+	nothing bool
+	raw     []byte
+	lit     interface{}
+}
+
+func (e *evaluator) value(o *operand, current []byte) (value, error) {
+	switch o.kind {
+	case literalOperand:
+		return value{lit: o.lit}, nil
+	case queryOperand:
+		var found []byte
+		err := e.eval(o.segments, e.queryStart(o, current), func(node []byte) error {
+			found = node
+			return errStop
+		})
+		if err != nil && err != errStop {
+			return value{}, err
+		}
+		return value{nothing: found == nil, raw: found}, nil
+	default:
+		return e.callValue(o.fn, current)
+	}
+}
+
+func (e *evaluator) callValue(fn *funcCall, current []byte) (value, error) {
+	switch fn.name {
+	case "length":
+		arg, err := e.value(fn.args[0], current)
+		if err != nil || arg.nothing {
+			return value{nothing: true}, err
+		}
+		return e.length(arg)
+	case "count":
+		nodes, err := e.nodes(fn.args[0], current)
+		return value{lit: float64(len(nodes))}, err
+	default:
+		nodes, err := e.nodes(fn.args[0], current)
+		if err != nil || len(nodes) != 1 {
+			return value{nothing: true}, err
+		}
+		return value{raw: nodes[0]}, nil
+	}
+}
+
+func (e *evaluator) length(v value) (value, error) {
+	if v.raw == nil {
+		s, ok := v.lit.(string)
+		if !ok {
+			return value{nothing: true}, nil
+		}
+		return value{lit: float64(utf8.RuneCountInString(s))}, nil
+	}
+	switch v.raw[0] {
+	case '"':
+		s, _, err := rjson.ReadString(v.raw, nil)
+		return value{lit: float64(utf8.RuneCountInString(s))}, err
+	case '{', '[':
+		lvl, err := e.enter(v.raw)
+		e.leave()
+		return value{lit: float64(len(lvl.members))}, err
+	}
+	return value{nothing: true}, nil
+}
+
+func (e *evaluator) callLogical(fn *funcCall, current []byte) (bool, error) {
+	str, err := e.value(fn.args[0], current)
+	if err != nil {
+		return false, err
+	}
+	s, ok, err := stringValue(str)
+	if err != nil || !ok {
+		return false, err
+	}
+	re := fn.re
+	if re == nil {
+		pattern, err := e.value(fn.args[1], current)
+		if err != nil {
+			return false, err
+		}
+		p, ok, err := stringValue(pattern)
+		if err != nil || !ok {
+			return false, err
+		}
+		re = e.regexp(p, fn.name == "match")
+		if re == nil {
+			return false, nil
+		}
+	}
+	return re.MatchString(s), nil
+}
+
+func (e *evaluator) regexp(pattern string, anchored bool) *regexp.Regexp {
+	key := pattern
+	if anchored {
+		key = "^" + pattern
+	}
+	re, ok := e.regexps[key]
+	if ok {
+		return re
+	}
+	if e.regexps == nil {
+		e.regexps = map[string]*regexp.Regexp{}
+	}
+	re = compileIRegexp(pattern, anchored)
+	e.regexps[key] = re
+	return re
+}
+
+func stringValue(v value) (s string, ok bool, err error) {
+	if v.nothing {
+		return "", false, nil
+	}
+	if v.raw == nil {
+		s, ok = v.lit.(string)
+		return s, ok, nil
+	}
+	if v.raw[0] != '"' {
+		return "", false, nil
+	}
+	s, _, err = rjson.ReadString(v.raw, nil)
+	return s, err == nil, err
+}
+
+type valueKind uint8
+
+const (
+	nothingKind valueKind = iota
+	nullKind
+	boolKind
+	numberKind
+	stringKind
+	arrayKind
+	objectKind
+)
+
+func (v value) kind() valueKind {
+	if v.nothing {
+		return nothingKind
+	}
+	if v.raw == nil {
+		switch v.lit.(type) {
+		case nil:
+			return nullKind
+		case bool:
+			return boolKind
+		case float64:
+			return numberKind
+		default:
+			return stringKind
+		}
+	}
+	switch v.raw[0] {
+	case 'n':
+		return nullKind
+	case 't', 'f':
+		return boolKind
+	case '"':
+		return stringKind
+	case '[':
+		return arrayKind
+	case '{':
+		return objectKind
+	default:
+		return numberKind
+	}
+}
+
+// decode returns the value as a string, bool, nil, float64, []interface{} or map[string]interface{}.
+func (v value) decode() (interface{}, error) {
+	if v.raw == nil {
+		return v.lit, nil
+	}
+	val, _, err := rjson.ReadValue(v.raw)
+	return val, err
+}
+
+func compare(op string, left, right value) (bool, error) {
+	switch op {
+	case "==":
+		return equal(left, right)
+	case "!=":
+		eq, err := equal(left, right)
+		return !eq, err
+	case "<":
+		return less(left, right)
+	case ">":
+		return less(right, left)
+	case "<=":
+		lt, err := less(left, right)
+		if err != nil || lt {
+			return lt, err
+		}
+		return equal(left, right)
+	default:
+		lt, err := less(right, left)
+		if err != nil || lt {
+			return lt, err
+		}
+		return equal(left, right)
+	}
+}
+
+func equal(left, right value) (bool, error) {
+	lk, rk := left.kind(), right.kind()
+	if lk != rk {
+		return false, nil
+	}
+	if lk == nothingKind || lk == nullKind {
+		return true, nil
+	}
+	lv, err := left.decode()
+	if err != nil {
+		return false, err
+	}
+	rv, err := right.decode()
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(lv, rv), nil
+}
+
+func less(left, right value) (bool, error) {
+	lk := left.kind()
+	if lk != right.kind() || lk != numberKind && lk != stringKind {
+		return false, nil
+	}
+	lv, err := left.decode()
+	if err != nil {
+		return false, err
+	}
+	rv, err := right.decode()
+	if err != nil {
+		return false, err
+	}
+	if lk == numberKind {
+		return lv.(float64) < rv.(float64), nil
+	}
+	return lv.(string) < rv.(string), nil
+}
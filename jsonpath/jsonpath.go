@@ -0,0 +1,86 @@
+// Package jsonpath implements RFC 9535 JSONPath queries on top of rjson.
+//
+// Matches are streamed as raw slices of the queried document. Values are only decoded when a filter expression
+// needs them for a comparison or function.
+package jsonpath
+
+import "github.com/willabides/rjson"
+
+// Query is a compiled JSONPath query. A Query is safe for concurrent use.
+type Query struct {
+	src      string
+	segments []segment
+}
+
+// Compile parses a JSONPath query.
+func Compile(query string) (*Query, error) {
+	ps := &parser{src: query}
+	if ps.peek() != '$' {
+		return nil, ps.errorf("query must start with '$'")
+	}
+	ps.pos++
+	segments, err := ps.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+	if ps.pos != len(ps.src) {
+		return nil, ps.errorf("unexpected %q", ps.src[ps.pos:])
+	}
+	return &Query{
+		src:      query,
+		segments: segments,
+	}, nil
+}
+
+// MustCompile is like Compile but panics when query is invalid.
+func MustCompile(query string) *Query {
+	q, err := Compile(query)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String returns the source of the query.
+func (q *Query) String() string {
+	return q.src
+}
+
+// Match calls fn with each value in data that the query matches. Values are subslices of data in the order defined by
+// RFC 9535. When fn returns an error, Match stops and returns the same error.
+//
+// data must contain a single valid json value. Leading whitespace and anything after the value are ignored. When it
+// doesn't, Match returns an *rjson.SyntaxError.
+func (q *Query) Match(data []byte, fn func(value []byte) error) error {
+	e := evaluator{}
+	p, err := rjson.SkipValue(data, &e.buffer)
+	if err != nil {
+		return err
+	}
+	e.root = data[countWhitespace(data):p]
+	return e.eval(q.segments, e.root, fn)
+}
+
+// MatchAll returns all the values in data that the query matches. See Match.
+func (q *Query) MatchAll(data []byte) ([][]byte, error) {
+	var matches [][]byte
+	err := q.Match(data, func(value []byte) error {
+		matches = append(matches, value)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+func countWhitespace(data []byte) int {
+	for i, c := range data {
+		switch c {
+		case ' ', '\t', '\n', '\r':
+		default:
+			return i
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,238 @@
+package jsonpath
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const bookstore = `{ "store": {
+    "book": [
+      { "category": "reference",
+        "author": "Nigel Rees",
+        "title": "Sayings of the Century",
+        "price": 8.95
+      },
+      { "category": "fiction",
+        "author": "Evelyn Waugh",
+        "title": "Sword of Honour",
+        "price": 12.99
+      },
+      { "category": "fiction",
+        "author": "Herman Melville",
+        "title": "Moby Dick",
+        "isbn": "0-553-21311-3",
+        "price": 8.99
+      },
+      { "category": "fiction",
+        "author": "J. R. R. Tolkien",
+        "title": "The Lord of the Rings",
+        "isbn": "0-395-19395-8",
+        "price": 22.99
+      }
+    ],
+    "bicycle": {
+      "color": "red",
+      "price": 399
+    }
+  }
+}`
+
+func TestQuery_MatchAll(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data  string
+		query string
+		want  []string
+	}{
+		{data: bookstore, query: `$.store.book[*].author`, want: []string{`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`}},
+		{data: bookstore, query: `$..author`, want: []string{`"Nigel Rees"`, `"Evelyn Waugh"`, `"Herman Melville"`, `"J. R. R. Tolkien"`}},
+		{data: bookstore, query: `$.store..price`, want: []string{`8.95`, `12.99`, `8.99`, `22.99`, `399`}},
+		{data: bookstore, query: `$..book[2].author`, want: []string{`"Herman Melville"`}},
+		{data: bookstore, query: `$..book[2].publisher`},
+		{data: bookstore, query: `$..book[-1].title`, want: []string{`"The Lord of the Rings"`}},
+		{data: bookstore, query: `$..book[0,1].title`, want: []string{`"Sayings of the Century"`, `"Sword of Honour"`}},
+		{data: bookstore, query: `$..book[:2].title`, want: []string{`"Sayings of the Century"`, `"Sword of Honour"`}},
+		{data: bookstore, query: `$..book[?@.isbn].title`, want: []string{`"Moby Dick"`, `"The Lord of the Rings"`}},
+		{data: bookstore, query: `$..book[?@.price<10].title`, want: []string{`"Sayings of the Century"`, `"Moby Dick"`}},
+		{data: bookstore, query: `$..book[?@.category == 'fiction' && !@.isbn].author`, want: []string{`"Evelyn Waugh"`}},
+		{data: bookstore, query: `$..book[?search(@.author, 'Mel+')].title`, want: []string{`"Moby Dick"`}},
+		{data: bookstore, query: `$..book[?match(@.title, 'Moby.*')].price`, want: []string{`8.99`}},
+		{data: bookstore, query: `$..book[?length(@.author) > 12].price`, want: []string{`8.99`, `22.99`}},
+		{data: bookstore, query: `$..[?length(@) == 2].color`, want: []string{`"red"`}},
+		{data: bookstore, query: `$.store[?count(@.*) == 2].color`, want: []string{`"red"`}},
+		{data: bookstore, query: `$.store.bicycle[?value(@) == 'red']`, want: []string{`"red"`}},
+		{data: `{"a": [1, 2]}`, query: `$`, want: []string{`{"a": [1, 2]}`}},
+		{data: ` [1, 2] `, query: `$[*]`, want: []string{`1`, `2`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3]}`, query: `$.o['j']`, want: []string{`1`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3]}`, query: `$.o["j", 'k']`, want: []string{`1`, `2`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3]}`, query: `$.o[*, 'k']`, want: []string{`1`, `2`, `2`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3]}`, query: `$.a.*`, want: []string{`5`, `3`}},
+		{data: `{"☺": 1, "a\"b": 2}`, query: `$['☺', 'a"b']`, want: []string{`1`, `2`}},
+		{data: `{"☺": 1}`, query: `$.☺`, want: []string{`1`}},
+		{data: `["a","b"]`, query: `$[-3]`},
+		{data: `["a","b","c","d","e","f","g"]`, query: `$[1:3]`, want: []string{`"b"`, `"c"`}},
+		{data: `["a","b","c","d","e","f","g"]`, query: `$[5:]`, want: []string{`"f"`, `"g"`}},
+		{data: `["a","b","c","d","e","f","g"]`, query: `$[1:5:2]`, want: []string{`"b"`, `"d"`}},
+		{data: `["a","b","c","d","e","f","g"]`, query: `$[5:1:-2]`, want: []string{`"f"`, `"d"`}},
+		{data: `["a","b","c","d","e","f","g"]`, query: `$[::-1]`, want: []string{`"g"`, `"f"`, `"e"`, `"d"`, `"c"`, `"b"`, `"a"`}},
+		{data: `["a","b","c"]`, query: `$[::0]`},
+		{data: `["a","b","c"]`, query: `$[-100:100]`, want: []string{`"a"`, `"b"`, `"c"`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3, [{"j": 4}, {"k": 6}]]}`, query: `$..j`, want: []string{`1`, `4`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3, [{"j": 4}, {"k": 6}]]}`, query: `$..[0]`, want: []string{`5`, `{"j": 4}`}},
+		{data: `{"o": {"j": 1, "k": 2}, "a": [5, 3, [{"j": 4}, {"k": 6}]]}`, query: `$..*`, want: []string{
+			`{"j": 1, "k": 2}`, `[5, 3, [{"j": 4}, {"k": 6}]]`, `1`, `2`, `5`, `3`,
+			`[{"j": 4}, {"k": 6}]`, `{"j": 4}`, `{"k": 6}`, `4`, `6`,
+		}},
+		{data: `{"a": null, "b": [null], "c": [{}], "null": 1}`, query: `$.a`, want: []string{`null`}},
+		{data: `{"a": null, "b": [null], "c": [{}], "null": 1}`, query: `$.a[0]`},
+		{data: `{"a": null, "b": [null], "c": [{}], "null": 1}`, query: `$.b[0]`, want: []string{`null`}},
+		{data: `{"a": null, "b": [null], "c": [{}], "null": 1}`, query: `$.c[*].d`},
+		{data: `{"a": null, "b": [null], "c": [{}], "null": 1}`, query: `$.null`, want: []string{`1`}},
+		{data: `[0, 1]`, query: `$[0, 0]`, want: []string{`0`, `0`}},
+		{data: `{"users": [{"id": 1, "active": true}, {"id": 2, "active": false}, {"id": 3, "active": true}]}`, query: `$.users[?@.active==true].id`, want: []string{`1`, `3`}},
+		{data: `{"users": [{"id": 1, "tags": ["a"]}, {"id": 2, "tags": ["a", "b"]}]}`, query: `$.users[?@.tags == $.users[0].tags].id`, want: []string{`1`}},
+	} {
+		td := td
+		t.Run(td.query, func(t *testing.T) {
+			t.Parallel()
+			q, err := Compile(td.query)
+			require.NoError(t, err)
+			got, err := q.MatchAll([]byte(td.data))
+			require.NoError(t, err)
+			var gotStrings []string
+			for _, g := range got {
+				gotStrings = append(gotStrings, string(g))
+			}
+			require.Equal(t, td.want, gotStrings)
+		})
+	}
+}
+
+// These are the filter examples from table 12 of RFC 9535.
+func TestQuery_filters(t *testing.T) {
+	t.Parallel()
+	data := `{
+  "a": [3, 5, 1, 2, 4, 6,
+        {"b": "j"},
+        {"b": "k"},
+        {"b": {}},
+        {"b": "kilo"}
+       ],
+  "o": {"p": 1, "q": 2, "r": 3, "s": 5, "t": {"u": 6}},
+  "e": "f"
+}`
+	for _, td := range []struct {
+		query string
+		want  []string
+	}{
+		{query: `$.a[?@.b == 'kilo']`, want: []string{`{"b": "kilo"}`}},
+		{query: `$.a[?(@.b == 'kilo')]`, want: []string{`{"b": "kilo"}`}},
+		{query: `$.a[?@>3.5]`, want: []string{`5`, `4`, `6`}},
+		{query: `$.a[?@.b]`, want: []string{`{"b": "j"}`, `{"b": "k"}`, `{"b": {}}`, `{"b": "kilo"}`}},
+		{query: `$[?@.*]`, want: []string{`[3, 5, 1, 2, 4, 6,
+        {"b": "j"},
+        {"b": "k"},
+        {"b": {}},
+        {"b": "kilo"}
+       ]`, `{"p": 1, "q": 2, "r": 3, "s": 5, "t": {"u": 6}}`}},
+		{query: `$[?@[?@.b]]`, want: []string{`[3, 5, 1, 2, 4, 6,
+        {"b": "j"},
+        {"b": "k"},
+        {"b": {}},
+        {"b": "kilo"}
+       ]`}},
+		{query: `$.o[?@<3, ?@<3]`, want: []string{`1`, `2`, `1`, `2`}},
+		{query: `$.a[?@<2 || @.b == "k"]`, want: []string{`1`, `{"b": "k"}`}},
+		{query: `$.a[?match(@.b, "[jk]")]`, want: []string{`{"b": "j"}`, `{"b": "k"}`}},
+		{query: `$.a[?search(@.b, "[jk]")]`, want: []string{`{"b": "j"}`, `{"b": "k"}`, `{"b": "kilo"}`}},
+		{query: `$.o[?@>1 && @<4]`, want: []string{`2`, `3`}},
+		{query: `$.o[?@.u || @.x]`, want: []string{`{"u": 6}`}},
+		{query: `$.a[?@.b == $.x]`, want: []string{`3`, `5`, `1`, `2`, `4`, `6`}},
+		{query: `$.a[?@ == @]`, want: []string{`3`, `5`, `1`, `2`, `4`, `6`, `{"b": "j"}`, `{"b": "k"}`, `{"b": {}}`, `{"b": "kilo"}`}},
+		{query: `$.a[?@.b != 'j' && @.b <= 'kilo']`, want: []string{`{"b": "k"}`, `{"b": "kilo"}`}},
+		{query: `$.a[?!(@ >= 3)]`, want: []string{`1`, `2`, `{"b": "j"}`, `{"b": "k"}`, `{"b": {}}`, `{"b": "kilo"}`}},
+	} {
+		td := td
+		t.Run(td.query, func(t *testing.T) {
+			t.Parallel()
+			q, err := Compile(td.query)
+			require.NoError(t, err)
+			got, err := q.MatchAll([]byte(data))
+			require.NoError(t, err)
+			var gotStrings []string
+			for _, g := range got {
+				gotStrings = append(gotStrings, string(g))
+			}
+			require.Equal(t, td.want, gotStrings)
+		})
+	}
+}
+
+func TestCompile_errors(t *testing.T) {
+	t.Parallel()
+	for _, query := range []string{
+		``,
+		`store`,
+		`$.`,
+		`$..`,
+		`$ `,
+		`$.1a`,
+		`$[`,
+		`$[1`,
+		`$[01]`,
+		`$[-0]`,
+		`$[9007199254740992]`,
+		`$['a]`,
+		`$['\x']`,
+		`$['\ud800']`,
+		`$[?@.a == 1 ==]`,
+		`$[?@.* == 1]`,
+		`$[?1]`,
+		`$[?!@.a == 1]`,
+		`$[?length(@.*) == 1]`,
+		`$[?count(1) == 1]`,
+		`$[?foo(@)]`,
+		`$[?length(@)]`,
+		`$[?match(@.a) == true]`,
+		`$[?(@.a]`,
+		`$[?@.a == {}]`,
+		`$[?@.a == ['a']]`,
+		`$[?@.a > 1 / 2]`,
+	} {
+		query := query
+		t.Run(query, func(t *testing.T) {
+			t.Parallel()
+			_, err := Compile(query)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestQuery_Match(t *testing.T) {
+	t.Parallel()
+	q := MustCompile(`$..price`)
+	require.Equal(t, `$..price`, q.String())
+
+	t.Run("stops on error", func(t *testing.T) {
+		t.Parallel()
+		wantErr := fmt.Errorf("stop here")
+		var got []string
+		err := q.Match([]byte(bookstore), func(value []byte) error {
+			got = append(got, string(value))
+			if len(got) == 2 {
+				return wantErr
+			}
+			return nil
+		})
+		require.Equal(t, wantErr, err)
+		require.Equal(t, []string{`8.95`, `12.99`}, got)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		t.Parallel()
+		_, err := q.MatchAll([]byte(`{"price": 1,}`))
+		require.EqualError(t, err, "invalid json object at offset 12: expected string but found '}'")
+	})
+}
@@ -0,0 +1,710 @@
+package jsonpath
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/willabides/rjson"
+)
+
+// maxSafeInt is the largest integer allowed in a query. It comes from I-JSON.
+const maxSafeInt = 1<<53 - 1
+
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selectorKind uint8
+
+const (
+	nameSelector selectorKind = iota
+	wildcardSelector
+	indexSelector
+	sliceSelector
+	filterSelector
+)
+
+type selector struct {
+	kind     selectorKind
+	name     string
+	index    int
+	start    int
+	end      int
+	step     int
+	hasStart bool
+	hasEnd   bool
+	filter   *expr
+}
+
+type exprKind uint8
+
+const (
+	exprOr exprKind = iota
+	exprAnd
+	exprNot
+	exprCompare
+	exprTest
+)
+
+type expr struct {
+	kind  exprKind
+	args  []*expr
+	op    string
+	left  *operand
+	right *operand
+}
+
+type operandKind uint8
+
+const (
+	literalOperand operandKind = iota
+	queryOperand
+	funcOperand
+)
+
+type operand struct {
+	kind     operandKind
+	lit      interface{}
+	relative bool
+	singular bool
+	segments []segment
+	fn       *funcCall
+}
+
+type funcType uint8
+
+const (
+	valueType funcType = iota
+	logicalType
+	nodesType
+)
+
+type funcCall struct {
+	name string
+	args []*operand
+	re   *regexp.Regexp
+}
+
+var funcResultTypes = map[string]funcType{
+	"length": valueType,
+	"count":  valueType,
+	"value":  valueType,
+	"match":  logicalType,
+	"search": logicalType,
+}
+
+var funcParamTypes = map[string][]funcType{
+	"length": {valueType},
+	"count":  {nodesType},
+	"value":  {nodesType},
+	"match":  {valueType, valueType},
+	"search": {valueType, valueType},
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (ps *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("invalid jsonpath query at offset %d: %s", ps.pos, fmt.Sprintf(format, args...))
+}
+
+func (ps *parser) peek() byte {
+	if ps.pos >= len(ps.src) {
+		return 0
+	}
+	return ps.src[ps.pos]
+}
+
+func (ps *parser) hasPrefix(s string) bool {
+	return strings.HasPrefix(ps.src[ps.pos:], s)
+}
+
+func (ps *parser) skipSpace() {
+	for ps.pos < len(ps.src) {
+		switch ps.src[ps.pos] {
+		case ' ', '\t', '\n', '\r':
+			ps.pos++
+		default:
+			return
+		}
+	}
+}
+
+// parseSegments parses segments until it reaches something that can't start a segment.
+func (ps *parser) parseSegments() ([]segment, error) {
+	var segments []segment
+	for {
+		start := ps.pos
+		ps.skipSpace()
+		switch {
+		case ps.hasPrefix(".."):
+			ps.pos += 2
+			seg, err := ps.parseDotSegment(true)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case ps.peek() == '.':
+			ps.pos++
+			seg, err := ps.parseDotSegment(false)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case ps.peek() == '[':
+			sels, err := ps.parseBracketedSelection()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment{selectors: sels})
+		default:
+			ps.pos = start
+			return segments, nil
+		}
+	}
+}
+
+// parseDotSegment parses what comes after "." or "..".
+func (ps *parser) parseDotSegment(descendant bool) (segment, error) {
+	seg := segment{descendant: descendant}
+	switch {
+	case ps.peek() == '*':
+		ps.pos++
+		seg.selectors = []selector{{kind: wildcardSelector}}
+	case descendant && ps.peek() == '[':
+		sels, err := ps.parseBracketedSelection()
+		if err != nil {
+			return seg, err
+		}
+		seg.selectors = sels
+	default:
+		name, ok := ps.parseMemberName()
+		if !ok {
+			return seg, ps.errorf("expected member name")
+		}
+		seg.selectors = []selector{{kind: nameSelector, name: name}}
+	}
+	return seg, nil
+}
+
+func isNameFirst(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '_' ||
+		r >= 0x80 && r <= 0xd7ff || r >= 0xe000 && r <= 0x10ffff
+}
+
+func (ps *parser) parseMemberName() (string, bool) {
+	start := ps.pos
+	for ps.pos < len(ps.src) {
+		r, w := utf8.DecodeRuneInString(ps.src[ps.pos:])
+		if r == utf8.RuneError && w == 1 {
+			break
+		}
+		if !isNameFirst(r) && (ps.pos == start || r < '0' || r > '9') {
+			break
+		}
+		ps.pos += w
+	}
+	return ps.src[start:ps.pos], ps.pos > start
+}
+
+func (ps *parser) parseBracketedSelection() ([]selector, error) {
+	ps.pos++
+	var sels []selector
+	for {
+		ps.skipSpace()
+		sel, err := ps.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+		ps.skipSpace()
+		switch ps.peek() {
+		case ',':
+			ps.pos++
+		case ']':
+			ps.pos++
+			return sels, nil
+		default:
+			return nil, ps.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (ps *parser) parseSelector() (selector, error) {
+	switch c := ps.peek(); {
+	case c == '\'' || c == '"':
+		name, err := ps.parseStringLiteral()
+		return selector{kind: nameSelector, name: name}, err
+	case c == '*':
+		ps.pos++
+		return selector{kind: wildcardSelector}, nil
+	case c == '?':
+		ps.pos++
+		ps.skipSpace()
+		filter, err := ps.parseLogicalOr()
+		return selector{kind: filterSelector, filter: filter}, err
+	case c == '-' || c >= '0' && c <= '9' || c == ':':
+		return ps.parseIndexOrSlice()
+	default:
+		return selector{}, ps.errorf("expected selector")
+	}
+}
+
+func (ps *parser) parseIndexOrSlice() (selector, error) {
+	var sel selector
+	var err error
+	if ps.peek() != ':' {
+		sel.index, err = ps.parseInt()
+		if err != nil {
+			return sel, err
+		}
+		ps.skipSpace()
+		if ps.peek() != ':' {
+			sel.kind = indexSelector
+			return sel, nil
+		}
+		sel.start, sel.hasStart = sel.index, true
+	}
+	sel.kind = sliceSelector
+	sel.step = 1
+	ps.pos++
+	ps.skipSpace()
+	if c := ps.peek(); c == '-' || c >= '0' && c <= '9' {
+		sel.end, err = ps.parseInt()
+		if err != nil {
+			return sel, err
+		}
+		sel.hasEnd = true
+		ps.skipSpace()
+	}
+	if ps.peek() != ':' {
+		return sel, nil
+	}
+	ps.pos++
+	ps.skipSpace()
+	if c := ps.peek(); c == '-' || c >= '0' && c <= '9' {
+		sel.step, err = ps.parseInt()
+		if err != nil {
+			return sel, err
+		}
+	}
+	return sel, nil
+}
+
+func (ps *parser) parseInt() (int, error) {
+	start := ps.pos
+	neg := ps.peek() == '-'
+	if neg {
+		ps.pos++
+	}
+	digitsStart := ps.pos
+	for ps.pos < len(ps.src) && ps.src[ps.pos] >= '0' && ps.src[ps.pos] <= '9' {
+		ps.pos++
+	}
+	digits := ps.src[digitsStart:ps.pos]
+	if digits == "" || len(digits) > 1 && digits[0] == '0' || neg && digits == "0" {
+		ps.pos = start
+		return 0, ps.errorf("invalid integer")
+	}
+	var val int64
+	for i := 0; i < len(digits); i++ {
+		val = val*10 + int64(digits[i]-'0')
+		if val > maxSafeInt {
+			ps.pos = start
+			return 0, ps.errorf("integer out of range")
+		}
+	}
+	if neg {
+		val = -val
+	}
+	return int(val), nil
+}
+
+func (ps *parser) parseStringLiteral() (string, error) {
+	quote := ps.src[ps.pos]
+	ps.pos++
+	var sb strings.Builder
+	for {
+		if ps.pos >= len(ps.src) {
+			return "", ps.errorf("unterminated string")
+		}
+		c := ps.src[ps.pos]
+		switch {
+		case c == quote:
+			ps.pos++
+			return sb.String(), nil
+		case c < 0x20:
+			return "", ps.errorf("control character in string")
+		case c != '\\':
+			sb.WriteByte(c)
+			ps.pos++
+			continue
+		}
+		ps.pos++
+		if ps.pos >= len(ps.src) {
+			return "", ps.errorf("unterminated string")
+		}
+		c = ps.src[ps.pos]
+		ps.pos++
+		switch c {
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'n':
+			sb.WriteByte('\n')
+		case 'r':
+			sb.WriteByte('\r')
+		case 't':
+			sb.WriteByte('\t')
+		case '/', '\\':
+			sb.WriteByte(c)
+		case '\'', '"':
+			if c != quote {
+				return "", ps.errorf("invalid escape")
+			}
+			sb.WriteByte(c)
+		case 'u':
+			r, err := ps.parseUnicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(r)
+		default:
+			return "", ps.errorf("invalid escape")
+		}
+	}
+}
+
+// parseUnicodeEscape parses the hex digits of a \u escape and the low surrogate that follows a high surrogate.
+func (ps *parser) parseUnicodeEscape() (rune, error) {
+	r, ok := ps.parseHex4()
+	if !ok {
+		return 0, ps.errorf("invalid unicode escape")
+	}
+	switch {
+	case r >= 0xdc00 && r <= 0xdfff:
+		return 0, ps.errorf("unpaired surrogate")
+	case r >= 0xd800 && r <= 0xdbff:
+		if !ps.hasPrefix(`\u`) {
+			return 0, ps.errorf("unpaired surrogate")
+		}
+		ps.pos += 2
+		r2, ok := ps.parseHex4()
+		if !ok || r2 < 0xdc00 || r2 > 0xdfff {
+			return 0, ps.errorf("unpaired surrogate")
+		}
+		return utf16.DecodeRune(r, r2), nil
+	}
+	return r, nil
+}
+
+func (ps *parser) parseHex4() (rune, bool) {
+	if ps.pos+4 > len(ps.src) {
+		return 0, false
+	}
+	var r rune
+	for _, c := range []byte(ps.src[ps.pos : ps.pos+4]) {
+		switch {
+		case c >= '0' && c <= '9':
+			c -= '0'
+		case c >= 'a' && c <= 'f':
+			c = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		r = r*16 + rune(c)
+	}
+	ps.pos += 4
+	return r, true
+}
+
+func (ps *parser) parseLogicalOr() (*expr, error) {
+	return ps.parseLogicalList(exprOr, "||", ps.parseLogicalAnd)
+}
+
+func (ps *parser) parseLogicalAnd() (*expr, error) {
+	return ps.parseLogicalList(exprAnd, "&&", ps.parseBasicExpr)
+}
+
+func (ps *parser) parseLogicalList(kind exprKind, sep string, next func() (*expr, error)) (*expr, error) {
+	x, err := next()
+	if err != nil {
+		return nil, err
+	}
+	args := []*expr{x}
+	for {
+		start := ps.pos
+		ps.skipSpace()
+		if !ps.hasPrefix(sep) {
+			ps.pos = start
+			break
+		}
+		ps.pos += len(sep)
+		ps.skipSpace()
+		x, err = next()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, x)
+	}
+	if len(args) == 1 {
+		return args[0], nil
+	}
+	return &expr{kind: kind, args: args}, nil
+}
+
+func (ps *parser) parseBasicExpr() (*expr, error) {
+	if ps.peek() == '!' {
+		ps.pos++
+		ps.skipSpace()
+		parenthesized := ps.peek() == '('
+		x, err := ps.parseBasicExpr()
+		if err != nil {
+			return nil, err
+		}
+		if x.kind == exprCompare && !parenthesized {
+			return nil, ps.errorf("comparison can't be negated without parentheses")
+		}
+		return &expr{kind: exprNot, args: []*expr{x}}, nil
+	}
+	if ps.peek() == '(' {
+		ps.pos++
+		ps.skipSpace()
+		x, err := ps.parseLogicalOr()
+		if err != nil {
+			return nil, err
+		}
+		ps.skipSpace()
+		if ps.peek() != ')' {
+			return nil, ps.errorf("expected ')'")
+		}
+		ps.pos++
+		return x, nil
+	}
+	left, err := ps.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	start := ps.pos
+	ps.skipSpace()
+	op := ps.parseComparisonOp()
+	if op == "" {
+		ps.pos = start
+		switch {
+		case left.kind == queryOperand:
+		case left.kind == funcOperand && funcResultTypes[left.fn.name] == logicalType:
+		default:
+			return nil, ps.errorf("expected a test or comparison")
+		}
+		return &expr{kind: exprTest, left: left}, nil
+	}
+	ps.skipSpace()
+	right, err := ps.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range []*operand{left, right} {
+		if o.kind == queryOperand && !o.singular {
+			return nil, ps.errorf("comparisons require singular queries")
+		}
+		if o.kind == funcOperand && funcResultTypes[o.fn.name] != valueType {
+			return nil, ps.errorf("%s() can't be compared", o.fn.name)
+		}
+	}
+	return &expr{kind: exprCompare, op: op, left: left, right: right}, nil
+}
+
+func (ps *parser) parseComparisonOp() string {
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if ps.hasPrefix(op) {
+			ps.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+func (ps *parser) parseOperand() (*operand, error) {
+	c := ps.peek()
+	switch {
+	case c == '@' || c == '$':
+		ps.pos++
+		segments, err := ps.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		return &operand{
+			kind:     queryOperand,
+			relative: c == '@',
+			singular: isSingular(segments),
+			segments: segments,
+		}, nil
+	case c == '\'' || c == '"':
+		s, err := ps.parseStringLiteral()
+		return &operand{kind: literalOperand, lit: s}, err
+	case c == '-' || c >= '0' && c <= '9':
+		return ps.parseNumberLiteral()
+	case ps.hasPrefix("true"):
+		ps.pos += len("true")
+		return &operand{kind: literalOperand, lit: true}, nil
+	case ps.hasPrefix("false"):
+		ps.pos += len("false")
+		return &operand{kind: literalOperand, lit: false}, nil
+	case ps.hasPrefix("null"):
+		ps.pos += len("null")
+		return &operand{kind: literalOperand, lit: nil}, nil
+	case c >= 'a' && c <= 'z':
+		return ps.parseFuncCall()
+	default:
+		return nil, ps.errorf("expected a query, literal or function")
+	}
+}
+
+func (ps *parser) parseNumberLiteral() (*operand, error) {
+	data := []byte(ps.src[ps.pos:])
+	if strings.HasPrefix(ps.src[ps.pos:], "-0") && (len(data) == 2 || data[2] < '0' || data[2] > '9') {
+		// -0 is allowed in queries but not in json
+		data = data[1:]
+		ps.pos++
+	}
+	val, p, err := rjson.ReadFloat64(data)
+	if err != nil || math.IsInf(val, 0) {
+		return nil, ps.errorf("invalid number")
+	}
+	ps.pos += p
+	return &operand{kind: literalOperand, lit: val}, nil
+}
+
+func (ps *parser) parseFuncCall() (*operand, error) {
+	start := ps.pos
+	for ps.pos < len(ps.src) {
+		c := ps.src[ps.pos]
+		if c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' {
+			ps.pos++
+			continue
+		}
+		break
+	}
+	name := ps.src[start:ps.pos]
+	paramTypes, ok := funcParamTypes[name]
+	if !ok {
+		ps.pos = start
+		return nil, ps.errorf("unknown function %q", name)
+	}
+	if ps.peek() != '(' {
+		return nil, ps.errorf("expected '('")
+	}
+	ps.pos++
+	fn := &funcCall{name: name}
+	for {
+		ps.skipSpace()
+		if ps.peek() == ')' && len(fn.args) == 0 {
+			break
+		}
+		arg, err := ps.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		fn.args = append(fn.args, arg)
+		ps.skipSpace()
+		if ps.peek() != ',' {
+			break
+		}
+		ps.pos++
+	}
+	if ps.peek() != ')' {
+		return nil, ps.errorf("expected ')'")
+	}
+	ps.pos++
+	if len(fn.args) != len(paramTypes) {
+		return nil, ps.errorf("%s() takes %d arguments", name, len(paramTypes))
+	}
+	for i, arg := range fn.args {
+		if !argMatches(paramTypes[i], arg) {
+			return nil, ps.errorf("invalid argument %d for %s()", i+1, name)
+		}
+	}
+	if (name == "match" || name == "search") && fn.args[1].kind == literalOperand {
+		pattern, ok := fn.args[1].lit.(string)
+		if ok {
+			fn.re = compileIRegexp(pattern, name == "match")
+		}
+	}
+	return &operand{kind: funcOperand, fn: fn}, nil
+}
+
+func argMatches(tp funcType, arg *operand) bool {
+	switch tp {
+	case nodesType:
+		return arg.kind == queryOperand
+	case valueType:
+		switch arg.kind {
+		case literalOperand:
+			return true
+		case queryOperand:
+			return arg.singular
+		default:
+			return funcResultTypes[arg.fn.name] == valueType
+		}
+	}
+	return false
+}
+
+// isSingular returns true if segments can only ever match a single node.
+func isSingular(segments []segment) bool {
+	for _, seg := range segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+		switch seg.selectors[0].kind {
+		case nameSelector, indexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// compileIRegexp compiles an RFC 9485 I-Regexp. It returns nil when pattern isn't valid.
+func compileIRegexp(pattern string, anchored bool) *regexp.Regexp {
+	// '.' in I-Regexp matches any character except line feed and carriage return
+	var sb strings.Builder
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			sb.WriteByte(c)
+			i++
+			sb.WriteByte(pattern[i])
+			continue
+		case c == '[':
+			inClass = true
+		case c == ']':
+			inClass = false
+		case c == '.' && !inClass:
+			sb.WriteString(`[^\n\r]`)
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	expr := sb.String()
+	if anchored {
+		expr = `^(?:` + expr + `)$`
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil
+	}
+	return re
+}
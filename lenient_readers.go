@@ -0,0 +1,716 @@
+package rjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ReadIntegralUint64 is like ReadUint64 except it also accepts numbers with a fraction or exponent as long as their
+// value is exactly an integer. 12.0, 1.2e1 and 120e-1 are all read as 12. p is the first position in data after the
+// value.
+func ReadIntegralUint64(data []byte) (val uint64, p int, err error) {
+	var neg bool
+	val, neg, p, err = readIntegral(data, ErrInvalidUInt)
+	if err != nil {
+		return 0, p, err
+	}
+	if neg && val != 0 {
+		return 0, p, ErrInvalidUInt
+	}
+	return val, p, nil
+}
+
+func readIntegralUint64Compat(data []byte) (val uint64, p int, err error) {
+	var bigVal *big.Int
+	bigVal, p, err = readIntegralCompatHelper(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !bigVal.IsUint64() {
+		return 0, 0, fmt.Errorf("value out of uint64 range")
+	}
+	return bigVal.Uint64(), p, nil
+}
+
+// ReadIntegralInt64 is like ReadInt64 except it also accepts numbers with a fraction or exponent as long as their
+// value is exactly an integer. -12.0, -1.2e1 and -120e-1 are all read as -12. p is the first position in data after the
+// value.
+func ReadIntegralInt64(data []byte) (val int64, p int, err error) {
+	const cutoff = uint64(1 << uint64(63))
+	var u64Val uint64
+	var neg bool
+	u64Val, neg, p, err = readIntegral(data, ErrInvalidInt)
+	if err != nil {
+		return 0, p, err
+	}
+	if neg {
+		if u64Val > cutoff {
+			return 0, p, ErrNumberRange
+		}
+		return -int64(u64Val), p, nil
+	}
+	if u64Val >= cutoff {
+		return 0, p, ErrNumberRange
+	}
+	return int64(u64Val), p, nil
+}
+
+func readIntegralInt64Compat(data []byte) (val int64, p int, err error) {
+	var bigVal *big.Int
+	bigVal, p, err = readIntegralCompatHelper(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !bigVal.IsInt64() {
+		return 0, 0, fmt.Errorf("value out of int64 range")
+	}
+	return bigVal.Int64(), p, nil
+}
+
+// ReadIntegralUint is like ReadUint except it also accepts numbers with a fraction or exponent as long as their value
+// is exactly an integer. p is the first position in data after the value.
+func ReadIntegralUint(data []byte) (val uint, p int, err error) {
+	switch strconv.IntSize {
+	case 64:
+		val, p, err := ReadIntegralUint64(data)
+		return uint(val), p, err
+	case 32:
+		val, p, err := ReadIntegralUint64(data)
+		if err == nil && val > math.MaxUint32 {
+			return 0, p, ErrNumberRange
+		}
+		return uint(val), p, err
+	default:
+		return 0, 0, fmt.Errorf("unsupported int size: %d", strconv.IntSize)
+	}
+}
+
+func readIntegralUintCompat(data []byte) (val uint, p int, err error) {
+	var val64 uint64
+	val64, p, err = readIntegralUint64Compat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if uint64(uint(val64)) != val64 {
+		return 0, 0, fmt.Errorf("value out of uint range")
+	}
+	return uint(val64), p, nil
+}
+
+// ReadIntegralInt is like ReadInt except it also accepts numbers with a fraction or exponent as long as their value is
+// exactly an integer. p is the first position in data after the value.
+func ReadIntegralInt(data []byte) (val, p int, err error) {
+	switch strconv.IntSize {
+	case 64:
+		val, p, err := ReadIntegralInt64(data)
+		return int(val), p, err
+	case 32:
+		val, p, err := ReadIntegralInt64(data)
+		if err == nil && (val > math.MaxInt32 || val < math.MinInt32) {
+			return 0, p, ErrNumberRange
+		}
+		return int(val), p, err
+	default:
+		return 0, 0, fmt.Errorf("unsupported int size: %d", strconv.IntSize)
+	}
+}
+
+func readIntegralIntCompat(data []byte) (val, p int, err error) {
+	var val64 int64
+	val64, p, err = readIntegralInt64Compat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	if int64(int(val64)) != val64 {
+		return 0, 0, fmt.Errorf("value out of int range")
+	}
+	return int(val64), p, nil
+}
+
+// readIntegral reads the json number at the beginning of data and returns its absolute value. It returns invalid when
+// data doesn't start with a number or the number isn't an integer and ErrNumberRange when it doesn't fit in a uint64.
+func readIntegral(data []byte, invalid error) (val uint64, neg bool, p int, err error) {
+	p = countWhitespace(data)
+	n, pp, err := scanNumber(data[p:])
+	if err != nil {
+		return 0, false, p + pp, invalid
+	}
+	data = data[p : p+pp]
+	p += pp
+	intDigits := data[n.intStart:n.intEnd]
+	fracDigits := data[n.fracStart:n.fracEnd]
+
+	// The value is intDigits followed by fracDigits times 10^shift.
+	shift := 0
+	if n.hasExp() {
+		// Past this the value is either 0, out of range or not an integer no matter what the digits are.
+		limit := len(data) + 20
+		expDigits := data[n.expStart:n.expEnd]
+		expNeg := expDigits[0] == '-'
+		if signBytes[expDigits[0]] {
+			expDigits = expDigits[1:]
+		}
+		for _, c := range expDigits {
+			if shift > limit {
+				break
+			}
+			shift = shift*10 + int(c-'0')
+		}
+		if expNeg {
+			shift = -shift
+		}
+	}
+	shift -= len(fracDigits)
+
+	// trailing zeros just move the shift
+	for len(fracDigits) > 0 && fracDigits[len(fracDigits)-1] == '0' {
+		fracDigits = fracDigits[:len(fracDigits)-1]
+		shift++
+	}
+	if len(fracDigits) == 0 {
+		for len(intDigits) > 0 && intDigits[len(intDigits)-1] == '0' {
+			intDigits = intDigits[:len(intDigits)-1]
+			shift++
+		}
+	}
+
+	// leading zeros don't count
+	if len(intDigits) == 1 && intDigits[0] == '0' {
+		intDigits = nil
+	}
+	if len(intDigits) == 0 {
+		for len(fracDigits) > 0 && fracDigits[0] == '0' {
+			fracDigits = fracDigits[1:]
+		}
+	}
+
+	neg = n.neg()
+	if len(intDigits)+len(fracDigits) == 0 {
+		return 0, neg, p, nil
+	}
+	if shift < 0 {
+		return 0, neg, p, invalid
+	}
+	if len(intDigits)+len(fracDigits)+shift > 20 {
+		return 0, neg, p, ErrNumberRange
+	}
+	const cutoff = (1<<64-1)/10 + 1
+	for i := 0; i < len(intDigits)+len(fracDigits)+shift; i++ {
+		var d uint64
+		switch {
+		case i < len(intDigits):
+			d = uint64(intDigits[i] - '0')
+		case i < len(intDigits)+len(fracDigits):
+			d = uint64(fracDigits[i-len(intDigits)] - '0')
+		}
+		if val >= cutoff {
+			return 0, neg, p, ErrNumberRange
+		}
+		newVal := val*10 + d
+		if newVal < val {
+			return 0, neg, p, ErrNumberRange
+		}
+		val = newVal
+	}
+	return val, neg, p, nil
+}
+
+// readIntegralCompatHelper reads a json number with encoding/json and returns its value when it is an integer.
+func readIntegralCompatHelper(data []byte) (val *big.Int, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	num, ok := token.(json.Number)
+	if !ok {
+		return nil, 0, ErrInvalidNumber
+	}
+	mantissa := strings.ToLower(string(num))
+	exp := new(big.Int)
+	if i := strings.IndexByte(mantissa, 'e'); i != -1 {
+		_, ok = exp.SetString(strings.TrimLeft(mantissa[i+1:], "+"), 10)
+		if !ok {
+			return nil, 0, ErrInvalidNumber
+		}
+		mantissa = mantissa[:i]
+	}
+	if i := strings.IndexByte(mantissa, '.'); i != -1 {
+		exp.Sub(exp, big.NewInt(int64(len(mantissa)-i-1)))
+		mantissa = mantissa[:i] + mantissa[i+1:]
+	}
+	val, ok = new(big.Int).SetString(mantissa, 10)
+	if !ok {
+		return nil, 0, ErrInvalidNumber
+	}
+	p = int(decoder.InputOffset())
+	if val.Sign() == 0 {
+		return val, p, nil
+	}
+	// |val| < 10^len(mantissa), so it can't be divided by any more powers of 10 than that
+	if exp.Cmp(big.NewInt(int64(-len(mantissa)))) < 0 {
+		return nil, 0, ErrInvalidInt
+	}
+	// anything larger is out of range for the types we check
+	if exp.Cmp(big.NewInt(40)) > 0 {
+		return nil, 0, fmt.Errorf("value out of range")
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), new(big.Int).Abs(exp), nil)
+	if exp.Sign() >= 0 {
+		return val.Mul(val, scale), p, nil
+	}
+	var rem big.Int
+	val.QuoRem(val, scale, &rem)
+	if rem.Sign() != 0 {
+		return nil, 0, ErrInvalidInt
+	}
+	return val, p, nil
+}
+
+// quotedNumberBufSize is the size of the stack buffer ReadQuoted* functions unescape strings into. Only numbers with
+// escaped characters are unescaped, and only longer numbers than this allocate.
+const quotedNumberBufSize = 64
+
+// ReadQuotedInt64 reads an int64 value from inside a string at the beginning of data. This is how encoding/json writes
+// values with the ",string" option. The string must hold nothing but the number. p is the first position in data
+// after the string.
+func ReadQuotedInt64(data []byte) (val int64, p int, err error) {
+	var buf [quotedNumberBufSize]byte
+	var content []byte
+	content, p, err = readQuotedNumber(data, buf[:0])
+	if err != nil {
+		return 0, p, err
+	}
+	var pp int
+	val, pp, err = ReadInt64(content)
+	if err != nil || pp != len(content) {
+		return 0, p, quotedIntError(content, false)
+	}
+	return val, p, nil
+}
+
+func readQuotedInt64Compat(data []byte) (val int64, p int, err error) {
+	var content []byte
+	content, p, err = readQuotedNumberCompat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pp int
+	val, pp, err = readInt64Compat(content)
+	if err != nil || pp != len(content) {
+		return 0, 0, ErrInvalidInt
+	}
+	return val, p, nil
+}
+
+// ReadQuotedUint64 reads a uint64 value from inside a string at the beginning of data. This is how encoding/json
+// writes values with the ",string" option. The string must hold nothing but the number. p is the first position in
+// data after the string.
+func ReadQuotedUint64(data []byte) (val uint64, p int, err error) {
+	var buf [quotedNumberBufSize]byte
+	var content []byte
+	content, p, err = readQuotedNumber(data, buf[:0])
+	if err != nil {
+		return 0, p, err
+	}
+	var pp int
+	val, pp, err = ReadUint64(content)
+	if err != nil || pp != len(content) {
+		return 0, p, quotedIntError(content, true)
+	}
+	return val, p, nil
+}
+
+func readQuotedUint64Compat(data []byte) (val uint64, p int, err error) {
+	var content []byte
+	content, p, err = readQuotedNumberCompat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pp int
+	val, pp, err = readUint64Compat(content)
+	if err != nil || pp != len(content) {
+		return 0, 0, ErrInvalidUInt
+	}
+	return val, p, nil
+}
+
+// ReadQuotedInt reads an int value from inside a string at the beginning of data. This is how encoding/json writes
+// values with the ",string" option. The string must hold nothing but the number. p is the first position in data
+// after the string.
+func ReadQuotedInt(data []byte) (val, p int, err error) {
+	var buf [quotedNumberBufSize]byte
+	var content []byte
+	content, p, err = readQuotedNumber(data, buf[:0])
+	if err != nil {
+		return 0, p, err
+	}
+	var pp int
+	val, pp, err = ReadInt(content)
+	if err != nil || pp != len(content) {
+		return 0, p, quotedIntError(content, false)
+	}
+	return val, p, nil
+}
+
+func readQuotedIntCompat(data []byte) (val, p int, err error) {
+	var content []byte
+	content, p, err = readQuotedNumberCompat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pp int
+	val, pp, err = readIntCompat(content)
+	if err != nil || pp != len(content) {
+		return 0, 0, ErrInvalidInt
+	}
+	return val, p, nil
+}
+
+// ReadQuotedUint reads a uint value from inside a string at the beginning of data. This is how encoding/json writes
+// values with the ",string" option. The string must hold nothing but the number. p is the first position in data
+// after the string.
+func ReadQuotedUint(data []byte) (val uint, p int, err error) {
+	var buf [quotedNumberBufSize]byte
+	var content []byte
+	content, p, err = readQuotedNumber(data, buf[:0])
+	if err != nil {
+		return 0, p, err
+	}
+	var pp int
+	val, pp, err = ReadUint(content)
+	if err != nil || pp != len(content) {
+		return 0, p, quotedIntError(content, true)
+	}
+	return val, p, nil
+}
+
+func readQuotedUintCompat(data []byte) (val uint, p int, err error) {
+	var content []byte
+	content, p, err = readQuotedNumberCompat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pp int
+	val, pp, err = readUintCompat(content)
+	if err != nil || pp != len(content) {
+		return 0, 0, ErrInvalidUInt
+	}
+	return val, p, nil
+}
+
+// ReadQuotedFloat64 reads a float64 value from inside a string at the beginning of data. This is how encoding/json
+// writes values with the ",string" option. The string must hold nothing but the number. p is the first position in
+// data after the string.
+func ReadQuotedFloat64(data []byte) (val float64, p int, err error) {
+	var buf [quotedNumberBufSize]byte
+	var content []byte
+	content, p, err = readQuotedNumber(data, buf[:0])
+	if err != nil {
+		return 0, p, err
+	}
+	var pp int
+	val, pp, err = ReadFloat64(content)
+	if err != nil || pp != len(content) {
+		return 0, p, ErrInvalidNumber
+	}
+	return val, p, nil
+}
+
+func readQuotedFloat64Compat(data []byte) (val float64, p int, err error) {
+	var content []byte
+	content, p, err = readQuotedNumberCompat(data)
+	if err != nil {
+		return 0, 0, err
+	}
+	var pp int
+	val, pp, err = readFloat64Compat(content)
+	if err != nil || pp != len(content) {
+		return 0, 0, ErrInvalidNumber
+	}
+	return val, p, nil
+}
+
+// quotedIntError returns the error for the content of a quoted number that couldn't be read as an integer. It is
+// ErrNumberRange when content is an integer that is too large for the type.
+func quotedIntError(content []byte, unsigned bool) error {
+	invalid := ErrInvalidInt
+	if unsigned {
+		invalid = ErrInvalidUInt
+	}
+	n, pp, err := scanNumber(content)
+	if err != nil || pp != len(content) || n.hasFrac() || n.hasExp() || unsigned && n.neg() {
+		return invalid
+	}
+	return ErrNumberRange
+}
+
+// readQuotedNumber reads the string at the beginning of data and returns its content. buf is only used when the
+// string has escaped characters.
+func readQuotedNumber(data, buf []byte) (content []byte, p int, err error) {
+	p = countWhitespace(data)
+	if p == len(data) || data[p] != '"' {
+		return nil, p, ErrInvalidString
+	}
+	start := p + 1
+	end := start
+	for end < len(data) && data[end] != '"' && data[end] != '\\' && data[end] > 0x1f {
+		end++
+	}
+	if end < len(data) && data[end] == '"' {
+		content = data[start:end]
+		p = end + 1
+	} else {
+		var pp int
+		content, pp, err = ReadStringBytes(data[p:], buf)
+		p += pp
+		if err != nil {
+			return nil, p, err
+		}
+	}
+	// the number has to start right after the quote
+	if len(content) == 0 || whitespace[content[0]] {
+		return nil, p, ErrInvalidNumber
+	}
+	return content, p, nil
+}
+
+func readQuotedNumberCompat(data []byte) (content []byte, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	s, ok := token.(string)
+	if !ok {
+		return nil, 0, ErrInvalidString
+	}
+	if s == "" || strings.TrimLeft(s, " \t\r\n") != s {
+		return nil, 0, ErrInvalidNumber
+	}
+	return []byte(s), int(decoder.InputOffset()), nil
+}
+
+// DecodeIntegralInt64 is like DecodeInt64 except it accepts the same numbers as ReadIntegralInt64.
+func DecodeIntegralInt64(data []byte, v *int64) (p int, err error) {
+	var val int64
+	val, p, err = ReadIntegralInt64(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeIntegralInt64Compat(data []byte, v *int64) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val int64
+	val, p, err = readIntegralInt64Compat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeIntegralUint64 is like DecodeUint64 except it accepts the same numbers as ReadIntegralUint64.
+func DecodeIntegralUint64(data []byte, v *uint64) (p int, err error) {
+	var val uint64
+	val, p, err = ReadIntegralUint64(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeIntegralUint64Compat(data []byte, v *uint64) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val uint64
+	val, p, err = readIntegralUint64Compat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeIntegralInt is like DecodeInt except it accepts the same numbers as ReadIntegralInt.
+func DecodeIntegralInt(data []byte, v *int) (p int, err error) {
+	var val int
+	val, p, err = ReadIntegralInt(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeIntegralIntCompat(data []byte, v *int) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val int
+	val, p, err = readIntegralIntCompat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeIntegralUint is like DecodeUint except it accepts the same numbers as ReadIntegralUint.
+func DecodeIntegralUint(data []byte, v *uint) (p int, err error) {
+	var val uint
+	val, p, err = ReadIntegralUint(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeIntegralUintCompat(data []byte, v *uint) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val uint
+	val, p, err = readIntegralUintCompat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeQuotedInt64 is like DecodeInt64 except it reads the number from inside a string like ReadQuotedInt64.
+func DecodeQuotedInt64(data []byte, v *int64) (p int, err error) {
+	var val int64
+	val, p, err = ReadQuotedInt64(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeQuotedInt64Compat(data []byte, v *int64) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val int64
+	val, p, err = readQuotedInt64Compat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeQuotedUint64 is like DecodeUint64 except it reads the number from inside a string like ReadQuotedUint64.
+func DecodeQuotedUint64(data []byte, v *uint64) (p int, err error) {
+	var val uint64
+	val, p, err = ReadQuotedUint64(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeQuotedUint64Compat(data []byte, v *uint64) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val uint64
+	val, p, err = readQuotedUint64Compat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeQuotedInt is like DecodeInt except it reads the number from inside a string like ReadQuotedInt.
+func DecodeQuotedInt(data []byte, v *int) (p int, err error) {
+	var val int
+	val, p, err = ReadQuotedInt(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeQuotedIntCompat(data []byte, v *int) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val int
+	val, p, err = readQuotedIntCompat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeQuotedUint is like DecodeUint except it reads the number from inside a string like ReadQuotedUint.
+func DecodeQuotedUint(data []byte, v *uint) (p int, err error) {
+	var val uint
+	val, p, err = ReadQuotedUint(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeQuotedUintCompat(data []byte, v *uint) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val uint
+	val, p, err = readQuotedUintCompat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
+
+// DecodeQuotedFloat64 is like DecodeFloat64 except it reads the number from inside a string like ReadQuotedFloat64.
+func DecodeQuotedFloat64(data []byte, v *float64) (p int, err error) {
+	var val float64
+	val, p, err = ReadQuotedFloat64(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+func decodeQuotedFloat64Compat(data []byte, v *float64) (p int, err error) {
+	if _, err = ReadNull(data); err == nil {
+		return decodeCompatHelper(data, v)
+	}
+	var val float64
+	val, p, err = readQuotedFloat64Compat(data)
+	if err != nil {
+		return 0, err
+	}
+	*v = val
+	return p, nil
+}
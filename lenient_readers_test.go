@@ -0,0 +1,140 @@
+package rjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadIntegral_matchStdLib(t *testing.T) {
+	t.Parallel()
+	inputs := []string{
+		`12`, `12.0`, `1.2e1`, `120e-1`, `1e3`, `1E+3`, `-12.000`, `-0`, `-0.0e5`, `0e999999999999`, `0.0`,
+		`0.00120e4`, `12.5`, `1e-1`, `100e-3`, `1e19`, `1e20`, `18446744073709551615`, `1.8446744073709551615e19`,
+		`18446744073709551616`, `9223372036854775807`, `9223372036854775808.0`, `-9223372036854775808`,
+		`-9.223372036854775808e18`, `-9223372036854775809`, `1e99999999999999999999`, `1e-99999999999999999999`,
+		`100000000000000000000e-2`, `  42.0  `, `42.0,`, `1.`, `.5`, `01`, `-`, `"1"`, `null`, ``,
+	}
+	for _, fn := range []func([]byte) (int, error){
+		fuzzReadIntegralInt64, fuzzReadIntegralUint64, fuzzReadIntegralInt, fuzzReadIntegralUint,
+		fuzzDecodeIntegralInt64, fuzzDecodeIntegralUint64, fuzzDecodeIntegralInt, fuzzDecodeIntegralUint,
+	} {
+		testFuzzerWithInput(t, fn, inputs...)
+	}
+}
+
+func TestReadQuoted_matchStdLib(t *testing.T) {
+	t.Parallel()
+	inputs := []string{
+		`"12"`, `"-12"`, `"1.5"`, `"1e3"`, `"\u0031\u0032"`, `"1\u0032"`, `" 12"`, `"12 "`, `""`, `"-"`, `"0012"`,
+		`"18446744073709551616"`, `"-9223372036854775808"`, `"null"`, `"12"x`, ` "12" `, `12`, `null`, `"12`,
+		`"1\n2"`, `"\ud800"`, `"123456789012345678901234567890123456789012345678901234567890123456789.5"`,
+	}
+	for _, fn := range []func([]byte) (int, error){
+		fuzzReadQuotedInt64, fuzzReadQuotedUint64, fuzzReadQuotedInt, fuzzReadQuotedUint, fuzzReadQuotedFloat64,
+		fuzzDecodeQuotedInt64, fuzzDecodeQuotedUint64, fuzzDecodeQuotedInt, fuzzDecodeQuotedUint, fuzzDecodeQuotedFloat64,
+	} {
+		testFuzzerWithInput(t, fn, inputs...)
+	}
+}
+
+func TestReadIntegralInt64(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data    string
+		want    int64
+		wantP   int
+		wantErr error
+	}{
+		{data: `12.0`, want: 12, wantP: 4},
+		{data: `1.2e1,`, want: 12, wantP: 5},
+		{data: ` -120e-1`, want: -12, wantP: 8},
+		{data: `1e3`, want: 1000, wantP: 3},
+		{data: `-9.223372036854775808e18`, want: -9223372036854775808, wantP: 24},
+		{data: `12.5`, wantErr: ErrInvalidInt},
+		{data: `9.223372036854775808e18`, wantErr: ErrNumberRange},
+		{data: `1e20`, wantErr: ErrNumberRange},
+		{data: `1e-1`, wantErr: ErrInvalidInt},
+		{data: `x`, wantErr: ErrInvalidInt},
+	} {
+		got, p, err := ReadIntegralInt64([]byte(td.data))
+		if td.wantErr != nil {
+			require.Equal(t, td.wantErr, err, td.data)
+			continue
+		}
+		require.NoError(t, err, td.data)
+		require.Equal(t, td.want, got, td.data)
+		require.Equal(t, td.wantP, p, td.data)
+	}
+}
+
+func TestReadIntegralUint64_errors(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data    string
+		wantErr error
+	}{
+		{data: `12.5`, wantErr: ErrInvalidUInt},
+		{data: `x`, wantErr: ErrInvalidUInt},
+		{data: `-1`, wantErr: ErrInvalidUInt},
+		{data: `1e20`, wantErr: ErrNumberRange},
+	} {
+		_, _, err := ReadIntegralUint64([]byte(td.data))
+		require.Equal(t, td.wantErr, err, td.data)
+	}
+}
+
+func TestReadQuotedInt64(t *testing.T) {
+	t.Parallel()
+	got, p, err := ReadQuotedInt64([]byte(` "-42", `))
+	require.NoError(t, err)
+	require.Equal(t, int64(-42), got)
+	require.Equal(t, 6, p)
+
+	got, p, err = ReadQuotedInt64([]byte(`"\u0034\u0032"`))
+	require.NoError(t, err)
+	require.Equal(t, int64(42), got)
+	require.Equal(t, 14, p)
+
+	_, _, err = ReadQuotedInt64([]byte(`"42.5"`))
+	require.Equal(t, ErrInvalidInt, err)
+	_, _, err = ReadQuotedInt64([]byte(`42`))
+	require.Error(t, err)
+	_, _, err = ReadQuotedInt64([]byte(`"9223372036854775808"`))
+	require.Equal(t, ErrNumberRange, err)
+	_, _, err = ReadQuotedUint64([]byte(`"18446744073709551616"`))
+	require.Equal(t, ErrNumberRange, err)
+	_, _, err = ReadQuotedUint64([]byte(`"-1"`))
+	require.Equal(t, ErrInvalidUInt, err)
+}
+
+func TestDecodeQuotedInt64(t *testing.T) {
+	t.Parallel()
+	got := int64(1)
+	p, err := DecodeQuotedInt64([]byte(`null`), &got)
+	require.NoError(t, err)
+	require.Equal(t, 4, p)
+	require.Equal(t, int64(1), got)
+
+	p, err = DecodeQuotedInt64([]byte(`"-42"`), &got)
+	require.NoError(t, err)
+	require.Equal(t, 5, p)
+	require.Equal(t, int64(-42), got)
+
+	_, err = DecodeQuotedInt64([]byte(`"1e3"`), &got)
+	require.Equal(t, ErrInvalidInt, err)
+	require.Equal(t, int64(-42), got)
+}
+
+func TestLenientReaders_allocs(t *testing.T) {
+	for _, fn := range []func(){
+		func() { _, _, _ = ReadIntegralInt64([]byte(`1.2345e4`)) },      //nolint:errcheck // only counting
+		func() { _, _, _ = ReadIntegralUint64([]byte(`12000e-3`)) },     //nolint:errcheck // only counting
+		func() { _, _, _ = ReadQuotedInt64([]byte(`"\u0031\u0032"`)) },  //nolint:errcheck // only counting
+		func() { _, _, _ = ReadQuotedUint64([]byte(`"\u0031\u0032"`)) }, //nolint:errcheck // only counting
+		func() { _, _, _ = ReadQuotedFloat64([]byte(`"1.5e3"`)) },       //nolint:errcheck // only counting
+		func() { _, _, _ = ReadQuotedInt64([]byte(`"1e99"`)) },          //nolint:errcheck // only counting
+	} {
+		require.Zero(t, testing.AllocsPerRun(10, fn))
+	}
+}
@@ -0,0 +1,123 @@
+package rjson
+
+import "fmt"
+
+// ErrLimitExceeded is returned when data goes over one of the Limits set on a Buffer or ValueReader. The error
+// returned is a *LimitError that says which limit and where.
+var ErrLimitExceeded = fmt.Errorf("limit exceeded")
+
+// Limits restrict the size and shape of the json values rjson will read. They are meant for rejecting hostile input
+// before doing any real work with it. A zero value means no limit.
+//
+// Set Limits on the Buffer passed to SkipValue, SkipValueFast, Valid, HandleObjectValues or HandleArrayValues, or on a
+// ValueReader. Limits are checked by the state machines as they read data, so handlers can be called for the values
+// before one that is over a limit. Strings and numbers are checked before they are passed to a handler. An object or
+// array that a handler reads itself is checked when the handler passes the same Buffer to the nested call, which
+// counts as one level deeper for MaxDepth. MaxDocumentSize is relative to the start of the data each call is given.
+type Limits struct {
+	// MaxDepth is the maximum nesting of objects and arrays. Depths over 10,000 are rejected with ErrMaxDepth
+	// regardless of MaxDepth.
+	MaxDepth int
+
+	// MaxStringLen is the maximum length of a string or object key in bytes, not counting the quotes. Escape
+	// sequences count as their escaped length.
+	MaxStringLen int
+
+	// MaxNumberLen is the maximum length of a number literal in bytes.
+	MaxNumberLen int
+
+	// MaxObjectKeys is the maximum number of keys in any one object.
+	MaxObjectKeys int
+
+	// MaxArrayElements is the maximum number of elements in any one array.
+	MaxArrayElements int
+
+	// MaxDocumentSize is the maximum size of the value in bytes including any whitespace before it.
+	MaxDocumentSize int
+}
+
+// LimitError is returned when data goes over one of the Limits. errors.Is(err, ErrLimitExceeded) is true for a
+// *LimitError.
+type LimitError struct {
+	// Limit is the name of the field in Limits that was exceeded, like "MaxDepth".
+	Limit string
+
+	// Max is the value of the limit.
+	Max int
+
+	// Offset is the position in data of the value that went over the limit. For MaxDocumentSize it is the first
+	// position after the limit.
+	Offset int
+
+	// dataLen is the length of the data Offset is relative to. See SyntaxError.dataLen.
+	dataLen int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%v at offset %d: %s is %d", ErrLimitExceeded, e.Offset, e.Limit, e.Max)
+}
+
+// Unwrap returns ErrLimitExceeded.
+func (e *LimitError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+func limitError(limit string, max, offset int, data []byte) *LimitError {
+	return &LimitError{
+		Limit:   limit,
+		Max:     max,
+		Offset:  offset,
+		dataLen: len(data),
+	}
+}
+
+// checkLevel is what the checked machines keep for each open object or array.
+type checkLevel struct {
+	count  int // keys or elements so far
+	object int // number for the object in buffer.keys
+}
+
+// needsCheck returns true when buffer has any Limits set or StrictUTF8, RejectDuplicateKeys, JSONC or AllowNonFinite is
+// set. Those are handled by the checked state machines, so buffers without them run the machines that don't check
+// anything.
+func (buffer *Buffer) needsCheck() bool {
+	return buffer != nil && (buffer.Limits != Limits{} || buffer.StrictUTF8 || buffer.RejectDuplicateKeys ||
+		buffer.JSONC || buffer.AllowNonFinite)
+}
+
+// beginCheck is called before running a checked machine and endCheck after. Handlers can make nested calls with the
+// same Buffer, and the objects they are in still need their keys, so only the outermost call resets buffer.keys.
+func (buffer *Buffer) beginCheck() {
+	if buffer.checks == 0 && buffer.RejectDuplicateKeys {
+		buffer.keys.reset()
+	}
+	buffer.checks++
+}
+
+func (buffer *Buffer) endCheck() {
+	buffer.checks--
+}
+
+// enterLevel starts counting for the object or array at level in the checked machines' stack.
+func (buffer *Buffer) enterLevel(level int) {
+	for len(buffer.checkLevels) <= level {
+		buffer.checkLevels = append(buffer.checkLevels, checkLevel{})
+	}
+	buffer.checkLevels[level].count = 0
+	if buffer.RejectDuplicateKeys {
+		buffer.checkLevels[level].object = buffer.keys.newObject()
+	}
+}
+
+// documentEnd returns where the checked machines stop reading data. When data is longer than MaxDocumentSize they stop
+// one byte past the limit, and reaching that is a MaxDocumentSize error.
+func (buffer *Buffer) documentEnd(data []byte) int {
+	if buffer.Limits.MaxDocumentSize > 0 && buffer.Limits.MaxDocumentSize < len(data) {
+		return buffer.Limits.MaxDocumentSize + 1
+	}
+	return len(data)
+}
+
+func (buffer *Buffer) documentSizeError(data []byte) error {
+	return limitError("MaxDocumentSize", buffer.Limits.MaxDocumentSize, buffer.Limits.MaxDocumentSize, data)
+}
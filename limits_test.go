@@ -0,0 +1,214 @@
+package rjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data   string
+		limits Limits
+		limit  string
+		offset int
+	}{
+		{data: `[[1], [[2]]]`, limits: Limits{MaxDepth: 2}, limit: "MaxDepth", offset: 7},
+		{data: `[[1], [[2]]]`, limits: Limits{MaxDepth: 3}},
+		{data: `{"a": "abc", "b": "abcd"}`, limits: Limits{MaxStringLen: 3}, limit: "MaxStringLen", offset: 18},
+		{data: `{"abcd": "abc"}`, limits: Limits{MaxStringLen: 3}, limit: "MaxStringLen", offset: 1},
+		{data: `["a\"b"]`, limits: Limits{MaxStringLen: 3}, limit: "MaxStringLen", offset: 1},
+		{data: `["a\"b"]`, limits: Limits{MaxStringLen: 4}},
+		{data: `[1.5, -1.5e10]`, limits: Limits{MaxNumberLen: 4}, limit: "MaxNumberLen", offset: 6},
+		{data: `-1.5`, limits: Limits{MaxNumberLen: 4}},
+		{data: `{"a": {"b": 1, "c": 2, "d": 3}}`, limits: Limits{MaxObjectKeys: 2}, limit: "MaxObjectKeys", offset: 23},
+		{data: `{"a": {"b": 1, "c": 2}}`, limits: Limits{MaxObjectKeys: 2}},
+		{data: `[[1, 2, 3], 4]`, limits: Limits{MaxArrayElements: 2}, limit: "MaxArrayElements", offset: 8},
+		{data: `[[1, 2], 4]`, limits: Limits{MaxArrayElements: 2}},
+		{data: `  [1, 2, 3]`, limits: Limits{MaxDocumentSize: 8}, limit: "MaxDocumentSize", offset: 8},
+		{data: `  [1, 2, 3]  `, limits: Limits{MaxDocumentSize: 11}},
+	} {
+		buf := &Buffer{Limits: td.limits}
+		p, err := SkipValue([]byte(td.data), buf)
+		require.Equal(t, td.limit == "", Valid([]byte(td.data), buf), td.data)
+		if td.limit == "" {
+			require.NoError(t, err, td.data)
+			require.Equal(t, len(td.data)-countWhitespace([]byte(td.data[p:])), p, td.data)
+			continue
+		}
+		require.True(t, errors.Is(err, ErrLimitExceeded), td.data)
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr), td.data)
+		require.Equal(t, td.limit, limitErr.Limit, td.data)
+		require.Equal(t, td.offset, limitErr.Offset, td.data)
+
+		_, err = SkipValueFast([]byte(td.data), buf)
+		require.True(t, errors.Is(err, ErrLimitExceeded), td.data)
+
+		skip := func(data []byte) (int, error) { return 0, nil }
+		switch td.data[countWhitespace([]byte(td.data))] {
+		case '{':
+			_, err = HandleObjectValues([]byte(td.data), ObjectValueHandlerFunc(func(_, data []byte) (int, error) {
+				return skip(data)
+			}), buf)
+		case '[':
+			_, err = HandleArrayValues([]byte(td.data), ArrayValueHandlerFunc(skip), buf)
+		}
+		require.True(t, errors.As(err, &limitErr), td.data)
+		require.Equal(t, td.offset, limitErr.Offset, td.data)
+
+		vr := ValueReader{Limits: td.limits}
+		_, _, err = vr.ReadValue([]byte(td.data))
+		require.True(t, errors.Is(err, ErrLimitExceeded), td.data)
+	}
+}
+
+func TestLimits_syntaxError(t *testing.T) {
+	t.Parallel()
+	buf := &Buffer{Limits: Limits{MaxArrayElements: 2}}
+	_, err := SkipValue([]byte(`[1, 2 3, 4]`), buf)
+	require.True(t, errors.Is(err, ErrInvalidArray))
+
+	// a limit that is exceeded before the syntax error is reported first
+	_, err = SkipValue([]byte(`[1, 2, 3, 4 5]`), buf)
+	require.True(t, errors.Is(err, ErrLimitExceeded))
+}
+
+func TestLimits_Error(t *testing.T) {
+	t.Parallel()
+	_, err := SkipValue([]byte(`[[1]]`), &Buffer{Limits: Limits{MaxDepth: 1}})
+	require.EqualError(t, err, `limit exceeded at offset 1: MaxDepth is 1`)
+}
+
+func TestLimits_handlers(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"a": [1, 2], "b": {"c": [1, 2, 3, 4]}}`)
+
+	t.Run("checked before handling", func(t *testing.T) {
+		t.Parallel()
+		var handled []string
+		_, err := HandleObjectValues([]byte(`{"a": "abc", "b": "abcd", "c": 1}`), ObjectValueHandlerFunc(
+			func(fieldname, _ []byte) (int, error) {
+				handled = append(handled, string(fieldname))
+				return 0, nil
+			}), &Buffer{Limits: Limits{MaxStringLen: 3}})
+		require.True(t, errors.Is(err, ErrLimitExceeded))
+		require.Equal(t, []string{"a"}, handled)
+	})
+
+	t.Run("nested calls", func(t *testing.T) {
+		t.Parallel()
+		buf := &Buffer{Limits: Limits{MaxArrayElements: 4}}
+		var handler ObjectValueHandlerFunc
+		handler = func(_, data []byte) (int, error) {
+			switch data[countWhitespace(data)] {
+			case '{':
+				return HandleObjectValues(data, handler, buf)
+			case '[':
+				return HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+					return SkipValue(data, buf)
+				}), buf)
+			default:
+				return SkipValue(data, buf)
+			}
+		}
+		_, err := HandleObjectValues(data, handler, buf)
+		require.NoError(t, err)
+		require.Zero(t, buf.checks)
+		require.Zero(t, buf.depth)
+
+		// a nested call with stricter limits is relative to its data until it is returned
+		_, err = HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+			if string(fieldname) == "b" {
+				return SkipValue(data, &Buffer{Limits: Limits{MaxArrayElements: 3}})
+			}
+			return 0, nil
+		}), buf)
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, 35, limitErr.Offset)
+	})
+
+	t.Run("TrackPath", func(t *testing.T) {
+		t.Parallel()
+		buf := &Buffer{
+			TrackPath: true,
+			Limits:    Limits{MaxArrayElements: 3},
+		}
+		_, err := HandleObjectValues(data, ObjectValueHandlerFunc(func(fieldname, data []byte) (int, error) {
+			if string(fieldname) == "b" {
+				return HandleObjectValues(data, ObjectValueHandlerFunc(func(_, data []byte) (int, error) {
+					return SkipValue(data, &Buffer{Limits: Limits{MaxArrayElements: 3}})
+				}), &Buffer{TrackPath: true})
+			}
+			return 0, nil
+		}), &Buffer{TrackPath: true})
+		var pathErr *PathError
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, "$.b.c", pathErr.Path)
+		require.Equal(t, 35, pathErr.Offset)
+		require.True(t, errors.Is(err, ErrLimitExceeded))
+
+		_, err = HandleObjectValues(data, ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+			return 0, nil
+		}), buf)
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr))
+		require.Equal(t, 35, limitErr.Offset)
+	})
+}
+
+func TestLimits_nestedDepth(t *testing.T) {
+	t.Parallel()
+	buf := &Buffer{Limits: Limits{MaxDepth: 2}}
+	var handler ArrayValueHandlerFunc
+	handler = func(data []byte) (int, error) {
+		if data[0] == '[' {
+			return HandleArrayValues(data, handler, buf)
+		}
+		return 0, nil
+	}
+	_, err := HandleArrayValues([]byte(`[1, [2]]`), handler, buf)
+	require.NoError(t, err)
+	_, err = HandleArrayValues([]byte(`[1, [2, [3]]]`), handler, buf)
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+	require.Equal(t, "MaxDepth", limitErr.Limit)
+	require.Equal(t, 8, limitErr.Offset)
+	require.Zero(t, buf.depth)
+}
+
+func TestLimits_ValueReader(t *testing.T) {
+	t.Parallel()
+	vr := ValueReader{Limits: Limits{MaxObjectKeys: 2}}
+	val, _, err := vr.ReadObject([]byte(`{"a": {"b": 1, "c": 2}, "d": [{"e": 3}]}`))
+	require.NoError(t, err)
+	require.Len(t, val, 2)
+
+	_, _, err = vr.ReadObject([]byte(`{"a": {"b": 1, "c": 2, "f": 4}}`))
+	require.True(t, errors.Is(err, ErrLimitExceeded))
+
+	vr = ValueReader{Limits: Limits{MaxStringLen: 2}}
+	_, _, err = vr.ReadArray([]byte(`["ab", "abc"]`))
+	require.True(t, errors.Is(err, ErrLimitExceeded))
+}
+
+func TestLimits_allocs(t *testing.T) {
+	data := getTestdataJSONGz(t, "twitter.json")
+	buf := &Buffer{Limits: Limits{
+		MaxDepth:         100,
+		MaxStringLen:     10_000,
+		MaxNumberLen:     100,
+		MaxObjectKeys:    1000,
+		MaxArrayElements: 1000,
+		MaxDocumentSize:  1 << 20,
+	}}
+	_, err := SkipValue(data, buf)
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = SkipValue(data, buf) //nolint:errcheck // checked above
+	})
+	require.Zero(t, allocs)
+}
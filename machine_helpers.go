@@ -8,24 +8,47 @@ import (
 )
 
 func errUnexpectedByteInString(b byte) error {
-	return fmt.Errorf("unexpected byte found in string: %q", string(b))
+	return fmt.Errorf("%w: unexpected byte found in string: %q", ErrInvalidString, string(b))
 }
 
 const skipMaxDepth = 10_000
 
+// Errors for invalid json. Functions that read complete values wrap them in a *SyntaxError that says where the
+// problem is. Use errors.Is to check for them.
 var (
-	errMaxDepth      = fmt.Errorf("exceeded max depth")
-	errUnexpectedEOF = fmt.Errorf("unexpected end of json")
-	errInvalidString = fmt.Errorf("invalid json string")
-	errInvalidArray  = fmt.Errorf("invalid json array")
-	errInvalidObject = fmt.Errorf("invalid json object")
-	errInvalidUInt   = fmt.Errorf("invalid json uint")
-	errInvalidInt    = fmt.Errorf("invalid json int")
-	errInvalidNumber = fmt.Errorf("invalid json number")
-	errNoValidToken  = fmt.Errorf("no valid json token found")
-	errNotNull       = fmt.Errorf("not null")
-	errNotBool       = fmt.Errorf("not a boolean value")
-	errPOutOfRange   = fmt.Errorf("p out of range")
+	ErrUnexpectedEOF = fmt.Errorf("unexpected end of json")
+	ErrInvalidString = fmt.Errorf("invalid json string")
+	ErrInvalidArray  = fmt.Errorf("invalid json array")
+	ErrInvalidObject = fmt.Errorf("invalid json object")
+	ErrInvalidUInt   = fmt.Errorf("invalid json uint")
+	ErrInvalidInt    = fmt.Errorf("invalid json int")
+	ErrInvalidNumber = fmt.Errorf("invalid json number")
+	ErrNoValidToken  = fmt.Errorf("no valid json token found")
+	ErrNotNull       = fmt.Errorf("not null")
+	ErrNotBool       = fmt.Errorf("not a boolean value")
+	ErrTrailingData  = fmt.Errorf("unexpected data after json value")
+
+	// ErrInvalidUTF8 is returned in strict utf-8 mode for strings that aren't valid utf-8 or that have an unpaired
+	// surrogate escape like \ud800. errors.Is(ErrInvalidUTF8, ErrInvalidString) is true.
+	ErrInvalidUTF8 = fmt.Errorf("%w: invalid utf-8", ErrInvalidString)
+)
+
+// Errors for valid json that rjson can't handle.
+var (
+	// ErrMaxDepth is returned when objects and arrays are nested more than 10,000 levels deep.
+	ErrMaxDepth = fmt.Errorf("exceeded max depth")
+
+	// ErrExponentRange is returned by ReadDecimal when a number's exponent doesn't fit in an int32.
+	ErrExponentRange = fmt.Errorf("exponent out of range")
+
+	// ErrNumberRange is returned by the ReadIntegral* and DecodeIntegral* readers, the ReadQuoted* and DecodeQuoted*
+	// int and uint readers and ReadBigFloat when a number is too large for the type it is read into. JSON5Parser
+	// returns it for hex numbers and for numbers with a leading or trailing decimal point that don't fit. The other
+	// number readers don't use it.
+	ErrNumberRange = fmt.Errorf("number out of range")
+
+	// ErrPOutOfRange is returned when a handler returns a p that is outside the data it was given.
+	ErrPOutOfRange = fmt.Errorf("p out of range")
 )
 
 func growBytesSliceCapacity(slice []byte, size int) []byte {
@@ -90,7 +113,7 @@ func getu4(data []byte) rune {
 
 func skipFloatExp(data []byte, p, pe int) (int, error) {
 	if p == pe {
-		return p - 1, errInvalidNumber
+		return p - 1, ErrInvalidNumber
 	}
 	startP := p
 	signed := false
@@ -107,10 +130,10 @@ func skipFloatExp(data []byte, p, pe int) (int, error) {
 	var err error
 	switch p - startP {
 	case 0:
-		err = errInvalidNumber
+		err = ErrInvalidNumber
 	case 1:
 		if signed {
-			err = errInvalidNumber
+			err = ErrInvalidNumber
 		}
 	}
 	return p - 1, err
@@ -118,10 +141,10 @@ func skipFloatExp(data []byte, p, pe int) (int, error) {
 
 func skipFloatDec(data []byte, p, pe int) (int, error) {
 	if p == pe {
-		return p - 1, errInvalidNumber
+		return p - 1, ErrInvalidNumber
 	}
 	if !digits[data[p]] {
-		return p - 1, errInvalidNumber
+		return p - 1, ErrInvalidNumber
 	}
 	p++
 	for ; p < pe; p++ {
@@ -140,6 +163,41 @@ func skipFloatDec(data []byte, p, pe int) (int, error) {
 	return p - 1, nil
 }
 
+// resumeEnd returns where a resumable machine has to stop in data when more data will follow. skipFloatDec and
+// skipFloatExp read to the end of a number, so a number that runs to the end of data is left for the next run. The e
+// at the end of true and false isn't part of a number.
+func resumeEnd(data []byte, p int) int {
+	pe := len(data)
+	number := false
+	for pe > p && (digits[data[pe-1]] || signBytes[data[pe-1]] || expBytes[data[pe-1]] || data[pe-1] == '.') {
+		pe--
+		number = number || !expBytes[data[pe]]
+	}
+	if !number {
+		return len(data)
+	}
+	return pe
+}
+
+// numberLiteral holds the positions of the parts of a json number found by scanNumber.
+type numberLiteral struct {
+	intStart, intEnd   int // integer digits
+	fracStart, fracEnd int // fraction digits; fracStart == fracEnd when there is no fraction
+	expStart, expEnd   int // exponent sign and digits; expStart == expEnd when there is no exponent
+}
+
+func (n *numberLiteral) neg() bool {
+	return n.intStart == 1
+}
+
+func (n *numberLiteral) hasFrac() bool {
+	return n.fracEnd > n.fracStart
+}
+
+func (n *numberLiteral) hasExp() bool {
+	return n.expEnd > n.expStart
+}
+
 var signBytes = [256]bool{
 	'-': true,
 	'+': true,
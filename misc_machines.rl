@@ -38,7 +38,7 @@ func unescapeStringContent(data []byte, dst []byte) ([]byte, int, error) {
        }
      }
  )*) @err{
-   return nil, p, errInvalidString
+   return nil, p, ErrInvalidString
  };
  write data; write init; write exec;
  }%%
@@ -85,10 +85,112 @@ func appendRemainderOfString(data []byte, dst []byte) ([]byte, int, error) {
      }
  )*
  double_quote) @err{
-   return nil, p, errInvalidString
+   return nil, p, ErrInvalidString
  };
  write data; write init; write exec;
  }%%
 
    return dst, p,nil
 }
+
+// appendStringStrict appends the unescaped content of a string that must be valid utf-8 to dst. data starts after the
+// opening quote. It returns ErrInvalidUTF8 with p at the start of the character or escape that isn't valid.
+func appendStringStrict(data []byte, dst []byte) ([]byte, int, error) {
+  cs, p := 0, 0
+  pe := len(data)
+  eof := len(data)
+  var segStart, charStart int
+  dst = growBytesSliceCapacity(dst, len(dst) + len(data))
+
+ %%{
+ machine appendStringStrict;
+
+ include common "common.rl";
+
+ action start_char { charStart = p }
+
+ action invalid_lead {
+   if p < pe && data[p] >= 0x80 {
+     return dst, p, ErrInvalidUTF8
+   }
+ }
+
+ action invalid_utf8 {
+   if p < pe {
+     return dst, charStart, ErrInvalidUTF8
+   }
+ }
+
+ action invalid_escape {
+   if p < pe && surrogateError(data, charStart, p) {
+     return dst, charStart, ErrInvalidUTF8
+   }
+ }
+
+ main :=
+ (
+ (
+ (
+   (
+     utf8_ascii_char
+     | utf8_char >start_char <>err(invalid_utf8)
+   ) >{segStart = p} %{dst = append(dst, data[segStart:p] ...)}
+
+   | (
+     '\\' (
+       '"' @{dst = append(dst, '"')}
+       | '\\' @{dst = append(dst, '\\')}
+       | '/' @{dst = append(dst, '/')}
+       | 'b' @{dst = append(dst, '\b')}
+       | 'f' @{dst = append(dst, '\f')}
+       | 'n' @{dst = append(dst, '\n')}
+       | 'r' @{dst = append(dst, '\r')}
+       | 't' @{dst = append(dst, '\t')}
+       | utf8_escaped_unicode @{dst, _, _ = unescapeUnicodeChar(data[charStart:], dst)}
+     )
+   ) >start_char <>err(invalid_escape)
+ ) >err(invalid_lead) )*
+ double_quote) @err{
+   return dst, p, ErrInvalidString
+ };
+ write data; write init; write exec;
+ }%%
+
+   return dst, p, nil
+}
+
+// utf8CharLen returns the length of the valid utf-8 character at the start of data. It returns 0 when data doesn't
+// start with one.
+func utf8CharLen(data []byte) int {
+  cs, p := 0, 0
+  pe := len(data)
+
+ %%{
+ machine utf8CharLen;
+
+ include common "common.rl";
+
+ main := utf8_char @{ return p + 1 };
+ write data; write init; write exec;
+ }%%
+
+   return 0
+}
+
+// unicodeEscapeLen returns the length of the \u escape at the start of data. A high surrogate's length includes the
+// low surrogate escape that must follow it. It returns 0 when the escape is a surrogate that isn't part of a pair.
+func unicodeEscapeLen(data []byte) int {
+  cs, p := 0, 0
+  pe := len(data)
+
+ %%{
+ machine unicodeEscapeLen;
+
+ include common "common.rl";
+
+ main := '\\' utf8_escaped_unicode @{ return p + 1 };
+ write data; write init; write exec;
+ }%%
+
+   return 0
+}
@@ -77,7 +77,7 @@ func unescapeStringContent(data []byte, dst []byte) ([]byte, int, error) {
 		goto tr15
 	tr0:
 
-		return nil, p, errInvalidString
+		return nil, p, ErrInvalidString
 
 		goto st0
 	st_case_0:
@@ -460,7 +460,7 @@ func unescapeStringContent(data []byte, dst []byte) ([]byte, int, error) {
 				dst = append(dst, data[segStart:p]...)
 			case 1, 2, 3, 4, 5:
 
-				return nil, p, errInvalidString
+				return nil, p, ErrInvalidString
 
 			}
 		}
@@ -548,7 +548,7 @@ func appendRemainderOfString(data []byte, dst []byte) ([]byte, int, error) {
 		goto tr1
 	tr0:
 
-		return nil, p, errInvalidString
+		return nil, p, ErrInvalidString
 
 		goto st0
 	st_case_0:
@@ -918,7 +918,7 @@ func appendRemainderOfString(data []byte, dst []byte) ([]byte, int, error) {
 			switch cs {
 			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16:
 
-				return nil, p, errInvalidString
+				return nil, p, ErrInvalidString
 			}
 		}
 
@@ -929,3 +929,2453 @@ func appendRemainderOfString(data []byte, dst []byte) ([]byte, int, error) {
 
 	return dst, p, nil
 }
+
+// appendStringStrict appends the unescaped content of a string that must be valid utf-8 to dst. data starts after the
+// opening quote. It returns ErrInvalidUTF8 with p at the start of the character or escape that isn't valid.
+func appendStringStrict(data []byte, dst []byte) ([]byte, int, error) {
+	cs, p := 0, 0
+	pe := len(data)
+	eof := len(data)
+	var segStart, charStart int
+	dst = growBytesSliceCapacity(dst, len(dst)+len(data))
+
+	const appendStringStrict_start int = 1
+	const appendStringStrict_first_final int = 51
+	const appendStringStrict_error int = 0
+
+	const appendStringStrict_en_main int = 1
+
+	{
+		cs = appendStringStrict_start
+	}
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 51:
+			goto st_case_51
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 13:
+			goto st_case_13
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 19:
+			goto st_case_19
+		case 20:
+			goto st_case_20
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 28:
+			goto st_case_28
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 34:
+			goto st_case_34
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		}
+		goto st_out
+	st_case_1:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr0:
+
+		if p < pe && data[p] >= 0x80 {
+			return dst, p, ErrInvalidUTF8
+		}
+
+		return dst, p, ErrInvalidString
+
+		goto st0
+	tr23:
+
+		if p < pe && surrogateError(data, charStart, p) {
+			return dst, charStart, ErrInvalidUTF8
+		}
+
+		return dst, p, ErrInvalidString
+
+		goto st0
+	tr33:
+
+		if p < pe {
+			return dst, charStart, ErrInvalidUTF8
+		}
+
+		return dst, p, ErrInvalidString
+
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	tr1:
+		segStart = p
+		goto st2
+	tr12:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		goto st2
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr13:
+		dst = append(dst, data[segStart:p]...)
+		goto st51
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		goto st0
+	tr3:
+		charStart = p
+		goto st3
+	tr14:
+		dst = append(dst, data[segStart:p]...)
+		charStart = p
+		goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 34:
+			goto tr24
+		case 47:
+			goto tr25
+		case 92:
+			goto tr26
+		case 98:
+			goto tr27
+		case 102:
+			goto tr28
+		case 110:
+			goto tr29
+		case 114:
+			goto tr30
+		case 116:
+			goto tr31
+		case 117:
+			goto st36
+		}
+		goto tr23
+	tr24:
+		dst = append(dst, '"')
+		goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr4:
+		segStart = p
+		charStart = p
+		goto st5
+	tr15:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st6
+		}
+		goto tr33
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr5:
+		segStart = p
+		charStart = p
+		goto st7
+	tr16:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if 160 <= data[p] && data[p] <= 191 {
+			goto st8
+		}
+		goto tr33
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st9
+		}
+		goto tr33
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr6:
+		segStart = p
+		charStart = p
+		goto st10
+	tr17:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st11
+		}
+		goto tr33
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st12
+		}
+		goto tr33
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr7:
+		segStart = p
+		charStart = p
+		goto st13
+	tr18:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if 128 <= data[p] && data[p] <= 159 {
+			goto st14
+		}
+		goto tr33
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st15
+		}
+		goto tr33
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr8:
+		segStart = p
+		charStart = p
+		goto st16
+	tr19:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st11
+		}
+		goto tr33
+	tr9:
+		segStart = p
+		charStart = p
+		goto st17
+	tr20:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if 144 <= data[p] && data[p] <= 191 {
+			goto st18
+		}
+		goto tr33
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st19
+		}
+		goto tr33
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st20
+		}
+		goto tr33
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr10:
+		segStart = p
+		charStart = p
+		goto st21
+	tr21:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st22
+		}
+		goto tr33
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st23
+		}
+		goto tr33
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st24
+		}
+		goto tr33
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr11:
+		segStart = p
+		charStart = p
+		goto st25
+	tr22:
+		dst = append(dst, data[segStart:p]...)
+		segStart = p
+		charStart = p
+		goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if 128 <= data[p] && data[p] <= 143 {
+			goto st26
+		}
+		goto tr33
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st27
+		}
+		goto tr33
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st28
+		}
+		goto tr33
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 34:
+			goto tr13
+		case 92:
+			goto tr14
+		case 224:
+			goto tr16
+		case 237:
+			goto tr18
+		case 240:
+			goto tr20
+		case 244:
+			goto tr22
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr15
+				}
+			case data[p] >= 32:
+				goto tr12
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr21
+				}
+			case data[p] >= 238:
+				goto tr19
+			}
+		default:
+			goto tr17
+		}
+		goto tr0
+	tr25:
+		dst = append(dst, '/')
+		goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr26:
+		dst = append(dst, '\\')
+		goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr27:
+		dst = append(dst, '\b')
+		goto st31
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr28:
+		dst = append(dst, '\f')
+		goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr29:
+		dst = append(dst, '\n')
+		goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr30:
+		dst = append(dst, '\r')
+		goto st34
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	tr31:
+		dst = append(dst, '\t')
+		goto st35
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch data[p] {
+		case 68:
+			goto st41
+		case 100:
+			goto st41
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st37
+			}
+		default:
+			goto st37
+		}
+		goto tr23
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto tr23
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st39
+			}
+		default:
+			goto st39
+		}
+		goto tr23
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr54
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr54
+			}
+		default:
+			goto tr54
+		}
+		goto tr23
+	tr54:
+		dst, _, _ = unescapeUnicodeChar(data[charStart:], dst)
+		goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		switch {
+		case data[p] < 56:
+			if 48 <= data[p] && data[p] <= 55 {
+				goto st38
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 66:
+				if 97 <= data[p] && data[p] <= 98 {
+					goto st42
+				}
+			case data[p] >= 65:
+				goto st42
+			}
+		default:
+			goto st42
+		}
+		goto tr23
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st43
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st43
+			}
+		default:
+			goto st43
+		}
+		goto tr23
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st44
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st44
+			}
+		default:
+			goto st44
+		}
+		goto tr23
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		if data[p] == 92 {
+			goto st45
+		}
+		goto tr23
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if data[p] == 117 {
+			goto st46
+		}
+		goto tr23
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 68:
+			goto st47
+		case 100:
+			goto st47
+		}
+		goto tr23
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch {
+		case data[p] > 70:
+			if 99 <= data[p] && data[p] <= 102 {
+				goto st48
+			}
+		case data[p] >= 67:
+			goto st48
+		}
+		goto tr23
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st49
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st49
+			}
+		default:
+			goto st49
+		}
+		goto tr23
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr63
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr63
+			}
+		default:
+			goto tr63
+		}
+		goto tr23
+	tr63:
+		dst, _, _ = unescapeUnicodeChar(data[charStart:], dst)
+		goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 34:
+			goto st51
+		case 92:
+			goto tr3
+		case 224:
+			goto tr5
+		case 237:
+			goto tr7
+		case 240:
+			goto tr9
+		case 244:
+			goto tr11
+		}
+		switch {
+		case data[p] < 225:
+			switch {
+			case data[p] > 127:
+				if 194 <= data[p] && data[p] <= 223 {
+					goto tr4
+				}
+			case data[p] >= 32:
+				goto tr1
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto tr10
+				}
+			case data[p] >= 238:
+				goto tr8
+			}
+		default:
+			goto tr6
+		}
+		goto tr0
+	st_out:
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof51:
+		cs = 51
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+	_test_eof18:
+		cs = 18
+		goto _test_eof
+	_test_eof19:
+		cs = 19
+		goto _test_eof
+	_test_eof20:
+		cs = 20
+		goto _test_eof
+	_test_eof21:
+		cs = 21
+		goto _test_eof
+	_test_eof22:
+		cs = 22
+		goto _test_eof
+	_test_eof23:
+		cs = 23
+		goto _test_eof
+	_test_eof24:
+		cs = 24
+		goto _test_eof
+	_test_eof25:
+		cs = 25
+		goto _test_eof
+	_test_eof26:
+		cs = 26
+		goto _test_eof
+	_test_eof27:
+		cs = 27
+		goto _test_eof
+	_test_eof28:
+		cs = 28
+		goto _test_eof
+	_test_eof29:
+		cs = 29
+		goto _test_eof
+	_test_eof30:
+		cs = 30
+		goto _test_eof
+	_test_eof31:
+		cs = 31
+		goto _test_eof
+	_test_eof32:
+		cs = 32
+		goto _test_eof
+	_test_eof33:
+		cs = 33
+		goto _test_eof
+	_test_eof34:
+		cs = 34
+		goto _test_eof
+	_test_eof35:
+		cs = 35
+		goto _test_eof
+	_test_eof36:
+		cs = 36
+		goto _test_eof
+	_test_eof37:
+		cs = 37
+		goto _test_eof
+	_test_eof38:
+		cs = 38
+		goto _test_eof
+	_test_eof39:
+		cs = 39
+		goto _test_eof
+	_test_eof40:
+		cs = 40
+		goto _test_eof
+	_test_eof41:
+		cs = 41
+		goto _test_eof
+	_test_eof42:
+		cs = 42
+		goto _test_eof
+	_test_eof43:
+		cs = 43
+		goto _test_eof
+	_test_eof44:
+		cs = 44
+		goto _test_eof
+	_test_eof45:
+		cs = 45
+		goto _test_eof
+	_test_eof46:
+		cs = 46
+		goto _test_eof
+	_test_eof47:
+		cs = 47
+		goto _test_eof
+	_test_eof48:
+		cs = 48
+		goto _test_eof
+	_test_eof49:
+		cs = 49
+		goto _test_eof
+	_test_eof50:
+		cs = 50
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 1, 2, 4, 6, 9, 12, 15, 20, 24, 28, 29, 30, 31, 32, 33, 34, 35, 40, 50:
+
+				if p < pe && data[p] >= 0x80 {
+					return dst, p, ErrInvalidUTF8
+				}
+
+				return dst, p, ErrInvalidString
+
+			case 5, 7, 8, 10, 11, 13, 14, 16, 17, 18, 19, 21, 22, 23, 25, 26, 27:
+
+				if p < pe {
+					return dst, charStart, ErrInvalidUTF8
+				}
+
+				return dst, p, ErrInvalidString
+
+			case 3, 36, 37, 38, 39, 41, 42, 43, 44, 45, 46, 47, 48, 49:
+
+				if p < pe && surrogateError(data, charStart, p) {
+					return dst, charStart, ErrInvalidUTF8
+				}
+
+				return dst, p, ErrInvalidString
+
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	return dst, p, nil
+}
+
+// utf8CharLen returns the length of the valid utf-8 character at the start of data. It returns 0 when data doesn't
+// start with one.
+func utf8CharLen(data []byte) int {
+	cs, p := 0, 0
+	pe := len(data)
+
+	const utf8CharLen_start int = 1
+	const utf8CharLen_first_final int = 19
+	const utf8CharLen_error int = 0
+
+	const utf8CharLen_en_main int = 1
+
+	{
+		cs = utf8CharLen_start
+	}
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 19:
+			goto st_case_19
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 20:
+			goto st_case_20
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 21:
+			goto st_case_21
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 22:
+			goto st_case_22
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 23:
+			goto st_case_23
+		case 13:
+			goto st_case_13
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 24:
+			goto st_case_24
+		case 16:
+			goto st_case_16
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 25:
+			goto st_case_25
+		}
+		goto st_out
+	st_case_1:
+		switch data[p] {
+		case 224:
+			goto st3
+		case 237:
+			goto st7
+		case 240:
+			goto st10
+		case 244:
+			goto st16
+		}
+		switch {
+		case data[p] < 225:
+			if 194 <= data[p] && data[p] <= 223 {
+				goto st2
+			}
+		case data[p] > 236:
+			switch {
+			case data[p] > 239:
+				if 241 <= data[p] && data[p] <= 243 {
+					goto st13
+				}
+			case data[p] >= 238:
+				goto st9
+			}
+		default:
+			goto st5
+		}
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr9
+		}
+		goto st0
+	tr9:
+		return p + 1
+		goto st19
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if 160 <= data[p] && data[p] <= 191 {
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr11
+		}
+		goto st0
+	tr11:
+		return p + 1
+		goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr13
+		}
+		goto st0
+	tr13:
+		return p + 1
+		goto st21
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		if 128 <= data[p] && data[p] <= 159 {
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr15
+		}
+		goto st0
+	tr15:
+		return p + 1
+		goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st6
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if 144 <= data[p] && data[p] <= 191 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr18
+		}
+		goto st0
+	tr18:
+		return p + 1
+		goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr21
+		}
+		goto st0
+	tr21:
+		return p + 1
+		goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		goto st0
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if 128 <= data[p] && data[p] <= 143 {
+			goto st17
+		}
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto st18
+		}
+		goto st0
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if 128 <= data[p] && data[p] <= 191 {
+			goto tr24
+		}
+		goto st0
+	tr24:
+		return p + 1
+		goto st25
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		goto st0
+	st_out:
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof19:
+		cs = 19
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof20:
+		cs = 20
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof21:
+		cs = 21
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof22:
+		cs = 22
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof23:
+		cs = 23
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof24:
+		cs = 24
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+	_test_eof18:
+		cs = 18
+		goto _test_eof
+	_test_eof25:
+		cs = 25
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+	_out:
+		{
+		}
+	}
+
+	return 0
+}
+
+// unicodeEscapeLen returns the length of the \u escape at the start of data. A high surrogate's length includes the
+// low surrogate escape that must follow it. It returns 0 when the escape is a surrogate that isn't part of a pair.
+func unicodeEscapeLen(data []byte) int {
+	cs, p := 0, 0
+	pe := len(data)
+
+	const unicodeEscapeLen_start int = 1
+	const unicodeEscapeLen_first_final int = 16
+	const unicodeEscapeLen_error int = 0
+
+	const unicodeEscapeLen_en_main int = 1
+
+	{
+		cs = unicodeEscapeLen_start
+	}
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 16:
+			goto st_case_16
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 13:
+			goto st_case_13
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 17:
+			goto st_case_17
+		}
+		goto st_out
+	st_case_1:
+		if data[p] == 92 {
+			goto st2
+		}
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 117 {
+			goto st3
+		}
+		goto st0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 68:
+			goto st7
+		case 100:
+			goto st7
+		}
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st4
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st4
+			}
+		default:
+			goto st4
+		}
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st5
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st5
+			}
+		default:
+			goto st5
+		}
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st6
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st6
+			}
+		default:
+			goto st6
+		}
+		goto st0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr7
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr7
+			}
+		default:
+			goto tr7
+		}
+		goto st0
+	tr7:
+		return p + 1
+		goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		goto st0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch {
+		case data[p] < 56:
+			if 48 <= data[p] && data[p] <= 55 {
+				goto st5
+			}
+		case data[p] > 57:
+			switch {
+			case data[p] > 66:
+				if 97 <= data[p] && data[p] <= 98 {
+					goto st8
+				}
+			case data[p] >= 65:
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto st0
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st9
+			}
+		default:
+			goto st9
+		}
+		goto st0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto st0
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if data[p] == 92 {
+			goto st11
+		}
+		goto st0
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		if data[p] == 117 {
+			goto st12
+		}
+		goto st0
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 68:
+			goto st13
+		case 100:
+			goto st13
+		}
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		switch {
+		case data[p] > 70:
+			if 99 <= data[p] && data[p] <= 102 {
+				goto st14
+			}
+		case data[p] >= 67:
+			goto st14
+		}
+		goto st0
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st15
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st15
+			}
+		default:
+			goto st15
+		}
+		goto st0
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr16
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr16
+			}
+		default:
+			goto tr16
+		}
+		goto st0
+	tr16:
+		return p + 1
+		goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		goto st0
+	st_out:
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+	_out:
+		{
+		}
+	}
+
+	return 0
+}
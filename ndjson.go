@@ -0,0 +1,114 @@
+package rjson
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// NDJSONReader iterates over the values in newline-delimited json (also known as JSON Lines). Each line must contain
+// exactly one json value. Lines that are empty or contain only whitespace are skipped.
+//
+// Use it like a bufio.Scanner:
+//
+//	r := rjson.NewNDJSONReader(data)
+//	for r.Next() {
+//		handleLine(r.Value())
+//	}
+//	if r.Err() != nil {
+//		return r.Err()
+//	}
+type NDJSONReader struct {
+	// OnError is called for each line that doesn't contain a single valid json value. line is the 1-based line number,
+	// offset is the position of the line in data and value is the line with surrounding whitespace removed. When
+	// OnError returns nil, the line is skipped and Next continues with the following line. When OnError returns an
+	// error, Next stops and Err returns that error. The Offset of a *SyntaxError in err is relative to data, not to the
+	// line.
+	//
+	// When OnError is nil, Next stops at the first invalid line.
+	OnError func(line, offset int, value []byte, err error) error
+
+	data   []byte
+	p      int
+	line   int
+	value  []byte
+	offset int
+	err    error
+	buffer Buffer
+}
+
+// NewNDJSONReader returns a new NDJSONReader that reads from data.
+func NewNDJSONReader(data []byte) *NDJSONReader {
+	return &NDJSONReader{
+		data: data,
+	}
+}
+
+// Next advances to the next value. It returns false when there are no more values or when it encounters an error.
+func (r *NDJSONReader) Next() bool {
+	for r.err == nil && r.p < len(r.data) {
+		lineStart := r.p
+		lineEnd := bytes.IndexByte(r.data[lineStart:], '\n')
+		if lineEnd == -1 {
+			lineEnd = len(r.data)
+		} else {
+			lineEnd += lineStart
+		}
+		r.p = lineEnd + 1
+		r.line++
+
+		start := lineStart + countWhitespace(r.data[lineStart:lineEnd])
+		if start == lineEnd {
+			continue
+		}
+		line := r.data[start:lineEnd]
+		p, err := SkipValue(line, &r.buffer)
+		if err == nil && p+countWhitespace(line[p:]) != len(line) {
+			err = ErrTrailingData
+		}
+		if err != nil {
+			// The line runs to lineEnd, so this moves the offset of a *SyntaxError from the line to data.
+			moveError(err, lineEnd)
+			line = line[:trimTrailingWhitespace(line)]
+			if r.OnError == nil {
+				r.err = fmt.Errorf("line %d: %w", r.line, err)
+				return false
+			}
+			r.err = r.OnError(r.line, start, line, err)
+			continue
+		}
+		r.value = line[:p]
+		r.offset = start
+		return true
+	}
+	r.value = nil
+	return false
+}
+
+// Value returns the current value. It is a subslice of data.
+func (r *NDJSONReader) Value() []byte {
+	return r.value
+}
+
+// Offset returns the position of the current value in data.
+func (r *NDJSONReader) Offset() int {
+	return r.offset
+}
+
+// Line returns the 1-based line number of the current value.
+func (r *NDJSONReader) Line() int {
+	return r.line
+}
+
+// Err returns the first error that stopped Next.
+func (r *NDJSONReader) Err() error {
+	return r.err
+}
+
+// trimTrailingWhitespace returns the length of data without trailing json whitespace.
+func trimTrailingWhitespace(data []byte) int {
+	i := len(data)
+	for i > 0 && whitespace[data[i-1]] {
+		i--
+	}
+	return i
+}
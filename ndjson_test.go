@@ -0,0 +1,59 @@
+package rjson
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONReader(t *testing.T) {
+	t.Parallel()
+	data := []byte("{\"a\":1}\n\n  [1, 2] \r\n\"foo\"\nnot json\n{\"b\": 2} 3\n12")
+
+	t.Run("stop on error", func(t *testing.T) {
+		t.Parallel()
+		r := NewNDJSONReader(data)
+		var got []string
+		for r.Next() {
+			got = append(got, fmt.Sprintf("%d:%d:%s", r.Line(), r.Offset(), r.Value()))
+		}
+		require.Equal(t, []string{`1:0:{"a":1}`, `3:11:[1, 2]`, `4:20:"foo"`}, got)
+		require.EqualError(t, r.Err(), "line 5: no valid json token found at offset 27: expected null but found 'o'")
+		require.False(t, r.Next())
+	})
+
+	t.Run("OnError", func(t *testing.T) {
+		t.Parallel()
+		r := NewNDJSONReader(data)
+		var errLines []string
+		r.OnError = func(line, offset int, value []byte, err error) error {
+			errLines = append(errLines, fmt.Sprintf("%d:%d:%s:%v", line, offset, value, err))
+			return nil
+		}
+		var got []string
+		for r.Next() {
+			got = append(got, string(r.Value()))
+		}
+		require.NoError(t, r.Err())
+		require.Equal(t, []string{`{"a":1}`, `[1, 2]`, `"foo"`, `12`}, got)
+		require.Equal(t, []string{
+			"5:26:not json:no valid json token found at offset 27: expected null but found 'o'",
+			`6:35:{"b": 2} 3:unexpected data after json value`,
+		}, errLines)
+	})
+
+	t.Run("OnError returns error", func(t *testing.T) {
+		t.Parallel()
+		r := NewNDJSONReader(data)
+		r.OnError = func(line, offset int, value []byte, err error) error {
+			return fmt.Errorf("bad line %d", line)
+		}
+		count := 0
+		for r.Next() {
+			count++
+		}
+		require.Equal(t, 3, count)
+		require.EqualError(t, r.Err(), "bad line 5")
+	})
+}
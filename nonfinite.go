@@ -0,0 +1,57 @@
+package rjson
+
+import (
+	"bytes"
+	"math"
+)
+
+// nonFiniteLiteral reads NaN, Infinity or -Infinity at the start of data. n is 0 when data doesn't start with one.
+func nonFiniteLiteral(data []byte) (val float64, n int) {
+	switch {
+	case bytes.HasPrefix(data, []byte("NaN")):
+		return math.NaN(), 3
+	case bytes.HasPrefix(data, []byte("Infinity")):
+		return math.Inf(1), 8
+	case bytes.HasPrefix(data, []byte("-Infinity")):
+		return math.Inf(-1), 9
+	default:
+		return 0, 0
+	}
+}
+
+// ReadFloat64NonFinite is like ReadFloat64 but it also accepts NaN, Infinity and -Infinity the way Python's json
+// module writes them.
+func ReadFloat64NonFinite(data []byte) (val float64, p int, err error) {
+	p = countWhitespace(data)
+	val, n := nonFiniteLiteral(data[p:])
+	if n > 0 {
+		return val, p + n, nil
+	}
+	return ReadFloat64(data)
+}
+
+// DecodeFloat64NonFinite is like DecodeFloat64 but it also accepts NaN, Infinity and -Infinity. If data begins with
+// null, v is untouched.
+func DecodeFloat64NonFinite(data []byte, v *float64) (p int, err error) {
+	var val float64
+	val, p, err = ReadFloat64NonFinite(data)
+	if err != nil {
+		return nullOrBust(data, err)
+	}
+	*v = val
+	return p, err
+}
+
+// readNumberNonFinite is ReadNumberBytes as a Number that also accepts NaN, Infinity and -Infinity. Number.Float64
+// understands all three.
+func readNumberNonFinite(data []byte) (val Number, p int, err error) {
+	p = countWhitespace(data)
+	if _, n := nonFiniteLiteral(data[p:]); n > 0 {
+		return Number(data[p : p+n]), p + n, nil
+	}
+	raw, p, err := ReadNumberBytes(data)
+	if err != nil {
+		return "", p, err
+	}
+	return Number(raw), p, nil
+}
@@ -0,0 +1,164 @@
+package rjson
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadFloat64NonFinite(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data string
+		want float64
+		p    int
+		err  bool
+	}{
+		{data: `NaN`, want: math.NaN(), p: 3},
+		{data: ` Infinity,`, want: math.Inf(1), p: 9},
+		{data: `-Infinity]`, want: math.Inf(-1), p: 9},
+		{data: `1.5e2`, want: 150, p: 5},
+		{data: `nan`, err: true},
+		{data: `-Inf`, err: true},
+	} {
+		got, p, err := ReadFloat64NonFinite([]byte(td.data))
+		require.Equal(t, td.p, p, td.data)
+		if td.err {
+			require.Error(t, err, td.data)
+			continue
+		}
+		require.NoError(t, err, td.data)
+		if math.IsNaN(td.want) {
+			require.True(t, math.IsNaN(got), td.data)
+		} else {
+			require.Equal(t, td.want, got, td.data)
+		}
+		_, _, err = ReadFloat64([]byte(td.data))
+		require.Equal(t, td.want == 150, err == nil, td.data)
+	}
+
+	v := 1.0
+	p, err := DecodeFloat64NonFinite([]byte(`null`), &v)
+	require.NoError(t, err)
+	require.Equal(t, 4, p)
+	require.Equal(t, 1.0, v)
+	p, err = DecodeFloat64NonFinite([]byte(`-Infinity`), &v)
+	require.NoError(t, err)
+	require.Equal(t, 9, p)
+	require.Equal(t, math.Inf(-1), v)
+}
+
+func TestBuffer_AllowNonFinite(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data  string
+		valid bool
+	}{
+		{data: `[NaN, Infinity, -Infinity]`, valid: true},
+		{data: `{"a":NaN,"b":{"c":-Infinity}}`, valid: true},
+		{data: `NaN`, valid: true},
+		{data: `["NaN", "x\"NaN"]`, valid: true},
+		{data: `[1NaN]`},
+		{data: `[NaNx]`},
+		{data: `[-NaN]`},
+		{data: `[+Infinity]`},
+		{data: `[Infinit]`},
+		{data: `{NaN: 1}`},
+	} {
+		data := []byte(td.data)
+		buf := &Buffer{AllowNonFinite: true}
+		p, err := SkipValue(data, buf)
+		require.Equal(t, td.valid, err == nil, td.data)
+		require.Equal(t, td.valid, Valid(data, buf), td.data)
+		require.False(t, Valid(data, nil) && !td.valid, td.data)
+		if td.valid {
+			require.Equal(t, len(data), p, td.data)
+			p, err = SkipValueFast(data, buf)
+			require.NoError(t, err, td.data)
+			require.Equal(t, len(data), p, td.data)
+			require.Equal(t, td.data, string(data))
+		}
+	}
+	require.False(t, Valid([]byte(`[NaN]`), nil))
+
+	buf := &Buffer{AllowNonFinite: true}
+	tp, p, err := buf.NextTokenType([]byte(` Infinity`))
+	require.NoError(t, err)
+	require.Equal(t, NumberType, tp)
+	require.Equal(t, 2, p)
+	token, p, err := buf.NextToken([]byte(`NaN`))
+	require.NoError(t, err)
+	require.Equal(t, byte('N'), token)
+	require.Equal(t, 1, p)
+	_, _, err = buf.NextToken([]byte(`Nope`))
+	require.Equal(t, ErrNoValidToken, err)
+
+	var got []float64
+	data := []byte(`[Infinity, -Infinity, 1]`)
+	p, err = HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+		f, p, err := ReadFloat64NonFinite(data)
+		got = append(got, f)
+		return p, err
+	}), buf)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, []float64{math.Inf(1), math.Inf(-1), 1}, got)
+	data = []byte(`{"a": NaN, "b": [NaN]}`)
+	p, err = HandleObjectValues(data, ObjectValueHandlerFunc(func(_, data []byte) (int, error) {
+		return SkipValue(data, buf)
+	}), buf)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+
+	// the checked machines only accept the literals with AllowNonFinite
+	for _, data := range []string{`[NaN]`, `[-Infinity]`, `{"a": Infinity}`} {
+		_, want := SkipValue([]byte(data), nil)
+		_, got := SkipValue([]byte(data), &Buffer{StrictUTF8: true})
+		require.Error(t, got, data)
+		require.Equal(t, want.Error(), got.Error(), data)
+	}
+}
+
+func TestValidIJSON_AllowNonFinite(t *testing.T) {
+	t.Parallel()
+	buf := Buffer{AllowNonFinite: true}
+	report := ValidIJSON([]byte(`{"a": Infinity}`), &buf)
+	require.True(t, report.Valid())
+	report = ValidIJSON([]byte(`[NaN, 1, -Infinity, 1e400]`), &buf)
+	require.NoError(t, report.Err)
+	require.Equal(t, []IJSONViolation{{Offset: 20, Err: ErrNumberPrecision}}, report.Violations)
+	report = ValidIJSON([]byte(`[NaN]`), nil)
+	require.Error(t, report.Err)
+}
+
+func TestValueReader_AllowNonFinite(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"a": [NaN, 1, -Infinity], "b": {"c": Infinity}, "d": "NaN"}`)
+	h := ValueReader{AllowNonFinite: true}
+	val, p, err := h.ReadValue(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	obj := val.(map[string]interface{})
+	arr := obj["a"].([]interface{})
+	require.True(t, math.IsNaN(arr[0].(float64)))
+	require.Equal(t, 1.0, arr[1])
+	require.Equal(t, math.Inf(-1), arr[2])
+	require.Equal(t, map[string]interface{}{"c": math.Inf(1)}, obj["b"])
+	require.Equal(t, "NaN", obj["d"])
+
+	h.UseNumber = true
+	arrVal, _, err := h.ReadArray([]byte(`[Infinity, 2]`))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{Number("Infinity"), Number("2")}, arrVal)
+	f, err := arrVal[0].(Number).Float64()
+	require.NoError(t, err)
+	require.Equal(t, math.Inf(1), f)
+
+	val, _, err = h.ReadValue([]byte(`-Infinity`))
+	require.NoError(t, err)
+	require.Equal(t, Number("-Infinity"), val)
+
+	_, _, err = ReadValue([]byte(`[NaN]`))
+	require.Error(t, err)
+}
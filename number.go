@@ -0,0 +1,221 @@
+package rjson
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/willabides/rjson/internal/fp"
+)
+
+// Number is the literal text of a json number. ValueReader returns numbers as Number instead of float64 when UseNumber
+// is set, so large integers like 64-bit IDs don't lose precision. It works like json.Number.
+type Number string
+
+// String returns the literal text of the number.
+func (n Number) String() string {
+	return string(n)
+}
+
+// Float64 returns the number as a float64.
+func (n Number) Float64() (float64, error) {
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// Int64 returns the number as an int64. It is an error for the number to have a fraction or exponent.
+func (n Number) Int64() (int64, error) {
+	return strconv.ParseInt(string(n), 10, 64)
+}
+
+// Uint64 returns the number as a uint64. It is an error for the number to have a fraction or exponent.
+func (n Number) Uint64() (uint64, error) {
+	return strconv.ParseUint(string(n), 10, 64)
+}
+
+// BigInt returns the number as a *big.Int. It is an error for the number to have a fraction or exponent.
+func (n Number) BigInt() (*big.Int, error) {
+	val, ok := new(big.Int).SetString(string(n), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", string(n))
+	}
+	return val, nil
+}
+
+// BigFloat returns the number as a *big.Float with enough precision to hold every digit of the number.
+func (n Number) BigFloat() (*big.Float, error) {
+	val, _, err := big.ParseFloat(string(n), 10, bigFloatPrec(len(n)), big.ToNearestEven)
+	if err != nil {
+		return nil, err
+	}
+	return val, nil
+}
+
+// NumberIsInteger returns true if raw is a json number with no fraction or exponent.
+func NumberIsInteger(raw []byte) bool {
+	n, ok := scanNumberLiteral(raw)
+	return ok && !n.hasFrac() && !n.hasExp()
+}
+
+// NumberHasExponent returns true if raw is a json number with an exponent.
+func NumberHasExponent(raw []byte) bool {
+	n, ok := scanNumberLiteral(raw)
+	return ok && n.hasExp()
+}
+
+// NumberFitsInt64 returns true if raw is a json number that ReadInt64 can read without error.
+func NumberFitsInt64(raw []byte) bool {
+	n, ok := scanNumberLiteral(raw)
+	if !ok || n.hasFrac() || n.hasExp() {
+		return false
+	}
+	limit := "9223372036854775807"
+	if n.neg() {
+		limit = "9223372036854775808"
+	}
+	return digitsLessOrEqual(raw[n.intStart:n.intEnd], limit)
+}
+
+// NumberFitsUint64 returns true if raw is a json number that ReadUint64 can read without error.
+func NumberFitsUint64(raw []byte) bool {
+	n, ok := scanNumberLiteral(raw)
+	if !ok || n.neg() || n.hasFrac() || n.hasExp() {
+		return false
+	}
+	return digitsLessOrEqual(raw[n.intStart:n.intEnd], "18446744073709551615")
+}
+
+// NumberFitsFloat64 returns true if raw is a json number that a float64 can hold without losing magnitude or
+// precision. That means converting it to a float64 and formatting the float64 with as few digits as possible gives the
+// same value as raw. It is false for numbers like 1e400 that overflow, 1e-400 that underflow and
+// 9007199254740993 or 3.14159265358979323846 that have more precision than a float64.
+func NumberFitsFloat64(raw []byte) bool {
+	n, ok := scanNumberLiteral(raw)
+	if !ok {
+		return false
+	}
+	f, _, err := fp.ParseJSONFloatPrefix(raw)
+	if err != nil {
+		return false
+	}
+	var buf [32]byte
+	short := strconv.AppendFloat(buf[:0], f, 'e', -1, 64)
+	shortN, _ := scanNumberLiteral(short)
+	var digits, shortDigits [17]byte
+	count, exp, ok := significand(raw, &n, &digits)
+	if !ok {
+		return false
+	}
+	shortCount, shortExp, _ := significand(short, &shortN, &shortDigits)
+	return count == shortCount && digits == shortDigits && (count == 0 || exp == shortExp)
+}
+
+// significand writes the significant digits of the number n in raw to sig without leading or trailing zeros and
+// returns how many there are along with exp for a value of 0.sig * 10^exp. ok is false when there are more than
+// len(sig) significant digits.
+func significand(raw []byte, n *numberLiteral, sig *[17]byte) (count, exp int, ok bool) {
+	intLen := n.intEnd - n.intStart
+	first, zeros := -1, 0
+	for i := 0; i < intLen+n.fracEnd-n.fracStart; i++ {
+		c := raw[n.intStart+i]
+		if i >= intLen {
+			c = raw[n.fracStart+i-intLen]
+		}
+		switch {
+		case c == '0' && first == -1:
+		case c == '0':
+			zeros++
+		default:
+			if first == -1 {
+				first = i
+			}
+			if count+zeros >= len(sig) {
+				return 0, 0, false
+			}
+			for ; zeros > 0; zeros-- {
+				sig[count] = '0'
+				count++
+			}
+			sig[count] = c
+			count++
+		}
+	}
+	if first == -1 {
+		return 0, 0, true
+	}
+	exp = intLen - first
+	if n.hasExp() {
+		// anything this big is out of range anyway
+		e := 0
+		for _, c := range raw[n.expStart:n.expEnd] {
+			if digits[c] && e < 100_000 {
+				e = e*10 + int(c-'0')
+			}
+		}
+		if raw[n.expStart] == '-' {
+			e = -e
+		}
+		exp += e
+	}
+	return count, exp, true
+}
+
+// scanNumberLiteral is like scanNumber but only succeeds when raw is exactly one json number.
+func scanNumberLiteral(raw []byte) (n numberLiteral, ok bool) {
+	n, p, err := scanNumber(raw)
+	return n, err == nil && p == len(raw)
+}
+
+// digitsLessOrEqual returns true if the integer with the decimal digits intDigits is no greater than limit. Neither
+// may have leading zeros.
+func digitsLessOrEqual(intDigits []byte, limit string) bool {
+	if len(intDigits) != len(limit) {
+		return len(intDigits) < len(limit)
+	}
+	return string(intDigits) <= limit
+}
+
+// bigFloatPrec returns a big.Float precision that is enough for a number literal with size digits.
+func bigFloatPrec(size int) uint {
+	// each decimal digit needs a little less than 4 bits
+	prec := uint(size) * 4
+	if prec < 64 {
+		prec = 64
+	}
+	return prec
+}
+
+// Decimal is an exact decimal number with the value Mantissa * 10^Exponent. ReadDecimal keeps every digit of a json
+// number, so 12.50 is read as a Mantissa of 1250 and an Exponent of -2.
+type Decimal struct {
+	Mantissa *big.Int
+	Exponent int
+}
+
+// String returns d as a json number.
+func (d Decimal) String() string {
+	mantissa := "0"
+	if d.Mantissa != nil {
+		mantissa = d.Mantissa.String()
+	}
+	if d.Exponent == 0 {
+		return mantissa
+	}
+	return mantissa + "e" + strconv.Itoa(d.Exponent)
+}
+
+// Rat returns the value of d as a *big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	val := new(big.Rat)
+	if d.Mantissa != nil {
+		val.SetInt(d.Mantissa)
+	}
+	exp := int64(d.Exponent)
+	if exp < 0 {
+		exp = -exp
+	}
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil))
+	if d.Exponent < 0 {
+		return val.Quo(val, scale)
+	}
+	return val.Mul(val, scale)
+}
@@ -0,0 +1,206 @@
+package rjson
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumber(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+		n := Number("505874924095815681")
+		require.Equal(t, "505874924095815681", n.String())
+		i, err := n.Int64()
+		require.NoError(t, err)
+		require.Equal(t, int64(505874924095815681), i)
+		u, err := n.Uint64()
+		require.NoError(t, err)
+		require.Equal(t, uint64(505874924095815681), u)
+		f, err := n.Float64()
+		require.NoError(t, err)
+		require.Equal(t, 505874924095815681.0, f)
+		b, err := n.BigInt()
+		require.NoError(t, err)
+		require.Equal(t, "505874924095815681", b.String())
+	})
+
+	t.Run("big int", func(t *testing.T) {
+		t.Parallel()
+		n := Number("-123456789012345678901234567890")
+		_, err := n.Int64()
+		require.Error(t, err)
+		_, err = n.Uint64()
+		require.Error(t, err)
+		b, err := n.BigInt()
+		require.NoError(t, err)
+		require.Equal(t, "-123456789012345678901234567890", b.String())
+		bf, err := n.BigFloat()
+		require.NoError(t, err)
+		require.Equal(t, "-123456789012345678901234567890", bf.Text('f', 0))
+	})
+
+	t.Run("float", func(t *testing.T) {
+		t.Parallel()
+		n := Number("1.5e-3")
+		_, err := n.Int64()
+		require.Error(t, err)
+		_, err = n.BigInt()
+		require.EqualError(t, err, `invalid integer "1.5e-3"`)
+		f, err := n.Float64()
+		require.NoError(t, err)
+		require.Equal(t, 0.0015, f)
+		bf, err := n.BigFloat()
+		require.NoError(t, err)
+		want, _, err := big.ParseFloat("0.0015", 10, bf.Prec(), big.ToNearestEven)
+		require.NoError(t, err)
+		require.Zero(t, want.Cmp(bf))
+	})
+}
+
+func TestValueReader_UseNumber(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"id": 505874924095815681, "ids": [1.0, -2e3, {"x": 0}], "s": "1", "f": 1.5}`)
+	h := &ValueReader{UseNumber: true}
+	got, p, err := h.ReadValue(data)
+	require.NoError(t, err)
+	require.Equal(t, len(data), p)
+	require.Equal(t, map[string]interface{}{
+		"id":  Number("505874924095815681"),
+		"ids": []interface{}{Number("1.0"), Number("-2e3"), map[string]interface{}{"x": Number("0")}},
+		"s":   "1",
+		"f":   Number("1.5"),
+	}, got)
+
+	h.UseNumber = false
+	got, _, err = h.ReadValue(data)
+	require.NoError(t, err)
+	require.Equal(t, 505874924095815681.0, got.(map[string]interface{})["id"])
+}
+
+func TestReadBigNumbers_matchStdLib(t *testing.T) {
+	t.Parallel()
+	inputs := []string{
+		`0`, `-0`, ` 12 `, `123456789012345678901234567890`, `-9999999999999999999`, `18446744073709551616`,
+		`12.50`, `-0.000123`, `1e3`, `1E+3`, `1.5e-10`, `-12345678901234567890.12345678901234567890e-30`,
+		`1e2147483647`, `1e2147483648`, `1e-2147483648`, `0.1e-2147483648`, `1e400`, `1e-400`,
+		`9223372036854775807`, `9223372036854775808`, `-9223372036854775808`, `-9223372036854775809`,
+		`18446744073709551615`, `01`, `1.`, `.5`, `-`, `1e`, `1e+`, `+1`, `1.5x`, `null`, `"1"`, ``,
+	}
+	for _, fn := range []func([]byte) (int, error){
+		fuzzReadBigInt, fuzzReadBigFloat, fuzzReadDecimal, fuzzReadNumberBytes,
+	} {
+		testFuzzerWithInput(t, fn, inputs...)
+	}
+}
+
+func TestReadDecimal(t *testing.T) {
+	t.Parallel()
+	val, p, err := ReadDecimal([]byte(` -12.50e3, 1`))
+	require.NoError(t, err)
+	require.Equal(t, 9, p)
+	require.Equal(t, "-1250", val.Mantissa.String())
+	require.Equal(t, 1, val.Exponent)
+	require.Equal(t, "-1250e1", val.String())
+	require.Equal(t, "-12500", val.Rat().RatString())
+
+	val, _, err = ReadDecimal([]byte(`0.0001`))
+	require.NoError(t, err)
+	require.Equal(t, "1e-4", val.String())
+	require.Equal(t, "1/10000", val.Rat().RatString())
+
+	_, _, err = ReadDecimal([]byte(`1e2147483648`))
+	require.EqualError(t, err, "exponent out of range")
+}
+
+func TestReadBigFloat(t *testing.T) {
+	t.Parallel()
+	val, p, err := ReadBigFloat([]byte(` -12.50e3, 1`))
+	require.NoError(t, err)
+	require.Equal(t, 9, p)
+	require.Equal(t, "-12500", val.Text('f', -1))
+
+	_, _, err = ReadBigFloat([]byte(`1e9999999999`))
+	require.Equal(t, ErrNumberRange, err)
+}
+
+func TestReadNumberBytes(t *testing.T) {
+	data := []byte(` -12.5e3, 1`)
+	raw, p, err := ReadNumberBytes(data)
+	require.NoError(t, err)
+	require.Equal(t, "-12.5e3", string(raw))
+	require.Equal(t, 8, p)
+	require.Same(t, &data[1], &raw[0])
+	require.False(t, NumberIsInteger(raw))
+	require.True(t, NumberHasExponent(raw))
+	require.False(t, NumberFitsInt64(raw))
+
+	raw, _, err = ReadNumberBytes([]byte(`-1`))
+	require.NoError(t, err)
+	require.True(t, NumberIsInteger(raw))
+	require.True(t, NumberFitsInt64(raw))
+	require.False(t, NumberFitsUint64(raw))
+
+	_, _, err = ReadNumberBytes([]byte(`1.`))
+	require.Error(t, err)
+	require.False(t, NumberIsInteger([]byte(`1 `)))
+
+	allocs := testing.AllocsPerRun(10, func() {
+		raw, _, _ = ReadNumberBytes(data) //nolint:errcheck // only counting
+		_ = NumberFitsUint64(raw)
+	})
+	require.Zero(t, allocs)
+}
+
+func TestNumberFitsFloat64(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		raw  string
+		want bool
+	}{
+		{raw: `0`, want: true},
+		{raw: `-0.000e10`, want: true},
+		{raw: `0.1`, want: true},
+		{raw: `123.4500`, want: true},
+		{raw: `1.2345e-3`, want: true},
+		{raw: `12345000e-10`, want: true},
+		{raw: `9007199254740992`, want: true},
+		{raw: `1.7976931348623157e308`, want: true},
+		{raw: `5e-324`, want: true},
+		{raw: `0.30000000000000004`, want: true},
+		{raw: `9007199254740993`},
+		{raw: `3.14159265358979323846`},
+		{raw: `1e400`},
+		{raw: `-1e400`},
+		{raw: `1e-400`},
+		{raw: `4e-324`},
+		{raw: `1e99999999999999999999`},
+		{raw: `1.`},
+		{raw: `1 `},
+	} {
+		require.Equal(t, td.want, NumberFitsFloat64([]byte(td.raw)), td.raw)
+		if _, ok := scanNumberLiteral([]byte(td.raw)); ok {
+			require.Equal(t, td.want, numberFitsFloat64Compat([]byte(td.raw)), td.raw)
+		}
+	}
+}
+
+func TestReadSizedInts(t *testing.T) {
+	t.Parallel()
+	_, p, err := ReadUint16([]byte(`65536 `))
+	require.Equal(t, ErrInvalidUInt, err)
+	require.Equal(t, 5, p)
+	_, _, err = ReadUint8([]byte(`256`))
+	require.Equal(t, ErrInvalidUInt, err)
+	_, _, err = ReadInt16([]byte(`-32769`))
+	require.Equal(t, ErrInvalidInt, err)
+	_, _, err = ReadInt8([]byte(`128`))
+	require.Equal(t, ErrInvalidInt, err)
+	i8, p, err := ReadInt8([]byte(`-128`))
+	require.NoError(t, err)
+	require.Equal(t, int8(-128), i8)
+	require.Equal(t, 4, p)
+}
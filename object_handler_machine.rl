@@ -1,8 +1,17 @@
 package rjson
 
-func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (int, []int ,error) {
+// handleObjectValues is HandleObjectValues without the Buffer options. depth is the number of objects and arrays that
+// data is nested in. It counts toward the depth limit for values that are skipped. expected is set like it is by
+// skipValue when the machine finds data invalid. It is empty when the error came from handler.
+//
+// state is nil unless StreamReader is running the machine on input that arrives in pieces. Then the machine starts
+// from state and saves its state there when it gets to the end of data.
+func handleObjectValues(
+  data []byte, handler ObjectValueHandler, stack []int, depth int, state *handlerState,
+) (int, []int, string, error) {
   var top, cs, p, pp int
   var err error
+  var expected string
   pe := len(data)
   eof := len(data)
   var currentFieldStart, currentFieldEnd int
@@ -13,6 +22,10 @@ machine handleObjectValues;
 include skipper "skip_machine.rl";
 
 prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
   if top + 1 >= len(stack) {
     stack = append(stack, make([]int, 1 + top - len(stack))...)
   }
@@ -21,15 +34,15 @@ prepush {
 action try_handler {
   pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
   if err != nil {
-    return p + pp, stack, err
+    return p + pp, stack, "", err
   }
   if pp < 0 {
-    err = errPOutOfRange
+    err = ErrPOutOfRange
     fbreak;
   }
   if pp != 0 {
     if p + pp - 1 >= pe {
-      err = errPOutOfRange
+      err = ErrPOutOfRange
       fbreak;
     }
     fexec p + pp - 1;
@@ -39,7 +52,7 @@ action try_handler {
 action try_handler_simple {
   _, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
   if err != nil {
-    return p, stack, err
+    return p, stack, "", err
   }
 }
 
@@ -47,30 +60,194 @@ skip_array := skip_array_def;
 skip_object := skip_object_def;
 
 handled_value =
- json_bool >(try_handler_simple)
- | json_null >(try_handler_simple)
- | json_number >(try_handler_simple)
- | json_string >(try_handler)
- | '[' >(try_handler) @{fcall skip_array;}
- | '{' >(try_handler) @{fcall skip_object;}
+ skip_json_literal >wait_for_value >(try_handler_simple)
+ | ( json_number <>err(expect_digit) ) >wait_for_value >(try_handler_simple)
+ | skip_json_string >wait_for_value >(try_handler)
+ | '[' >wait_for_value >(try_handler) @{fcall skip_array;}
+ | '{' >wait_for_value >(try_handler) @{fcall skip_object;}
 ;
 
-json_object_field = (json_string >{currentFieldStart = p} %{currentFieldEnd = p} );
+json_object_field = (skip_json_string >{currentFieldStart = p} %{currentFieldEnd = p} );
+
+json_object_member = json_space* $err(expect_colon) ':' json_space* handled_value >err(expect_value);
 
 main :=
   ( json_space* (
-  json_null |
+  json_null <>err(expect_null) |
   ('{'
-      (
-        json_space* json_object_field json_space* ':' json_space* handled_value
-        (json_space* ',' json_space* json_object_field json_space* ':' json_space* handled_value )*
-      )?
-  json_space* '}'))) @err{
-    return p, stack, errInvalidObject
+    json_space* (
+      '}'
+      | json_object_field json_object_member
+        (
+          json_space* $err(expect_comma_or_object_end) ','
+          json_space* json_object_field >err(expect_string) json_object_member
+        )*
+        json_space* $err(expect_comma_or_object_end) '}'
+    ) >err(expect_string_or_object_end)
+  )) >err(expect_object)) @err{
+    return p, stack, expected, ErrInvalidObject
+  };
+
+write data;
+}%%
+
+%%{
+write init;
+}%%
+  if state != nil {
+    if state.cs != 0 {
+      cs, top = state.cs, state.top
+    }
+    p, stack = state.p, state.stack
+    currentFieldStart, currentFieldEnd = state.fieldStart, state.fieldEnd
+    if !state.atEOF {
+      eof = -1
+    }
+  }
+
+%%{
+write exec;
+}%%
+
+  // The machine is in its error state without an error when it read the byte after the end of the value.
+  if state != nil {
+    state.save(cs, top, p, stack, cs >= handleObjectValues_first_final || cs == handleObjectValues_error)
+    state.fieldStart, state.fieldEnd = currentFieldStart, currentFieldEnd
+  }
+return p, stack, expected, err
+}
+
+// handleObjectValuesChecked is handleObjectValues with the checks for buffer's Limits, StrictUTF8 and
+// RejectDuplicateKeys. Strings and numbers are checked before they are passed to handler. It also reads JSONC when
+// buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func handleObjectValuesChecked(
+  data []byte, handler ObjectValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+  var top, cs, p, pp, tokenStart, count int
+  var err error
+  var expected string
+  pe := buffer.documentEnd(data)
+  eof := pe
+  var currentFieldStart, currentFieldEnd int
+  var object int
+  if buffer.RejectDuplicateKeys {
+    object = buffer.keys.newObject()
+  }
+
+%%{
+machine handleObjectValuesChecked;
+
+include skipper "skip_machine.rl";
+
+prepush {
+  if top + depth + 1 == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth + 1 >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+action check_depth {
+  if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+}
+
+action count_handled_key {
+  count++
+  if buffer.Limits.MaxObjectKeys > 0 && count > buffer.Limits.MaxObjectKeys {
+    return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+  }
+}
+
+action add_handled_key {
+  if buffer.RejectDuplicateKeys && !buffer.keys.add(data, object, tokenStart+1, p) {
+    return tokenStart, stack, "unique object key", ErrDuplicateKey
+  }
+}
+
+action try_handler {
+  pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+  if err != nil {
+    return p + pp, stack, "", err
+  }
+  if pp < 0 {
+    err = ErrPOutOfRange
+    fbreak;
+  }
+  if pp != 0 {
+    if p + pp - 1 >= pe {
+      if p + pp <= len(data) {
+        return pe - 1, stack, "", buffer.documentSizeError(data)
+      }
+      err = ErrPOutOfRange
+      fbreak;
+    }
+    fexec p + pp - 1;
+  }
+}
+
+action try_handler_simple {
+  _, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+  if err != nil {
+    return p, stack, "", err
+  }
+}
+
+# try_handler_checked runs the handler on a string or number after it has been checked.
+action try_handler_checked {
+  _, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+  if err != nil {
+    return tokenStart, stack, "", err
+  }
+}
+
+checked_array := checked_array_def;
+checked_object := checked_object_def;
+
+handled_value =
+ skip_json_literal >(try_handler_simple)
+ | ( json_number <>err(expect_digit) | non_finite_number ) >start_token %check_number %(try_handler_checked)
+ | checked_json_string @(try_handler_checked)
+ | '[' >(try_handler) @{fcall checked_array;}
+ | '{' >(try_handler) @{fcall checked_object;}
+;
+
+json_object_field = (
+  checked_json_string >{currentFieldStart = p} >count_handled_key @add_handled_key %{currentFieldEnd = p}
+);
+
+json_object_member = jsonc_space* $err(expect_colon) ':' jsonc_space* handled_value >err(expect_value);
+
+main :=
+  ( jsonc_space* (
+  json_null <>err(expect_null) |
+  ('{' @check_depth
+    jsonc_space* (
+      '}'
+      | json_object_field json_object_member
+        (
+          jsonc_space* $err(expect_comma_or_object_end) ','
+          jsonc_space* json_object_field >err(expect_string) json_object_member
+        )*
+        jsonc_space* $err(expect_comma_or_object_end) ( '}' | ',' jsonc_space* ( '}' when jsonc ) )
+    ) >err(expect_string_or_object_end)
+  )) >err(expect_object)) @err{
+    err = ErrInvalidObject
+    fhold; fbreak;
   };
 
 write data; write init;  write exec;
 }%%
 
-return p, stack, err
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
 }
@@ -2,9 +2,18 @@
 
 package rjson
 
-func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (int, []int, error) {
+// handleObjectValues is HandleObjectValues without the Buffer options. depth is the number of objects and arrays that
+// data is nested in. It counts toward the depth limit for values that are skipped. expected is set like it is by
+// skipValue when the machine finds data invalid. It is empty when the error came from handler.
+//
+// state is nil unless StreamReader is running the machine on input that arrives in pieces. Then the machine starts
+// from state and saves its state there when it gets to the end of data.
+func handleObjectValues(
+	data []byte, handler ObjectValueHandler, stack []int, depth int, state *handlerState,
+) (int, []int, string, error) {
 	var top, cs, p, pp int
 	var err error
+	var expected string
 	pe := len(data)
 	eof := len(data)
 	var currentFieldStart, currentFieldEnd int
@@ -22,7 +31,19 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		top = 0
 	}
 
+	if state != nil {
+		if state.cs != 0 {
+			cs, top = state.cs, state.top
+		}
+		p, stack = state.p, state.stack
+		currentFieldStart, currentFieldEnd = state.fieldStart, state.fieldEnd
+		if !state.atEOF {
+			eof = -1
+		}
+	}
+
 	{
+		var _ps int = 0
 		if p == pe {
 			goto _test_eof
 		}
@@ -252,6 +273,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st107
 		case 108:
 			goto st108
+		case 271:
+			goto st271
 		case 109:
 			goto st109
 		case 110:
@@ -274,8 +297,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st118
 		case 119:
 			goto st119
-		case 271:
-			goto st271
+		case 272:
+			goto st272
 		case 120:
 			goto st120
 		case 121:
@@ -360,6 +383,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st160
 		case 161:
 			goto st161
+		case 273:
+			goto st273
 		case 162:
 			goto st162
 		case 163:
@@ -434,8 +459,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st197
 		case 198:
 			goto st198
-		case 272:
-			goto st272
+		case 274:
+			goto st274
 		case 199:
 			goto st199
 		case 200:
@@ -576,6 +601,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st267
 		case 268:
 			goto st268
+		case 275:
+			goto st275
 		}
 
 		if p++; p == pe {
@@ -805,6 +832,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st_case_107
 		case 108:
 			goto st_case_108
+		case 271:
+			goto st_case_271
 		case 109:
 			goto st_case_109
 		case 110:
@@ -827,8 +856,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st_case_118
 		case 119:
 			goto st_case_119
-		case 271:
-			goto st_case_271
+		case 272:
+			goto st_case_272
 		case 120:
 			goto st_case_120
 		case 121:
@@ -913,6 +942,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st_case_160
 		case 161:
 			goto st_case_161
+		case 273:
+			goto st_case_273
 		case 162:
 			goto st_case_162
 		case 163:
@@ -987,8 +1018,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st_case_197
 		case 198:
 			goto st_case_198
-		case 272:
-			goto st_case_272
+		case 274:
+			goto st_case_274
 		case 199:
 			goto st_case_199
 		case 200:
@@ -1129,6 +1160,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st_case_267
 		case 268:
 			goto st_case_268
+		case 275:
+			goto st_case_275
 		}
 		goto st_out
 	st1:
@@ -1151,12 +1184,167 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		goto tr0
 	tr0:
+		expected = "object"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr4:
+		expected = "null"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr8:
+		expected = "string or '}'"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr12:
+		expected = "string character"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr16:
+		expected = "':'"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr21:
+		expected = "value"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr35:
+		expected = "',' or '}'"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr39:
+		expected = "string"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr62:
+		expected = "escape sequence"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr65:
+		expected = "hex digit"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr70:
+		expected = "digit"
+
+		return p, stack, expected, ErrInvalidObject
+
+		goto st0
+	tr81:
+		expected = "false"
+
+		return p, stack, expected, ErrInvalidObject
 
-		return p, stack, errInvalidObject
+		goto st0
+	tr89:
+		expected = "true"
+
+		return p, stack, expected, ErrInvalidObject
 
 		goto st0
-	tr118:
-		err = errInvalidArray
+	tr131:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr143:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr147:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr151:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr165:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr168:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr173:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr177:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr182:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
 		{
 			p++
 			cs = 0
@@ -1164,7 +1352,129 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		goto st0
 	tr186:
-		err = errInvalidObject
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr209:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr213:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr217:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr220:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr234:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr238:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr259:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr262:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr267:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr271:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr276:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr280:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
 		{
 			p++
 			cs = 0
@@ -1202,7 +1512,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st4
 		}
-		goto tr0
+		goto tr4
 	st4:
 		if p++; p == pe {
 			goto _test_eof4
@@ -1211,7 +1521,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st5
 		}
-		goto tr0
+		goto tr4
 	st5:
 		if p++; p == pe {
 			goto _test_eof5
@@ -1220,7 +1530,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st269
 		}
-		goto tr0
+		goto tr4
 	st269:
 		if p++; p == pe {
 			goto _test_eof269
@@ -1238,14 +1548,14 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 32:
 			goto st7
 		case 34:
-			goto tr8
+			goto tr10
 		case 125:
-			goto st270
+			goto st271
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st7
 		}
-		goto tr0
+		goto tr8
 	st7:
 		if p++; p == pe {
 			goto _test_eof7
@@ -1257,15 +1567,15 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 32:
 			goto st7
 		case 34:
-			goto tr8
+			goto tr10
 		case 125:
-			goto st270
+			goto st271
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st7
 		}
-		goto tr0
-	tr8:
+		goto tr8
+	tr10:
 		currentFieldStart = p
 		goto st8
 	st8:
@@ -1280,7 +1590,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st102
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st9
 	st9:
@@ -1295,7 +1605,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st102
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st9
 	st10:
@@ -1305,17 +1615,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	st_case_10:
 		switch data[p] {
 		case 13:
-			goto tr13
+			goto tr17
 		case 32:
-			goto tr13
+			goto tr17
 		case 58:
-			goto tr14
+			goto tr18
 		}
 		if 9 <= data[p] && data[p] <= 10 {
-			goto tr13
+			goto tr17
 		}
-		goto tr0
-	tr13:
+		goto tr16
+	tr17:
 		currentFieldEnd = p
 		goto st11
 	st11:
@@ -1334,8 +1644,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st11
 		}
-		goto tr0
-	tr14:
+		goto tr16
+	tr18:
 		currentFieldEnd = p
 		goto st12
 	st12:
@@ -1343,81 +1653,93 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto _test_eof12
 		}
 	st_case_12:
+		_ps = 12
 		switch data[p] {
 		case 13:
 			goto st13
 		case 32:
 			goto st13
 		case 34:
-			goto tr18
+			goto tr23
 		case 45:
-			goto tr19
+			goto tr24
 		case 48:
-			goto tr20
+			goto tr25
 		case 91:
-			goto tr22
+			goto tr27
 		case 102:
-			goto tr23
+			goto tr28
 		case 110:
-			goto tr24
+			goto tr29
 		case 116:
-			goto tr25
+			goto tr30
 		case 123:
-			goto tr26
+			goto tr31
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr21
+				goto tr26
 			}
 		case data[p] >= 9:
 			goto st13
 		}
-		goto tr0
+		goto tr21
 	st13:
 		if p++; p == pe {
 			goto _test_eof13
 		}
 	st_case_13:
+		_ps = 13
 		switch data[p] {
 		case 13:
 			goto st13
 		case 32:
 			goto st13
 		case 34:
-			goto tr18
+			goto tr23
 		case 45:
-			goto tr19
+			goto tr24
 		case 48:
-			goto tr20
+			goto tr25
 		case 91:
-			goto tr22
+			goto tr27
 		case 102:
-			goto tr23
+			goto tr28
 		case 110:
-			goto tr24
+			goto tr29
 		case 116:
-			goto tr25
+			goto tr30
 		case 123:
-			goto tr26
+			goto tr31
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr21
+				goto tr26
 			}
 		case data[p] >= 9:
 			goto st13
 		}
-		goto tr0
-	tr18:
+		goto tr21
+	tr23:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 14
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 14
@@ -1426,7 +1748,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 14
@@ -1450,7 +1772,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st69
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st15
 	st15:
@@ -1465,7 +1787,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st69
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st15
 	st16:
@@ -1486,7 +1808,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st17:
 		if p++; p == pe {
 			goto _test_eof17
@@ -1505,7 +1827,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st18:
 		if p++; p == pe {
 			goto _test_eof18
@@ -1517,12 +1839,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 32:
 			goto st19
 		case 34:
-			goto tr33
+			goto tr41
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st19
 		}
-		goto tr0
+		goto tr39
 	st19:
 		if p++; p == pe {
 			goto _test_eof19
@@ -1534,13 +1856,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 32:
 			goto st19
 		case 34:
-			goto tr33
+			goto tr41
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st19
 		}
-		goto tr0
-	tr33:
+		goto tr39
+	tr41:
 		currentFieldStart = p
 		goto st20
 	st20:
@@ -1555,7 +1877,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st21
 	st21:
@@ -1570,7 +1892,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st21
 	st22:
@@ -1580,17 +1902,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	st_case_22:
 		switch data[p] {
 		case 13:
-			goto tr37
+			goto tr45
 		case 32:
-			goto tr37
+			goto tr45
 		case 58:
-			goto tr38
+			goto tr46
 		}
 		if 9 <= data[p] && data[p] <= 10 {
-			goto tr37
+			goto tr45
 		}
-		goto tr0
-	tr37:
+		goto tr16
+	tr45:
 		currentFieldEnd = p
 		goto st23
 	st23:
@@ -1609,8 +1931,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st23
 		}
-		goto tr0
-	tr38:
+		goto tr16
+	tr46:
 		currentFieldEnd = p
 		goto st24
 	st24:
@@ -1618,81 +1940,93 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto _test_eof24
 		}
 	st_case_24:
+		_ps = 24
 		switch data[p] {
 		case 13:
 			goto st25
 		case 32:
 			goto st25
 		case 34:
-			goto tr42
+			goto tr50
 		case 45:
-			goto tr43
+			goto tr51
 		case 48:
-			goto tr44
+			goto tr52
 		case 91:
-			goto tr46
+			goto tr54
 		case 102:
-			goto tr47
+			goto tr55
 		case 110:
-			goto tr48
+			goto tr56
 		case 116:
-			goto tr49
+			goto tr57
 		case 123:
-			goto tr50
+			goto tr58
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr45
+				goto tr53
 			}
 		case data[p] >= 9:
 			goto st25
 		}
-		goto tr0
+		goto tr21
 	st25:
 		if p++; p == pe {
 			goto _test_eof25
 		}
 	st_case_25:
+		_ps = 25
 		switch data[p] {
 		case 13:
 			goto st25
 		case 32:
 			goto st25
 		case 34:
-			goto tr42
+			goto tr50
 		case 45:
-			goto tr43
+			goto tr51
 		case 48:
-			goto tr44
+			goto tr52
 		case 91:
-			goto tr46
+			goto tr54
 		case 102:
-			goto tr47
+			goto tr55
 		case 110:
-			goto tr48
+			goto tr56
 		case 116:
-			goto tr49
+			goto tr57
 		case 123:
-			goto tr50
+			goto tr58
 		}
 		switch {
 		case data[p] > 10:
 			if 49 <= data[p] && data[p] <= 57 {
-				goto tr45
+				goto tr53
 			}
 		case data[p] >= 9:
 			goto st25
 		}
-		goto tr0
-	tr42:
+		goto tr21
+	tr50:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 26
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 26
@@ -1701,7 +2035,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 26
@@ -1725,7 +2059,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st29
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st27
 	st27:
@@ -1740,7 +2074,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st29
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st27
 	st28:
@@ -1761,7 +2095,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st270:
 		if p++; p == pe {
 			goto _test_eof270
@@ -1793,7 +2127,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st31
 		}
-		goto tr0
+		goto tr62
 	st30:
 		if p++; p == pe {
 			goto _test_eof30
@@ -1806,7 +2140,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st29
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st27
 	st31:
@@ -1826,7 +2160,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st32
 		}
-		goto tr0
+		goto tr65
 	st32:
 		if p++; p == pe {
 			goto _test_eof32
@@ -1844,7 +2178,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st33
 		}
-		goto tr0
+		goto tr65
 	st33:
 		if p++; p == pe {
 			goto _test_eof33
@@ -1862,7 +2196,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st34
 		}
-		goto tr0
+		goto tr65
 	st34:
 		if p++; p == pe {
 			goto _test_eof34
@@ -1880,7 +2214,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st35
 		}
-		goto tr0
+		goto tr65
 	st35:
 		if p++; p == pe {
 			goto _test_eof35
@@ -1893,14 +2227,24 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st29
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st27
-	tr43:
+	tr51:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 36
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st36
@@ -1915,12 +2259,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st45
 		}
-		goto tr0
-	tr44:
+		goto tr70
+	tr52:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 37
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st37
@@ -1948,7 +2302,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st38:
 		if p++; p == pe {
 			goto _test_eof38
@@ -1957,7 +2311,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st39
 		}
-		goto tr0
+		goto tr70
 	st39:
 		if p++; p == pe {
 			goto _test_eof39
@@ -1985,7 +2339,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st40:
 		if p++; p == pe {
 			goto _test_eof40
@@ -2013,7 +2367,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st41:
 		if p++; p == pe {
 			goto _test_eof41
@@ -2028,7 +2382,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st43
 		}
-		goto tr0
+		goto tr70
 	st42:
 		if p++; p == pe {
 			goto _test_eof42
@@ -2037,7 +2391,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st43
 		}
-		goto tr0
+		goto tr70
 	st43:
 		if p++; p == pe {
 			goto _test_eof43
@@ -2061,7 +2415,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st44:
 		if p++; p == pe {
 			goto _test_eof44
@@ -2085,12 +2439,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
-	tr45:
+		goto tr35
+	tr53:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 45
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st45
@@ -2123,7 +2487,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st46:
 		if p++; p == pe {
 			goto _test_eof46
@@ -2153,15 +2517,25 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
-	tr46:
+		goto tr35
+	tr54:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 47
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 47
@@ -2170,7 +2544,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 47
@@ -2182,6 +2556,14 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -2210,12 +2592,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr47:
+		goto tr35
+	tr55:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 48
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st48
@@ -2227,7 +2619,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st49
 		}
-		goto tr0
+		goto tr81
 	st49:
 		if p++; p == pe {
 			goto _test_eof49
@@ -2236,7 +2628,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st50
 		}
-		goto tr0
+		goto tr81
 	st50:
 		if p++; p == pe {
 			goto _test_eof50
@@ -2245,7 +2637,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st51
 		}
-		goto tr0
+		goto tr81
 	st51:
 		if p++; p == pe {
 			goto _test_eof51
@@ -2254,7 +2646,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st52
 		}
-		goto tr0
+		goto tr81
 	st52:
 		if p++; p == pe {
 			goto _test_eof52
@@ -2273,12 +2665,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr48:
+		goto tr35
+	tr56:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 53
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st53
@@ -2290,7 +2692,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st54
 		}
-		goto tr0
+		goto tr4
 	st54:
 		if p++; p == pe {
 			goto _test_eof54
@@ -2299,7 +2701,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st55
 		}
-		goto tr0
+		goto tr4
 	st55:
 		if p++; p == pe {
 			goto _test_eof55
@@ -2308,7 +2710,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st56
 		}
-		goto tr0
+		goto tr4
 	st56:
 		if p++; p == pe {
 			goto _test_eof56
@@ -2327,12 +2729,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr49:
+		goto tr35
+	tr57:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 57
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st57
@@ -2344,7 +2756,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st58
 		}
-		goto tr0
+		goto tr89
 	st58:
 		if p++; p == pe {
 			goto _test_eof58
@@ -2353,7 +2765,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st59
 		}
-		goto tr0
+		goto tr89
 	st59:
 		if p++; p == pe {
 			goto _test_eof59
@@ -2362,7 +2774,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st60
 		}
-		goto tr0
+		goto tr89
 	st60:
 		if p++; p == pe {
 			goto _test_eof60
@@ -2381,15 +2793,25 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr50:
+		goto tr35
+	tr58:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 61
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 61
@@ -2398,7 +2820,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 61
@@ -2410,6 +2832,14 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -2438,7 +2868,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st62:
 		if p++; p == pe {
 			goto _test_eof62
@@ -2464,7 +2894,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st64
 		}
-		goto tr0
+		goto tr62
 	st63:
 		if p++; p == pe {
 			goto _test_eof63
@@ -2477,7 +2907,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st21
 	st64:
@@ -2497,7 +2927,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st65
 		}
-		goto tr0
+		goto tr65
 	st65:
 		if p++; p == pe {
 			goto _test_eof65
@@ -2515,7 +2945,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st66
 		}
-		goto tr0
+		goto tr65
 	st66:
 		if p++; p == pe {
 			goto _test_eof66
@@ -2533,7 +2963,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st67
 		}
-		goto tr0
+		goto tr65
 	st67:
 		if p++; p == pe {
 			goto _test_eof67
@@ -2551,7 +2981,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st68
 		}
-		goto tr0
+		goto tr65
 	st68:
 		if p++; p == pe {
 			goto _test_eof68
@@ -2564,7 +2994,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st21
 	st69:
@@ -2592,7 +3022,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st71
 		}
-		goto tr0
+		goto tr62
 	st70:
 		if p++; p == pe {
 			goto _test_eof70
@@ -2605,7 +3035,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st69
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st15
 	st71:
@@ -2625,7 +3055,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st72
 		}
-		goto tr0
+		goto tr65
 	st72:
 		if p++; p == pe {
 			goto _test_eof72
@@ -2643,7 +3073,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st73
 		}
-		goto tr0
+		goto tr65
 	st73:
 		if p++; p == pe {
 			goto _test_eof73
@@ -2661,7 +3091,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st74
 		}
-		goto tr0
+		goto tr65
 	st74:
 		if p++; p == pe {
 			goto _test_eof74
@@ -2679,7 +3109,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st75
 		}
-		goto tr0
+		goto tr65
 	st75:
 		if p++; p == pe {
 			goto _test_eof75
@@ -2692,14 +3122,24 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st69
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st15
-	tr19:
+	tr24:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 76
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st76
@@ -2714,12 +3154,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st85
 		}
-		goto tr0
-	tr20:
+		goto tr70
+	tr25:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 77
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st77
@@ -2747,7 +3197,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st78:
 		if p++; p == pe {
 			goto _test_eof78
@@ -2756,7 +3206,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st79
 		}
-		goto tr0
+		goto tr70
 	st79:
 		if p++; p == pe {
 			goto _test_eof79
@@ -2784,7 +3234,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st80:
 		if p++; p == pe {
 			goto _test_eof80
@@ -2812,7 +3262,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st81:
 		if p++; p == pe {
 			goto _test_eof81
@@ -2827,7 +3277,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st83
 		}
-		goto tr0
+		goto tr70
 	st82:
 		if p++; p == pe {
 			goto _test_eof82
@@ -2836,7 +3286,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st83
 		}
-		goto tr0
+		goto tr70
 	st83:
 		if p++; p == pe {
 			goto _test_eof83
@@ -2860,7 +3310,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st84:
 		if p++; p == pe {
 			goto _test_eof84
@@ -2884,12 +3334,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
-	tr21:
+		goto tr35
+	tr26:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 85
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st85
@@ -2922,7 +3382,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st86:
 		if p++; p == pe {
 			goto _test_eof86
@@ -2952,15 +3412,25 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st17
 		}
-		goto tr0
-	tr22:
+		goto tr35
+	tr27:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 87
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 87
@@ -2969,7 +3439,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 87
@@ -2981,6 +3451,14 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3009,12 +3487,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr23:
+		goto tr35
+	tr28:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 88
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st88
@@ -3026,7 +3514,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st89
 		}
-		goto tr0
+		goto tr81
 	st89:
 		if p++; p == pe {
 			goto _test_eof89
@@ -3035,7 +3523,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st90
 		}
-		goto tr0
+		goto tr81
 	st90:
 		if p++; p == pe {
 			goto _test_eof90
@@ -3044,7 +3532,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st91
 		}
-		goto tr0
+		goto tr81
 	st91:
 		if p++; p == pe {
 			goto _test_eof91
@@ -3053,7 +3541,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st92
 		}
-		goto tr0
+		goto tr81
 	st92:
 		if p++; p == pe {
 			goto _test_eof92
@@ -3072,12 +3560,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr24:
+		goto tr35
+	tr29:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 93
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st93
@@ -3089,7 +3587,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st94
 		}
-		goto tr0
+		goto tr4
 	st94:
 		if p++; p == pe {
 			goto _test_eof94
@@ -3098,7 +3596,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st95
 		}
-		goto tr0
+		goto tr4
 	st95:
 		if p++; p == pe {
 			goto _test_eof95
@@ -3107,7 +3605,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st96
 		}
-		goto tr0
+		goto tr4
 	st96:
 		if p++; p == pe {
 			goto _test_eof96
@@ -3126,12 +3624,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr25:
+		goto tr35
+	tr30:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 97
+				goto _out
+			}
+		}
 
 		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p, stack, err
+			return p, stack, "", err
 		}
 
 		goto st97
@@ -3143,7 +3651,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st98
 		}
-		goto tr0
+		goto tr89
 	st98:
 		if p++; p == pe {
 			goto _test_eof98
@@ -3152,7 +3660,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st99
 		}
-		goto tr0
+		goto tr89
 	st99:
 		if p++; p == pe {
 			goto _test_eof99
@@ -3161,7 +3669,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st100
 		}
-		goto tr0
+		goto tr89
 	st100:
 		if p++; p == pe {
 			goto _test_eof100
@@ -3180,15 +3688,25 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
-	tr26:
+		goto tr35
+	tr31:
+
+		if state != nil && !state.valueBuffered(data, p) {
+			state.wait = (_ps)
+			p--
+			{
+				p++
+				cs = 101
+				goto _out
+			}
+		}
 
 		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
 		if err != nil {
-			return p + pp, stack, err
+			return p + pp, stack, "", err
 		}
 		if pp < 0 {
-			err = errPOutOfRange
+			err = ErrPOutOfRange
 			{
 				p++
 				cs = 101
@@ -3197,7 +3715,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 		if pp != 0 {
 			if p+pp-1 >= pe {
-				err = errPOutOfRange
+				err = ErrPOutOfRange
 				{
 					p++
 					cs = 101
@@ -3209,6 +3727,14 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3237,7 +3763,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st17
 		}
-		goto tr0
+		goto tr35
 	st102:
 		if p++; p == pe {
 			goto _test_eof102
@@ -3263,7 +3789,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st104
 		}
-		goto tr0
+		goto tr62
 	st103:
 		if p++; p == pe {
 			goto _test_eof103
@@ -3276,7 +3802,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st102
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st9
 	st104:
@@ -3296,7 +3822,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st105
 		}
-		goto tr0
+		goto tr65
 	st105:
 		if p++; p == pe {
 			goto _test_eof105
@@ -3314,7 +3840,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st106
 		}
-		goto tr0
+		goto tr65
 	st106:
 		if p++; p == pe {
 			goto _test_eof106
@@ -3332,7 +3858,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st107
 		}
-		goto tr0
+		goto tr65
 	st107:
 		if p++; p == pe {
 			goto _test_eof107
@@ -3350,7 +3876,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st108
 		}
-		goto tr0
+		goto tr65
 	st108:
 		if p++; p == pe {
 			goto _test_eof108
@@ -3363,9 +3889,15 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st102
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr12
 		}
 		goto st9
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		goto st0
 	st109:
 		if p++; p == pe {
 			goto _test_eof109
@@ -3383,9 +3915,9 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st156
 		case 91:
-			goto tr124
+			goto tr137
 		case 93:
-			goto tr125
+			goto tr138
 		case 102:
 			goto st162
 		case 110:
@@ -3393,7 +3925,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st171
 		case 123:
-			goto tr129
+			goto tr142
 		}
 		switch {
 		case data[p] > 10:
@@ -3403,7 +3935,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st110
 		}
-		goto tr118
+		goto tr131
 	st110:
 		if p++; p == pe {
 			goto _test_eof110
@@ -3421,9 +3953,9 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st156
 		case 91:
-			goto tr124
+			goto tr137
 		case 93:
-			goto tr125
+			goto tr138
 		case 102:
 			goto st162
 		case 110:
@@ -3431,7 +3963,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st171
 		case 123:
-			goto tr129
+			goto tr142
 		}
 		switch {
 		case data[p] > 10:
@@ -3441,7 +3973,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st110
 		}
-		goto tr118
+		goto tr131
 	st111:
 		if p++; p == pe {
 			goto _test_eof111
@@ -3454,7 +3986,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st112
 	st112:
@@ -3469,7 +4001,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st112
 	st113:
@@ -3485,12 +4017,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st114:
 		if p++; p == pe {
 			goto _test_eof114
@@ -3504,12 +4036,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st115:
 		if p++; p == pe {
 			goto _test_eof115
@@ -3527,7 +4059,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st128
 		case 91:
-			goto tr140
+			goto tr157
 		case 102:
 			goto st134
 		case 110:
@@ -3535,7 +4067,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st143
 		case 123:
-			goto tr144
+			goto tr161
 		}
 		switch {
 		case data[p] > 10:
@@ -3545,7 +4077,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st116
 		}
-		goto tr118
+		goto tr151
 	st116:
 		if p++; p == pe {
 			goto _test_eof116
@@ -3563,7 +4095,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st128
 		case 91:
-			goto tr140
+			goto tr157
 		case 102:
 			goto st134
 		case 110:
@@ -3571,7 +4103,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st143
 		case 123:
-			goto tr144
+			goto tr161
 		}
 		switch {
 		case data[p] > 10:
@@ -3581,7 +4113,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st116
 		}
-		goto tr118
+		goto tr151
 	st117:
 		if p++; p == pe {
 			goto _test_eof117
@@ -3594,7 +4126,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st120
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st118
 	st118:
@@ -3609,7 +4141,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st120
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st118
 	st119:
@@ -3625,24 +4157,24 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr125:
+		goto tr147
+	tr150:
 		{
 			top--
 			cs = stack[top]
 			goto _again
 		}
-		goto st271
-	st271:
+		goto st272
+	st272:
 		if p++; p == pe {
-			goto _test_eof271
+			goto _test_eof272
 		}
-	st_case_271:
+	st_case_272:
 		goto st0
 	st120:
 		if p++; p == pe {
@@ -3669,7 +4201,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st122
 		}
-		goto tr118
+		goto tr165
 	st121:
 		if p++; p == pe {
 			goto _test_eof121
@@ -3682,7 +4214,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st120
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st118
 	st122:
@@ -3702,7 +4234,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st123
 		}
-		goto tr118
+		goto tr168
 	st123:
 		if p++; p == pe {
 			goto _test_eof123
@@ -3720,7 +4252,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st124
 		}
-		goto tr118
+		goto tr168
 	st124:
 		if p++; p == pe {
 			goto _test_eof124
@@ -3738,7 +4270,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st125
 		}
-		goto tr118
+		goto tr168
 	st125:
 		if p++; p == pe {
 			goto _test_eof125
@@ -3756,7 +4288,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st126
 		}
-		goto tr118
+		goto tr168
 	st126:
 		if p++; p == pe {
 			goto _test_eof126
@@ -3769,7 +4301,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st120
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st118
 	st127:
@@ -3783,7 +4315,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st131
 		}
-		goto tr118
+		goto tr173
 	st128:
 		if p++; p == pe {
 			goto _test_eof128
@@ -3797,22 +4329,23 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr154
+			goto tr174
 		case 69:
-			goto tr155
+			goto tr175
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr155
+			goto tr175
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr154:
+		goto tr147
+	tr174:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 129
@@ -3834,16 +4367,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr155:
+		goto tr147
+	tr175:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 130
@@ -3865,12 +4399,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st131:
 		if p++; p == pe {
 			goto _test_eof131
@@ -3884,13 +4418,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr154
+			goto tr174
 		case 69:
-			goto tr155
+			goto tr175
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr155
+			goto tr175
 		}
 		switch {
 		case data[p] > 10:
@@ -3900,7 +4434,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st132:
 		if p++; p == pe {
 			goto _test_eof132
@@ -3914,13 +4448,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr154
+			goto tr174
 		case 69:
-			goto tr155
+			goto tr175
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr155
+			goto tr175
 		}
 		switch {
 		case data[p] > 10:
@@ -3930,9 +4464,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st114
 		}
-		goto tr118
-	tr140:
+		goto tr147
+	tr157:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -3956,12 +4498,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st134:
 		if p++; p == pe {
 			goto _test_eof134
@@ -3970,7 +4512,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st135
 		}
-		goto tr118
+		goto tr177
 	st135:
 		if p++; p == pe {
 			goto _test_eof135
@@ -3979,7 +4521,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st136
 		}
-		goto tr118
+		goto tr177
 	st136:
 		if p++; p == pe {
 			goto _test_eof136
@@ -3988,7 +4530,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st137
 		}
-		goto tr118
+		goto tr177
 	st137:
 		if p++; p == pe {
 			goto _test_eof137
@@ -3997,7 +4539,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st138
 		}
-		goto tr118
+		goto tr177
 	st138:
 		if p++; p == pe {
 			goto _test_eof138
@@ -4011,12 +4553,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st139:
 		if p++; p == pe {
 			goto _test_eof139
@@ -4025,7 +4567,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st140
 		}
-		goto tr118
+		goto tr182
 	st140:
 		if p++; p == pe {
 			goto _test_eof140
@@ -4034,7 +4576,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st141
 		}
-		goto tr118
+		goto tr182
 	st141:
 		if p++; p == pe {
 			goto _test_eof141
@@ -4043,7 +4585,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st142
 		}
-		goto tr118
+		goto tr182
 	st142:
 		if p++; p == pe {
 			goto _test_eof142
@@ -4057,12 +4599,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st143:
 		if p++; p == pe {
 			goto _test_eof143
@@ -4071,7 +4613,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st144
 		}
-		goto tr118
+		goto tr186
 	st144:
 		if p++; p == pe {
 			goto _test_eof144
@@ -4080,7 +4622,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st145
 		}
-		goto tr118
+		goto tr186
 	st145:
 		if p++; p == pe {
 			goto _test_eof145
@@ -4089,7 +4631,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st146
 		}
-		goto tr118
+		goto tr186
 	st146:
 		if p++; p == pe {
 			goto _test_eof146
@@ -4103,14 +4645,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr144:
+		goto tr147
+	tr161:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -4134,12 +4684,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st148:
 		if p++; p == pe {
 			goto _test_eof148
@@ -4165,7 +4715,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st150
 		}
-		goto tr118
+		goto tr165
 	st149:
 		if p++; p == pe {
 			goto _test_eof149
@@ -4178,7 +4728,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st112
 	st150:
@@ -4198,7 +4748,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st151
 		}
-		goto tr118
+		goto tr168
 	st151:
 		if p++; p == pe {
 			goto _test_eof151
@@ -4216,7 +4766,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st152
 		}
-		goto tr118
+		goto tr168
 	st152:
 		if p++; p == pe {
 			goto _test_eof152
@@ -4234,7 +4784,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st153
 		}
-		goto tr118
+		goto tr168
 	st153:
 		if p++; p == pe {
 			goto _test_eof153
@@ -4252,7 +4802,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st154
 		}
-		goto tr118
+		goto tr168
 	st154:
 		if p++; p == pe {
 			goto _test_eof154
@@ -4265,7 +4815,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr118
+			goto tr143
 		}
 		goto st112
 	st155:
@@ -4279,7 +4829,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st159
 		}
-		goto tr118
+		goto tr173
 	st156:
 		if p++; p == pe {
 			goto _test_eof156
@@ -4293,22 +4843,23 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr173
+			goto tr196
 		case 69:
-			goto tr174
+			goto tr197
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr174
+			goto tr197
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr173:
+		goto tr147
+	tr196:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 157
@@ -4330,16 +4881,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr174:
+		goto tr147
+	tr197:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 158
@@ -4361,12 +4913,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st159:
 		if p++; p == pe {
 			goto _test_eof159
@@ -4380,13 +4932,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr173
+			goto tr196
 		case 69:
-			goto tr174
+			goto tr197
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr174
+			goto tr197
 		}
 		switch {
 		case data[p] > 10:
@@ -4396,7 +4948,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st160:
 		if p++; p == pe {
 			goto _test_eof160
@@ -4410,13 +4962,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 46:
-			goto tr173
+			goto tr196
 		case 69:
-			goto tr174
+			goto tr197
 		case 93:
-			goto tr125
+			goto tr150
 		case 101:
-			goto tr174
+			goto tr197
 		}
 		switch {
 		case data[p] > 10:
@@ -4426,9 +4978,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st114
 		}
-		goto tr118
-	tr124:
+		goto tr147
+	tr137:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -4452,12 +5012,25 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
+	tr138:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st273
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		goto st0
 	st162:
 		if p++; p == pe {
 			goto _test_eof162
@@ -4466,7 +5039,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st163
 		}
-		goto tr118
+		goto tr177
 	st163:
 		if p++; p == pe {
 			goto _test_eof163
@@ -4475,7 +5048,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st164
 		}
-		goto tr118
+		goto tr177
 	st164:
 		if p++; p == pe {
 			goto _test_eof164
@@ -4484,7 +5057,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st165
 		}
-		goto tr118
+		goto tr177
 	st165:
 		if p++; p == pe {
 			goto _test_eof165
@@ -4493,7 +5066,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st166
 		}
-		goto tr118
+		goto tr177
 	st166:
 		if p++; p == pe {
 			goto _test_eof166
@@ -4507,12 +5080,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st167:
 		if p++; p == pe {
 			goto _test_eof167
@@ -4521,7 +5094,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st168
 		}
-		goto tr118
+		goto tr182
 	st168:
 		if p++; p == pe {
 			goto _test_eof168
@@ -4530,7 +5103,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st169
 		}
-		goto tr118
+		goto tr182
 	st169:
 		if p++; p == pe {
 			goto _test_eof169
@@ -4539,7 +5112,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st170
 		}
-		goto tr118
+		goto tr182
 	st170:
 		if p++; p == pe {
 			goto _test_eof170
@@ -4553,12 +5126,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st171:
 		if p++; p == pe {
 			goto _test_eof171
@@ -4567,7 +5140,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st172
 		}
-		goto tr118
+		goto tr186
 	st172:
 		if p++; p == pe {
 			goto _test_eof172
@@ -4576,7 +5149,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st173
 		}
-		goto tr118
+		goto tr186
 	st173:
 		if p++; p == pe {
 			goto _test_eof173
@@ -4585,7 +5158,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st174
 		}
-		goto tr118
+		goto tr186
 	st174:
 		if p++; p == pe {
 			goto _test_eof174
@@ -4599,14 +5172,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
-	tr129:
+		goto tr147
+	tr142:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -4630,12 +5211,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st115
 		case 93:
-			goto tr125
+			goto tr150
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st114
 		}
-		goto tr118
+		goto tr147
 	st176:
 		if p++; p == pe {
 			goto _test_eof176
@@ -4649,12 +5230,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 34:
 			goto st178
 		case 125:
-			goto tr189
+			goto tr212
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st177
 		}
-		goto tr186
+		goto tr209
 	st177:
 		if p++; p == pe {
 			goto _test_eof177
@@ -4668,12 +5249,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 34:
 			goto st178
 		case 125:
-			goto tr189
+			goto tr212
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st177
 		}
-		goto tr186
+		goto tr209
 	st178:
 		if p++; p == pe {
 			goto _test_eof178
@@ -4686,7 +5267,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st262
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st179
 	st179:
@@ -4701,7 +5282,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st262
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st179
 	st180:
@@ -4720,7 +5301,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st181
 		}
-		goto tr186
+		goto tr217
 	st181:
 		if p++; p == pe {
 			goto _test_eof181
@@ -4737,7 +5318,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st181
 		}
-		goto tr186
+		goto tr217
 	st182:
 		if p++; p == pe {
 			goto _test_eof182
@@ -4755,7 +5336,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st242
 		case 91:
-			goto tr200
+			goto tr226
 		case 102:
 			goto st248
 		case 110:
@@ -4763,7 +5344,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st257
 		case 123:
-			goto tr204
+			goto tr230
 		}
 		switch {
 		case data[p] > 10:
@@ -4773,7 +5354,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st183
 		}
-		goto tr186
+		goto tr220
 	st183:
 		if p++; p == pe {
 			goto _test_eof183
@@ -4791,7 +5372,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st242
 		case 91:
-			goto tr200
+			goto tr226
 		case 102:
 			goto st248
 		case 110:
@@ -4799,7 +5380,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st257
 		case 123:
-			goto tr204
+			goto tr230
 		}
 		switch {
 		case data[p] > 10:
@@ -4809,7 +5390,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st183
 		}
-		goto tr186
+		goto tr220
 	st184:
 		if p++; p == pe {
 			goto _test_eof184
@@ -4822,7 +5403,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st234
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st185
 	st185:
@@ -4837,7 +5418,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st234
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st185
 	st186:
@@ -4853,12 +5434,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st187:
 		if p++; p == pe {
 			goto _test_eof187
@@ -4872,12 +5453,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st188:
 		if p++; p == pe {
 			goto _test_eof188
@@ -4894,7 +5475,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st189
 		}
-		goto tr186
+		goto tr238
 	st189:
 		if p++; p == pe {
 			goto _test_eof189
@@ -4911,7 +5492,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st189
 		}
-		goto tr186
+		goto tr238
 	st190:
 		if p++; p == pe {
 			goto _test_eof190
@@ -4924,7 +5505,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st227
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st191
 	st191:
@@ -4939,7 +5520,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st227
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st191
 	st192:
@@ -4958,7 +5539,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st193
 		}
-		goto tr186
+		goto tr217
 	st193:
 		if p++; p == pe {
 			goto _test_eof193
@@ -4975,7 +5556,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st193
 		}
-		goto tr186
+		goto tr217
 	st194:
 		if p++; p == pe {
 			goto _test_eof194
@@ -4993,7 +5574,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st207
 		case 91:
-			goto tr222
+			goto tr251
 		case 102:
 			goto st213
 		case 110:
@@ -5001,7 +5582,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st222
 		case 123:
-			goto tr226
+			goto tr255
 		}
 		switch {
 		case data[p] > 10:
@@ -5011,7 +5592,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st195
 		}
-		goto tr186
+		goto tr220
 	st195:
 		if p++; p == pe {
 			goto _test_eof195
@@ -5029,7 +5610,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 48:
 			goto st207
 		case 91:
-			goto tr222
+			goto tr251
 		case 102:
 			goto st213
 		case 110:
@@ -5037,7 +5618,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 116:
 			goto st222
 		case 123:
-			goto tr226
+			goto tr255
 		}
 		switch {
 		case data[p] > 10:
@@ -5047,7 +5628,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st195
 		}
-		goto tr186
+		goto tr220
 	st196:
 		if p++; p == pe {
 			goto _test_eof196
@@ -5060,7 +5641,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st199
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st197
 	st197:
@@ -5075,7 +5656,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st199
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st197
 	st198:
@@ -5091,24 +5672,24 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr189:
+		goto tr234
+	tr237:
 		{
 			top--
 			cs = stack[top]
 			goto _again
 		}
-		goto st272
-	st272:
+		goto st274
+	st274:
 		if p++; p == pe {
-			goto _test_eof272
+			goto _test_eof274
 		}
-	st_case_272:
+	st_case_274:
 		goto st0
 	st199:
 		if p++; p == pe {
@@ -5135,7 +5716,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st201
 		}
-		goto tr186
+		goto tr259
 	st200:
 		if p++; p == pe {
 			goto _test_eof200
@@ -5148,7 +5729,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st199
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st197
 	st201:
@@ -5168,7 +5749,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st202
 		}
-		goto tr186
+		goto tr262
 	st202:
 		if p++; p == pe {
 			goto _test_eof202
@@ -5186,7 +5767,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st203
 		}
-		goto tr186
+		goto tr262
 	st203:
 		if p++; p == pe {
 			goto _test_eof203
@@ -5204,7 +5785,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st204
 		}
-		goto tr186
+		goto tr262
 	st204:
 		if p++; p == pe {
 			goto _test_eof204
@@ -5222,7 +5803,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st205
 		}
-		goto tr186
+		goto tr262
 	st205:
 		if p++; p == pe {
 			goto _test_eof205
@@ -5235,7 +5816,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st199
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st197
 	st206:
@@ -5249,7 +5830,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st210
 		}
-		goto tr186
+		goto tr267
 	st207:
 		if p++; p == pe {
 			goto _test_eof207
@@ -5263,22 +5844,23 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr236
+			goto tr268
 		case 69:
-			goto tr237
+			goto tr269
 		case 101:
-			goto tr237
+			goto tr269
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr236:
+		goto tr234
+	tr268:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 208
@@ -5300,16 +5882,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr237:
+		goto tr234
+	tr269:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 209
@@ -5331,12 +5914,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st210:
 		if p++; p == pe {
 			goto _test_eof210
@@ -5350,13 +5933,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr236
+			goto tr268
 		case 69:
-			goto tr237
+			goto tr269
 		case 101:
-			goto tr237
+			goto tr269
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		switch {
 		case data[p] > 10:
@@ -5366,7 +5949,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st211:
 		if p++; p == pe {
 			goto _test_eof211
@@ -5380,13 +5963,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr236
+			goto tr268
 		case 69:
-			goto tr237
+			goto tr269
 		case 101:
-			goto tr237
+			goto tr269
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		switch {
 		case data[p] > 10:
@@ -5396,9 +5979,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st187
 		}
-		goto tr186
-	tr222:
+		goto tr234
+	tr251:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -5422,12 +6013,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st213:
 		if p++; p == pe {
 			goto _test_eof213
@@ -5436,7 +6027,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st214
 		}
-		goto tr186
+		goto tr271
 	st214:
 		if p++; p == pe {
 			goto _test_eof214
@@ -5445,7 +6036,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st215
 		}
-		goto tr186
+		goto tr271
 	st215:
 		if p++; p == pe {
 			goto _test_eof215
@@ -5454,7 +6045,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st216
 		}
-		goto tr186
+		goto tr271
 	st216:
 		if p++; p == pe {
 			goto _test_eof216
@@ -5463,7 +6054,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st217
 		}
-		goto tr186
+		goto tr271
 	st217:
 		if p++; p == pe {
 			goto _test_eof217
@@ -5477,12 +6068,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st218:
 		if p++; p == pe {
 			goto _test_eof218
@@ -5491,7 +6082,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st219
 		}
-		goto tr186
+		goto tr276
 	st219:
 		if p++; p == pe {
 			goto _test_eof219
@@ -5500,7 +6091,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st220
 		}
-		goto tr186
+		goto tr276
 	st220:
 		if p++; p == pe {
 			goto _test_eof220
@@ -5509,7 +6100,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st221
 		}
-		goto tr186
+		goto tr276
 	st221:
 		if p++; p == pe {
 			goto _test_eof221
@@ -5523,12 +6114,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st222:
 		if p++; p == pe {
 			goto _test_eof222
@@ -5537,7 +6128,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st223
 		}
-		goto tr186
+		goto tr280
 	st223:
 		if p++; p == pe {
 			goto _test_eof223
@@ -5546,7 +6137,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st224
 		}
-		goto tr186
+		goto tr280
 	st224:
 		if p++; p == pe {
 			goto _test_eof224
@@ -5555,7 +6146,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st225
 		}
-		goto tr186
+		goto tr280
 	st225:
 		if p++; p == pe {
 			goto _test_eof225
@@ -5569,14 +6160,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr226:
+		goto tr234
+	tr255:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -5600,12 +6199,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st227:
 		if p++; p == pe {
 			goto _test_eof227
@@ -5631,7 +6230,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st229
 		}
-		goto tr186
+		goto tr259
 	st228:
 		if p++; p == pe {
 			goto _test_eof228
@@ -5644,7 +6243,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st227
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st191
 	st229:
@@ -5664,7 +6263,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st230
 		}
-		goto tr186
+		goto tr262
 	st230:
 		if p++; p == pe {
 			goto _test_eof230
@@ -5682,7 +6281,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st231
 		}
-		goto tr186
+		goto tr262
 	st231:
 		if p++; p == pe {
 			goto _test_eof231
@@ -5700,7 +6299,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st232
 		}
-		goto tr186
+		goto tr262
 	st232:
 		if p++; p == pe {
 			goto _test_eof232
@@ -5718,7 +6317,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st233
 		}
-		goto tr186
+		goto tr262
 	st233:
 		if p++; p == pe {
 			goto _test_eof233
@@ -5731,7 +6330,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st227
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st191
 	st234:
@@ -5759,7 +6358,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st236
 		}
-		goto tr186
+		goto tr259
 	st235:
 		if p++; p == pe {
 			goto _test_eof235
@@ -5772,7 +6371,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st234
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st185
 	st236:
@@ -5792,7 +6391,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st237
 		}
-		goto tr186
+		goto tr262
 	st237:
 		if p++; p == pe {
 			goto _test_eof237
@@ -5810,7 +6409,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st238
 		}
-		goto tr186
+		goto tr262
 	st238:
 		if p++; p == pe {
 			goto _test_eof238
@@ -5828,7 +6427,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st239
 		}
-		goto tr186
+		goto tr262
 	st239:
 		if p++; p == pe {
 			goto _test_eof239
@@ -5846,7 +6445,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st240
 		}
-		goto tr186
+		goto tr262
 	st240:
 		if p++; p == pe {
 			goto _test_eof240
@@ -5859,7 +6458,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st234
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st185
 	st241:
@@ -5873,7 +6472,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st245
 		}
-		goto tr186
+		goto tr267
 	st242:
 		if p++; p == pe {
 			goto _test_eof242
@@ -5887,22 +6486,23 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr261
+			goto tr296
 		case 69:
-			goto tr262
+			goto tr297
 		case 101:
-			goto tr262
+			goto tr297
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr261:
+		goto tr234
+	tr296:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 243
@@ -5924,16 +6524,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr262:
+		goto tr234
+	tr297:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 244
@@ -5955,12 +6556,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st245:
 		if p++; p == pe {
 			goto _test_eof245
@@ -5974,13 +6575,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr261
+			goto tr296
 		case 69:
-			goto tr262
+			goto tr297
 		case 101:
-			goto tr262
+			goto tr297
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		switch {
 		case data[p] > 10:
@@ -5990,7 +6591,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st246:
 		if p++; p == pe {
 			goto _test_eof246
@@ -6004,13 +6605,13 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 46:
-			goto tr261
+			goto tr296
 		case 69:
-			goto tr262
+			goto tr297
 		case 101:
-			goto tr262
+			goto tr297
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		switch {
 		case data[p] > 10:
@@ -6020,9 +6621,17 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case data[p] >= 9:
 			goto st187
 		}
-		goto tr186
-	tr200:
+		goto tr234
+	tr226:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -6046,12 +6655,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st248:
 		if p++; p == pe {
 			goto _test_eof248
@@ -6060,7 +6669,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 97 {
 			goto st249
 		}
-		goto tr186
+		goto tr271
 	st249:
 		if p++; p == pe {
 			goto _test_eof249
@@ -6069,7 +6678,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st250
 		}
-		goto tr186
+		goto tr271
 	st250:
 		if p++; p == pe {
 			goto _test_eof250
@@ -6078,7 +6687,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 115 {
 			goto st251
 		}
-		goto tr186
+		goto tr271
 	st251:
 		if p++; p == pe {
 			goto _test_eof251
@@ -6087,7 +6696,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st252
 		}
-		goto tr186
+		goto tr271
 	st252:
 		if p++; p == pe {
 			goto _test_eof252
@@ -6101,12 +6710,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st253:
 		if p++; p == pe {
 			goto _test_eof253
@@ -6115,7 +6724,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st254
 		}
-		goto tr186
+		goto tr276
 	st254:
 		if p++; p == pe {
 			goto _test_eof254
@@ -6124,7 +6733,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st255
 		}
-		goto tr186
+		goto tr276
 	st255:
 		if p++; p == pe {
 			goto _test_eof255
@@ -6133,7 +6742,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 108 {
 			goto st256
 		}
-		goto tr186
+		goto tr276
 	st256:
 		if p++; p == pe {
 			goto _test_eof256
@@ -6147,12 +6756,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st257:
 		if p++; p == pe {
 			goto _test_eof257
@@ -6161,7 +6770,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 114 {
 			goto st258
 		}
-		goto tr186
+		goto tr280
 	st258:
 		if p++; p == pe {
 			goto _test_eof258
@@ -6170,7 +6779,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 117 {
 			goto st259
 		}
-		goto tr186
+		goto tr280
 	st259:
 		if p++; p == pe {
 			goto _test_eof259
@@ -6179,7 +6788,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		if data[p] == 101 {
 			goto st260
 		}
-		goto tr186
+		goto tr280
 	st260:
 		if p++; p == pe {
 			goto _test_eof260
@@ -6193,14 +6802,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
-	tr204:
+		goto tr234
+	tr230:
 		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
 			if top+1 >= len(stack) {
 				stack = append(stack, make([]int, 1+top-len(stack))...)
 			}
@@ -6224,12 +6841,12 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 44:
 			goto st188
 		case 125:
-			goto tr189
+			goto tr237
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st187
 		}
-		goto tr186
+		goto tr234
 	st262:
 		if p++; p == pe {
 			goto _test_eof262
@@ -6255,7 +6872,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		case 117:
 			goto st264
 		}
-		goto tr186
+		goto tr259
 	st263:
 		if p++; p == pe {
 			goto _test_eof263
@@ -6268,7 +6885,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st262
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st179
 	st264:
@@ -6288,7 +6905,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st265
 		}
-		goto tr186
+		goto tr262
 	st265:
 		if p++; p == pe {
 			goto _test_eof265
@@ -6306,7 +6923,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st266
 		}
-		goto tr186
+		goto tr262
 	st266:
 		if p++; p == pe {
 			goto _test_eof266
@@ -6324,7 +6941,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st267
 		}
-		goto tr186
+		goto tr262
 	st267:
 		if p++; p == pe {
 			goto _test_eof267
@@ -6342,7 +6959,7 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		default:
 			goto st268
 		}
-		goto tr186
+		goto tr262
 	st268:
 		if p++; p == pe {
 			goto _test_eof268
@@ -6355,9 +6972,22 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 			goto st262
 		}
 		if data[p] <= 31 {
-			goto tr186
+			goto tr213
 		}
 		goto st179
+	tr212:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st275
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		goto st0
 	st_out:
 	_test_eof1:
 		cs = 1
@@ -6689,6 +7319,9 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	_test_eof108:
 		cs = 108
 		goto _test_eof
+	_test_eof271:
+		cs = 271
+		goto _test_eof
 	_test_eof109:
 		cs = 109
 		goto _test_eof
@@ -6722,8 +7355,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	_test_eof119:
 		cs = 119
 		goto _test_eof
-	_test_eof271:
-		cs = 271
+	_test_eof272:
+		cs = 272
 		goto _test_eof
 	_test_eof120:
 		cs = 120
@@ -6851,6 +7484,9 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	_test_eof161:
 		cs = 161
 		goto _test_eof
+	_test_eof273:
+		cs = 273
+		goto _test_eof
 	_test_eof162:
 		cs = 162
 		goto _test_eof
@@ -6962,8 +7598,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	_test_eof198:
 		cs = 198
 		goto _test_eof
-	_test_eof272:
-		cs = 272
+	_test_eof274:
+		cs = 274
 		goto _test_eof
 	_test_eof199:
 		cs = 199
@@ -7175,39 +7811,14164 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 	_test_eof268:
 		cs = 268
 		goto _test_eof
+	_test_eof275:
+		cs = 275
+		goto _test_eof
 
 	_test_eof:
 		{
 		}
 		if p == eof {
 			switch cs {
-			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108:
+			case 12, 13, 24, 25:
+				expected = "value"
 
-				return p, stack, errInvalidObject
+				return p, stack, expected, ErrInvalidObject
 
-			case 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175:
-				err = errUnexpectedEOF
-				{
-					p++
-					cs = 0
-					goto _out
-				}
-				err = errInvalidArray
-				{
-					p++
-					cs = 0
-					goto _out
-				}
-			case 176, 177, 178, 179, 180, 181, 182, 183, 184, 185, 186, 187, 188, 189, 190, 191, 192, 193, 194, 195, 196, 197, 198, 199, 200, 201, 202, 203, 204, 205, 206, 207, 208, 209, 210, 211, 212, 213, 214, 215, 216, 217, 218, 219, 220, 221, 222, 223, 224, 225, 226, 227, 228, 229, 230, 231, 232, 233, 234, 235, 236, 237, 238, 239, 240, 241, 242, 243, 244, 245, 246, 247, 248, 249, 250, 251, 252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267, 268:
-				err = errUnexpectedEOF
-				{
-					p++
-					cs = 0
-					goto _out
-				}
-				err = errInvalidObject
-				{
-					p++
+			case 6, 7:
+				expected = "string or '}'"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 18, 19:
+				expected = "string"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 10, 11, 22, 23:
+				expected = "':'"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 16, 17, 28, 37, 39, 40, 43, 44, 45, 46, 47, 52, 56, 60, 61, 77, 79, 80, 83, 84, 85, 86, 87, 92, 96, 100, 101:
+				expected = "',' or '}'"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 29, 62, 69, 102:
+				expected = "escape sequence"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 31, 32, 33, 34, 64, 65, 66, 67, 71, 72, 73, 74, 104, 105, 106, 107:
+				expected = "hex digit"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 36, 38, 41, 42, 76, 78, 81, 82:
+				expected = "digit"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 57, 58, 59, 97, 98, 99:
+				expected = "true"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 48, 49, 50, 51, 88, 89, 90, 91:
+				expected = "false"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 3, 4, 5, 53, 54, 55, 93, 94, 95:
+				expected = "null"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 1, 2:
+				expected = "object"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 115, 116:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 182, 183, 194, 195:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 109, 110:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 113, 114, 119, 128, 129, 130, 131, 132, 133, 138, 142, 146, 147, 156, 157, 158, 159, 160, 161, 166, 170, 174, 175:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 176, 177:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 188, 189:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 180, 181, 192, 193:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 186, 187, 198, 207, 208, 209, 210, 211, 212, 217, 221, 225, 226, 242, 243, 244, 245, 246, 247, 252, 256, 260, 261:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 8, 9, 14, 15, 20, 21, 26, 27, 30, 35, 63, 68, 70, 75, 103, 108:
+				expected = "string character"
+				expected = "'\"'"
+
+				return p, stack, expected, ErrInvalidObject
+
+			case 120, 148:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 199, 227, 234, 262:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 122, 123, 124, 125, 150, 151, 152, 153:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 201, 202, 203, 204, 229, 230, 231, 232, 236, 237, 238, 239, 264, 265, 266, 267:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 127, 155:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 206, 241:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 143, 144, 145, 171, 172, 173:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 222, 223, 224, 257, 258, 259:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 134, 135, 136, 137, 162, 163, 164, 165:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 213, 214, 215, 216, 248, 249, 250, 251:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 139, 140, 141, 167, 168, 169:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 218, 219, 220, 253, 254, 255:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 111, 112, 117, 118, 121, 126, 149, 154:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 178, 179, 184, 185, 190, 191, 196, 197, 200, 205, 228, 233, 235, 240, 263, 268:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	// The machine is in its error state without an error when it read the byte after the end of the value.
+	if state != nil {
+		state.save(cs, top, p, stack, cs >= handleObjectValues_first_final || cs == handleObjectValues_error)
+		state.fieldStart, state.fieldEnd = currentFieldStart, currentFieldEnd
+	}
+	return p, stack, expected, err
+}
+
+// handleObjectValuesChecked is handleObjectValues with the checks for buffer's Limits, StrictUTF8 and
+// RejectDuplicateKeys. Strings and numbers are checked before they are passed to handler. It also reads JSONC when
+// buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func handleObjectValuesChecked(
+	data []byte, handler ObjectValueHandler, stack []int, depth int, buffer *Buffer,
+) (int, []int, string, error) {
+	var top, cs, p, pp, tokenStart, count int
+	var err error
+	var expected string
+	pe := buffer.documentEnd(data)
+	eof := pe
+	var currentFieldStart, currentFieldEnd int
+	var object int
+	if buffer.RejectDuplicateKeys {
+		object = buffer.keys.newObject()
+	}
+
+	const handleObjectValuesChecked_start int = 1
+	const handleObjectValuesChecked_first_final int = 381
+	const handleObjectValuesChecked_error int = 0
+
+	const handleObjectValuesChecked_en_checked_array int = 151
+	const handleObjectValuesChecked_en_checked_object int = 248
+	const handleObjectValuesChecked_en_main int = 1
+
+	{
+		cs = handleObjectValuesChecked_start
+		top = 0
+	}
+
+	{
+		var _widec int16
+		if p == pe {
+			goto _test_eof
+		}
+		goto _resume
+
+	_again:
+		switch cs {
+		case 1:
+			goto st1
+		case 0:
+			goto st0
+		case 2:
+			goto st2
+		case 3:
+			goto st3
+		case 4:
+			goto st4
+		case 5:
+			goto st5
+		case 381:
+			goto st381
+		case 6:
+			goto st6
+		case 7:
+			goto st7
+		case 8:
+			goto st8
+		case 9:
+			goto st9
+		case 10:
+			goto st10
+		case 11:
+			goto st11
+		case 12:
+			goto st12
+		case 13:
+			goto st13
+		case 14:
+			goto st14
+		case 15:
+			goto st15
+		case 16:
+			goto st16
+		case 17:
+			goto st17
+		case 18:
+			goto st18
+		case 19:
+			goto st19
+		case 20:
+			goto st20
+		case 21:
+			goto st21
+		case 22:
+			goto st22
+		case 23:
+			goto st23
+		case 24:
+			goto st24
+		case 25:
+			goto st25
+		case 26:
+			goto st26
+		case 27:
+			goto st27
+		case 28:
+			goto st28
+		case 382:
+			goto st382
+		case 29:
+			goto st29
+		case 30:
+			goto st30
+		case 31:
+			goto st31
+		case 32:
+			goto st32
+		case 33:
+			goto st33
+		case 34:
+			goto st34
+		case 35:
+			goto st35
+		case 36:
+			goto st36
+		case 37:
+			goto st37
+		case 38:
+			goto st38
+		case 39:
+			goto st39
+		case 40:
+			goto st40
+		case 41:
+			goto st41
+		case 42:
+			goto st42
+		case 43:
+			goto st43
+		case 44:
+			goto st44
+		case 45:
+			goto st45
+		case 46:
+			goto st46
+		case 47:
+			goto st47
+		case 48:
+			goto st48
+		case 49:
+			goto st49
+		case 50:
+			goto st50
+		case 51:
+			goto st51
+		case 52:
+			goto st52
+		case 53:
+			goto st53
+		case 54:
+			goto st54
+		case 55:
+			goto st55
+		case 56:
+			goto st56
+		case 57:
+			goto st57
+		case 58:
+			goto st58
+		case 59:
+			goto st59
+		case 60:
+			goto st60
+		case 61:
+			goto st61
+		case 62:
+			goto st62
+		case 63:
+			goto st63
+		case 64:
+			goto st64
+		case 65:
+			goto st65
+		case 66:
+			goto st66
+		case 67:
+			goto st67
+		case 68:
+			goto st68
+		case 69:
+			goto st69
+		case 70:
+			goto st70
+		case 71:
+			goto st71
+		case 72:
+			goto st72
+		case 73:
+			goto st73
+		case 74:
+			goto st74
+		case 75:
+			goto st75
+		case 76:
+			goto st76
+		case 77:
+			goto st77
+		case 78:
+			goto st78
+		case 79:
+			goto st79
+		case 80:
+			goto st80
+		case 81:
+			goto st81
+		case 82:
+			goto st82
+		case 83:
+			goto st83
+		case 84:
+			goto st84
+		case 85:
+			goto st85
+		case 86:
+			goto st86
+		case 87:
+			goto st87
+		case 88:
+			goto st88
+		case 89:
+			goto st89
+		case 383:
+			goto st383
+		case 90:
+			goto st90
+		case 91:
+			goto st91
+		case 92:
+			goto st92
+		case 93:
+			goto st93
+		case 94:
+			goto st94
+		case 95:
+			goto st95
+		case 96:
+			goto st96
+		case 97:
+			goto st97
+		case 98:
+			goto st98
+		case 99:
+			goto st99
+		case 100:
+			goto st100
+		case 101:
+			goto st101
+		case 102:
+			goto st102
+		case 103:
+			goto st103
+		case 104:
+			goto st104
+		case 105:
+			goto st105
+		case 106:
+			goto st106
+		case 107:
+			goto st107
+		case 108:
+			goto st108
+		case 109:
+			goto st109
+		case 110:
+			goto st110
+		case 111:
+			goto st111
+		case 112:
+			goto st112
+		case 113:
+			goto st113
+		case 114:
+			goto st114
+		case 115:
+			goto st115
+		case 116:
+			goto st116
+		case 117:
+			goto st117
+		case 118:
+			goto st118
+		case 119:
+			goto st119
+		case 120:
+			goto st120
+		case 121:
+			goto st121
+		case 122:
+			goto st122
+		case 123:
+			goto st123
+		case 124:
+			goto st124
+		case 125:
+			goto st125
+		case 126:
+			goto st126
+		case 127:
+			goto st127
+		case 128:
+			goto st128
+		case 129:
+			goto st129
+		case 130:
+			goto st130
+		case 131:
+			goto st131
+		case 132:
+			goto st132
+		case 133:
+			goto st133
+		case 134:
+			goto st134
+		case 135:
+			goto st135
+		case 136:
+			goto st136
+		case 137:
+			goto st137
+		case 138:
+			goto st138
+		case 139:
+			goto st139
+		case 140:
+			goto st140
+		case 141:
+			goto st141
+		case 142:
+			goto st142
+		case 143:
+			goto st143
+		case 144:
+			goto st144
+		case 145:
+			goto st145
+		case 146:
+			goto st146
+		case 384:
+			goto st384
+		case 147:
+			goto st147
+		case 148:
+			goto st148
+		case 149:
+			goto st149
+		case 150:
+			goto st150
+		case 151:
+			goto st151
+		case 152:
+			goto st152
+		case 153:
+			goto st153
+		case 154:
+			goto st154
+		case 155:
+			goto st155
+		case 156:
+			goto st156
+		case 157:
+			goto st157
+		case 158:
+			goto st158
+		case 159:
+			goto st159
+		case 160:
+			goto st160
+		case 161:
+			goto st161
+		case 385:
+			goto st385
+		case 162:
+			goto st162
+		case 163:
+			goto st163
+		case 164:
+			goto st164
+		case 165:
+			goto st165
+		case 166:
+			goto st166
+		case 167:
+			goto st167
+		case 168:
+			goto st168
+		case 169:
+			goto st169
+		case 170:
+			goto st170
+		case 171:
+			goto st171
+		case 172:
+			goto st172
+		case 173:
+			goto st173
+		case 174:
+			goto st174
+		case 175:
+			goto st175
+		case 176:
+			goto st176
+		case 177:
+			goto st177
+		case 178:
+			goto st178
+		case 179:
+			goto st179
+		case 180:
+			goto st180
+		case 181:
+			goto st181
+		case 182:
+			goto st182
+		case 183:
+			goto st183
+		case 184:
+			goto st184
+		case 185:
+			goto st185
+		case 186:
+			goto st186
+		case 187:
+			goto st187
+		case 188:
+			goto st188
+		case 189:
+			goto st189
+		case 190:
+			goto st190
+		case 191:
+			goto st191
+		case 192:
+			goto st192
+		case 193:
+			goto st193
+		case 194:
+			goto st194
+		case 195:
+			goto st195
+		case 196:
+			goto st196
+		case 197:
+			goto st197
+		case 198:
+			goto st198
+		case 199:
+			goto st199
+		case 200:
+			goto st200
+		case 201:
+			goto st201
+		case 202:
+			goto st202
+		case 386:
+			goto st386
+		case 203:
+			goto st203
+		case 204:
+			goto st204
+		case 205:
+			goto st205
+		case 206:
+			goto st206
+		case 207:
+			goto st207
+		case 208:
+			goto st208
+		case 209:
+			goto st209
+		case 210:
+			goto st210
+		case 211:
+			goto st211
+		case 212:
+			goto st212
+		case 213:
+			goto st213
+		case 214:
+			goto st214
+		case 215:
+			goto st215
+		case 216:
+			goto st216
+		case 217:
+			goto st217
+		case 218:
+			goto st218
+		case 219:
+			goto st219
+		case 220:
+			goto st220
+		case 221:
+			goto st221
+		case 222:
+			goto st222
+		case 223:
+			goto st223
+		case 224:
+			goto st224
+		case 225:
+			goto st225
+		case 226:
+			goto st226
+		case 227:
+			goto st227
+		case 228:
+			goto st228
+		case 387:
+			goto st387
+		case 229:
+			goto st229
+		case 230:
+			goto st230
+		case 231:
+			goto st231
+		case 232:
+			goto st232
+		case 233:
+			goto st233
+		case 234:
+			goto st234
+		case 235:
+			goto st235
+		case 236:
+			goto st236
+		case 237:
+			goto st237
+		case 238:
+			goto st238
+		case 239:
+			goto st239
+		case 240:
+			goto st240
+		case 241:
+			goto st241
+		case 242:
+			goto st242
+		case 243:
+			goto st243
+		case 244:
+			goto st244
+		case 245:
+			goto st245
+		case 246:
+			goto st246
+		case 247:
+			goto st247
+		case 248:
+			goto st248
+		case 249:
+			goto st249
+		case 250:
+			goto st250
+		case 251:
+			goto st251
+		case 252:
+			goto st252
+		case 253:
+			goto st253
+		case 254:
+			goto st254
+		case 255:
+			goto st255
+		case 256:
+			goto st256
+		case 257:
+			goto st257
+		case 258:
+			goto st258
+		case 259:
+			goto st259
+		case 260:
+			goto st260
+		case 261:
+			goto st261
+		case 262:
+			goto st262
+		case 263:
+			goto st263
+		case 264:
+			goto st264
+		case 265:
+			goto st265
+		case 266:
+			goto st266
+		case 267:
+			goto st267
+		case 268:
+			goto st268
+		case 269:
+			goto st269
+		case 270:
+			goto st270
+		case 388:
+			goto st388
+		case 271:
+			goto st271
+		case 272:
+			goto st272
+		case 273:
+			goto st273
+		case 274:
+			goto st274
+		case 275:
+			goto st275
+		case 276:
+			goto st276
+		case 277:
+			goto st277
+		case 278:
+			goto st278
+		case 279:
+			goto st279
+		case 280:
+			goto st280
+		case 281:
+			goto st281
+		case 282:
+			goto st282
+		case 283:
+			goto st283
+		case 284:
+			goto st284
+		case 285:
+			goto st285
+		case 286:
+			goto st286
+		case 287:
+			goto st287
+		case 288:
+			goto st288
+		case 289:
+			goto st289
+		case 290:
+			goto st290
+		case 291:
+			goto st291
+		case 292:
+			goto st292
+		case 293:
+			goto st293
+		case 294:
+			goto st294
+		case 295:
+			goto st295
+		case 296:
+			goto st296
+		case 297:
+			goto st297
+		case 298:
+			goto st298
+		case 299:
+			goto st299
+		case 300:
+			goto st300
+		case 301:
+			goto st301
+		case 302:
+			goto st302
+		case 303:
+			goto st303
+		case 304:
+			goto st304
+		case 305:
+			goto st305
+		case 306:
+			goto st306
+		case 307:
+			goto st307
+		case 308:
+			goto st308
+		case 309:
+			goto st309
+		case 310:
+			goto st310
+		case 311:
+			goto st311
+		case 312:
+			goto st312
+		case 313:
+			goto st313
+		case 314:
+			goto st314
+		case 315:
+			goto st315
+		case 316:
+			goto st316
+		case 317:
+			goto st317
+		case 318:
+			goto st318
+		case 319:
+			goto st319
+		case 320:
+			goto st320
+		case 321:
+			goto st321
+		case 322:
+			goto st322
+		case 323:
+			goto st323
+		case 324:
+			goto st324
+		case 325:
+			goto st325
+		case 326:
+			goto st326
+		case 389:
+			goto st389
+		case 327:
+			goto st327
+		case 328:
+			goto st328
+		case 329:
+			goto st329
+		case 330:
+			goto st330
+		case 331:
+			goto st331
+		case 332:
+			goto st332
+		case 333:
+			goto st333
+		case 334:
+			goto st334
+		case 335:
+			goto st335
+		case 336:
+			goto st336
+		case 337:
+			goto st337
+		case 338:
+			goto st338
+		case 339:
+			goto st339
+		case 340:
+			goto st340
+		case 341:
+			goto st341
+		case 342:
+			goto st342
+		case 343:
+			goto st343
+		case 344:
+			goto st344
+		case 345:
+			goto st345
+		case 346:
+			goto st346
+		case 347:
+			goto st347
+		case 348:
+			goto st348
+		case 349:
+			goto st349
+		case 350:
+			goto st350
+		case 351:
+			goto st351
+		case 352:
+			goto st352
+		case 353:
+			goto st353
+		case 354:
+			goto st354
+		case 355:
+			goto st355
+		case 356:
+			goto st356
+		case 357:
+			goto st357
+		case 358:
+			goto st358
+		case 359:
+			goto st359
+		case 360:
+			goto st360
+		case 361:
+			goto st361
+		case 362:
+			goto st362
+		case 363:
+			goto st363
+		case 364:
+			goto st364
+		case 365:
+			goto st365
+		case 366:
+			goto st366
+		case 367:
+			goto st367
+		case 368:
+			goto st368
+		case 369:
+			goto st369
+		case 370:
+			goto st370
+		case 371:
+			goto st371
+		case 372:
+			goto st372
+		case 373:
+			goto st373
+		case 374:
+			goto st374
+		case 375:
+			goto st375
+		case 376:
+			goto st376
+		case 377:
+			goto st377
+		case 378:
+			goto st378
+		case 390:
+			goto st390
+		case 379:
+			goto st379
+		case 380:
+			goto st380
+		}
+
+		if p++; p == pe {
+			goto _test_eof
+		}
+	_resume:
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		case 381:
+			goto st_case_381
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 13:
+			goto st_case_13
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 19:
+			goto st_case_19
+		case 20:
+			goto st_case_20
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 28:
+			goto st_case_28
+		case 382:
+			goto st_case_382
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 34:
+			goto st_case_34
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		case 51:
+			goto st_case_51
+		case 52:
+			goto st_case_52
+		case 53:
+			goto st_case_53
+		case 54:
+			goto st_case_54
+		case 55:
+			goto st_case_55
+		case 56:
+			goto st_case_56
+		case 57:
+			goto st_case_57
+		case 58:
+			goto st_case_58
+		case 59:
+			goto st_case_59
+		case 60:
+			goto st_case_60
+		case 61:
+			goto st_case_61
+		case 62:
+			goto st_case_62
+		case 63:
+			goto st_case_63
+		case 64:
+			goto st_case_64
+		case 65:
+			goto st_case_65
+		case 66:
+			goto st_case_66
+		case 67:
+			goto st_case_67
+		case 68:
+			goto st_case_68
+		case 69:
+			goto st_case_69
+		case 70:
+			goto st_case_70
+		case 71:
+			goto st_case_71
+		case 72:
+			goto st_case_72
+		case 73:
+			goto st_case_73
+		case 74:
+			goto st_case_74
+		case 75:
+			goto st_case_75
+		case 76:
+			goto st_case_76
+		case 77:
+			goto st_case_77
+		case 78:
+			goto st_case_78
+		case 79:
+			goto st_case_79
+		case 80:
+			goto st_case_80
+		case 81:
+			goto st_case_81
+		case 82:
+			goto st_case_82
+		case 83:
+			goto st_case_83
+		case 84:
+			goto st_case_84
+		case 85:
+			goto st_case_85
+		case 86:
+			goto st_case_86
+		case 87:
+			goto st_case_87
+		case 88:
+			goto st_case_88
+		case 89:
+			goto st_case_89
+		case 383:
+			goto st_case_383
+		case 90:
+			goto st_case_90
+		case 91:
+			goto st_case_91
+		case 92:
+			goto st_case_92
+		case 93:
+			goto st_case_93
+		case 94:
+			goto st_case_94
+		case 95:
+			goto st_case_95
+		case 96:
+			goto st_case_96
+		case 97:
+			goto st_case_97
+		case 98:
+			goto st_case_98
+		case 99:
+			goto st_case_99
+		case 100:
+			goto st_case_100
+		case 101:
+			goto st_case_101
+		case 102:
+			goto st_case_102
+		case 103:
+			goto st_case_103
+		case 104:
+			goto st_case_104
+		case 105:
+			goto st_case_105
+		case 106:
+			goto st_case_106
+		case 107:
+			goto st_case_107
+		case 108:
+			goto st_case_108
+		case 109:
+			goto st_case_109
+		case 110:
+			goto st_case_110
+		case 111:
+			goto st_case_111
+		case 112:
+			goto st_case_112
+		case 113:
+			goto st_case_113
+		case 114:
+			goto st_case_114
+		case 115:
+			goto st_case_115
+		case 116:
+			goto st_case_116
+		case 117:
+			goto st_case_117
+		case 118:
+			goto st_case_118
+		case 119:
+			goto st_case_119
+		case 120:
+			goto st_case_120
+		case 121:
+			goto st_case_121
+		case 122:
+			goto st_case_122
+		case 123:
+			goto st_case_123
+		case 124:
+			goto st_case_124
+		case 125:
+			goto st_case_125
+		case 126:
+			goto st_case_126
+		case 127:
+			goto st_case_127
+		case 128:
+			goto st_case_128
+		case 129:
+			goto st_case_129
+		case 130:
+			goto st_case_130
+		case 131:
+			goto st_case_131
+		case 132:
+			goto st_case_132
+		case 133:
+			goto st_case_133
+		case 134:
+			goto st_case_134
+		case 135:
+			goto st_case_135
+		case 136:
+			goto st_case_136
+		case 137:
+			goto st_case_137
+		case 138:
+			goto st_case_138
+		case 139:
+			goto st_case_139
+		case 140:
+			goto st_case_140
+		case 141:
+			goto st_case_141
+		case 142:
+			goto st_case_142
+		case 143:
+			goto st_case_143
+		case 144:
+			goto st_case_144
+		case 145:
+			goto st_case_145
+		case 146:
+			goto st_case_146
+		case 384:
+			goto st_case_384
+		case 147:
+			goto st_case_147
+		case 148:
+			goto st_case_148
+		case 149:
+			goto st_case_149
+		case 150:
+			goto st_case_150
+		case 151:
+			goto st_case_151
+		case 152:
+			goto st_case_152
+		case 153:
+			goto st_case_153
+		case 154:
+			goto st_case_154
+		case 155:
+			goto st_case_155
+		case 156:
+			goto st_case_156
+		case 157:
+			goto st_case_157
+		case 158:
+			goto st_case_158
+		case 159:
+			goto st_case_159
+		case 160:
+			goto st_case_160
+		case 161:
+			goto st_case_161
+		case 385:
+			goto st_case_385
+		case 162:
+			goto st_case_162
+		case 163:
+			goto st_case_163
+		case 164:
+			goto st_case_164
+		case 165:
+			goto st_case_165
+		case 166:
+			goto st_case_166
+		case 167:
+			goto st_case_167
+		case 168:
+			goto st_case_168
+		case 169:
+			goto st_case_169
+		case 170:
+			goto st_case_170
+		case 171:
+			goto st_case_171
+		case 172:
+			goto st_case_172
+		case 173:
+			goto st_case_173
+		case 174:
+			goto st_case_174
+		case 175:
+			goto st_case_175
+		case 176:
+			goto st_case_176
+		case 177:
+			goto st_case_177
+		case 178:
+			goto st_case_178
+		case 179:
+			goto st_case_179
+		case 180:
+			goto st_case_180
+		case 181:
+			goto st_case_181
+		case 182:
+			goto st_case_182
+		case 183:
+			goto st_case_183
+		case 184:
+			goto st_case_184
+		case 185:
+			goto st_case_185
+		case 186:
+			goto st_case_186
+		case 187:
+			goto st_case_187
+		case 188:
+			goto st_case_188
+		case 189:
+			goto st_case_189
+		case 190:
+			goto st_case_190
+		case 191:
+			goto st_case_191
+		case 192:
+			goto st_case_192
+		case 193:
+			goto st_case_193
+		case 194:
+			goto st_case_194
+		case 195:
+			goto st_case_195
+		case 196:
+			goto st_case_196
+		case 197:
+			goto st_case_197
+		case 198:
+			goto st_case_198
+		case 199:
+			goto st_case_199
+		case 200:
+			goto st_case_200
+		case 201:
+			goto st_case_201
+		case 202:
+			goto st_case_202
+		case 386:
+			goto st_case_386
+		case 203:
+			goto st_case_203
+		case 204:
+			goto st_case_204
+		case 205:
+			goto st_case_205
+		case 206:
+			goto st_case_206
+		case 207:
+			goto st_case_207
+		case 208:
+			goto st_case_208
+		case 209:
+			goto st_case_209
+		case 210:
+			goto st_case_210
+		case 211:
+			goto st_case_211
+		case 212:
+			goto st_case_212
+		case 213:
+			goto st_case_213
+		case 214:
+			goto st_case_214
+		case 215:
+			goto st_case_215
+		case 216:
+			goto st_case_216
+		case 217:
+			goto st_case_217
+		case 218:
+			goto st_case_218
+		case 219:
+			goto st_case_219
+		case 220:
+			goto st_case_220
+		case 221:
+			goto st_case_221
+		case 222:
+			goto st_case_222
+		case 223:
+			goto st_case_223
+		case 224:
+			goto st_case_224
+		case 225:
+			goto st_case_225
+		case 226:
+			goto st_case_226
+		case 227:
+			goto st_case_227
+		case 228:
+			goto st_case_228
+		case 387:
+			goto st_case_387
+		case 229:
+			goto st_case_229
+		case 230:
+			goto st_case_230
+		case 231:
+			goto st_case_231
+		case 232:
+			goto st_case_232
+		case 233:
+			goto st_case_233
+		case 234:
+			goto st_case_234
+		case 235:
+			goto st_case_235
+		case 236:
+			goto st_case_236
+		case 237:
+			goto st_case_237
+		case 238:
+			goto st_case_238
+		case 239:
+			goto st_case_239
+		case 240:
+			goto st_case_240
+		case 241:
+			goto st_case_241
+		case 242:
+			goto st_case_242
+		case 243:
+			goto st_case_243
+		case 244:
+			goto st_case_244
+		case 245:
+			goto st_case_245
+		case 246:
+			goto st_case_246
+		case 247:
+			goto st_case_247
+		case 248:
+			goto st_case_248
+		case 249:
+			goto st_case_249
+		case 250:
+			goto st_case_250
+		case 251:
+			goto st_case_251
+		case 252:
+			goto st_case_252
+		case 253:
+			goto st_case_253
+		case 254:
+			goto st_case_254
+		case 255:
+			goto st_case_255
+		case 256:
+			goto st_case_256
+		case 257:
+			goto st_case_257
+		case 258:
+			goto st_case_258
+		case 259:
+			goto st_case_259
+		case 260:
+			goto st_case_260
+		case 261:
+			goto st_case_261
+		case 262:
+			goto st_case_262
+		case 263:
+			goto st_case_263
+		case 264:
+			goto st_case_264
+		case 265:
+			goto st_case_265
+		case 266:
+			goto st_case_266
+		case 267:
+			goto st_case_267
+		case 268:
+			goto st_case_268
+		case 269:
+			goto st_case_269
+		case 270:
+			goto st_case_270
+		case 388:
+			goto st_case_388
+		case 271:
+			goto st_case_271
+		case 272:
+			goto st_case_272
+		case 273:
+			goto st_case_273
+		case 274:
+			goto st_case_274
+		case 275:
+			goto st_case_275
+		case 276:
+			goto st_case_276
+		case 277:
+			goto st_case_277
+		case 278:
+			goto st_case_278
+		case 279:
+			goto st_case_279
+		case 280:
+			goto st_case_280
+		case 281:
+			goto st_case_281
+		case 282:
+			goto st_case_282
+		case 283:
+			goto st_case_283
+		case 284:
+			goto st_case_284
+		case 285:
+			goto st_case_285
+		case 286:
+			goto st_case_286
+		case 287:
+			goto st_case_287
+		case 288:
+			goto st_case_288
+		case 289:
+			goto st_case_289
+		case 290:
+			goto st_case_290
+		case 291:
+			goto st_case_291
+		case 292:
+			goto st_case_292
+		case 293:
+			goto st_case_293
+		case 294:
+			goto st_case_294
+		case 295:
+			goto st_case_295
+		case 296:
+			goto st_case_296
+		case 297:
+			goto st_case_297
+		case 298:
+			goto st_case_298
+		case 299:
+			goto st_case_299
+		case 300:
+			goto st_case_300
+		case 301:
+			goto st_case_301
+		case 302:
+			goto st_case_302
+		case 303:
+			goto st_case_303
+		case 304:
+			goto st_case_304
+		case 305:
+			goto st_case_305
+		case 306:
+			goto st_case_306
+		case 307:
+			goto st_case_307
+		case 308:
+			goto st_case_308
+		case 309:
+			goto st_case_309
+		case 310:
+			goto st_case_310
+		case 311:
+			goto st_case_311
+		case 312:
+			goto st_case_312
+		case 313:
+			goto st_case_313
+		case 314:
+			goto st_case_314
+		case 315:
+			goto st_case_315
+		case 316:
+			goto st_case_316
+		case 317:
+			goto st_case_317
+		case 318:
+			goto st_case_318
+		case 319:
+			goto st_case_319
+		case 320:
+			goto st_case_320
+		case 321:
+			goto st_case_321
+		case 322:
+			goto st_case_322
+		case 323:
+			goto st_case_323
+		case 324:
+			goto st_case_324
+		case 325:
+			goto st_case_325
+		case 326:
+			goto st_case_326
+		case 389:
+			goto st_case_389
+		case 327:
+			goto st_case_327
+		case 328:
+			goto st_case_328
+		case 329:
+			goto st_case_329
+		case 330:
+			goto st_case_330
+		case 331:
+			goto st_case_331
+		case 332:
+			goto st_case_332
+		case 333:
+			goto st_case_333
+		case 334:
+			goto st_case_334
+		case 335:
+			goto st_case_335
+		case 336:
+			goto st_case_336
+		case 337:
+			goto st_case_337
+		case 338:
+			goto st_case_338
+		case 339:
+			goto st_case_339
+		case 340:
+			goto st_case_340
+		case 341:
+			goto st_case_341
+		case 342:
+			goto st_case_342
+		case 343:
+			goto st_case_343
+		case 344:
+			goto st_case_344
+		case 345:
+			goto st_case_345
+		case 346:
+			goto st_case_346
+		case 347:
+			goto st_case_347
+		case 348:
+			goto st_case_348
+		case 349:
+			goto st_case_349
+		case 350:
+			goto st_case_350
+		case 351:
+			goto st_case_351
+		case 352:
+			goto st_case_352
+		case 353:
+			goto st_case_353
+		case 354:
+			goto st_case_354
+		case 355:
+			goto st_case_355
+		case 356:
+			goto st_case_356
+		case 357:
+			goto st_case_357
+		case 358:
+			goto st_case_358
+		case 359:
+			goto st_case_359
+		case 360:
+			goto st_case_360
+		case 361:
+			goto st_case_361
+		case 362:
+			goto st_case_362
+		case 363:
+			goto st_case_363
+		case 364:
+			goto st_case_364
+		case 365:
+			goto st_case_365
+		case 366:
+			goto st_case_366
+		case 367:
+			goto st_case_367
+		case 368:
+			goto st_case_368
+		case 369:
+			goto st_case_369
+		case 370:
+			goto st_case_370
+		case 371:
+			goto st_case_371
+		case 372:
+			goto st_case_372
+		case 373:
+			goto st_case_373
+		case 374:
+			goto st_case_374
+		case 375:
+			goto st_case_375
+		case 376:
+			goto st_case_376
+		case 377:
+			goto st_case_377
+		case 378:
+			goto st_case_378
+		case 390:
+			goto st_case_390
+		case 379:
+			goto st_case_379
+		case 380:
+			goto st_case_380
+		}
+		goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 110:
+			goto st3
+		case 123:
+			goto tr3
+		case 559:
+			goto st149
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	tr0:
+		expected = "object"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr5:
+		expected = "null"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr9:
+		expected = "string or '}'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr14:
+		expected = "string character"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr19:
+		expected = "':'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr26:
+		expected = "value"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr44:
+		expected = "',' or '}'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr49:
+		expected = "string"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr81:
+		expected = "'/' or '*'"
+		expected = "',' or '}'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr83:
+		expected = "escape sequence"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr86:
+		expected = "hex digit"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr91:
+		expected = "digit"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr107:
+		expected = "Infinity"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr115:
+		expected = "false"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr123:
+		expected = "true"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr127:
+		expected = "'/' or '*'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr129:
+		expected = "NaN"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr132:
+		expected = "'/' or '*'"
+		expected = "':'"
+
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr187:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr202:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr207:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr212:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr231:
+		expected = "'/' or '*'"
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr233:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr236:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr241:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr252:
+		expected = "Infinity"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr260:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr265:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr269:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr273:
+		expected = "'/' or '*'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr275:
+		expected = "NaN"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr310:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr315:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr320:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr324:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr342:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr347:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr376:
+		expected = "'/' or '*'"
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr378:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr381:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr386:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr397:
+		expected = "Infinity"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr405:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr410:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr414:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr418:
+		expected = "'/' or '*'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr420:
+		expected = "NaN"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr423:
+		expected = "'/' or '*'"
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 110:
+			goto st3
+		case 123:
+			goto tr3
+		case 559:
+			goto st149
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if data[p] == 117 {
+			goto st4
+		}
+		goto tr5
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		if data[p] == 108 {
+			goto st5
+		}
+		goto tr5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if data[p] == 108 {
+			goto st381
+		}
+		goto tr5
+	st381:
+		if p++; p == pe {
+			goto _test_eof381
+		}
+	st_case_381:
+		goto st0
+	tr3:
+
+		if buffer.Limits.MaxDepth > 0 && depth >= buffer.Limits.MaxDepth {
+			return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+		}
+
+		goto st6
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st7
+		case 32:
+			goto st7
+		case 34:
+			goto tr11
+		case 125:
+			goto st384
+		case 559:
+			goto st147
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st7
+		}
+		goto tr9
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st7
+		case 32:
+			goto st7
+		case 34:
+			goto tr11
+		case 125:
+			goto st384
+		case 559:
+			goto st147
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st7
+		}
+		goto tr9
+	tr11:
+		currentFieldStart = p
+
+		count++
+		if buffer.Limits.MaxObjectKeys > 0 && count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st8
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st139
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st9
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st139
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st9
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	tr16:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st10
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr20
+		case 32:
+			goto tr20
+		case 58:
+			goto tr21
+		case 559:
+			goto tr22
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr20
+		}
+		goto tr19
+	tr20:
+		currentFieldEnd = p
+		goto st11
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st11
+		case 32:
+			goto st11
+		case 58:
+			goto st12
+		case 559:
+			goto st137
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st11
+		}
+		goto tr19
+	tr21:
+		currentFieldEnd = p
+		goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st13
+		case 32:
+			goto st13
+		case 34:
+			goto tr28
+		case 45:
+			goto tr29
+		case 48:
+			goto tr30
+		case 91:
+			goto tr32
+		case 102:
+			goto tr33
+		case 110:
+			goto tr34
+		case 116:
+			goto tr35
+		case 123:
+			goto tr36
+		case 559:
+			goto st132
+		case 1097:
+			goto tr38
+		case 1102:
+			goto tr39
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr31
+			}
+		case _widec >= 9:
+			goto st13
+		}
+		goto tr26
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st13
+		case 32:
+			goto st13
+		case 34:
+			goto tr28
+		case 45:
+			goto tr29
+		case 48:
+			goto tr30
+		case 91:
+			goto tr32
+		case 102:
+			goto tr33
+		case 110:
+			goto tr34
+		case 116:
+			goto tr35
+		case 123:
+			goto tr36
+		case 559:
+			goto st132
+		case 1097:
+			goto tr38
+		case 1102:
+			goto tr39
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr31
+			}
+		case _widec >= 9:
+			goto st13
+		}
+		goto tr26
+	tr28:
+		tokenStart = p
+		goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		switch data[p] {
+		case 34:
+			goto tr41
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st15
+			}
+		default:
+			goto tr14
+		}
+		goto tr43
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		switch data[p] {
+		case 34:
+			goto tr41
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st15
+			}
+		default:
+			goto tr14
+		}
+		goto tr43
+	tr41:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st16
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr95:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st17
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr96:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st18
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st19
+		case 32:
+			goto st19
+		case 34:
+			goto tr51
+		case 559:
+			goto st88
+		case 637:
+			goto st383
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st19
+		}
+		goto tr49
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st19
+		case 32:
+			goto st19
+		case 34:
+			goto tr51
+		case 559:
+			goto st88
+		case 637:
+			goto st383
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st19
+		}
+		goto tr49
+	tr51:
+		currentFieldStart = p
+
+		count++
+		if buffer.Limits.MaxObjectKeys > 0 && count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st20
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		switch data[p] {
+		case 34:
+			goto tr55
+		case 92:
+			goto st80
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st21
+			}
+		default:
+			goto tr14
+		}
+		goto tr57
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		switch data[p] {
+		case 34:
+			goto tr55
+		case 92:
+			goto st80
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st21
+			}
+		default:
+			goto tr14
+		}
+		goto tr57
+	tr55:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st22
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr58
+		case 32:
+			goto tr58
+		case 58:
+			goto tr59
+		case 559:
+			goto tr60
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr58
+		}
+		goto tr19
+	tr58:
+		currentFieldEnd = p
+		goto st23
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st23
+		case 32:
+			goto st23
+		case 58:
+			goto st24
+		case 559:
+			goto st78
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st23
+		}
+		goto tr19
+	tr59:
+		currentFieldEnd = p
+		goto st24
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st25
+		case 32:
+			goto st25
+		case 34:
+			goto tr65
+		case 45:
+			goto tr66
+		case 48:
+			goto tr67
+		case 91:
+			goto tr69
+		case 102:
+			goto tr70
+		case 110:
+			goto tr71
+		case 116:
+			goto tr72
+		case 123:
+			goto tr73
+		case 559:
+			goto st73
+		case 1097:
+			goto tr75
+		case 1102:
+			goto tr76
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr68
+			}
+		case _widec >= 9:
+			goto st25
+		}
+		goto tr26
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st25
+		case 32:
+			goto st25
+		case 34:
+			goto tr65
+		case 45:
+			goto tr66
+		case 48:
+			goto tr67
+		case 91:
+			goto tr69
+		case 102:
+			goto tr70
+		case 110:
+			goto tr71
+		case 116:
+			goto tr72
+		case 123:
+			goto tr73
+		case 559:
+			goto st73
+		case 1097:
+			goto tr75
+		case 1102:
+			goto tr76
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr68
+			}
+		case _widec >= 9:
+			goto st25
+		}
+		goto tr26
+	tr65:
+		tokenStart = p
+		goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch data[p] {
+		case 34:
+			goto tr78
+		case 92:
+			goto st31
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st27
+			}
+		default:
+			goto tr14
+		}
+		goto tr80
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 34:
+			goto tr78
+		case 92:
+			goto st31
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st27
+			}
+		default:
+			goto tr14
+		}
+		goto tr80
+	tr78:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st28
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr99:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st382
+	st382:
+		if p++; p == pe {
+			goto _test_eof382
+		}
+	st_case_382:
+		goto st0
+	tr100:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[tokenStart:])
+		if err != nil {
+			return tokenStart, stack, "", err
+		}
+
+		goto st29
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 42:
+			goto tr82
+		case 47:
+			goto tr82
+		}
+		goto tr81
+	tr82:
+		p = skipComment(data, p, pe)
+		goto st30
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 34:
+			goto st32
+		case 47:
+			goto st32
+		case 92:
+			goto st32
+		case 98:
+			goto st32
+		case 102:
+			goto st32
+		case 110:
+			goto st32
+		case 114:
+			goto st32
+		case 116:
+			goto st32
+		case 117:
+			goto st34
+		}
+		goto tr83
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 34:
+			goto tr78
+		case 92:
+			goto st31
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st27
+			}
+		default:
+			goto tr14
+		}
+		goto tr80
+	tr80:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 33
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 34:
+			goto tr78
+		case 92:
+			goto st31
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st27
+			}
+		default:
+			goto tr14
+		}
+		goto tr80
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st35
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st35
+			}
+		default:
+			goto st35
+		}
+		goto tr86
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st36
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st36
+			}
+		default:
+			goto st36
+		}
+		goto tr86
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st37
+			}
+		default:
+			goto st37
+		}
+		goto tr86
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr90
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr90
+			}
+		default:
+			goto tr90
+		}
+		goto tr86
+	tr90:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 38
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st38
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 34:
+			goto tr78
+		case 92:
+			goto st31
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st27
+			}
+		default:
+			goto tr14
+		}
+		goto tr80
+	tr66:
+		tokenStart = p
+		goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st40
+		case 1097:
+			goto st50
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st48
+		}
+		goto tr91
+	tr67:
+		tokenStart = p
+		goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st41
+		case 69:
+			goto st44
+		case 101:
+			goto st44
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st42
+		}
+		goto tr91
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 69:
+			goto st44
+		case 101:
+			goto st44
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st43
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 69:
+			goto st44
+		case 101:
+			goto st44
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st43
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 43:
+			goto st45
+		case 45:
+			goto st45
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st46
+		}
+		goto tr91
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st46
+		}
+		goto tr91
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st47
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st47
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	tr68:
+		tokenStart = p
+		goto st48
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st41
+		case 69:
+			goto st44
+		case 101:
+			goto st44
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st49
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st41
+		case 69:
+			goto st44
+		case 101:
+			goto st44
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st49
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	tr75:
+		tokenStart = p
+		goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 110 {
+			goto st51
+		}
+		goto tr107
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 102 {
+			goto st52
+		}
+		goto tr107
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		if data[p] == 105 {
+			goto st53
+		}
+		goto tr107
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 110 {
+			goto st54
+		}
+		goto tr107
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		if data[p] == 105 {
+			goto st55
+		}
+		goto tr107
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 116 {
+			goto st56
+		}
+		goto tr107
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		if data[p] == 121 {
+			goto st57
+		}
+		goto tr107
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	tr69:
+
+		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 58
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 58
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 58
+				top++
+				goto st151
+			}
+		}
+		goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr70:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 97 {
+			goto st60
+		}
+		goto tr115
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		if data[p] == 108 {
+			goto st61
+		}
+		goto tr115
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		if data[p] == 115 {
+			goto st62
+		}
+		goto tr115
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 101 {
+			goto st63
+		}
+		goto tr115
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr71:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st64
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 117 {
+			goto st65
+		}
+		goto tr5
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 108 {
+			goto st66
+		}
+		goto tr5
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 108 {
+			goto st67
+		}
+		goto tr5
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr72:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st68
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 114 {
+			goto st69
+		}
+		goto tr123
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 117 {
+			goto st70
+		}
+		goto tr123
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		if data[p] == 101 {
+			goto st71
+		}
+		goto tr123
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr73:
+
+		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 72
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 72
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 72
+				top++
+				goto st248
+			}
+		}
+		goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 42:
+			goto tr128
+		case 47:
+			goto tr128
+		}
+		goto tr127
+	tr128:
+		p = skipComment(data, p, pe)
+		goto st74
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st25
+		case 32:
+			goto st25
+		case 34:
+			goto tr65
+		case 45:
+			goto tr66
+		case 48:
+			goto tr67
+		case 91:
+			goto tr69
+		case 102:
+			goto tr70
+		case 110:
+			goto tr71
+		case 116:
+			goto tr72
+		case 123:
+			goto tr73
+		case 559:
+			goto st73
+		case 1097:
+			goto tr75
+		case 1102:
+			goto tr76
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr68
+			}
+		case _widec >= 9:
+			goto st25
+		}
+		goto tr26
+	tr76:
+		tokenStart = p
+		goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		if data[p] == 97 {
+			goto st76
+		}
+		goto tr129
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 78 {
+			goto st77
+		}
+		goto tr129
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	tr60:
+		currentFieldEnd = p
+		goto st78
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		switch data[p] {
+		case 42:
+			goto tr133
+		case 47:
+			goto tr133
+		}
+		goto tr132
+	tr133:
+		p = skipComment(data, p, pe)
+		goto st79
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st23
+		case 32:
+			goto st23
+		case 58:
+			goto st24
+		case 559:
+			goto st78
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st23
+		}
+		goto tr19
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch data[p] {
+		case 34:
+			goto st81
+		case 47:
+			goto st81
+		case 92:
+			goto st81
+		case 98:
+			goto st81
+		case 102:
+			goto st81
+		case 110:
+			goto st81
+		case 114:
+			goto st81
+		case 116:
+			goto st81
+		case 117:
+			goto st83
+		}
+		goto tr83
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		switch data[p] {
+		case 34:
+			goto tr55
+		case 92:
+			goto st80
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st21
+			}
+		default:
+			goto tr14
+		}
+		goto tr57
+	tr57:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 82
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st82
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		switch data[p] {
+		case 34:
+			goto tr55
+		case 92:
+			goto st80
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st21
+			}
+		default:
+			goto tr14
+		}
+		goto tr57
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st84
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st84
+			}
+		default:
+			goto st84
+		}
+		goto tr86
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st85
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st85
+			}
+		default:
+			goto st85
+		}
+		goto tr86
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st86
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st86
+			}
+		default:
+			goto st86
+		}
+		goto tr86
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr139
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr139
+			}
+		default:
+			goto tr139
+		}
+		goto tr86
+	tr139:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 87
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st87
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		switch data[p] {
+		case 34:
+			goto tr55
+		case 92:
+			goto st80
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st21
+			}
+		default:
+			goto tr14
+		}
+		goto tr57
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch data[p] {
+		case 42:
+			goto tr140
+		case 47:
+			goto tr140
+		}
+		goto tr127
+	tr140:
+		p = skipComment(data, p, pe)
+		goto st89
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st19
+		case 32:
+			goto st19
+		case 34:
+			goto tr51
+		case 559:
+			goto st88
+		case 637:
+			goto st383
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st19
+		}
+		goto tr49
+	st383:
+		if p++; p == pe {
+			goto _test_eof383
+		}
+	st_case_383:
+		goto st0
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 34:
+			goto st91
+		case 47:
+			goto st91
+		case 92:
+			goto st91
+		case 98:
+			goto st91
+		case 102:
+			goto st91
+		case 110:
+			goto st91
+		case 114:
+			goto st91
+		case 116:
+			goto st91
+		case 117:
+			goto st93
+		}
+		goto tr83
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch data[p] {
+		case 34:
+			goto tr41
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st15
+			}
+		default:
+			goto tr14
+		}
+		goto tr43
+	tr43:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 92
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st92
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch data[p] {
+		case 34:
+			goto tr41
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st15
+			}
+		default:
+			goto tr14
+		}
+		goto tr43
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st94
+			}
+		default:
+			goto st94
+		}
+		goto tr86
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st95
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st95
+			}
+		default:
+			goto st95
+		}
+		goto tr86
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st96
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto tr86
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr146
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr146
+			}
+		default:
+			goto tr146
+		}
+		goto tr86
+	tr146:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 97
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st97
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		switch data[p] {
+		case 34:
+			goto tr41
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st15
+			}
+		default:
+			goto tr14
+		}
+		goto tr43
+	tr29:
+		tokenStart = p
+		goto st98
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st99
+		case 1097:
+			goto st109
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st107
+		}
+		goto tr91
+	tr30:
+		tokenStart = p
+		goto st99
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st100
+		case 69:
+			goto st103
+		case 101:
+			goto st103
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st101
+		}
+		goto tr91
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 69:
+			goto st103
+		case 101:
+			goto st103
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st102
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 69:
+			goto st103
+		case 101:
+			goto st103
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st102
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		switch data[p] {
+		case 43:
+			goto st104
+		case 45:
+			goto st104
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st105
+		}
+		goto tr91
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st105
+		}
+		goto tr91
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st106
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st106
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	tr31:
+		tokenStart = p
+		goto st107
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st100
+		case 69:
+			goto st103
+		case 101:
+			goto st103
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st108
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 46:
+			goto st100
+		case 69:
+			goto st103
+		case 101:
+			goto st103
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st108
+			}
+		case _widec >= 9:
+			goto tr95
+		}
+		goto tr44
+	tr38:
+		tokenStart = p
+		goto st109
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		if data[p] == 110 {
+			goto st110
+		}
+		goto tr107
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 102 {
+			goto st111
+		}
+		goto tr107
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		if data[p] == 105 {
+			goto st112
+		}
+		goto tr107
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		if data[p] == 110 {
+			goto st113
+		}
+		goto tr107
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 105 {
+			goto st114
+		}
+		goto tr107
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 116 {
+			goto st115
+		}
+		goto tr107
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 121 {
+			goto st116
+		}
+		goto tr107
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	tr32:
+
+		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 117
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 117
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 117
+				top++
+				goto st151
+			}
+		}
+		goto st117
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr33:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st118
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 97 {
+			goto st119
+		}
+		goto tr115
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		if data[p] == 108 {
+			goto st120
+		}
+		goto tr115
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 115 {
+			goto st121
+		}
+		goto tr115
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		if data[p] == 101 {
+			goto st122
+		}
+		goto tr115
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr34:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st123
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 117 {
+			goto st124
+		}
+		goto tr5
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 108 {
+			goto st125
+		}
+		goto tr5
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		if data[p] == 108 {
+			goto st126
+		}
+		goto tr5
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr35:
+
+		_, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p, stack, "", err
+		}
+
+		goto st127
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 114 {
+			goto st128
+		}
+		goto tr123
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		if data[p] == 117 {
+			goto st129
+		}
+		goto tr123
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 101 {
+			goto st130
+		}
+		goto tr123
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	tr36:
+
+		pp, err = handler.HandleObjectValue(data[currentFieldStart+1:currentFieldEnd-1], data[p:])
+		if err != nil {
+			return p + pp, stack, "", err
+		}
+		if pp < 0 {
+			err = ErrPOutOfRange
+			{
+				p++
+				cs = 131
+				goto _out
+			}
+		}
+		if pp != 0 {
+			if p+pp-1 >= pe {
+				if p+pp <= len(data) {
+					return pe - 1, stack, "", buffer.documentSizeError(data)
+				}
+				err = ErrPOutOfRange
+				{
+					p++
+					cs = 131
+					goto _out
+				}
+			}
+			p = (p + pp - 1) - 1
+
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 131
+				top++
+				goto st248
+			}
+		}
+		goto st131
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st17
+		case 32:
+			goto st17
+		case 44:
+			goto st18
+		case 125:
+			goto st382
+		case 559:
+			goto st29
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st17
+		}
+		goto tr44
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		switch data[p] {
+		case 42:
+			goto tr175
+		case 47:
+			goto tr175
+		}
+		goto tr127
+	tr175:
+		p = skipComment(data, p, pe)
+		goto st133
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st13
+		case 32:
+			goto st13
+		case 34:
+			goto tr28
+		case 45:
+			goto tr29
+		case 48:
+			goto tr30
+		case 91:
+			goto tr32
+		case 102:
+			goto tr33
+		case 110:
+			goto tr34
+		case 116:
+			goto tr35
+		case 123:
+			goto tr36
+		case 559:
+			goto st132
+		case 1097:
+			goto tr38
+		case 1102:
+			goto tr39
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr31
+			}
+		case _widec >= 9:
+			goto st13
+		}
+		goto tr26
+	tr39:
+		tokenStart = p
+		goto st134
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if data[p] == 97 {
+			goto st135
+		}
+		goto tr129
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		if data[p] == 78 {
+			goto st136
+		}
+		goto tr129
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr95
+		case 32:
+			goto tr95
+		case 44:
+			goto tr96
+		case 125:
+			goto tr99
+		case 559:
+			goto tr100
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr95
+		}
+		goto tr44
+	tr22:
+		currentFieldEnd = p
+		goto st137
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		switch data[p] {
+		case 42:
+			goto tr178
+		case 47:
+			goto tr178
+		}
+		goto tr132
+	tr178:
+		p = skipComment(data, p, pe)
+		goto st138
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st11
+		case 32:
+			goto st11
+		case 58:
+			goto st12
+		case 559:
+			goto st137
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st11
+		}
+		goto tr19
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		switch data[p] {
+		case 34:
+			goto st140
+		case 47:
+			goto st140
+		case 92:
+			goto st140
+		case 98:
+			goto st140
+		case 102:
+			goto st140
+		case 110:
+			goto st140
+		case 114:
+			goto st140
+		case 116:
+			goto st140
+		case 117:
+			goto st142
+		}
+		goto tr83
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st139
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st9
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	tr18:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 141
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st141
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st139
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st9
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st143
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st143
+			}
+		default:
+			goto st143
+		}
+		goto tr86
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st144
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st144
+			}
+		default:
+			goto st144
+		}
+		goto tr86
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st145
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st145
+			}
+		default:
+			goto st145
+		}
+		goto tr86
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr184
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr184
+			}
+		default:
+			goto tr184
+		}
+		goto tr86
+	tr184:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 146
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st146
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st139
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st9
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	st384:
+		if p++; p == pe {
+			goto _test_eof384
+		}
+	st_case_384:
+		goto st0
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		switch data[p] {
+		case 42:
+			goto tr185
+		case 47:
+			goto tr185
+		}
+		goto tr127
+	tr185:
+		p = skipComment(data, p, pe)
+		goto st148
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st7
+		case 32:
+			goto st7
+		case 34:
+			goto tr11
+		case 125:
+			goto st384
+		case 559:
+			goto st147
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st7
+		}
+		goto tr9
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch data[p] {
+		case 42:
+			goto tr186
+		case 47:
+			goto tr186
+		}
+		goto tr127
+	tr186:
+		p = skipComment(data, p, pe)
+		goto st150
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 110:
+			goto st3
+		case 123:
+			goto tr3
+		case 559:
+			goto st149
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st2
+		}
+		goto tr0
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st152
+		case 32:
+			goto st152
+		case 34:
+			goto tr189
+		case 45:
+			goto tr190
+		case 48:
+			goto tr191
+		case 91:
+			goto tr193
+		case 93:
+			goto tr194
+		case 102:
+			goto tr195
+		case 110:
+			goto tr196
+		case 116:
+			goto tr197
+		case 123:
+			goto tr198
+		case 559:
+			goto st243
+		case 1097:
+			goto tr200
+		case 1102:
+			goto tr201
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr192
+			}
+		case _widec >= 9:
+			goto st152
+		}
+		goto tr187
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st152
+		case 32:
+			goto st152
+		case 34:
+			goto tr189
+		case 45:
+			goto tr190
+		case 48:
+			goto tr191
+		case 91:
+			goto tr193
+		case 93:
+			goto tr194
+		case 102:
+			goto tr195
+		case 110:
+			goto tr196
+		case 116:
+			goto tr197
+		case 123:
+			goto tr198
+		case 559:
+			goto st243
+		case 1097:
+			goto tr200
+		case 1102:
+			goto tr201
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr192
+			}
+		case _widec >= 9:
+			goto st152
+		}
+		goto tr187
+	tr189:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st153
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch data[p] {
+		case 34:
+			goto tr204
+		case 92:
+			goto st206
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st154
+			}
+		default:
+			goto tr202
+		}
+		goto tr206
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		switch data[p] {
+		case 34:
+			goto tr204
+		case 92:
+			goto st206
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st154
+			}
+		default:
+			goto tr202
+		}
+		goto tr206
+	tr204:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st155
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr245:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st156
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr246:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st157
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st158
+		case 32:
+			goto st158
+		case 34:
+			goto tr214
+		case 45:
+			goto tr215
+		case 48:
+			goto tr216
+		case 91:
+			goto tr218
+		case 102:
+			goto tr219
+		case 110:
+			goto tr220
+		case 116:
+			goto tr221
+		case 123:
+			goto tr222
+		case 559:
+			goto st201
+		case 605:
+			goto tr224
+		case 1097:
+			goto tr225
+		case 1102:
+			goto tr226
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr217
+			}
+		case _widec >= 9:
+			goto st158
+		}
+		goto tr212
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st158
+		case 32:
+			goto st158
+		case 34:
+			goto tr214
+		case 45:
+			goto tr215
+		case 48:
+			goto tr216
+		case 91:
+			goto tr218
+		case 102:
+			goto tr219
+		case 110:
+			goto tr220
+		case 116:
+			goto tr221
+		case 123:
+			goto tr222
+		case 559:
+			goto st201
+		case 605:
+			goto tr224
+		case 1097:
+			goto tr225
+		case 1102:
+			goto tr226
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr217
+			}
+		case _widec >= 9:
+			goto st158
+		}
+		goto tr212
+	tr214:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st159
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 34:
+			goto tr228
+		case 92:
+			goto st164
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st160
+			}
+		default:
+			goto tr202
+		}
+		goto tr230
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch data[p] {
+		case 34:
+			goto tr228
+		case 92:
+			goto st164
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st160
+			}
+		default:
+			goto tr202
+		}
+		goto tr230
+	tr228:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st161
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr210:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st385
+	tr249:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st385
+	st385:
+		if p++; p == pe {
+			goto _test_eof385
+		}
+	st_case_385:
+		goto st0
+	tr250:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st162
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		switch data[p] {
+		case 42:
+			goto tr232
+		case 47:
+			goto tr232
+		}
+		goto tr231
+	tr232:
+		p = skipComment(data, p, pe)
+		goto st163
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		switch data[p] {
+		case 34:
+			goto st165
+		case 47:
+			goto st165
+		case 92:
+			goto st165
+		case 98:
+			goto st165
+		case 102:
+			goto st165
+		case 110:
+			goto st165
+		case 114:
+			goto st165
+		case 116:
+			goto st165
+		case 117:
+			goto st167
+		}
+		goto tr233
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		switch data[p] {
+		case 34:
+			goto tr228
+		case 92:
+			goto st164
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st160
+			}
+		default:
+			goto tr202
+		}
+		goto tr230
+	tr230:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 166
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st166
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch data[p] {
+		case 34:
+			goto tr228
+		case 92:
+			goto st164
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st160
+			}
+		default:
+			goto tr202
+		}
+		goto tr230
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st168
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st168
+			}
+		default:
+			goto st168
+		}
+		goto tr236
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st169
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st169
+			}
+		default:
+			goto st169
+		}
+		goto tr236
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st170
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st170
+			}
+		default:
+			goto st170
+		}
+		goto tr236
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr240
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr240
+			}
+		default:
+			goto tr240
+		}
+		goto tr236
+	tr240:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 171
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st171
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		switch data[p] {
+		case 34:
+			goto tr228
+		case 92:
+			goto st164
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st160
+			}
+		default:
+			goto tr202
+		}
+		goto tr230
+	tr215:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st172
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st173
+		case 1097:
+			goto st178
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st176
+		}
+		goto tr241
+	tr216:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st173
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr247
+		case 69:
+			goto tr248
+		case 93:
+			goto tr249
+		case 101:
+			goto tr248
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr247:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 174
+				goto _out
+			}
+		}
+
+		goto st174
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr248:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 175
+				goto _out
+			}
+		}
+
+		goto st175
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr217:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st176
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr247
+		case 69:
+			goto tr248
+		case 93:
+			goto tr249
+		case 101:
+			goto tr248
+		case 559:
+			goto tr250
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st177
+			}
+		case _widec >= 9:
+			goto tr245
+		}
+		goto tr207
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr247
+		case 69:
+			goto tr248
+		case 93:
+			goto tr249
+		case 101:
+			goto tr248
+		case 559:
+			goto tr250
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st177
+			}
+		case _widec >= 9:
+			goto tr245
+		}
+		goto tr207
+	tr225:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st178
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		if data[p] == 110 {
+			goto st179
+		}
+		goto tr252
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		if data[p] == 102 {
+			goto st180
+		}
+		goto tr252
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		if data[p] == 105 {
+			goto st181
+		}
+		goto tr252
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		if data[p] == 110 {
+			goto st182
+		}
+		goto tr252
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 105 {
+			goto st183
+		}
+		goto tr252
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 116 {
+			goto st184
+		}
+		goto tr252
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		if data[p] == 121 {
+			goto st185
+		}
+		goto tr252
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr218:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 186
+				top++
+				goto st151
+			}
+		}
+		goto st186
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr219:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st187
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 97 {
+			goto st188
+		}
+		goto tr260
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		if data[p] == 108 {
+			goto st189
+		}
+		goto tr260
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		if data[p] == 115 {
+			goto st190
+		}
+		goto tr260
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		if data[p] == 101 {
+			goto st191
+		}
+		goto tr260
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr220:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st192
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		if data[p] == 117 {
+			goto st193
+		}
+		goto tr265
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		if data[p] == 108 {
+			goto st194
+		}
+		goto tr265
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		if data[p] == 108 {
+			goto st195
+		}
+		goto tr265
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr221:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st196
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		if data[p] == 114 {
+			goto st197
+		}
+		goto tr269
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		if data[p] == 117 {
+			goto st198
+		}
+		goto tr269
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		if data[p] == 101 {
+			goto st199
+		}
+		goto tr269
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr222:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 200
+				top++
+				goto st248
+			}
+		}
+		goto st200
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch data[p] {
+		case 42:
+			goto tr274
+		case 47:
+			goto tr274
+		}
+		goto tr273
+	tr274:
+		p = skipComment(data, p, pe)
+		goto st202
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st158
+		case 32:
+			goto st158
+		case 34:
+			goto tr214
+		case 45:
+			goto tr215
+		case 48:
+			goto tr216
+		case 91:
+			goto tr218
+		case 102:
+			goto tr219
+		case 110:
+			goto tr220
+		case 116:
+			goto tr221
+		case 123:
+			goto tr222
+		case 559:
+			goto st201
+		case 605:
+			goto tr224
+		case 1097:
+			goto tr225
+		case 1102:
+			goto tr226
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr217
+			}
+		case _widec >= 9:
+			goto st158
+		}
+		goto tr212
+	tr224:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st386
+	st386:
+		if p++; p == pe {
+			goto _test_eof386
+		}
+	st_case_386:
+		goto st0
+	tr226:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st203
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		if data[p] == 97 {
+			goto st204
+		}
+		goto tr275
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		if data[p] == 78 {
+			goto st205
+		}
+		goto tr275
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		switch data[p] {
+		case 34:
+			goto st207
+		case 47:
+			goto st207
+		case 92:
+			goto st207
+		case 98:
+			goto st207
+		case 102:
+			goto st207
+		case 110:
+			goto st207
+		case 114:
+			goto st207
+		case 116:
+			goto st207
+		case 117:
+			goto st209
+		}
+		goto tr233
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch data[p] {
+		case 34:
+			goto tr204
+		case 92:
+			goto st206
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st154
+			}
+		default:
+			goto tr202
+		}
+		goto tr206
+	tr206:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 208
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st208
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		switch data[p] {
+		case 34:
+			goto tr204
+		case 92:
+			goto st206
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st154
+			}
+		default:
+			goto tr202
+		}
+		goto tr206
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st210
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st210
+			}
+		default:
+			goto st210
+		}
+		goto tr236
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st211
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st211
+			}
+		default:
+			goto st211
+		}
+		goto tr236
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st212
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st212
+			}
+		default:
+			goto st212
+		}
+		goto tr236
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr283
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr283
+			}
+		default:
+			goto tr283
+		}
+		goto tr236
+	tr283:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 213
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st213
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		switch data[p] {
+		case 34:
+			goto tr204
+		case 92:
+			goto st206
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st154
+			}
+		default:
+			goto tr202
+		}
+		goto tr206
+	tr190:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st214
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st215
+		case 1097:
+			goto st220
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st218
+		}
+		goto tr241
+	tr191:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st215
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr287
+		case 69:
+			goto tr288
+		case 93:
+			goto tr249
+		case 101:
+			goto tr288
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr287:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 216
+				goto _out
+			}
+		}
+
+		goto st216
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr288:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 217
+				goto _out
+			}
+		}
+
+		goto st217
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr192:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st218
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr287
+		case 69:
+			goto tr288
+		case 93:
+			goto tr249
+		case 101:
+			goto tr288
+		case 559:
+			goto tr250
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st219
+			}
+		case _widec >= 9:
+			goto tr245
+		}
+		goto tr207
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 46:
+			goto tr287
+		case 69:
+			goto tr288
+		case 93:
+			goto tr249
+		case 101:
+			goto tr288
+		case 559:
+			goto tr250
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st219
+			}
+		case _widec >= 9:
+			goto tr245
+		}
+		goto tr207
+	tr200:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st220
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		if data[p] == 110 {
+			goto st221
+		}
+		goto tr252
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		if data[p] == 102 {
+			goto st222
+		}
+		goto tr252
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		if data[p] == 105 {
+			goto st223
+		}
+		goto tr252
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		if data[p] == 110 {
+			goto st224
+		}
+		goto tr252
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		if data[p] == 105 {
+			goto st225
+		}
+		goto tr252
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 116 {
+			goto st226
+		}
+		goto tr252
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 121 {
+			goto st227
+		}
+		goto tr252
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	tr193:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 228
+				top++
+				goto st151
+			}
+		}
+		goto st228
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr194:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st387
+	st387:
+		if p++; p == pe {
+			goto _test_eof387
+		}
+	st_case_387:
+		goto st0
+	tr195:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st229
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 97 {
+			goto st230
+		}
+		goto tr260
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		if data[p] == 108 {
+			goto st231
+		}
+		goto tr260
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 115 {
+			goto st232
+		}
+		goto tr260
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		if data[p] == 101 {
+			goto st233
+		}
+		goto tr260
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr196:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st234
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 117 {
+			goto st235
+		}
+		goto tr265
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if data[p] == 108 {
+			goto st236
+		}
+		goto tr265
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		if data[p] == 108 {
+			goto st237
+		}
+		goto tr265
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr197:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st238
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		if data[p] == 114 {
+			goto st239
+		}
+		goto tr269
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		if data[p] == 117 {
+			goto st240
+		}
+		goto tr269
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		if data[p] == 101 {
+			goto st241
+		}
+		goto tr269
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	tr198:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 242
+				top++
+				goto st248
+			}
+		}
+		goto st242
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st156
+		case 32:
+			goto st156
+		case 44:
+			goto st157
+		case 93:
+			goto tr210
+		case 559:
+			goto st162
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st156
+		}
+		goto tr207
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		switch data[p] {
+		case 42:
+			goto tr307
+		case 47:
+			goto tr307
+		}
+		goto tr273
+	tr307:
+		p = skipComment(data, p, pe)
+		goto st244
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st152
+		case 32:
+			goto st152
+		case 34:
+			goto tr189
+		case 45:
+			goto tr190
+		case 48:
+			goto tr191
+		case 91:
+			goto tr193
+		case 93:
+			goto tr194
+		case 102:
+			goto tr195
+		case 110:
+			goto tr196
+		case 116:
+			goto tr197
+		case 123:
+			goto tr198
+		case 559:
+			goto st243
+		case 1097:
+			goto tr200
+		case 1102:
+			goto tr201
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr192
+			}
+		case _widec >= 9:
+			goto st152
+		}
+		goto tr187
+	tr201:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st245
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 97 {
+			goto st246
+		}
+		goto tr275
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		if data[p] == 78 {
+			goto st247
+		}
+		goto tr275
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr245
+		case 32:
+			goto tr245
+		case 44:
+			goto tr246
+		case 93:
+			goto tr249
+		case 559:
+			goto tr250
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr245
+		}
+		goto tr207
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st249
+		case 32:
+			goto st249
+		case 34:
+			goto tr312
+		case 125:
+			goto tr313
+		case 559:
+			goto st379
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st249
+		}
+		goto tr310
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st249
+		case 32:
+			goto st249
+		case 34:
+			goto tr312
+		case 125:
+			goto tr313
+		case 559:
+			goto st379
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st249
+		}
+		goto tr310
+	tr312:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st250
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		switch data[p] {
+		case 34:
+			goto tr317
+		case 92:
+			goto st371
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st251
+			}
+		default:
+			goto tr315
+		}
+		goto tr319
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		switch data[p] {
+		case 34:
+			goto tr317
+		case 92:
+			goto st371
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st251
+			}
+		default:
+			goto tr315
+		}
+		goto tr319
+	tr317:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st252
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st253
+		case 32:
+			goto st253
+		case 58:
+			goto st254
+		case 559:
+			goto st369
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st253
+		}
+		goto tr320
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st253
+		case 32:
+			goto st253
+		case 58:
+			goto st254
+		case 559:
+			goto st369
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st253
+		}
+		goto tr320
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st255
+		case 32:
+			goto st255
+		case 34:
+			goto tr326
+		case 45:
+			goto tr327
+		case 48:
+			goto tr328
+		case 91:
+			goto tr330
+		case 102:
+			goto st350
+		case 110:
+			goto st355
+		case 116:
+			goto st359
+		case 123:
+			goto tr334
+		case 559:
+			goto st364
+		case 1097:
+			goto tr336
+		case 1102:
+			goto tr337
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr329
+			}
+		case _widec >= 9:
+			goto st255
+		}
+		goto tr324
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st255
+		case 32:
+			goto st255
+		case 34:
+			goto tr326
+		case 45:
+			goto tr327
+		case 48:
+			goto tr328
+		case 91:
+			goto tr330
+		case 102:
+			goto st350
+		case 110:
+			goto st355
+		case 116:
+			goto st359
+		case 123:
+			goto tr334
+		case 559:
+			goto st364
+		case 1097:
+			goto tr336
+		case 1102:
+			goto tr337
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr329
+			}
+		case _widec >= 9:
+			goto st255
+		}
+		goto tr324
+	tr326:
+		tokenStart = p
+		goto st256
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		switch data[p] {
+		case 34:
+			goto tr339
+		case 92:
+			goto st327
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st257
+			}
+		default:
+			goto tr315
+		}
+		goto tr341
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+		switch data[p] {
+		case 34:
+			goto tr339
+		case 92:
+			goto st327
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st257
+			}
+		default:
+			goto tr315
+		}
+		goto tr341
+	tr339:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st258
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	tr390:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st259
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	tr391:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st260
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st261
+		case 32:
+			goto st261
+		case 34:
+			goto tr349
+		case 559:
+			goto st325
+		case 637:
+			goto tr351
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st261
+		}
+		goto tr347
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st261
+		case 32:
+			goto st261
+		case 34:
+			goto tr349
+		case 559:
+			goto st325
+		case 637:
+			goto tr351
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st261
+		}
+		goto tr347
+	tr349:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st262
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+		switch data[p] {
+		case 34:
+			goto tr353
+		case 92:
+			goto st317
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st263
+			}
+		default:
+			goto tr315
+		}
+		goto tr355
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		switch data[p] {
+		case 34:
+			goto tr353
+		case 92:
+			goto st317
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st263
+			}
+		default:
+			goto tr315
+		}
+		goto tr355
+	tr353:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st264
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st265
+		case 32:
+			goto st265
+		case 58:
+			goto st266
+		case 559:
+			goto st315
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st265
+		}
+		goto tr320
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st265
+		case 32:
+			goto st265
+		case 58:
+			goto st266
+		case 559:
+			goto st315
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st265
+		}
+		goto tr320
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st267
+		case 32:
+			goto st267
+		case 34:
+			goto tr360
+		case 45:
+			goto tr361
+		case 48:
+			goto tr362
+		case 91:
+			goto tr364
+		case 102:
+			goto st296
+		case 110:
+			goto st301
+		case 116:
+			goto st305
+		case 123:
+			goto tr368
+		case 559:
+			goto st310
+		case 1097:
+			goto tr370
+		case 1102:
+			goto tr371
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr363
+			}
+		case _widec >= 9:
+			goto st267
+		}
+		goto tr324
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st267
+		case 32:
+			goto st267
+		case 34:
+			goto tr360
+		case 45:
+			goto tr361
+		case 48:
+			goto tr362
+		case 91:
+			goto tr364
+		case 102:
+			goto st296
+		case 110:
+			goto st301
+		case 116:
+			goto st305
+		case 123:
+			goto tr368
+		case 559:
+			goto st310
+		case 1097:
+			goto tr370
+		case 1102:
+			goto tr371
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr363
+			}
+		case _widec >= 9:
+			goto st267
+		}
+		goto tr324
+	tr360:
+		tokenStart = p
+		goto st268
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		switch data[p] {
+		case 34:
+			goto tr373
+		case 92:
+			goto st273
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st269
+			}
+		default:
+			goto tr315
+		}
+		goto tr375
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		switch data[p] {
+		case 34:
+			goto tr373
+		case 92:
+			goto st273
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st269
+			}
+		default:
+			goto tr315
+		}
+		goto tr375
+	tr373:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st270
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	tr345:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st388
+	tr394:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st388
+	st388:
+		if p++; p == pe {
+			goto _test_eof388
+		}
+	st_case_388:
+		goto st0
+	tr395:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st271
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		switch data[p] {
+		case 42:
+			goto tr377
+		case 47:
+			goto tr377
+		}
+		goto tr376
+	tr377:
+		p = skipComment(data, p, pe)
+		goto st272
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		switch data[p] {
+		case 34:
+			goto st274
+		case 47:
+			goto st274
+		case 92:
+			goto st274
+		case 98:
+			goto st274
+		case 102:
+			goto st274
+		case 110:
+			goto st274
+		case 114:
+			goto st274
+		case 116:
+			goto st274
+		case 117:
+			goto st276
+		}
+		goto tr378
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		switch data[p] {
+		case 34:
+			goto tr373
+		case 92:
+			goto st273
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st269
+			}
+		default:
+			goto tr315
+		}
+		goto tr375
+	tr375:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 275
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st275
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		switch data[p] {
+		case 34:
+			goto tr373
+		case 92:
+			goto st273
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st269
+			}
+		default:
+			goto tr315
+		}
+		goto tr375
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st277
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st277
+			}
+		default:
+			goto st277
+		}
+		goto tr381
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st278
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st278
+			}
+		default:
+			goto st278
+		}
+		goto tr381
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st279
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st279
+			}
+		default:
+			goto st279
+		}
+		goto tr381
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr385
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr385
+			}
+		default:
+			goto tr385
+		}
+		goto tr381
+	tr385:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 280
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st280
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		switch data[p] {
+		case 34:
+			goto tr373
+		case 92:
+			goto st273
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st269
+			}
+		default:
+			goto tr315
+		}
+		goto tr375
+	tr361:
+		tokenStart = p
+		goto st281
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st282
+		case 1097:
+			goto st287
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st285
+		}
+		goto tr386
+	tr362:
+		tokenStart = p
+		goto st282
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr392
+		case 69:
+			goto tr393
+		case 101:
+			goto tr393
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr392:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 283
+				goto _out
+			}
+		}
+
+		goto st283
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr393:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 284
+				goto _out
+			}
+		}
+
+		goto st284
+	st284:
+		if p++; p == pe {
+			goto _test_eof284
+		}
+	st_case_284:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr363:
+		tokenStart = p
+		goto st285
+	st285:
+		if p++; p == pe {
+			goto _test_eof285
+		}
+	st_case_285:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr392
+		case 69:
+			goto tr393
+		case 101:
+			goto tr393
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st286
+			}
+		case _widec >= 9:
+			goto tr390
+		}
+		goto tr342
+	st286:
+		if p++; p == pe {
+			goto _test_eof286
+		}
+	st_case_286:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr392
+		case 69:
+			goto tr393
+		case 101:
+			goto tr393
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st286
+			}
+		case _widec >= 9:
+			goto tr390
+		}
+		goto tr342
+	tr370:
+		tokenStart = p
+		goto st287
+	st287:
+		if p++; p == pe {
+			goto _test_eof287
+		}
+	st_case_287:
+		if data[p] == 110 {
+			goto st288
+		}
+		goto tr397
+	st288:
+		if p++; p == pe {
+			goto _test_eof288
+		}
+	st_case_288:
+		if data[p] == 102 {
+			goto st289
+		}
+		goto tr397
+	st289:
+		if p++; p == pe {
+			goto _test_eof289
+		}
+	st_case_289:
+		if data[p] == 105 {
+			goto st290
+		}
+		goto tr397
+	st290:
+		if p++; p == pe {
+			goto _test_eof290
+		}
+	st_case_290:
+		if data[p] == 110 {
+			goto st291
+		}
+		goto tr397
+	st291:
+		if p++; p == pe {
+			goto _test_eof291
+		}
+	st_case_291:
+		if data[p] == 105 {
+			goto st292
+		}
+		goto tr397
+	st292:
+		if p++; p == pe {
+			goto _test_eof292
+		}
+	st_case_292:
+		if data[p] == 116 {
+			goto st293
+		}
+		goto tr397
+	st293:
+		if p++; p == pe {
+			goto _test_eof293
+		}
+	st_case_293:
+		if data[p] == 121 {
+			goto st294
+		}
+		goto tr397
+	st294:
+		if p++; p == pe {
+			goto _test_eof294
+		}
+	st_case_294:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr364:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 295
+				top++
+				goto st151
+			}
+		}
+		goto st295
+	st295:
+		if p++; p == pe {
+			goto _test_eof295
+		}
+	st_case_295:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st296:
+		if p++; p == pe {
+			goto _test_eof296
+		}
+	st_case_296:
+		if data[p] == 97 {
+			goto st297
+		}
+		goto tr405
+	st297:
+		if p++; p == pe {
+			goto _test_eof297
+		}
+	st_case_297:
+		if data[p] == 108 {
+			goto st298
+		}
+		goto tr405
+	st298:
+		if p++; p == pe {
+			goto _test_eof298
+		}
+	st_case_298:
+		if data[p] == 115 {
+			goto st299
+		}
+		goto tr405
+	st299:
+		if p++; p == pe {
+			goto _test_eof299
+		}
+	st_case_299:
+		if data[p] == 101 {
+			goto st300
+		}
+		goto tr405
+	st300:
+		if p++; p == pe {
+			goto _test_eof300
+		}
+	st_case_300:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st301:
+		if p++; p == pe {
+			goto _test_eof301
+		}
+	st_case_301:
+		if data[p] == 117 {
+			goto st302
+		}
+		goto tr410
+	st302:
+		if p++; p == pe {
+			goto _test_eof302
+		}
+	st_case_302:
+		if data[p] == 108 {
+			goto st303
+		}
+		goto tr410
+	st303:
+		if p++; p == pe {
+			goto _test_eof303
+		}
+	st_case_303:
+		if data[p] == 108 {
+			goto st304
+		}
+		goto tr410
+	st304:
+		if p++; p == pe {
+			goto _test_eof304
+		}
+	st_case_304:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st305:
+		if p++; p == pe {
+			goto _test_eof305
+		}
+	st_case_305:
+		if data[p] == 114 {
+			goto st306
+		}
+		goto tr414
+	st306:
+		if p++; p == pe {
+			goto _test_eof306
+		}
+	st_case_306:
+		if data[p] == 117 {
+			goto st307
+		}
+		goto tr414
+	st307:
+		if p++; p == pe {
+			goto _test_eof307
+		}
+	st_case_307:
+		if data[p] == 101 {
+			goto st308
+		}
+		goto tr414
+	st308:
+		if p++; p == pe {
+			goto _test_eof308
+		}
+	st_case_308:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	tr368:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 309
+				top++
+				goto st248
+			}
+		}
+		goto st309
+	st309:
+		if p++; p == pe {
+			goto _test_eof309
+		}
+	st_case_309:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st310:
+		if p++; p == pe {
+			goto _test_eof310
+		}
+	st_case_310:
+		switch data[p] {
+		case 42:
+			goto tr419
+		case 47:
+			goto tr419
+		}
+		goto tr418
+	tr419:
+		p = skipComment(data, p, pe)
+		goto st311
+	st311:
+		if p++; p == pe {
+			goto _test_eof311
+		}
+	st_case_311:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st267
+		case 32:
+			goto st267
+		case 34:
+			goto tr360
+		case 45:
+			goto tr361
+		case 48:
+			goto tr362
+		case 91:
+			goto tr364
+		case 102:
+			goto st296
+		case 110:
+			goto st301
+		case 116:
+			goto st305
+		case 123:
+			goto tr368
+		case 559:
+			goto st310
+		case 1097:
+			goto tr370
+		case 1102:
+			goto tr371
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr363
+			}
+		case _widec >= 9:
+			goto st267
+		}
+		goto tr324
+	tr371:
+		tokenStart = p
+		goto st312
+	st312:
+		if p++; p == pe {
+			goto _test_eof312
+		}
+	st_case_312:
+		if data[p] == 97 {
+			goto st313
+		}
+		goto tr420
+	st313:
+		if p++; p == pe {
+			goto _test_eof313
+		}
+	st_case_313:
+		if data[p] == 78 {
+			goto st314
+		}
+		goto tr420
+	st314:
+		if p++; p == pe {
+			goto _test_eof314
+		}
+	st_case_314:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	st315:
+		if p++; p == pe {
+			goto _test_eof315
+		}
+	st_case_315:
+		switch data[p] {
+		case 42:
+			goto tr424
+		case 47:
+			goto tr424
+		}
+		goto tr423
+	tr424:
+		p = skipComment(data, p, pe)
+		goto st316
+	st316:
+		if p++; p == pe {
+			goto _test_eof316
+		}
+	st_case_316:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st265
+		case 32:
+			goto st265
+		case 58:
+			goto st266
+		case 559:
+			goto st315
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st265
+		}
+		goto tr320
+	st317:
+		if p++; p == pe {
+			goto _test_eof317
+		}
+	st_case_317:
+		switch data[p] {
+		case 34:
+			goto st318
+		case 47:
+			goto st318
+		case 92:
+			goto st318
+		case 98:
+			goto st318
+		case 102:
+			goto st318
+		case 110:
+			goto st318
+		case 114:
+			goto st318
+		case 116:
+			goto st318
+		case 117:
+			goto st320
+		}
+		goto tr378
+	st318:
+		if p++; p == pe {
+			goto _test_eof318
+		}
+	st_case_318:
+		switch data[p] {
+		case 34:
+			goto tr353
+		case 92:
+			goto st317
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st263
+			}
+		default:
+			goto tr315
+		}
+		goto tr355
+	tr355:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 319
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st319
+	st319:
+		if p++; p == pe {
+			goto _test_eof319
+		}
+	st_case_319:
+		switch data[p] {
+		case 34:
+			goto tr353
+		case 92:
+			goto st317
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st263
+			}
+		default:
+			goto tr315
+		}
+		goto tr355
+	st320:
+		if p++; p == pe {
+			goto _test_eof320
+		}
+	st_case_320:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st321
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st321
+			}
+		default:
+			goto st321
+		}
+		goto tr381
+	st321:
+		if p++; p == pe {
+			goto _test_eof321
+		}
+	st_case_321:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st322
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st322
+			}
+		default:
+			goto st322
+		}
+		goto tr381
+	st322:
+		if p++; p == pe {
+			goto _test_eof322
+		}
+	st_case_322:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st323
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st323
+			}
+		default:
+			goto st323
+		}
+		goto tr381
+	st323:
+		if p++; p == pe {
+			goto _test_eof323
+		}
+	st_case_323:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr430
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr430
+			}
+		default:
+			goto tr430
+		}
+		goto tr381
+	tr430:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 324
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st324
+	st324:
+		if p++; p == pe {
+			goto _test_eof324
+		}
+	st_case_324:
+		switch data[p] {
+		case 34:
+			goto tr353
+		case 92:
+			goto st317
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st263
+			}
+		default:
+			goto tr315
+		}
+		goto tr355
+	st325:
+		if p++; p == pe {
+			goto _test_eof325
+		}
+	st_case_325:
+		switch data[p] {
+		case 42:
+			goto tr431
+		case 47:
+			goto tr431
+		}
+		goto tr418
+	tr431:
+		p = skipComment(data, p, pe)
+		goto st326
+	st326:
+		if p++; p == pe {
+			goto _test_eof326
+		}
+	st_case_326:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st261
+		case 32:
+			goto st261
+		case 34:
+			goto tr349
+		case 559:
+			goto st325
+		case 637:
+			goto tr351
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st261
+		}
+		goto tr347
+	tr351:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st389
+	st389:
+		if p++; p == pe {
+			goto _test_eof389
+		}
+	st_case_389:
+		goto st0
+	st327:
+		if p++; p == pe {
+			goto _test_eof327
+		}
+	st_case_327:
+		switch data[p] {
+		case 34:
+			goto st328
+		case 47:
+			goto st328
+		case 92:
+			goto st328
+		case 98:
+			goto st328
+		case 102:
+			goto st328
+		case 110:
+			goto st328
+		case 114:
+			goto st328
+		case 116:
+			goto st328
+		case 117:
+			goto st330
+		}
+		goto tr378
+	st328:
+		if p++; p == pe {
+			goto _test_eof328
+		}
+	st_case_328:
+		switch data[p] {
+		case 34:
+			goto tr339
+		case 92:
+			goto st327
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st257
+			}
+		default:
+			goto tr315
+		}
+		goto tr341
+	tr341:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 329
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st329
+	st329:
+		if p++; p == pe {
+			goto _test_eof329
+		}
+	st_case_329:
+		switch data[p] {
+		case 34:
+			goto tr339
+		case 92:
+			goto st327
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st257
+			}
+		default:
+			goto tr315
+		}
+		goto tr341
+	st330:
+		if p++; p == pe {
+			goto _test_eof330
+		}
+	st_case_330:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st331
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st331
+			}
+		default:
+			goto st331
+		}
+		goto tr381
+	st331:
+		if p++; p == pe {
+			goto _test_eof331
+		}
+	st_case_331:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st332
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st332
+			}
+		default:
+			goto st332
+		}
+		goto tr381
+	st332:
+		if p++; p == pe {
+			goto _test_eof332
+		}
+	st_case_332:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st333
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st333
+			}
+		default:
+			goto st333
+		}
+		goto tr381
+	st333:
+		if p++; p == pe {
+			goto _test_eof333
+		}
+	st_case_333:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr437
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr437
+			}
+		default:
+			goto tr437
+		}
+		goto tr381
+	tr437:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 334
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st334
+	st334:
+		if p++; p == pe {
+			goto _test_eof334
+		}
+	st_case_334:
+		switch data[p] {
+		case 34:
+			goto tr339
+		case 92:
+			goto st327
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st257
+			}
+		default:
+			goto tr315
+		}
+		goto tr341
+	tr327:
+		tokenStart = p
+		goto st335
+	st335:
+		if p++; p == pe {
+			goto _test_eof335
+		}
+	st_case_335:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st336
+		case 1097:
+			goto st341
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st339
+		}
+		goto tr386
+	tr328:
+		tokenStart = p
+		goto st336
+	st336:
+		if p++; p == pe {
+			goto _test_eof336
+		}
+	st_case_336:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr441
+		case 69:
+			goto tr442
+		case 101:
+			goto tr442
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr441:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 337
+				goto _out
+			}
+		}
+
+		goto st337
+	st337:
+		if p++; p == pe {
+			goto _test_eof337
+		}
+	st_case_337:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr442:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 338
+				goto _out
+			}
+		}
+
+		goto st338
+	st338:
+		if p++; p == pe {
+			goto _test_eof338
+		}
+	st_case_338:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr329:
+		tokenStart = p
+		goto st339
+	st339:
+		if p++; p == pe {
+			goto _test_eof339
+		}
+	st_case_339:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr441
+		case 69:
+			goto tr442
+		case 101:
+			goto tr442
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st340
+			}
+		case _widec >= 9:
+			goto tr390
+		}
+		goto tr342
+	st340:
+		if p++; p == pe {
+			goto _test_eof340
+		}
+	st_case_340:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 46:
+			goto tr441
+		case 69:
+			goto tr442
+		case 101:
+			goto tr442
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st340
+			}
+		case _widec >= 9:
+			goto tr390
+		}
+		goto tr342
+	tr336:
+		tokenStart = p
+		goto st341
+	st341:
+		if p++; p == pe {
+			goto _test_eof341
+		}
+	st_case_341:
+		if data[p] == 110 {
+			goto st342
+		}
+		goto tr397
+	st342:
+		if p++; p == pe {
+			goto _test_eof342
+		}
+	st_case_342:
+		if data[p] == 102 {
+			goto st343
+		}
+		goto tr397
+	st343:
+		if p++; p == pe {
+			goto _test_eof343
+		}
+	st_case_343:
+		if data[p] == 105 {
+			goto st344
+		}
+		goto tr397
+	st344:
+		if p++; p == pe {
+			goto _test_eof344
+		}
+	st_case_344:
+		if data[p] == 110 {
+			goto st345
+		}
+		goto tr397
+	st345:
+		if p++; p == pe {
+			goto _test_eof345
+		}
+	st_case_345:
+		if data[p] == 105 {
+			goto st346
+		}
+		goto tr397
+	st346:
+		if p++; p == pe {
+			goto _test_eof346
+		}
+	st_case_346:
+		if data[p] == 116 {
+			goto st347
+		}
+		goto tr397
+	st347:
+		if p++; p == pe {
+			goto _test_eof347
+		}
+	st_case_347:
+		if data[p] == 121 {
+			goto st348
+		}
+		goto tr397
+	st348:
+		if p++; p == pe {
+			goto _test_eof348
+		}
+	st_case_348:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	tr330:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 349
+				top++
+				goto st151
+			}
+		}
+		goto st349
+	st349:
+		if p++; p == pe {
+			goto _test_eof349
+		}
+	st_case_349:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st350:
+		if p++; p == pe {
+			goto _test_eof350
+		}
+	st_case_350:
+		if data[p] == 97 {
+			goto st351
+		}
+		goto tr405
+	st351:
+		if p++; p == pe {
+			goto _test_eof351
+		}
+	st_case_351:
+		if data[p] == 108 {
+			goto st352
+		}
+		goto tr405
+	st352:
+		if p++; p == pe {
+			goto _test_eof352
+		}
+	st_case_352:
+		if data[p] == 115 {
+			goto st353
+		}
+		goto tr405
+	st353:
+		if p++; p == pe {
+			goto _test_eof353
+		}
+	st_case_353:
+		if data[p] == 101 {
+			goto st354
+		}
+		goto tr405
+	st354:
+		if p++; p == pe {
+			goto _test_eof354
+		}
+	st_case_354:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st355:
+		if p++; p == pe {
+			goto _test_eof355
+		}
+	st_case_355:
+		if data[p] == 117 {
+			goto st356
+		}
+		goto tr410
+	st356:
+		if p++; p == pe {
+			goto _test_eof356
+		}
+	st_case_356:
+		if data[p] == 108 {
+			goto st357
+		}
+		goto tr410
+	st357:
+		if p++; p == pe {
+			goto _test_eof357
+		}
+	st_case_357:
+		if data[p] == 108 {
+			goto st358
+		}
+		goto tr410
+	st358:
+		if p++; p == pe {
+			goto _test_eof358
+		}
+	st_case_358:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st359:
+		if p++; p == pe {
+			goto _test_eof359
+		}
+	st_case_359:
+		if data[p] == 114 {
+			goto st360
+		}
+		goto tr414
+	st360:
+		if p++; p == pe {
+			goto _test_eof360
+		}
+	st_case_360:
+		if data[p] == 117 {
+			goto st361
+		}
+		goto tr414
+	st361:
+		if p++; p == pe {
+			goto _test_eof361
+		}
+	st_case_361:
+		if data[p] == 101 {
+			goto st362
+		}
+		goto tr414
+	st362:
+		if p++; p == pe {
+			goto _test_eof362
+		}
+	st_case_362:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	tr334:
+		{
+			if top+depth+1 == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth+1 >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 363
+				top++
+				goto st248
+			}
+		}
+		goto st363
+	st363:
+		if p++; p == pe {
+			goto _test_eof363
+		}
+	st_case_363:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st259
+		case 32:
+			goto st259
+		case 44:
+			goto st260
+		case 125:
+			goto tr345
+		case 559:
+			goto st271
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st259
+		}
+		goto tr342
+	st364:
+		if p++; p == pe {
+			goto _test_eof364
+		}
+	st_case_364:
+		switch data[p] {
+		case 42:
+			goto tr461
+		case 47:
+			goto tr461
+		}
+		goto tr418
+	tr461:
+		p = skipComment(data, p, pe)
+		goto st365
+	st365:
+		if p++; p == pe {
+			goto _test_eof365
+		}
+	st_case_365:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st255
+		case 32:
+			goto st255
+		case 34:
+			goto tr326
+		case 45:
+			goto tr327
+		case 48:
+			goto tr328
+		case 91:
+			goto tr330
+		case 102:
+			goto st350
+		case 110:
+			goto st355
+		case 116:
+			goto st359
+		case 123:
+			goto tr334
+		case 559:
+			goto st364
+		case 1097:
+			goto tr336
+		case 1102:
+			goto tr337
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr329
+			}
+		case _widec >= 9:
+			goto st255
+		}
+		goto tr324
+	tr337:
+		tokenStart = p
+		goto st366
+	st366:
+		if p++; p == pe {
+			goto _test_eof366
+		}
+	st_case_366:
+		if data[p] == 97 {
+			goto st367
+		}
+		goto tr420
+	st367:
+		if p++; p == pe {
+			goto _test_eof367
+		}
+	st_case_367:
+		if data[p] == 78 {
+			goto st368
+		}
+		goto tr420
+	st368:
+		if p++; p == pe {
+			goto _test_eof368
+		}
+	st_case_368:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr390
+		case 32:
+			goto tr390
+		case 44:
+			goto tr391
+		case 125:
+			goto tr394
+		case 559:
+			goto tr395
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr390
+		}
+		goto tr342
+	st369:
+		if p++; p == pe {
+			goto _test_eof369
+		}
+	st_case_369:
+		switch data[p] {
+		case 42:
+			goto tr464
+		case 47:
+			goto tr464
+		}
+		goto tr423
+	tr464:
+		p = skipComment(data, p, pe)
+		goto st370
+	st370:
+		if p++; p == pe {
+			goto _test_eof370
+		}
+	st_case_370:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st253
+		case 32:
+			goto st253
+		case 58:
+			goto st254
+		case 559:
+			goto st369
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st253
+		}
+		goto tr320
+	st371:
+		if p++; p == pe {
+			goto _test_eof371
+		}
+	st_case_371:
+		switch data[p] {
+		case 34:
+			goto st372
+		case 47:
+			goto st372
+		case 92:
+			goto st372
+		case 98:
+			goto st372
+		case 102:
+			goto st372
+		case 110:
+			goto st372
+		case 114:
+			goto st372
+		case 116:
+			goto st372
+		case 117:
+			goto st374
+		}
+		goto tr378
+	st372:
+		if p++; p == pe {
+			goto _test_eof372
+		}
+	st_case_372:
+		switch data[p] {
+		case 34:
+			goto tr317
+		case 92:
+			goto st371
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st251
+			}
+		default:
+			goto tr315
+		}
+		goto tr319
+	tr319:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 373
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st373
+	st373:
+		if p++; p == pe {
+			goto _test_eof373
+		}
+	st_case_373:
+		switch data[p] {
+		case 34:
+			goto tr317
+		case 92:
+			goto st371
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st251
+			}
+		default:
+			goto tr315
+		}
+		goto tr319
+	st374:
+		if p++; p == pe {
+			goto _test_eof374
+		}
+	st_case_374:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st375
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st375
+			}
+		default:
+			goto st375
+		}
+		goto tr381
+	st375:
+		if p++; p == pe {
+			goto _test_eof375
+		}
+	st_case_375:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st376
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st376
+			}
+		default:
+			goto st376
+		}
+		goto tr381
+	st376:
+		if p++; p == pe {
+			goto _test_eof376
+		}
+	st_case_376:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st377
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st377
+			}
+		default:
+			goto st377
+		}
+		goto tr381
+	st377:
+		if p++; p == pe {
+			goto _test_eof377
+		}
+	st_case_377:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr470
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr470
+			}
+		default:
+			goto tr470
+		}
+		goto tr381
+	tr470:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 378
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st378
+	st378:
+		if p++; p == pe {
+			goto _test_eof378
+		}
+	st_case_378:
+		switch data[p] {
+		case 34:
+			goto tr317
+		case 92:
+			goto st371
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st251
+			}
+		default:
+			goto tr315
+		}
+		goto tr319
+	tr313:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st390
+	st390:
+		if p++; p == pe {
+			goto _test_eof390
+		}
+	st_case_390:
+		goto st0
+	st379:
+		if p++; p == pe {
+			goto _test_eof379
+		}
+	st_case_379:
+		switch data[p] {
+		case 42:
+			goto tr471
+		case 47:
+			goto tr471
+		}
+		goto tr418
+	tr471:
+		p = skipComment(data, p, pe)
+		goto st380
+	st380:
+		if p++; p == pe {
+			goto _test_eof380
+		}
+	st_case_380:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st249
+		case 32:
+			goto st249
+		case 34:
+			goto tr312
+		case 125:
+			goto tr313
+		case 559:
+			goto st379
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st249
+		}
+		goto tr310
+	st_out:
+	_test_eof1:
+		cs = 1
+		goto _test_eof
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof381:
+		cs = 381
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+	_test_eof18:
+		cs = 18
+		goto _test_eof
+	_test_eof19:
+		cs = 19
+		goto _test_eof
+	_test_eof20:
+		cs = 20
+		goto _test_eof
+	_test_eof21:
+		cs = 21
+		goto _test_eof
+	_test_eof22:
+		cs = 22
+		goto _test_eof
+	_test_eof23:
+		cs = 23
+		goto _test_eof
+	_test_eof24:
+		cs = 24
+		goto _test_eof
+	_test_eof25:
+		cs = 25
+		goto _test_eof
+	_test_eof26:
+		cs = 26
+		goto _test_eof
+	_test_eof27:
+		cs = 27
+		goto _test_eof
+	_test_eof28:
+		cs = 28
+		goto _test_eof
+	_test_eof382:
+		cs = 382
+		goto _test_eof
+	_test_eof29:
+		cs = 29
+		goto _test_eof
+	_test_eof30:
+		cs = 30
+		goto _test_eof
+	_test_eof31:
+		cs = 31
+		goto _test_eof
+	_test_eof32:
+		cs = 32
+		goto _test_eof
+	_test_eof33:
+		cs = 33
+		goto _test_eof
+	_test_eof34:
+		cs = 34
+		goto _test_eof
+	_test_eof35:
+		cs = 35
+		goto _test_eof
+	_test_eof36:
+		cs = 36
+		goto _test_eof
+	_test_eof37:
+		cs = 37
+		goto _test_eof
+	_test_eof38:
+		cs = 38
+		goto _test_eof
+	_test_eof39:
+		cs = 39
+		goto _test_eof
+	_test_eof40:
+		cs = 40
+		goto _test_eof
+	_test_eof41:
+		cs = 41
+		goto _test_eof
+	_test_eof42:
+		cs = 42
+		goto _test_eof
+	_test_eof43:
+		cs = 43
+		goto _test_eof
+	_test_eof44:
+		cs = 44
+		goto _test_eof
+	_test_eof45:
+		cs = 45
+		goto _test_eof
+	_test_eof46:
+		cs = 46
+		goto _test_eof
+	_test_eof47:
+		cs = 47
+		goto _test_eof
+	_test_eof48:
+		cs = 48
+		goto _test_eof
+	_test_eof49:
+		cs = 49
+		goto _test_eof
+	_test_eof50:
+		cs = 50
+		goto _test_eof
+	_test_eof51:
+		cs = 51
+		goto _test_eof
+	_test_eof52:
+		cs = 52
+		goto _test_eof
+	_test_eof53:
+		cs = 53
+		goto _test_eof
+	_test_eof54:
+		cs = 54
+		goto _test_eof
+	_test_eof55:
+		cs = 55
+		goto _test_eof
+	_test_eof56:
+		cs = 56
+		goto _test_eof
+	_test_eof57:
+		cs = 57
+		goto _test_eof
+	_test_eof58:
+		cs = 58
+		goto _test_eof
+	_test_eof59:
+		cs = 59
+		goto _test_eof
+	_test_eof60:
+		cs = 60
+		goto _test_eof
+	_test_eof61:
+		cs = 61
+		goto _test_eof
+	_test_eof62:
+		cs = 62
+		goto _test_eof
+	_test_eof63:
+		cs = 63
+		goto _test_eof
+	_test_eof64:
+		cs = 64
+		goto _test_eof
+	_test_eof65:
+		cs = 65
+		goto _test_eof
+	_test_eof66:
+		cs = 66
+		goto _test_eof
+	_test_eof67:
+		cs = 67
+		goto _test_eof
+	_test_eof68:
+		cs = 68
+		goto _test_eof
+	_test_eof69:
+		cs = 69
+		goto _test_eof
+	_test_eof70:
+		cs = 70
+		goto _test_eof
+	_test_eof71:
+		cs = 71
+		goto _test_eof
+	_test_eof72:
+		cs = 72
+		goto _test_eof
+	_test_eof73:
+		cs = 73
+		goto _test_eof
+	_test_eof74:
+		cs = 74
+		goto _test_eof
+	_test_eof75:
+		cs = 75
+		goto _test_eof
+	_test_eof76:
+		cs = 76
+		goto _test_eof
+	_test_eof77:
+		cs = 77
+		goto _test_eof
+	_test_eof78:
+		cs = 78
+		goto _test_eof
+	_test_eof79:
+		cs = 79
+		goto _test_eof
+	_test_eof80:
+		cs = 80
+		goto _test_eof
+	_test_eof81:
+		cs = 81
+		goto _test_eof
+	_test_eof82:
+		cs = 82
+		goto _test_eof
+	_test_eof83:
+		cs = 83
+		goto _test_eof
+	_test_eof84:
+		cs = 84
+		goto _test_eof
+	_test_eof85:
+		cs = 85
+		goto _test_eof
+	_test_eof86:
+		cs = 86
+		goto _test_eof
+	_test_eof87:
+		cs = 87
+		goto _test_eof
+	_test_eof88:
+		cs = 88
+		goto _test_eof
+	_test_eof89:
+		cs = 89
+		goto _test_eof
+	_test_eof383:
+		cs = 383
+		goto _test_eof
+	_test_eof90:
+		cs = 90
+		goto _test_eof
+	_test_eof91:
+		cs = 91
+		goto _test_eof
+	_test_eof92:
+		cs = 92
+		goto _test_eof
+	_test_eof93:
+		cs = 93
+		goto _test_eof
+	_test_eof94:
+		cs = 94
+		goto _test_eof
+	_test_eof95:
+		cs = 95
+		goto _test_eof
+	_test_eof96:
+		cs = 96
+		goto _test_eof
+	_test_eof97:
+		cs = 97
+		goto _test_eof
+	_test_eof98:
+		cs = 98
+		goto _test_eof
+	_test_eof99:
+		cs = 99
+		goto _test_eof
+	_test_eof100:
+		cs = 100
+		goto _test_eof
+	_test_eof101:
+		cs = 101
+		goto _test_eof
+	_test_eof102:
+		cs = 102
+		goto _test_eof
+	_test_eof103:
+		cs = 103
+		goto _test_eof
+	_test_eof104:
+		cs = 104
+		goto _test_eof
+	_test_eof105:
+		cs = 105
+		goto _test_eof
+	_test_eof106:
+		cs = 106
+		goto _test_eof
+	_test_eof107:
+		cs = 107
+		goto _test_eof
+	_test_eof108:
+		cs = 108
+		goto _test_eof
+	_test_eof109:
+		cs = 109
+		goto _test_eof
+	_test_eof110:
+		cs = 110
+		goto _test_eof
+	_test_eof111:
+		cs = 111
+		goto _test_eof
+	_test_eof112:
+		cs = 112
+		goto _test_eof
+	_test_eof113:
+		cs = 113
+		goto _test_eof
+	_test_eof114:
+		cs = 114
+		goto _test_eof
+	_test_eof115:
+		cs = 115
+		goto _test_eof
+	_test_eof116:
+		cs = 116
+		goto _test_eof
+	_test_eof117:
+		cs = 117
+		goto _test_eof
+	_test_eof118:
+		cs = 118
+		goto _test_eof
+	_test_eof119:
+		cs = 119
+		goto _test_eof
+	_test_eof120:
+		cs = 120
+		goto _test_eof
+	_test_eof121:
+		cs = 121
+		goto _test_eof
+	_test_eof122:
+		cs = 122
+		goto _test_eof
+	_test_eof123:
+		cs = 123
+		goto _test_eof
+	_test_eof124:
+		cs = 124
+		goto _test_eof
+	_test_eof125:
+		cs = 125
+		goto _test_eof
+	_test_eof126:
+		cs = 126
+		goto _test_eof
+	_test_eof127:
+		cs = 127
+		goto _test_eof
+	_test_eof128:
+		cs = 128
+		goto _test_eof
+	_test_eof129:
+		cs = 129
+		goto _test_eof
+	_test_eof130:
+		cs = 130
+		goto _test_eof
+	_test_eof131:
+		cs = 131
+		goto _test_eof
+	_test_eof132:
+		cs = 132
+		goto _test_eof
+	_test_eof133:
+		cs = 133
+		goto _test_eof
+	_test_eof134:
+		cs = 134
+		goto _test_eof
+	_test_eof135:
+		cs = 135
+		goto _test_eof
+	_test_eof136:
+		cs = 136
+		goto _test_eof
+	_test_eof137:
+		cs = 137
+		goto _test_eof
+	_test_eof138:
+		cs = 138
+		goto _test_eof
+	_test_eof139:
+		cs = 139
+		goto _test_eof
+	_test_eof140:
+		cs = 140
+		goto _test_eof
+	_test_eof141:
+		cs = 141
+		goto _test_eof
+	_test_eof142:
+		cs = 142
+		goto _test_eof
+	_test_eof143:
+		cs = 143
+		goto _test_eof
+	_test_eof144:
+		cs = 144
+		goto _test_eof
+	_test_eof145:
+		cs = 145
+		goto _test_eof
+	_test_eof146:
+		cs = 146
+		goto _test_eof
+	_test_eof384:
+		cs = 384
+		goto _test_eof
+	_test_eof147:
+		cs = 147
+		goto _test_eof
+	_test_eof148:
+		cs = 148
+		goto _test_eof
+	_test_eof149:
+		cs = 149
+		goto _test_eof
+	_test_eof150:
+		cs = 150
+		goto _test_eof
+	_test_eof151:
+		cs = 151
+		goto _test_eof
+	_test_eof152:
+		cs = 152
+		goto _test_eof
+	_test_eof153:
+		cs = 153
+		goto _test_eof
+	_test_eof154:
+		cs = 154
+		goto _test_eof
+	_test_eof155:
+		cs = 155
+		goto _test_eof
+	_test_eof156:
+		cs = 156
+		goto _test_eof
+	_test_eof157:
+		cs = 157
+		goto _test_eof
+	_test_eof158:
+		cs = 158
+		goto _test_eof
+	_test_eof159:
+		cs = 159
+		goto _test_eof
+	_test_eof160:
+		cs = 160
+		goto _test_eof
+	_test_eof161:
+		cs = 161
+		goto _test_eof
+	_test_eof385:
+		cs = 385
+		goto _test_eof
+	_test_eof162:
+		cs = 162
+		goto _test_eof
+	_test_eof163:
+		cs = 163
+		goto _test_eof
+	_test_eof164:
+		cs = 164
+		goto _test_eof
+	_test_eof165:
+		cs = 165
+		goto _test_eof
+	_test_eof166:
+		cs = 166
+		goto _test_eof
+	_test_eof167:
+		cs = 167
+		goto _test_eof
+	_test_eof168:
+		cs = 168
+		goto _test_eof
+	_test_eof169:
+		cs = 169
+		goto _test_eof
+	_test_eof170:
+		cs = 170
+		goto _test_eof
+	_test_eof171:
+		cs = 171
+		goto _test_eof
+	_test_eof172:
+		cs = 172
+		goto _test_eof
+	_test_eof173:
+		cs = 173
+		goto _test_eof
+	_test_eof174:
+		cs = 174
+		goto _test_eof
+	_test_eof175:
+		cs = 175
+		goto _test_eof
+	_test_eof176:
+		cs = 176
+		goto _test_eof
+	_test_eof177:
+		cs = 177
+		goto _test_eof
+	_test_eof178:
+		cs = 178
+		goto _test_eof
+	_test_eof179:
+		cs = 179
+		goto _test_eof
+	_test_eof180:
+		cs = 180
+		goto _test_eof
+	_test_eof181:
+		cs = 181
+		goto _test_eof
+	_test_eof182:
+		cs = 182
+		goto _test_eof
+	_test_eof183:
+		cs = 183
+		goto _test_eof
+	_test_eof184:
+		cs = 184
+		goto _test_eof
+	_test_eof185:
+		cs = 185
+		goto _test_eof
+	_test_eof186:
+		cs = 186
+		goto _test_eof
+	_test_eof187:
+		cs = 187
+		goto _test_eof
+	_test_eof188:
+		cs = 188
+		goto _test_eof
+	_test_eof189:
+		cs = 189
+		goto _test_eof
+	_test_eof190:
+		cs = 190
+		goto _test_eof
+	_test_eof191:
+		cs = 191
+		goto _test_eof
+	_test_eof192:
+		cs = 192
+		goto _test_eof
+	_test_eof193:
+		cs = 193
+		goto _test_eof
+	_test_eof194:
+		cs = 194
+		goto _test_eof
+	_test_eof195:
+		cs = 195
+		goto _test_eof
+	_test_eof196:
+		cs = 196
+		goto _test_eof
+	_test_eof197:
+		cs = 197
+		goto _test_eof
+	_test_eof198:
+		cs = 198
+		goto _test_eof
+	_test_eof199:
+		cs = 199
+		goto _test_eof
+	_test_eof200:
+		cs = 200
+		goto _test_eof
+	_test_eof201:
+		cs = 201
+		goto _test_eof
+	_test_eof202:
+		cs = 202
+		goto _test_eof
+	_test_eof386:
+		cs = 386
+		goto _test_eof
+	_test_eof203:
+		cs = 203
+		goto _test_eof
+	_test_eof204:
+		cs = 204
+		goto _test_eof
+	_test_eof205:
+		cs = 205
+		goto _test_eof
+	_test_eof206:
+		cs = 206
+		goto _test_eof
+	_test_eof207:
+		cs = 207
+		goto _test_eof
+	_test_eof208:
+		cs = 208
+		goto _test_eof
+	_test_eof209:
+		cs = 209
+		goto _test_eof
+	_test_eof210:
+		cs = 210
+		goto _test_eof
+	_test_eof211:
+		cs = 211
+		goto _test_eof
+	_test_eof212:
+		cs = 212
+		goto _test_eof
+	_test_eof213:
+		cs = 213
+		goto _test_eof
+	_test_eof214:
+		cs = 214
+		goto _test_eof
+	_test_eof215:
+		cs = 215
+		goto _test_eof
+	_test_eof216:
+		cs = 216
+		goto _test_eof
+	_test_eof217:
+		cs = 217
+		goto _test_eof
+	_test_eof218:
+		cs = 218
+		goto _test_eof
+	_test_eof219:
+		cs = 219
+		goto _test_eof
+	_test_eof220:
+		cs = 220
+		goto _test_eof
+	_test_eof221:
+		cs = 221
+		goto _test_eof
+	_test_eof222:
+		cs = 222
+		goto _test_eof
+	_test_eof223:
+		cs = 223
+		goto _test_eof
+	_test_eof224:
+		cs = 224
+		goto _test_eof
+	_test_eof225:
+		cs = 225
+		goto _test_eof
+	_test_eof226:
+		cs = 226
+		goto _test_eof
+	_test_eof227:
+		cs = 227
+		goto _test_eof
+	_test_eof228:
+		cs = 228
+		goto _test_eof
+	_test_eof387:
+		cs = 387
+		goto _test_eof
+	_test_eof229:
+		cs = 229
+		goto _test_eof
+	_test_eof230:
+		cs = 230
+		goto _test_eof
+	_test_eof231:
+		cs = 231
+		goto _test_eof
+	_test_eof232:
+		cs = 232
+		goto _test_eof
+	_test_eof233:
+		cs = 233
+		goto _test_eof
+	_test_eof234:
+		cs = 234
+		goto _test_eof
+	_test_eof235:
+		cs = 235
+		goto _test_eof
+	_test_eof236:
+		cs = 236
+		goto _test_eof
+	_test_eof237:
+		cs = 237
+		goto _test_eof
+	_test_eof238:
+		cs = 238
+		goto _test_eof
+	_test_eof239:
+		cs = 239
+		goto _test_eof
+	_test_eof240:
+		cs = 240
+		goto _test_eof
+	_test_eof241:
+		cs = 241
+		goto _test_eof
+	_test_eof242:
+		cs = 242
+		goto _test_eof
+	_test_eof243:
+		cs = 243
+		goto _test_eof
+	_test_eof244:
+		cs = 244
+		goto _test_eof
+	_test_eof245:
+		cs = 245
+		goto _test_eof
+	_test_eof246:
+		cs = 246
+		goto _test_eof
+	_test_eof247:
+		cs = 247
+		goto _test_eof
+	_test_eof248:
+		cs = 248
+		goto _test_eof
+	_test_eof249:
+		cs = 249
+		goto _test_eof
+	_test_eof250:
+		cs = 250
+		goto _test_eof
+	_test_eof251:
+		cs = 251
+		goto _test_eof
+	_test_eof252:
+		cs = 252
+		goto _test_eof
+	_test_eof253:
+		cs = 253
+		goto _test_eof
+	_test_eof254:
+		cs = 254
+		goto _test_eof
+	_test_eof255:
+		cs = 255
+		goto _test_eof
+	_test_eof256:
+		cs = 256
+		goto _test_eof
+	_test_eof257:
+		cs = 257
+		goto _test_eof
+	_test_eof258:
+		cs = 258
+		goto _test_eof
+	_test_eof259:
+		cs = 259
+		goto _test_eof
+	_test_eof260:
+		cs = 260
+		goto _test_eof
+	_test_eof261:
+		cs = 261
+		goto _test_eof
+	_test_eof262:
+		cs = 262
+		goto _test_eof
+	_test_eof263:
+		cs = 263
+		goto _test_eof
+	_test_eof264:
+		cs = 264
+		goto _test_eof
+	_test_eof265:
+		cs = 265
+		goto _test_eof
+	_test_eof266:
+		cs = 266
+		goto _test_eof
+	_test_eof267:
+		cs = 267
+		goto _test_eof
+	_test_eof268:
+		cs = 268
+		goto _test_eof
+	_test_eof269:
+		cs = 269
+		goto _test_eof
+	_test_eof270:
+		cs = 270
+		goto _test_eof
+	_test_eof388:
+		cs = 388
+		goto _test_eof
+	_test_eof271:
+		cs = 271
+		goto _test_eof
+	_test_eof272:
+		cs = 272
+		goto _test_eof
+	_test_eof273:
+		cs = 273
+		goto _test_eof
+	_test_eof274:
+		cs = 274
+		goto _test_eof
+	_test_eof275:
+		cs = 275
+		goto _test_eof
+	_test_eof276:
+		cs = 276
+		goto _test_eof
+	_test_eof277:
+		cs = 277
+		goto _test_eof
+	_test_eof278:
+		cs = 278
+		goto _test_eof
+	_test_eof279:
+		cs = 279
+		goto _test_eof
+	_test_eof280:
+		cs = 280
+		goto _test_eof
+	_test_eof281:
+		cs = 281
+		goto _test_eof
+	_test_eof282:
+		cs = 282
+		goto _test_eof
+	_test_eof283:
+		cs = 283
+		goto _test_eof
+	_test_eof284:
+		cs = 284
+		goto _test_eof
+	_test_eof285:
+		cs = 285
+		goto _test_eof
+	_test_eof286:
+		cs = 286
+		goto _test_eof
+	_test_eof287:
+		cs = 287
+		goto _test_eof
+	_test_eof288:
+		cs = 288
+		goto _test_eof
+	_test_eof289:
+		cs = 289
+		goto _test_eof
+	_test_eof290:
+		cs = 290
+		goto _test_eof
+	_test_eof291:
+		cs = 291
+		goto _test_eof
+	_test_eof292:
+		cs = 292
+		goto _test_eof
+	_test_eof293:
+		cs = 293
+		goto _test_eof
+	_test_eof294:
+		cs = 294
+		goto _test_eof
+	_test_eof295:
+		cs = 295
+		goto _test_eof
+	_test_eof296:
+		cs = 296
+		goto _test_eof
+	_test_eof297:
+		cs = 297
+		goto _test_eof
+	_test_eof298:
+		cs = 298
+		goto _test_eof
+	_test_eof299:
+		cs = 299
+		goto _test_eof
+	_test_eof300:
+		cs = 300
+		goto _test_eof
+	_test_eof301:
+		cs = 301
+		goto _test_eof
+	_test_eof302:
+		cs = 302
+		goto _test_eof
+	_test_eof303:
+		cs = 303
+		goto _test_eof
+	_test_eof304:
+		cs = 304
+		goto _test_eof
+	_test_eof305:
+		cs = 305
+		goto _test_eof
+	_test_eof306:
+		cs = 306
+		goto _test_eof
+	_test_eof307:
+		cs = 307
+		goto _test_eof
+	_test_eof308:
+		cs = 308
+		goto _test_eof
+	_test_eof309:
+		cs = 309
+		goto _test_eof
+	_test_eof310:
+		cs = 310
+		goto _test_eof
+	_test_eof311:
+		cs = 311
+		goto _test_eof
+	_test_eof312:
+		cs = 312
+		goto _test_eof
+	_test_eof313:
+		cs = 313
+		goto _test_eof
+	_test_eof314:
+		cs = 314
+		goto _test_eof
+	_test_eof315:
+		cs = 315
+		goto _test_eof
+	_test_eof316:
+		cs = 316
+		goto _test_eof
+	_test_eof317:
+		cs = 317
+		goto _test_eof
+	_test_eof318:
+		cs = 318
+		goto _test_eof
+	_test_eof319:
+		cs = 319
+		goto _test_eof
+	_test_eof320:
+		cs = 320
+		goto _test_eof
+	_test_eof321:
+		cs = 321
+		goto _test_eof
+	_test_eof322:
+		cs = 322
+		goto _test_eof
+	_test_eof323:
+		cs = 323
+		goto _test_eof
+	_test_eof324:
+		cs = 324
+		goto _test_eof
+	_test_eof325:
+		cs = 325
+		goto _test_eof
+	_test_eof326:
+		cs = 326
+		goto _test_eof
+	_test_eof389:
+		cs = 389
+		goto _test_eof
+	_test_eof327:
+		cs = 327
+		goto _test_eof
+	_test_eof328:
+		cs = 328
+		goto _test_eof
+	_test_eof329:
+		cs = 329
+		goto _test_eof
+	_test_eof330:
+		cs = 330
+		goto _test_eof
+	_test_eof331:
+		cs = 331
+		goto _test_eof
+	_test_eof332:
+		cs = 332
+		goto _test_eof
+	_test_eof333:
+		cs = 333
+		goto _test_eof
+	_test_eof334:
+		cs = 334
+		goto _test_eof
+	_test_eof335:
+		cs = 335
+		goto _test_eof
+	_test_eof336:
+		cs = 336
+		goto _test_eof
+	_test_eof337:
+		cs = 337
+		goto _test_eof
+	_test_eof338:
+		cs = 338
+		goto _test_eof
+	_test_eof339:
+		cs = 339
+		goto _test_eof
+	_test_eof340:
+		cs = 340
+		goto _test_eof
+	_test_eof341:
+		cs = 341
+		goto _test_eof
+	_test_eof342:
+		cs = 342
+		goto _test_eof
+	_test_eof343:
+		cs = 343
+		goto _test_eof
+	_test_eof344:
+		cs = 344
+		goto _test_eof
+	_test_eof345:
+		cs = 345
+		goto _test_eof
+	_test_eof346:
+		cs = 346
+		goto _test_eof
+	_test_eof347:
+		cs = 347
+		goto _test_eof
+	_test_eof348:
+		cs = 348
+		goto _test_eof
+	_test_eof349:
+		cs = 349
+		goto _test_eof
+	_test_eof350:
+		cs = 350
+		goto _test_eof
+	_test_eof351:
+		cs = 351
+		goto _test_eof
+	_test_eof352:
+		cs = 352
+		goto _test_eof
+	_test_eof353:
+		cs = 353
+		goto _test_eof
+	_test_eof354:
+		cs = 354
+		goto _test_eof
+	_test_eof355:
+		cs = 355
+		goto _test_eof
+	_test_eof356:
+		cs = 356
+		goto _test_eof
+	_test_eof357:
+		cs = 357
+		goto _test_eof
+	_test_eof358:
+		cs = 358
+		goto _test_eof
+	_test_eof359:
+		cs = 359
+		goto _test_eof
+	_test_eof360:
+		cs = 360
+		goto _test_eof
+	_test_eof361:
+		cs = 361
+		goto _test_eof
+	_test_eof362:
+		cs = 362
+		goto _test_eof
+	_test_eof363:
+		cs = 363
+		goto _test_eof
+	_test_eof364:
+		cs = 364
+		goto _test_eof
+	_test_eof365:
+		cs = 365
+		goto _test_eof
+	_test_eof366:
+		cs = 366
+		goto _test_eof
+	_test_eof367:
+		cs = 367
+		goto _test_eof
+	_test_eof368:
+		cs = 368
+		goto _test_eof
+	_test_eof369:
+		cs = 369
+		goto _test_eof
+	_test_eof370:
+		cs = 370
+		goto _test_eof
+	_test_eof371:
+		cs = 371
+		goto _test_eof
+	_test_eof372:
+		cs = 372
+		goto _test_eof
+	_test_eof373:
+		cs = 373
+		goto _test_eof
+	_test_eof374:
+		cs = 374
+		goto _test_eof
+	_test_eof375:
+		cs = 375
+		goto _test_eof
+	_test_eof376:
+		cs = 376
+		goto _test_eof
+	_test_eof377:
+		cs = 377
+		goto _test_eof
+	_test_eof378:
+		cs = 378
+		goto _test_eof
+	_test_eof390:
+		cs = 390
+		goto _test_eof
+	_test_eof379:
+		cs = 379
+		goto _test_eof
+	_test_eof380:
+		cs = 380
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 73, 88, 132, 147, 149:
+				expected = "'/' or '*'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 12, 13, 24, 25, 74, 133:
+				expected = "value"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 6, 7, 148:
+				expected = "string or '}'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 18, 19, 89:
+				expected = "string"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 10, 11, 22, 23, 79, 138:
+				expected = "':'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 16, 17, 28, 30, 40, 42, 43, 46, 47, 48, 49, 57, 58, 63, 67, 71, 72, 77, 99, 101, 102, 105, 106, 107, 108, 116, 117, 122, 126, 130, 131, 136:
+				expected = "',' or '}'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 31, 80, 90, 139:
+				expected = "escape sequence"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 34, 35, 36, 37, 83, 84, 85, 86, 93, 94, 95, 96, 142, 143, 144, 145:
+				expected = "hex digit"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 39, 41, 44, 45, 98, 100, 103, 104:
+				expected = "digit"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 68, 69, 70, 127, 128, 129:
+				expected = "true"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 59, 60, 61, 62, 118, 119, 120, 121:
+				expected = "false"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 3, 4, 5, 64, 65, 66, 123, 124, 125:
+				expected = "null"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 1, 2, 150:
+				expected = "object"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 75, 76, 134, 135:
+				expected = "NaN"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 50, 51, 52, 53, 54, 55, 56, 109, 110, 111, 112, 113, 114, 115:
+				expected = "Infinity"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 78, 137:
+				expected = "'/' or '*'"
+				expected = "':'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 29:
+				expected = "'/' or '*'"
+				expected = "',' or '}'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 201, 243:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 310, 325, 364, 379:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 157, 158, 202:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 254, 255, 266, 267, 311, 365:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 151, 152, 244:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 155, 156, 161, 163, 173, 174, 175, 176, 177, 185, 186, 191, 195, 199, 200, 205, 215, 216, 217, 218, 219, 227, 228, 233, 237, 241, 242, 247:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 248, 249, 380:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 260, 261, 326:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 252, 253, 264, 265, 316, 370:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 258, 259, 270, 272, 282, 283, 284, 285, 286, 294, 295, 300, 304, 308, 309, 314, 336, 337, 338, 339, 340, 348, 349, 354, 358, 362, 363, 368:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 8, 9, 14, 15, 20, 21, 26, 27, 32, 33, 38, 81, 82, 87, 91, 92, 97, 140, 141, 146:
+				expected = "string character"
+				expected = "'\"'"
+
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 164, 206:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 273, 317, 327, 371:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 167, 168, 169, 170, 209, 210, 211, 212:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 276, 277, 278, 279, 320, 321, 322, 323, 330, 331, 332, 333, 374, 375, 376, 377:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 172, 214:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 281, 335:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 196, 197, 198, 238, 239, 240:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 305, 306, 307, 359, 360, 361:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 187, 188, 189, 190, 229, 230, 231, 232:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 296, 297, 298, 299, 350, 351, 352, 353:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 192, 193, 194, 234, 235, 236:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 301, 302, 303, 355, 356, 357:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 203, 204, 245, 246:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 312, 313, 366, 367:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 178, 179, 180, 181, 182, 183, 184, 220, 221, 222, 223, 224, 225, 226:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 287, 288, 289, 290, 291, 292, 293, 341, 342, 343, 344, 345, 346, 347:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 162:
+				expected = "'/' or '*'"
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 315, 369:
+				expected = "'/' or '*'"
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 271:
+				expected = "'/' or '*'"
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 153, 154, 159, 160, 165, 166, 171, 207, 208, 213:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 250, 251, 256, 257, 262, 263, 268, 269, 274, 275, 280, 318, 319, 324, 328, 329, 334, 372, 373, 378:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
 					cs = 0
 					goto _out
 				}
@@ -7219,5 +21980,8 @@ func handleObjectValues(data []byte, handler ObjectValueHandler, stack []int) (i
 		}
 	}
 
-	return p, stack, err
+	if pe < len(data) && p >= pe {
+		return pe - 1, stack, "", buffer.documentSizeError(data)
+	}
+	return p, stack, expected, err
 }
@@ -0,0 +1,270 @@
+package rjson
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PathError is returned by HandleObjectValues and HandleArrayValues when Buffer.TrackPath is set. It says where in the
+// document an error happened, whether the error came from rjson or from a handler.
+type PathError struct {
+	// Path is the location of the value that failed as a JSONPath like $.items[12].price. Nested calls only add to the
+	// path when the Buffer they are given has TrackPath set.
+	Path string
+
+	// Offset is the position of the error in data. It is the offset of the *SyntaxError or *LimitError when there is
+	// one, otherwise it is the start of the value the handler failed on.
+	Offset int
+
+	// Excerpt is a short excerpt of data around Offset followed by a line with a caret pointing at Offset.
+	Excerpt string
+
+	// Err is the underlying error.
+	Err error
+
+	// dataLen is the length of the data Offset is relative to. See SyntaxError.dataLen.
+	dataLen int
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns e.Err.
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// errorPathObjectHandler wraps an ObjectValueHandler to remember which field it was called for last.
+type errorPathObjectHandler struct {
+	handler   ObjectValueHandler
+	dataLen   int
+	fieldname []byte
+	start     int
+	called    bool
+	failed    bool
+}
+
+func (h *errorPathObjectHandler) HandleObjectValue(fieldname, data []byte) (int, error) {
+	h.fieldname, h.start, h.called = fieldname, h.dataLen-len(data), true
+	p, err := h.handler.HandleObjectValue(fieldname, data)
+	h.failed = err != nil
+	return p, err
+}
+
+// errorPathArrayHandler wraps an ArrayValueHandler to remember which item it was called for last.
+type errorPathArrayHandler struct {
+	handler ArrayValueHandler
+	dataLen int
+	index   int
+	start   int
+	failed  bool
+}
+
+func (h *errorPathArrayHandler) HandleArrayValue(data []byte) (int, error) {
+	h.index++
+	h.start = h.dataLen - len(data)
+	p, err := h.handler.HandleArrayValue(data)
+	h.failed = err != nil
+	return p, err
+}
+
+// objectPathHandler returns the wrapper for a HandleObjectValues call with TrackPath set. Handlers can make nested
+// calls with the same Buffer, so each running call gets its own wrapper until it calls pathHandlerDone.
+func (buffer *Buffer) objectPathHandler() *errorPathObjectHandler {
+	for len(buffer.objectPathHandlers) <= buffer.pathCalls {
+		buffer.objectPathHandlers = append(buffer.objectPathHandlers, &errorPathObjectHandler{})
+	}
+	h := buffer.objectPathHandlers[buffer.pathCalls]
+	buffer.pathCalls++
+	return h
+}
+
+// arrayPathHandler is objectPathHandler for HandleArrayValues.
+func (buffer *Buffer) arrayPathHandler() *errorPathArrayHandler {
+	for len(buffer.arrayPathHandlers) <= buffer.pathCalls {
+		buffer.arrayPathHandlers = append(buffer.arrayPathHandlers, &errorPathArrayHandler{})
+	}
+	h := buffer.arrayPathHandlers[buffer.pathCalls]
+	buffer.pathCalls++
+	return h
+}
+
+func (buffer *Buffer) pathHandlerDone() {
+	buffer.pathCalls--
+}
+
+// handleObjectValuesWithPath is HandleObjectValues with TrackPath set. json5 is true for JSON5.HandleObjectValues.
+func handleObjectValuesWithPath(data []byte, handler ObjectValueHandler, buffer *Buffer, json5 bool) (p int, err error) {
+	h := buffer.objectPathHandler()
+	*h = errorPathObjectHandler{
+		handler: handler,
+		dataLen: len(data),
+	}
+	var expected string
+	p, expected, err = buffer.handleObjectValues(data, h, json5)
+	h.handler = nil
+	buffer.pathHandlerDone()
+	if err == nil {
+		return p, nil
+	}
+	err = toSyntaxError(data, p, err, expected)
+	segment := ""
+	if h.called {
+		segment = fieldnameSegment(h.fieldname, json5)
+	}
+	return p, wrapPathError(data, p, err, segment, h.start, h.failed, json5, buffer)
+}
+
+// handleArrayValuesWithPath is handleObjectValuesWithPath for arrays.
+func handleArrayValuesWithPath(data []byte, handler ArrayValueHandler, buffer *Buffer, json5 bool) (p int, err error) {
+	h := buffer.arrayPathHandler()
+	*h = errorPathArrayHandler{
+		handler: handler,
+		dataLen: len(data),
+		index:   -1,
+	}
+	var expected string
+	p, expected, err = buffer.handleArrayValues(data, h, json5)
+	h.handler = nil
+	buffer.pathHandlerDone()
+	if err == nil {
+		return p, nil
+	}
+	err = toSyntaxError(data, p, err, expected)
+	segment := ""
+	if h.index >= 0 {
+		segment = "[" + strconv.Itoa(h.index) + "]"
+	}
+	return p, wrapPathError(data, p, err, segment, h.start, h.failed, json5, buffer)
+}
+
+// wrapPathError returns err as a *PathError relative to data. segment is the path segment for the last value passed
+// to the handler, which started at start in data. fromHandler is true when the handler returned err. json5 is true when
+// data is JSON5, and buffer is the Buffer data was read with.
+func wrapPathError(
+	data []byte, p int, err error, segment string, start int, fromHandler, json5 bool, buffer *Buffer,
+) error {
+	offset := p
+	var syntaxErr *SyntaxError
+	var limitErr *LimitError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &limitErr):
+		offset = limitErr.Offset
+	case fromHandler:
+		offset = start
+	}
+
+	// An error from the state machine belongs to the last handled value when it is inside that value. That happens
+	// when the handler returns 0 and the machine finds the error while skipping the value.
+	if segment != "" && !fromHandler {
+		// the value is skipped with the checks it was read with, one level deeper than the object or array it is in
+		skipBuffer := &Buffer{
+			Limits:              buffer.Limits,
+			StrictUTF8:          buffer.StrictUTF8,
+			RejectDuplicateKeys: buffer.RejectDuplicateKeys,
+			JSONC:               buffer.JSONC,
+			AllowNonFinite:      buffer.AllowNonFinite,
+			depth:               buffer.depth + 1,
+		}
+		var skipErr error
+		if json5 {
+			_, skipErr = JSON5.SkipValue(data[start:], skipBuffer)
+		} else {
+			_, skipErr = SkipValue(data[start:], skipBuffer)
+		}
+		if offset < start || skipErr == nil {
+			segment = ""
+		}
+	}
+
+	var pathErr *PathError
+	if !errors.As(err, &pathErr) {
+		pathErr = &PathError{
+			Path:    "$",
+			Offset:  offset,
+			Err:     err,
+			dataLen: len(data),
+		}
+		err = pathErr
+	}
+	if pathErr.dataLen < len(data) {
+		pathErr.Offset += len(data) - pathErr.dataLen
+		pathErr.dataLen = len(data)
+	}
+	pathErr.Path = "$" + segment + pathErr.Path[1:]
+	pathErr.Excerpt = errorExcerpt(data, pathErr.Offset)
+	return err
+}
+
+// fieldnameSegment returns the JSONPath segment for the raw object field name fieldname. json5 is true for the field
+// names JSON5.HandleObjectValues gives handlers.
+func fieldnameSegment(fieldname []byte, json5 bool) string {
+	unescape := UnescapeStringContent
+	if json5 {
+		unescape = JSON5.UnescapeStringContent
+	}
+	name, _, err := unescape(fieldname, nil)
+	if err != nil {
+		name = fieldname
+	}
+	isIdentifier := len(name) > 0
+	for i, c := range name {
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (i == 0 || c < '0' || c > '9') {
+			isIdentifier = false
+			break
+		}
+	}
+	if isIdentifier {
+		return "." + string(name)
+	}
+	var sb strings.Builder
+	sb.WriteString("['")
+	for _, c := range string(name) {
+		switch c {
+		case '\'', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	sb.WriteString("']")
+	return sb.String()
+}
+
+// errorExcerptRadius is how many bytes errorExcerpt shows on each side of the offset.
+const errorExcerptRadius = 30
+
+// errorExcerpt returns up to errorExcerptRadius bytes of data on either side of offset on one line and a second line
+// with a caret under offset. Whitespace is shown as spaces.
+func errorExcerpt(data []byte, offset int) string {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	start, end := offset-errorExcerptRadius, offset+errorExcerptRadius
+	prefix, suffix := "...", "..."
+	if start <= 0 {
+		start, prefix = 0, ""
+	}
+	if end >= len(data) {
+		end, suffix = len(data), ""
+	}
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	for _, c := range data[start:end] {
+		if whitespace[c] {
+			c = ' '
+		}
+		sb.WriteByte(c)
+	}
+	sb.WriteString(suffix)
+	sb.WriteByte('\n')
+	sb.WriteString(strings.Repeat(" ", len(prefix)+offset-start))
+	sb.WriteByte('^')
+	return sb.String()
+}
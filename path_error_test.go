@@ -0,0 +1,179 @@
+package rjson
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pathTestHandler descends into every object and array with TrackPath set and reads everything else with leaf.
+type pathTestHandler struct {
+	leaf func(data []byte) (int, error)
+}
+
+func (h *pathTestHandler) handle(data []byte) (int, error) {
+	tknType, _, err := NextTokenType(data)
+	if err != nil {
+		return 0, err
+	}
+	switch tknType {
+	case ObjectStartType:
+		return HandleObjectValues(data, h, &Buffer{TrackPath: true})
+	case ArrayStartType:
+		return HandleArrayValues(data, h, &Buffer{TrackPath: true})
+	default:
+		return h.leaf(data)
+	}
+}
+
+func (h *pathTestHandler) HandleObjectValue(_, data []byte) (int, error) {
+	return h.handle(data)
+}
+
+func (h *pathTestHandler) HandleArrayValue(data []byte) (int, error) {
+	return h.handle(data)
+}
+
+func TestPathError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("syntax error", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"items": [{"price": 1}, {"price": tru}]}`)
+		h := &pathTestHandler{leaf: func(data []byte) (int, error) {
+			return SkipValue(data, nil)
+		}}
+		_, err := h.handle(data)
+		var pathErr *PathError
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, "$.items[1].price", pathErr.Path)
+		require.Equal(t, 38, pathErr.Offset)
+		require.True(t, errors.Is(err, ErrNoValidToken))
+		require.Equal(t, `...: [{"price": 1}, {"price": tru}]}`+"\n"+`                                 ^`, pathErr.Excerpt)
+		require.EqualError(t, err, `$.items[1].price: no valid json token found at offset 38: expected true but found '}'`)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"a": [1, 2, {"b c": 3, "d": "x"}]}`)
+		wantErr := fmt.Errorf("not a number")
+		h := &pathTestHandler{leaf: func(data []byte) (int, error) {
+			if data[0] == '"' {
+				return 0, wantErr
+			}
+			return SkipValue(data, nil)
+		}}
+		_, err := h.handle(data)
+		var pathErr *PathError
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, `$.a[2].d`, pathErr.Path)
+		require.Equal(t, 29, pathErr.Offset)
+		require.Equal(t, wantErr, pathErr.Err)
+		require.Equal(t, `{"a": [1, 2, {"b c": 3, "d": "x"}]}`+"\n"+`                             ^`, pathErr.Excerpt)
+
+		data = []byte(`{"b c": [0, "x"]}`)
+		_, err = h.handle(data)
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, `$['b c'][1]`, pathErr.Path)
+	})
+
+	t.Run("error in skipped value", func(t *testing.T) {
+		t.Parallel()
+		skip := ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+			return 0, nil
+		})
+		_, err := HandleObjectValues([]byte(`{"a": 1, "b": [1 2]}`), skip, &Buffer{TrackPath: true})
+		var pathErr *PathError
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, "$.b", pathErr.Path)
+		require.Equal(t, 17, pathErr.Offset)
+
+		_, err = HandleObjectValues([]byte(`{"a": 1 "b": 2}`), skip, &Buffer{TrackPath: true})
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, "$", pathErr.Path)
+		require.Equal(t, 8, pathErr.Offset)
+	})
+
+	t.Run("error in skipped value with checks", func(t *testing.T) {
+		t.Parallel()
+		skip := ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+			return 0, nil
+		})
+		for _, td := range []struct {
+			data   string
+			buffer Buffer
+			path   string
+		}{
+			{data: `{"a": [[[1]]]}`, buffer: Buffer{Limits: Limits{MaxDepth: 3}}, path: "$.a"},
+			{data: "{\"a\": [\"\xff\"]}", buffer: Buffer{StrictUTF8: true}, path: "$.a"},
+			{data: `{"a": {"b": 1, "b": 2}}`, buffer: Buffer{RejectDuplicateKeys: true}, path: "$.a"},
+			{data: `{"a": NaN "b": 1}`, buffer: Buffer{AllowNonFinite: true}, path: "$"},
+			{data: `{"a": [1, /* c */ 2] "b": 1}`, buffer: Buffer{JSONC: true}, path: "$"},
+		} {
+			buffer := td.buffer
+			buffer.TrackPath = true
+			_, err := HandleObjectValues([]byte(td.data), skip, &buffer)
+			var pathErr *PathError
+			require.True(t, errors.As(err, &pathErr), td.data)
+			require.Equal(t, td.path, pathErr.Path, td.data)
+		}
+	})
+
+	t.Run("nested calls with the same buffer", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"a": {"b": [1, {"c": tru}]}, "d": 1}`)
+		buf := &Buffer{TrackPath: true}
+		var objHandler ObjectValueHandlerFunc
+		var arrHandler ArrayValueHandlerFunc
+		handle := func(data []byte) (int, error) {
+			switch data[0] {
+			case '{':
+				return HandleObjectValues(data, objHandler, buf)
+			case '[':
+				return HandleArrayValues(data, arrHandler, buf)
+			default:
+				return SkipValue(data, nil)
+			}
+		}
+		objHandler = func(_, data []byte) (int, error) {
+			return handle(data)
+		}
+		arrHandler = func(data []byte) (int, error) {
+			return handle(data)
+		}
+		_, err := handle(data)
+		var pathErr *PathError
+		require.True(t, errors.As(err, &pathErr))
+		require.Equal(t, "$.a.b[1].c", pathErr.Path)
+		require.Equal(t, 25, pathErr.Offset)
+
+		p, err := handle([]byte(`{"a": {"b": [1, {"c": true}]}, "d": 1}`))
+		require.NoError(t, err)
+		require.Equal(t, 38, p)
+	})
+
+	t.Run("without TrackPath", func(t *testing.T) {
+		t.Parallel()
+		_, err := HandleArrayValues([]byte(`[1, 2 3]`), ArrayValueHandlerFunc(func(_ []byte) (int, error) {
+			return 0, nil
+		}), &Buffer{})
+		var pathErr *PathError
+		require.False(t, errors.As(err, &pathErr))
+	})
+}
+
+func TestPathError_allocs(t *testing.T) {
+	data := getTestdataJSONGz(t, "twitter.json")
+	buf := &Buffer{TrackPath: true}
+	handler := ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+		return 0, nil
+	})
+	_, err := HandleObjectValues(data, handler, buf)
+	require.NoError(t, err)
+	allocs := testing.AllocsPerRun(10, func() {
+		_, _ = HandleObjectValues(data, handler, buf) //nolint:errcheck // checked above
+	})
+	require.Zero(t, allocs)
+}
@@ -0,0 +1,199 @@
+package rjson
+
+import (
+	"bytes"
+)
+
+// PathValueHandler is a handler for values matched by a PathSet.
+type PathValueHandler interface {
+	HandlePathValue(idx int, data []byte) (p int, err error)
+}
+
+// PathValueHandlerFunc is a function that implements PathValueHandler
+type PathValueHandlerFunc func(idx int, data []byte) (p int, err error)
+
+// HandlePathValue implements PathValueHandler.HandlePathValue
+func (fn PathValueHandlerFunc) HandlePathValue(idx int, data []byte) (int, error) {
+	return fn(idx, data)
+}
+
+// PathSet is a set of json pointers compiled into a trie for HandlePathValues. A PathSet is safe for concurrent use.
+type PathSet struct {
+	root pathNode
+	size int
+}
+
+type pathNode struct {
+	fields  map[string]*pathNode
+	indexes map[int]*pathNode
+	idx     int
+}
+
+func newPathNode() *pathNode {
+	return &pathNode{idx: -1}
+}
+
+// NewPathSet compiles pointers into a PathSet. pointers are RFC 6901 json pointers. The index of each pointer in
+// pointers is the idx that is passed to PathValueHandler.HandlePathValue for the values it matches.
+func NewPathSet(pointers ...string) (*PathSet, error) {
+	ps := &PathSet{
+		root: pathNode{idx: -1},
+		size: len(pointers),
+	}
+	var token []byte
+	for i, pointer := range pointers {
+		if pointer != "" && pointer[0] != '/' {
+			return nil, errInvalidPointer
+		}
+		node := &ps.root
+		for pointer != "" {
+			var err error
+			token, pointer, err = nextPointerToken(pointer, token[:0])
+			if err != nil {
+				return nil, err
+			}
+			node = node.child(token)
+		}
+		if node.idx != -1 {
+			continue
+		}
+		node.idx = i
+	}
+	return ps, nil
+}
+
+// Len returns the number of pointers used to create the PathSet.
+func (ps *PathSet) Len() int {
+	return ps.size
+}
+
+func (n *pathNode) child(token []byte) *pathNode {
+	if n.fields == nil {
+		n.fields = map[string]*pathNode{}
+	}
+	c := n.fields[string(token)]
+	if c != nil {
+		return c
+	}
+	c = newPathNode()
+	n.fields[string(token)] = c
+	idx := pointerIndex(token)
+	if idx >= 0 {
+		if n.indexes == nil {
+			n.indexes = map[int]*pathNode{}
+		}
+		n.indexes[idx] = c
+	}
+	return c
+}
+
+func (n *pathNode) hasChildren() bool {
+	return len(n.fields) > 0
+}
+
+// HandlePathValues runs handler.HandlePathValue on each value in data that matches a pointer in paths. It reads the
+// document in a single pass and skips values that can't contain a match without decoding them. When more than one
+// pointer in paths is the same, only the first is matched.
+//
+// handler follows the same rules as ObjectValueHandler. When it returns an error, HandlePathValues immediately
+// returns the same error. p is the position after the last byte read. When err is nil, p will be the position after
+// the value at the beginning of data.
+// buffer is optional. Reusing a buffer can reduce memory allocations.
+func HandlePathValues(data []byte, paths *PathSet, handler PathValueHandler, buffer *Buffer) (p int, err error) {
+	if buffer == nil {
+		buffer = &Buffer{}
+	}
+	lvl := buffer.pathLevel(0)
+	lvl.handler = handler
+	p, err = lvl.visit(&paths.root, data)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, "")
+	}
+	if p != 0 {
+		return p, nil
+	}
+	return SkipValue(data, &lvl.buffer)
+}
+
+// pathLevel walks one level of a document for HandlePathValues.
+type pathLevel struct {
+	buffer   Buffer
+	root     *Buffer
+	depth    int
+	handler  PathValueHandler
+	node     *pathNode
+	count    int
+	fieldBuf []byte
+}
+
+func (b *Buffer) pathLevel(depth int) *pathLevel {
+	for len(b.pathLevels) <= depth {
+		b.pathLevels = append(b.pathLevels, &pathLevel{
+			root:  b,
+			depth: len(b.pathLevels),
+		})
+	}
+	return b.pathLevels[depth]
+}
+
+// visit handles the value at the start of data for node. It returns 0 for p when it doesn't read the value.
+func (l *pathLevel) visit(node *pathNode, data []byte) (p int, err error) {
+	if node.idx >= 0 {
+		p, err = l.handler.HandlePathValue(node.idx, data)
+		if err != nil || !node.hasChildren() {
+			return p, err
+		}
+	}
+	if !node.hasChildren() {
+		return 0, nil
+	}
+	tknType, _, err := NextTokenType(data)
+	if err != nil {
+		return 0, nil
+	}
+	if l.depth == skipMaxDepth {
+		return 0, ErrMaxDepth
+	}
+	next := l.root.pathLevel(l.depth + 1)
+	next.handler = l.handler
+	next.node = node
+	switch tknType {
+	case ObjectStartType:
+		return HandleObjectValues(data, next, &next.buffer)
+	case ArrayStartType:
+		if node.indexes == nil {
+			return 0, nil
+		}
+		next.count = 0
+		return HandleArrayValues(data, next, &next.buffer)
+	default:
+		return 0, nil
+	}
+}
+
+// HandleObjectValue implements ObjectValueHandler.HandleObjectValue
+func (l *pathLevel) HandleObjectValue(fieldname, data []byte) (int, error) {
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		var err error
+		l.fieldBuf, _, err = UnescapeStringContent(fieldname, l.fieldBuf[:0])
+		if err != nil {
+			return 0, err
+		}
+		fieldname = l.fieldBuf
+	}
+	child := l.node.fields[string(fieldname)]
+	if child == nil {
+		return 0, nil
+	}
+	return l.visit(child, data)
+}
+
+// HandleArrayValue implements ArrayValueHandler.HandleArrayValue
+func (l *pathLevel) HandleArrayValue(data []byte) (int, error) {
+	child := l.node.indexes[l.count]
+	l.count++
+	if child == nil {
+		return 0, nil
+	}
+	return l.visit(child, data)
+}
@@ -0,0 +1,90 @@
+package rjson
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlePathValues(t *testing.T) {
+	t.Parallel()
+	doc := []byte(`{
+		"a": {"b": [10, {"c": "x"}, 12], "d": true},
+		"e~/f": null,
+		"g\"h": "esc",
+		"i": [[1, 2], [3, 4]]
+	}`)
+	paths, err := NewPathSet(
+		"/a/b/1/c",
+		"/a/d",
+		"/a/b",
+		"/e~0~1f",
+		`/g"h`,
+		"/i/1/0",
+		"/a/nope",
+		"/a/d",
+		"/a/b/3",
+		"/i/1/0/x",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 10, paths.Len())
+
+	got := map[int]string{}
+	handler := PathValueHandlerFunc(func(idx int, data []byte) (p int, err error) {
+		p, err = SkipValue(data, nil)
+		got[idx] = string(data[:p])
+		return p, err
+	})
+	var buf Buffer
+	p, err := HandlePathValues(doc, paths, handler, &buf)
+	require.NoError(t, err)
+	require.Equal(t, len(doc), p)
+	require.Equal(t, map[int]string{
+		0: `"x"`,
+		1: `true`,
+		2: `[10, {"c": "x"}, 12]`,
+		3: `null`,
+		4: `"esc"`,
+		5: `3`,
+	}, got)
+
+	_, err = NewPathSet("/ok", "bad")
+	require.Equal(t, errInvalidPointer, err)
+
+	doneErr := fmt.Errorf("done")
+	_, err = HandlePathValues(doc, paths, PathValueHandlerFunc(func(idx int, data []byte) (int, error) {
+		return 0, doneErr
+	}), nil)
+	require.Equal(t, doneErr, err)
+
+	_, err = HandlePathValues([]byte(`{"a": {"d": true`), paths, handler, nil)
+	require.EqualError(t, err, "invalid json object at offset 16: expected ',' or '}' but found end of data")
+}
+
+func TestHandlePathValues_matchesGet(t *testing.T) {
+	t.Parallel()
+	data := getTestdataJSONGz(t, "twitter.json")
+	pointers := []string{
+		"/statuses/0/id",
+		"/statuses/3/user/screen_name",
+		"/statuses/3/user",
+		"/statuses/99/entities/hashtags",
+		"/search_metadata/count",
+		"/search_metadata",
+	}
+	paths, err := NewPathSet(pointers...)
+	require.NoError(t, err)
+	got := make([]string, len(pointers))
+	_, err = HandlePathValues(data, paths, PathValueHandlerFunc(func(idx int, data []byte) (p int, err error) {
+		p, err = SkipValue(data, nil)
+		got[idx] = string(data[:p])
+		return p, err
+	}), nil)
+	require.NoError(t, err)
+	for i, pointer := range pointers {
+		want, _, err := Get(data, pointer)
+		require.NoError(t, err)
+		require.Equal(t, string(want), got[i], pointer)
+	}
+}
@@ -0,0 +1,178 @@
+package rjson
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrNotFound is returned when a json pointer doesn't match any value in the document.
+var ErrNotFound = fmt.Errorf("value not found")
+
+var (
+	errInvalidPointer = fmt.Errorf("invalid json pointer")
+	errFound          = fmt.Errorf("found")
+)
+
+// Get finds the value referenced by the RFC 6901 json pointer in data. It returns the raw bytes of the value and its
+// TokenType. It returns ErrNotFound when no value matches pointer. The empty pointer "" references the whole document.
+//
+// Get only reads as much of data as it needs to find the value. Siblings of the values along the pointer's path are
+// skipped without being decoded.
+func Get(data []byte, pointer string) (val []byte, tknType TokenType, err error) {
+	var w pointerWalker
+	var p int
+	val, tknType, p, err = w.get(data, pointer)
+	if err != nil {
+		return nil, InvalidType, toSyntaxError(data, p, err, "")
+	}
+	return val, tknType, nil
+}
+
+type pointerWalker struct {
+	buffer   Buffer
+	token    []byte
+	fieldBuf []byte
+	index    int
+	count    int
+	found    []byte
+}
+
+// get returns the value pointer references in data. When it fails, p is the position in data where the lookup
+// stopped.
+func (w *pointerWalker) get(data []byte, pointer string) (val []byte, tknType TokenType, p int, err error) {
+	if pointer != "" && pointer[0] != '/' {
+		return nil, InvalidType, 0, errInvalidPointer
+	}
+	// Each step returns a subslice that runs to the end of data, so the position of the current value is how much
+	// shorter it is.
+	docLen := len(data)
+	for pointer != "" {
+		w.token, pointer, err = nextPointerToken(pointer, w.token[:0])
+		if err != nil {
+			return nil, InvalidType, docLen - len(data), err
+		}
+		var stepData []byte
+		stepData, p, err = w.step(data)
+		if err != nil {
+			return nil, InvalidType, docLen - len(data) + p, err
+		}
+		data = stepData
+	}
+	tknType, p, err = NextTokenType(data)
+	if err != nil {
+		return nil, InvalidType, docLen - len(data) + p, ErrNoValidToken
+	}
+	start := p - 1
+	p, err = SkipValue(data[start:], &w.buffer)
+	if err != nil {
+		return nil, InvalidType, docLen - len(data) + start + p, err
+	}
+	return data[start : start+p], tknType, 0, nil
+}
+
+// step returns the value in data that matches w.token. When it fails, p is the position in data where it stopped.
+func (w *pointerWalker) step(data []byte) (val []byte, p int, err error) {
+	tknType, p, err := NextTokenType(data)
+	if err != nil {
+		return nil, p, ErrNoValidToken
+	}
+	w.found = nil
+	switch tknType {
+	case ObjectStartType:
+		p, err = HandleObjectValues(data, w, &w.buffer)
+	case ArrayStartType:
+		w.index = pointerIndex(w.token)
+		if w.index < 0 {
+			return nil, p - 1, ErrNotFound
+		}
+		w.count = 0
+		p, err = HandleArrayValues(data, w, &w.buffer)
+	default:
+		return nil, p - 1, ErrNotFound
+	}
+	if err == errFound {
+		return w.found, 0, nil
+	}
+	if err != nil {
+		return nil, p, err
+	}
+	return nil, p, ErrNotFound
+}
+
+// HandleObjectValue implements ObjectValueHandler.HandleObjectValue
+func (w *pointerWalker) HandleObjectValue(fieldname, data []byte) (int, error) {
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		var err error
+		w.fieldBuf, _, err = UnescapeStringContent(fieldname, w.fieldBuf[:0])
+		if err != nil {
+			return 0, err
+		}
+		fieldname = w.fieldBuf
+	}
+	if !bytes.Equal(fieldname, w.token) {
+		return 0, nil
+	}
+	w.found = data
+	return 0, errFound
+}
+
+// HandleArrayValue implements ArrayValueHandler.HandleArrayValue
+func (w *pointerWalker) HandleArrayValue(data []byte) (int, error) {
+	if w.count != w.index {
+		w.count++
+		return 0, nil
+	}
+	w.found = data
+	return 0, errFound
+}
+
+// nextPointerToken reads the first reference token from pointer, unescapes it and appends it to buf. rest is the
+// remainder of pointer starting with the next '/'.
+func nextPointerToken(pointer string, buf []byte) (token []byte, rest string, err error) {
+	pointer = pointer[1:]
+	end := strings.IndexByte(pointer, '/')
+	if end == -1 {
+		end = len(pointer)
+	}
+	rest = pointer[end:]
+	pointer = pointer[:end]
+	for i := 0; i < len(pointer); i++ {
+		if pointer[i] != '~' {
+			buf = append(buf, pointer[i])
+			continue
+		}
+		i++
+		if i == len(pointer) {
+			return nil, "", errInvalidPointer
+		}
+		switch pointer[i] {
+		case '0':
+			buf = append(buf, '~')
+		case '1':
+			buf = append(buf, '/')
+		default:
+			return nil, "", errInvalidPointer
+		}
+	}
+	return buf, rest, nil
+}
+
+// pointerIndex returns the array index referenced by token or -1 if token isn't a valid index.
+func pointerIndex(token []byte) int {
+	if len(token) == 0 || len(token) > 1 && token[0] == '0' {
+		return -1
+	}
+	var idx int
+	for _, b := range token {
+		if b < '0' || b > '9' {
+			return -1
+		}
+		idx = idx*10 + int(b-'0')
+		if idx > math.MaxInt32 {
+			return -1
+		}
+	}
+	return idx
+}
@@ -0,0 +1,80 @@
+package rjson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	// the example document from RFC 6901
+	doc := []byte(`{
+      "foo": ["bar", "baz"],
+      "": 0,
+      "a/b": 1,
+      "c%d": 2,
+      "e^f": 3,
+      "g|h": 4,
+      "i\\j": 5,
+      "k\"l": 6,
+      " ": 7,
+      "m~n": 8,
+      "p": {"q": [10, {"r": null}]}
+   }`)
+	for _, td := range []struct {
+		pointer string
+		val     string
+		tknType TokenType
+		err     error
+	}{
+		{pointer: "/foo", val: `["bar", "baz"]`, tknType: ArrayStartType},
+		{pointer: "/foo/0", val: `"bar"`, tknType: StringType},
+		{pointer: "/foo/1", val: `"baz"`, tknType: StringType},
+		{pointer: "/", val: `0`, tknType: NumberType},
+		{pointer: "/a~1b", val: `1`, tknType: NumberType},
+		{pointer: "/c%d", val: `2`, tknType: NumberType},
+		{pointer: "/e^f", val: `3`, tknType: NumberType},
+		{pointer: "/g|h", val: `4`, tknType: NumberType},
+		{pointer: `/i\j`, val: `5`, tknType: NumberType},
+		{pointer: `/k"l`, val: `6`, tknType: NumberType},
+		{pointer: "/ ", val: `7`, tknType: NumberType},
+		{pointer: "/m~0n", val: `8`, tknType: NumberType},
+		{pointer: "/p/q/1/r", val: `null`, tknType: NullType},
+		{pointer: "/foo/2", err: ErrNotFound},
+		{pointer: "/foo/-", err: ErrNotFound},
+		{pointer: "/foo/01", err: ErrNotFound},
+		{pointer: "/foo/0/x", err: ErrNotFound},
+		{pointer: "/nope", err: ErrNotFound},
+		{pointer: "foo", err: errInvalidPointer},
+		{pointer: "/m~2n", err: errInvalidPointer},
+		{pointer: "/m~", err: errInvalidPointer},
+	} {
+		val, tknType, err := Get(doc, td.pointer)
+		require.Equal(t, td.err, err, td.pointer)
+		require.Equal(t, td.val, string(val), td.pointer)
+		require.Equal(t, td.tknType, tknType, td.pointer)
+	}
+
+	val, tknType, err := Get(doc, "")
+	require.NoError(t, err)
+	require.Equal(t, ObjectStartType, tknType)
+	require.Equal(t, string(doc), string(val))
+
+	data := getTestdataJSONGz(t, "twitter.json")
+	val, tknType, err = Get(data, "/statuses/3/user/screen_name")
+	require.NoError(t, err)
+	require.Equal(t, StringType, tknType)
+	require.Equal(t, `"chibu4267"`, string(val))
+
+	_, _, err = Get([]byte(`{"a": [1, 2`), "/a/1")
+	require.NoError(t, err)
+	_, _, err = Get([]byte(`{"a": [1, 2`), "/a/2")
+	require.EqualError(t, err, "invalid json array at offset 11: expected ',' or ']' but found end of data")
+	_, _, err = Get([]byte(`{"a": [1, 2 x]}`), "/a/2")
+	require.EqualError(t, err, "invalid json array at offset 12: expected ',' or ']' but found 'x'")
+	_, _, err = Get([]byte(`{"a": {"b":}}`), "/a/c")
+	require.EqualError(t, err, "invalid json object at offset 11: expected value but found '}'")
+	_, _, err = Get([]byte(" \n "), "/a")
+	require.EqualError(t, err, "no valid json token found at offset 3: expected value but found end of data")
+}
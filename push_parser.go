@@ -0,0 +1,205 @@
+package rjson
+
+import (
+	"fmt"
+	"io"
+)
+
+// ErrNeedMoreInput is returned by PushParser when the buffered input ends before the current token.
+var ErrNeedMoreInput = fmt.Errorf("need more input")
+
+var errNotTopLevel = fmt.Errorf("NextValue called inside a container")
+
+// PushParser is an incremental json tokenizer for input that arrives in chunks. Write input to it as it arrives and
+// read tokens with NextToken or complete top-level values with NextValue. When the buffered input ends in the middle
+// of a token, they return ErrNeedMoreInput and pick up where they left off after the next Write without scanning the
+// buffered part of the token again.
+//
+// The input may contain any number of top-level values separated by whitespace. Call Close when there is no more
+// input.
+//
+// The zero value is ready to use. PushParser is not thread-safe.
+type PushParser struct {
+	buf        []byte
+	p          int
+	offset     int64
+	mark       int
+	inValue    bool
+	valType    TokenType
+	closed     bool
+	state      resumeState
+	reading    bool
+	scanP      int
+	scanEscape bool
+	err        error
+}
+
+// Write appends chunk to the buffered input. It never returns an error.
+func (x *PushParser) Write(chunk []byte) (int, error) {
+	keep := x.p
+	if x.inValue {
+		keep = x.mark
+	}
+	if keep > 0 && keep >= len(x.buf)/2 {
+		n := copy(x.buf, x.buf[keep:])
+		x.buf = x.buf[:n]
+		x.offset += int64(keep)
+		x.p -= keep
+		x.mark -= keep
+	}
+	x.buf = append(x.buf, chunk...)
+	return len(chunk), nil
+}
+
+// Close tells the parser that there is no more input. After Close, NextToken and NextValue return io.EOF after the last
+// complete top-level value instead of ErrNeedMoreInput.
+func (x *PushParser) Close() error {
+	x.closed = true
+	return nil
+}
+
+// InputOffset returns the offset in the input of the next unread byte.
+func (x *PushParser) InputOffset() int64 {
+	return x.offset + int64(x.p)
+}
+
+// Depth returns the number of objects and arrays that are open at the current position.
+func (x *PushParser) Depth() int {
+	return x.state.top
+}
+
+// NextToken reads the next token. raw is the token's bytes. For string and number tokens it is the complete string
+// or number. raw is only valid until the next call to Write.
+//
+// NextToken returns ErrNeedMoreInput when the buffered input ends before the token does. It returns io.EOF when the
+// parser is closed and all input has been read. Any other error is a syntax error, and the parser will continue to
+// return it.
+func (x *PushParser) NextToken() (tknType TokenType, raw []byte, err error) {
+	if x.err != nil {
+		return InvalidType, nil, x.err
+	}
+	tknType, raw, err = x.nextToken()
+	if err != nil && err != ErrNeedMoreInput && err != io.EOF {
+		x.err = err
+	}
+	return tknType, raw, err
+}
+
+// NextValue reads the next complete top-level value. raw is the value's bytes, and tknType is the type of its
+// first token. raw is only valid until the next call to Write.
+//
+// When NextValue returns ErrNeedMoreInput, the bytes read so far are kept, and the next call continues reading the
+// same value. It may not be called while NextToken is in the middle of an object or array.
+func (x *PushParser) NextValue() (raw []byte, tknType TokenType, err error) {
+	if !x.inValue && x.state.top > 0 {
+		return nil, InvalidType, errNotTopLevel
+	}
+	for {
+		var tkn []byte
+		tknType, tkn, err = x.NextToken()
+		if err != nil {
+			return nil, InvalidType, err
+		}
+		if !x.inValue {
+			x.inValue = true
+			x.mark = x.p - len(tkn)
+			x.valType = tknType
+		}
+		if x.state.top == 0 {
+			x.inValue = false
+			return x.buf[x.mark:x.p], x.valType, nil
+		}
+	}
+}
+
+// nextToken runs skipValue's machine on the next token. The machine keeps its state between tokens, so it checks that
+// the token is valid where it is. Whitespace is run through the machine before the token so a number isn't read as
+// part of the one before it.
+func (x *PushParser) nextToken() (TokenType, []byte, error) {
+	start := x.p + countWhitespace(x.buf[x.p:])
+	if x.reading && start > x.p {
+		_, _, err := x.state.skip(x.buf, x.p, start, false)
+		if err != nil {
+			return InvalidType, nil, err
+		}
+	}
+	x.p = start
+	if start == len(x.buf) {
+		switch {
+		case !x.closed:
+			return InvalidType, nil, ErrNeedMoreInput
+		case !x.reading:
+			return InvalidType, nil, io.EOF
+		}
+		_, _, err := x.state.skip(x.buf, start, start, true)
+		if err == nil {
+			err = ErrUnexpectedEOF
+		}
+		return InvalidType, nil, err
+	}
+	end, ok := x.tokenEnd(start)
+	if !ok && !x.closed {
+		return InvalidType, nil, ErrNeedMoreInput
+	}
+	x.scanP, x.scanEscape = 0, false
+	if !x.reading {
+		x.state.initSkip()
+		x.reading = true
+	}
+	_, done, err := x.state.skip(x.buf[:end], start, end, x.closed && end == len(x.buf))
+	if err != nil {
+		return InvalidType, nil, err
+	}
+	x.p = end
+	x.reading = !done
+	return tokenTypes[x.buf[start]], x.buf[start:end], nil
+}
+
+// tokenEnd returns the end of the token that starts at start and whether the buffered input has all of it. A string
+// ends at its closing quote, and any other token that is longer than a byte ends at the next byte that can't be part
+// of it. x.scanP is where the last call stopped in the same token, so the buffered part of a token is only scanned
+// once.
+func (x *PushParser) tokenEnd(start int) (int, bool) {
+	c := x.buf[start]
+	switch {
+	case c == '"':
+		p := start + x.scanP
+		if x.scanP == 0 {
+			p++
+		}
+		escaped := x.scanEscape
+		for ; p < len(x.buf); p++ {
+			switch {
+			case escaped:
+				escaped = false
+			case x.buf[p] == '\\':
+				escaped = true
+			case x.buf[p] == '"':
+				return p + 1, true
+			}
+		}
+		x.scanP, x.scanEscape = p-start, escaped
+		return p, false
+	case pushDelims[c]:
+		return start + 1, true
+	}
+	p := start + x.scanP
+	for ; p < len(x.buf); p++ {
+		if pushDelims[x.buf[p]] || whitespace[x.buf[p]] {
+			return p, true
+		}
+	}
+	x.scanP = p - start
+	return p, false
+}
+
+// pushDelims are the tokens that are a single byte. They also end a number, true, false or null.
+var pushDelims = [256]bool{
+	'{': true,
+	'}': true,
+	'[': true,
+	']': true,
+	':': true,
+	',': true,
+	'"': true,
+}
@@ -0,0 +1,175 @@
+package rjson
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type pushTestToken struct {
+	tknType TokenType
+	raw     string
+}
+
+func pushTokens(t *testing.T, data []byte, chunkSize int) []pushTestToken {
+	t.Helper()
+	var x PushParser
+	var result []pushTestToken
+	for {
+		tknType, raw, err := x.NextToken()
+		if err == ErrNeedMoreInput {
+			if len(data) == 0 {
+				require.NoError(t, x.Close())
+				continue
+			}
+			n := chunkSize
+			if n > len(data) {
+				n = len(data)
+			}
+			_, err = x.Write(data[:n])
+			require.NoError(t, err)
+			data = data[n:]
+			continue
+		}
+		if err == io.EOF {
+			return result
+		}
+		require.NoError(t, err)
+		result = append(result, pushTestToken{tknType: tknType, raw: string(raw)})
+	}
+}
+
+func TestPushParser_NextToken(t *testing.T) {
+	t.Parallel()
+	data := getTestdataJSONGz(t, "github_repo.json")
+	want := pushTokens(t, data, len(data))
+	require.Len(t, want, countTokens(data))
+	for _, chunkSize := range []int{1, 2, 3, 7, 100} {
+		require.Equal(t, want, pushTokens(t, data, chunkSize))
+	}
+
+	got := pushTokens(t, []byte(`{"a": [1, -2.5e3, "b\"c", true, false, null, {}]} 12`), 1)
+	require.Equal(t, []pushTestToken{
+		{ObjectStartType, `{`},
+		{StringType, `"a"`},
+		{ColonType, `:`},
+		{ArrayStartType, `[`},
+		{NumberType, `1`},
+		{CommaType, `,`},
+		{NumberType, `-2.5e3`},
+		{CommaType, `,`},
+		{StringType, `"b\"c"`},
+		{CommaType, `,`},
+		{TrueType, `true`},
+		{CommaType, `,`},
+		{FalseType, `false`},
+		{CommaType, `,`},
+		{NullType, `null`},
+		{CommaType, `,`},
+		{ObjectStartType, `{`},
+		{ObjectEndType, `}`},
+		{ArrayEndType, `]`},
+		{ObjectEndType, `}`},
+		{NumberType, `12`},
+	}, got)
+}
+
+func TestPushParser_NextValue(t *testing.T) {
+	t.Parallel()
+	data := []byte(` {"a":1} [2, {"b":[]}]"x" 12 true `)
+	var x PushParser
+	var got []string
+	var types []TokenType
+	for {
+		raw, tknType, err := x.NextValue()
+		if err == ErrNeedMoreInput {
+			if len(data) == 0 {
+				require.NoError(t, x.Close())
+				continue
+			}
+			_, err = x.Write(data[:1])
+			require.NoError(t, err)
+			data = data[1:]
+			continue
+		}
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, string(raw))
+		types = append(types, tknType)
+	}
+	require.Equal(t, []string{`{"a":1}`, `[2, {"b":[]}]`, `"x"`, `12`, `true`}, got)
+	require.Equal(t, []TokenType{ObjectStartType, ArrayStartType, StringType, NumberType, TrueType}, types)
+}
+
+func TestPushParser_errors(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data string
+		err  error
+	}{
+		{data: `{"a":1`, err: ErrUnexpectedEOF},
+		{data: `{"a":1,}`, err: ErrInvalidObject},
+		{data: `{"a" 1}`, err: ErrInvalidObject},
+		{data: `[1 2]`, err: ErrInvalidArray},
+		{data: `[1,]`, err: ErrInvalidArray},
+		{data: `]`, err: ErrNoValidToken},
+		{data: `"abc`, err: ErrNoValidToken},
+		{data: `[1.]`, err: ErrInvalidNumber},
+		{data: `[tru]`, err: ErrInvalidArray},
+		{data: `[1]]`, err: ErrNoValidToken},
+	} {
+		var x PushParser
+		_, err := x.Write([]byte(td.data))
+		require.NoError(t, err)
+		require.NoError(t, x.Close())
+		for err == nil {
+			_, _, err = x.NextToken()
+		}
+		require.Equal(t, td.err, err, td.data)
+		_, _, err = x.NextToken()
+		require.Equal(t, td.err, err, td.data)
+	}
+}
+
+func TestPushParser_resume(t *testing.T) {
+	t.Parallel()
+	var x PushParser
+	_, err := x.Write([]byte(`[[`))
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, _, err = x.NextToken()
+		require.NoError(t, err)
+	}
+	data := []byte(`"abc\"def",-12.5e3]]`)
+	for i := 0; i < 9; i++ {
+		_, err = x.Write(data[i : i+1])
+		require.NoError(t, err)
+		_, _, err = x.NextToken()
+		require.Equal(t, ErrNeedMoreInput, err)
+		// the bytes that are already scanned aren't scanned again
+		require.Equal(t, i+1, x.scanP)
+		require.Equal(t, 2, x.Depth())
+	}
+	_, err = x.Write(data[9:])
+	require.NoError(t, err)
+	require.NoError(t, x.Close())
+	var got []pushTestToken
+	for {
+		tknType, raw, err := x.NextToken()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, pushTestToken{tknType: tknType, raw: string(raw)})
+	}
+	require.Equal(t, []pushTestToken{
+		{StringType, `"abc\"def"`},
+		{CommaType, `,`},
+		{NumberType, `-12.5e3`},
+		{ArrayEndType, `]`},
+		{ArrayEndType, `]`},
+	}, got)
+}
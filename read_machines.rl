@@ -10,7 +10,7 @@ func readNull(data []byte) (int, error) {
 machine readNull;
 include common "common.rl";
 
-main := (json_space* json_null)@err{return p, errNotNull};
+main := (json_space* json_null)@err{return p, ErrNotNull};
 
 write data; write init; write exec;
 }%%
@@ -35,7 +35,7 @@ main := (
     json_true @{val = true}
     | json_false @{val = false}
   )
-)@err{return false, p, errNotBool}
+)@err{return false, p, ErrNotBool}
 ;
 
 write data; write init; write exec;
@@ -43,3 +43,41 @@ write data; write init; write exec;
 
   return val, p, nil
 }
+
+// scanNumber finds the parts of the json_number at the beginning of data. p is the first position in data after the
+// number.
+func scanNumber(data []byte) (n numberLiteral, p int, err error) {
+  cs := 0
+  pe := len(data)
+  eof := len(data)
+  dot, exp, end := -1, -1, -1
+
+%%{
+
+machine scanNumber;
+include common "common.rl";
+
+main := (
+  json_int
+  (json_frac >{dot = p})?
+  (json_exp >{exp = p})?
+) %err{end = p} @err{return n, p, ErrInvalidNumber};
+
+write data; write init; write exec;
+}%%
+
+  if end == -1 {
+    end = p
+  }
+  if data[0] == '-' {
+    n.intStart = 1
+  }
+  n.intEnd, n.fracStart, n.fracEnd, n.expStart, n.expEnd = end, end, end, end, end
+  if exp != -1 {
+    n.intEnd, n.fracStart, n.fracEnd, n.expStart = exp, exp, exp, exp + 1
+  }
+  if dot != -1 {
+    n.intEnd, n.fracStart = dot, dot + 1
+  }
+  return n, end, nil
+}
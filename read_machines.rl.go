@@ -52,7 +52,7 @@ func readNull(data []byte) (int, error) {
 		}
 		goto tr0
 	tr0:
-		return p, errNotNull
+		return p, ErrNotNull
 		goto st0
 	st_case_0:
 	st0:
@@ -131,7 +131,7 @@ func readNull(data []byte) (int, error) {
 		if p == eof {
 			switch cs {
 			case 1, 2, 3, 4, 5:
-				return p, errNotNull
+				return p, ErrNotNull
 			}
 		}
 
@@ -206,7 +206,7 @@ func readBool(data []byte) (bool, int, error) {
 		}
 		goto tr0
 	tr0:
-		return false, p, errNotBool
+		return false, p, ErrNotBool
 		goto st0
 	st_case_0:
 	st0:
@@ -350,7 +350,7 @@ func readBool(data []byte) (bool, int, error) {
 		if p == eof {
 			switch cs {
 			case 1, 2, 3, 4, 5, 6, 7, 8, 9:
-				return false, p, errNotBool
+				return false, p, ErrNotBool
 			}
 		}
 
@@ -361,3 +361,288 @@ func readBool(data []byte) (bool, int, error) {
 
 	return val, p, nil
 }
+
+// scanNumber finds the parts of the json_number at the beginning of data. p is the first position in data after the
+// number.
+func scanNumber(data []byte) (n numberLiteral, p int, err error) {
+	cs := 0
+	pe := len(data)
+	eof := len(data)
+	dot, exp, end := -1, -1, -1
+
+	const scanNumber_start int = 1
+	const scanNumber_first_final int = 6
+	const scanNumber_error int = 0
+
+	const scanNumber_en_main int = 1
+
+	{
+		cs = scanNumber_start
+	}
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 6:
+			goto st_case_6
+		case 3:
+			goto st_case_3
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		}
+		goto st_out
+	st_case_1:
+		switch data[p] {
+		case 45:
+			goto st2
+		case 48:
+			goto st6
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st11
+		}
+		goto tr0
+	tr0:
+		return n, p, ErrInvalidNumber
+		goto st0
+	tr7:
+		end = p
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		if data[p] == 48 {
+			goto st6
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st11
+		}
+		goto tr0
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 46:
+			goto tr8
+		case 69:
+			goto tr9
+		case 101:
+			goto tr9
+		}
+		goto tr7
+	tr8:
+		dot = p
+		goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st7
+		}
+		goto tr0
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 69:
+			goto tr9
+		case 101:
+			goto tr9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto tr7
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch data[p] {
+		case 69:
+			goto tr9
+		case 101:
+			goto tr9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st8
+		}
+		goto tr7
+	tr9:
+		exp = p
+		goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 43:
+			goto st5
+		case 45:
+			goto st5
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st9
+		}
+		goto tr0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st9
+		}
+		goto tr0
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto tr7
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st10
+		}
+		goto tr7
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 46:
+			goto tr8
+		case 69:
+			goto tr9
+		case 101:
+			goto tr9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st12
+		}
+		goto tr7
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 46:
+			goto tr8
+		case 69:
+			goto tr9
+		case 101:
+			goto tr9
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st12
+		}
+		goto tr7
+	st_out:
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 1, 2, 3, 4, 5:
+				return n, p, ErrInvalidNumber
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	if end == -1 {
+		end = p
+	}
+	if data[0] == '-' {
+		n.intStart = 1
+	}
+	n.intEnd, n.fracStart, n.fracEnd, n.expStart, n.expEnd = end, end, end, end, end
+	if exp != -1 {
+		n.intEnd, n.fracStart, n.fracEnd, n.expStart = exp, exp, exp, exp+1
+	}
+	if dot != -1 {
+		n.intEnd, n.fracStart = dot, dot+1
+	}
+	return n, end, nil
+}
@@ -10,6 +10,7 @@ import (
 //go:generate script/generate-ragel-file skip_machine.rl
 //go:generate script/generate-ragel-file array_handler_machine.rl
 //go:generate script/generate-ragel-file object_handler_machine.rl
+//go:generate script/generate-ragel-file json5_machine.rl
 
 // ObjectValueHandler is a handler for json objects.
 type ObjectValueHandler interface {
@@ -40,7 +41,104 @@ func (fn ArrayValueHandlerFunc) HandleArrayValue(data []byte) (int, error) {
 // Buffer is a reusable stack buffer for functions that read nested objects and arrays.
 // Buffer is not thread-safe.
 type Buffer struct {
-	stackBuf []int
+	// TrackPath makes HandleObjectValues and HandleArrayValues return errors as a *PathError with the JSONPath of the
+	// value that failed. Handlers that make nested calls need to pass a Buffer with TrackPath set for the path to
+	// include the nested levels. The path is only built when there is an error, but tracking it makes handling
+	// values a little slower.
+	TrackPath bool
+
+	// Limits are checked by SkipValue, SkipValueFast, Valid, HandleObjectValues and HandleArrayValues as they read a
+	// value. SkipValueFast validates the whole value when it has anything to check. The default is no limits.
+	Limits Limits
+
+	// StrictUTF8 makes the same functions return ErrInvalidUTF8 for strings that aren't valid utf-8 or that have an
+	// unpaired surrogate escape like \ud800. By default, rjson accepts invalid utf-8 and keeps the bytes as they are.
+	StrictUTF8 bool
+
+	// RejectDuplicateKeys makes the same functions return ErrDuplicateKey when an object has the same key more than
+	// once.
+	RejectDuplicateKeys bool
+
+	// JSONC makes the same functions and Buffer's NextToken and NextTokenType accept // and /* */ comments and
+	// trailing commas in arrays and objects like VS Code's JSONC. Handlers are given the original data, so they need
+	// to pass the Buffer to nested calls that read values with comments.
+	JSONC bool
+
+	// AllowNonFinite makes the same functions and Buffer's NextToken and NextTokenType accept NaN, Infinity and
+	// -Infinity as numbers the way Python's json module writes them. Handlers are given them as they are, so read them
+	// with ReadFloat64NonFinite.
+	AllowNonFinite bool
+
+	stackBuf           []int
+	depth              int // objects and arrays around the value, so skipped values count toward the depth limit
+	pathLevels         []*pathLevel
+	objectPathHandlers []*errorPathObjectHandler
+	arrayPathHandlers  []*errorPathArrayHandler
+	pathCalls          int // HandleObjectValues and HandleArrayValues calls with TrackPath running on the Buffer
+	checkLevels        []checkLevel
+	checks             int // calls running the checked machines on the Buffer
+	keys               keyTable
+	strictStrings      []byte
+}
+
+// skipValue runs skipValueChecked when buffer has checks to make and skipValue otherwise.
+func (buffer *Buffer) skipValue(data []byte) (p int, expected string, err error) {
+	switch {
+	case buffer == nil:
+		p, _, expected, err = skipValue(data, nil, 0)
+	case buffer.needsCheck():
+		buffer.beginCheck()
+		p, buffer.stackBuf, expected, err = skipValueChecked(data, buffer.stackBuf, buffer.depth, buffer)
+		buffer.endCheck()
+	default:
+		p, buffer.stackBuf, expected, err = skipValue(data, buffer.stackBuf, buffer.depth)
+	}
+	return p, expected, err
+}
+
+// handleObjectValues runs handleObjectValuesChecked when buffer has checks to make and handleObjectValues otherwise.
+// It runs json5HandleObjectValues when json5 is true. Nested calls that handler makes with buffer are one level deeper.
+func (buffer *Buffer) handleObjectValues(
+	data []byte, handler ObjectValueHandler, json5 bool,
+) (p int, expected string, err error) {
+	depth := buffer.depth
+	buffer.depth++
+	switch {
+	case json5:
+		buffer.beginCheck()
+		p, buffer.stackBuf, expected, err = json5HandleObjectValues(data, handler, buffer.stackBuf, depth, buffer)
+		buffer.endCheck()
+	case buffer.needsCheck():
+		buffer.beginCheck()
+		p, buffer.stackBuf, expected, err = handleObjectValuesChecked(data, handler, buffer.stackBuf, depth, buffer)
+		buffer.endCheck()
+	default:
+		p, buffer.stackBuf, expected, err = handleObjectValues(data, handler, buffer.stackBuf, depth, nil)
+	}
+	buffer.depth = depth
+	return p, expected, err
+}
+
+// handleArrayValues is handleObjectValues for arrays.
+func (buffer *Buffer) handleArrayValues(
+	data []byte, handler ArrayValueHandler, json5 bool,
+) (p int, expected string, err error) {
+	depth := buffer.depth
+	buffer.depth++
+	switch {
+	case json5:
+		buffer.beginCheck()
+		p, buffer.stackBuf, expected, err = json5HandleArrayValues(data, handler, buffer.stackBuf, depth, buffer)
+		buffer.endCheck()
+	case buffer.needsCheck():
+		buffer.beginCheck()
+		p, buffer.stackBuf, expected, err = handleArrayValuesChecked(data, handler, buffer.stackBuf, depth, buffer)
+		buffer.endCheck()
+	default:
+		p, buffer.stackBuf, expected, err = handleArrayValues(data, handler, buffer.stackBuf, depth, nil)
+	}
+	buffer.depth = depth
+	return p, expected, err
 }
 
 // HandleObjectValues runs handler.HandleObjectValue on each field in the object at the beginning of data until it
@@ -48,12 +146,19 @@ type Buffer struct {
 // is nil, p will be the position after the object.
 // buffer is optional. Reusing a buffer can reduce memory allocations.
 func HandleObjectValues(data []byte, handler ObjectValueHandler, buffer *Buffer) (p int, err error) {
+	if buffer != nil && buffer.TrackPath {
+		return handleObjectValuesWithPath(data, handler, buffer, false)
+	}
+	var expected string
 	if buffer == nil {
-		p, _, err = handleObjectValues(data, handler, nil)
-		return p, err
+		p, _, expected, err = handleObjectValues(data, handler, nil, 0, nil)
+	} else {
+		p, expected, err = buffer.handleObjectValues(data, handler, false)
+	}
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
 	}
-	p, buffer.stackBuf, err = handleObjectValues(data, handler, buffer.stackBuf)
-	return p, err
+	return p, nil
 }
 
 // HandleArrayValues runs handler.HandleArrayValue on each item in the array at the beginning of data until it encounters
@@ -61,23 +166,29 @@ func HandleObjectValues(data []byte, handler ObjectValueHandler, buffer *Buffer)
 // be the position after the object.
 // buffer is optional. Reusing a buffer can reduce memory allocations.
 func HandleArrayValues(data []byte, handler ArrayValueHandler, buffer *Buffer) (p int, err error) {
+	if buffer != nil && buffer.TrackPath {
+		return handleArrayValuesWithPath(data, handler, buffer, false)
+	}
+	var expected string
 	if buffer == nil {
-		p, _, err = handleArrayValues(data, handler, nil)
-		return p, err
+		p, _, expected, err = handleArrayValues(data, handler, nil, 0, nil)
+	} else {
+		p, expected, err = buffer.handleArrayValues(data, handler, false)
+	}
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
 	}
-	p, buffer.stackBuf, err = handleArrayValues(data, handler, buffer.stackBuf)
-	return p, err
+	return p, nil
 }
 
 // SkipValue skips the first json value in data. p is the position after the skipped value.
 // buffer is optional. Reusing a buffer can reduce memory allocations.
 func SkipValue(data []byte, buffer *Buffer) (p int, err error) {
-	if buffer == nil {
-		p, _, err = skipValue(data, nil)
-		return p, err
+	p, expected, err := buffer.skipValue(data)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
 	}
-	p, buffer.stackBuf, err = skipValue(data, buffer.stackBuf)
-	return p, err
+	return p, nil
 }
 
 func skipValueCompat(data []byte) (p int, err error) {
@@ -101,12 +212,19 @@ func skipValueCompat(data []byte) (p int, err error) {
 // SkipValueFast is like SkipValue but it speeds things up by skipping validation on objects and arrays.
 // buffer is optional. Reusing a buffer can reduce memory allocations.
 func SkipValueFast(data []byte, buffer *Buffer) (p int, err error) {
-	if buffer == nil {
+	var expected string
+	switch {
+	case buffer == nil:
 		p, _, err = skipValueFast(data, nil)
-		return p, err
+	case buffer.needsCheck():
+		p, expected, err = buffer.skipValue(data)
+	default:
+		p, buffer.stackBuf, err = skipValueFast(data, buffer.stackBuf)
+	}
+	if err != nil {
+		return p, toSyntaxError(data, p, err, expected)
 	}
-	p, buffer.stackBuf, err = skipValueFast(data, buffer.stackBuf)
-	return p, err
+	return p, nil
 }
 
 // UnescapeStringContent unescapes the content of a raw json string. data must be the content of the raw string without
@@ -118,21 +236,14 @@ func UnescapeStringContent(data, dst []byte) (val []byte, p int, err error) {
 // Valid returns true if data contains a single valid json value.
 // buffer is optional. Reusing a buffer can reduce memory allocations.
 func Valid(data []byte, buffer *Buffer) bool {
-	var p int
-	var err error
-	if buffer == nil {
-		p, _, err = skipValue(data, nil)
-	} else {
-		p, buffer.stackBuf, err = skipValue(data, buffer.stackBuf)
-	}
-
+	p, _, err := buffer.skipValue(data)
 	if err != nil {
 		return false
 	}
 	if p > len(data) {
 		return true
 	}
-	return p+countWhitespace(data[p:]) >= len(data)
+	return p+buffer.countWhitespace(data[p:]) >= len(data)
 }
 
 var whitespace = [256]bool{
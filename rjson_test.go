@@ -75,6 +75,11 @@ func Test_fuzzReadFloat64(t *testing.T) {
 	testFuzzerFunc(t, fuzzReadFloat64)
 }
 
+func Test_fuzzReadFloat64NonFinite(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadFloat64NonFinite)
+}
+
 func Test_fuzzReadUint64(t *testing.T) {
 	t.Parallel()
 	testFuzzerFunc(t, fuzzReadUint64)
@@ -95,6 +100,116 @@ func Test_fuzzReadInt64(t *testing.T) {
 	testFuzzerFunc(t, fuzzReadInt64)
 }
 
+func Test_fuzzReadBigInt(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadBigInt)
+}
+
+func Test_fuzzReadBigFloat(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadBigFloat)
+}
+
+func Test_fuzzReadDecimal(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadDecimal)
+}
+
+func Test_fuzzReadNumberBytes(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadNumberBytes)
+}
+
+func Test_fuzzReadIntegralInt64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadIntegralInt64)
+}
+
+func Test_fuzzReadIntegralUint64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadIntegralUint64)
+}
+
+func Test_fuzzReadIntegralInt(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadIntegralInt)
+}
+
+func Test_fuzzReadIntegralUint(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadIntegralUint)
+}
+
+func Test_fuzzReadQuotedInt64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadQuotedInt64)
+}
+
+func Test_fuzzReadQuotedUint64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadQuotedUint64)
+}
+
+func Test_fuzzReadQuotedInt(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadQuotedInt)
+}
+
+func Test_fuzzReadQuotedUint(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadQuotedUint)
+}
+
+func Test_fuzzReadQuotedFloat64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadQuotedFloat64)
+}
+
+func Test_fuzzDecodeIntegralInt64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeIntegralInt64)
+}
+
+func Test_fuzzDecodeIntegralUint64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeIntegralUint64)
+}
+
+func Test_fuzzDecodeIntegralInt(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeIntegralInt)
+}
+
+func Test_fuzzDecodeIntegralUint(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeIntegralUint)
+}
+
+func Test_fuzzDecodeQuotedInt64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeQuotedInt64)
+}
+
+func Test_fuzzDecodeQuotedUint64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeQuotedUint64)
+}
+
+func Test_fuzzDecodeQuotedInt(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeQuotedInt)
+}
+
+func Test_fuzzDecodeQuotedUint(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeQuotedUint)
+}
+
+func Test_fuzzDecodeQuotedFloat64(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeQuotedFloat64)
+}
+
 func Test_fuzzReadInt32(t *testing.T) {
 	t.Parallel()
 	testFuzzerFunc(t, fuzzReadInt32)
@@ -135,6 +250,16 @@ func Test_fuzzValid(t *testing.T) {
 	testFuzzerFunc(t, fuzzValid)
 }
 
+func Test_fuzzValidIJSON(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzValidIJSON)
+}
+
+func Test_fuzzJSON5(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzJSON5)
+}
+
 func Test_fuzzNextToken(t *testing.T) {
 	t.Parallel()
 	testFuzzerFunc(t, fuzzNextToken)
@@ -170,6 +295,11 @@ func Test_fuzzReadValue(t *testing.T) {
 	testFuzzerFunc(t, fuzzReadValue)
 }
 
+func Test_fuzzUnmarshal(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzUnmarshal)
+}
+
 func Test_fuzzDecodeFloat64(t *testing.T) {
 	t.Parallel()
 	testFuzzerFunc(t, fuzzDecodeFloat64)
@@ -215,6 +345,56 @@ func Test_fuzzDecodeBool(t *testing.T) {
 	testFuzzerFunc(t, fuzzDecodeBool)
 }
 
+func Test_fuzzReadFloat32(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadFloat32)
+}
+
+func Test_fuzzDecodeFloat32(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeFloat32)
+}
+
+func Test_fuzzReadUint16(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadUint16)
+}
+
+func Test_fuzzDecodeUint16(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeUint16)
+}
+
+func Test_fuzzReadUint8(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadUint8)
+}
+
+func Test_fuzzDecodeUint8(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeUint8)
+}
+
+func Test_fuzzReadInt16(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadInt16)
+}
+
+func Test_fuzzDecodeInt16(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeInt16)
+}
+
+func Test_fuzzReadInt8(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzReadInt8)
+}
+
+func Test_fuzzDecodeInt8(t *testing.T) {
+	t.Parallel()
+	testFuzzerFunc(t, fuzzDecodeInt8)
+}
+
 // various values that fuzz has crashed on in the past
 var oldCrashers = []string{
 	`"浱up蔽Cr"`,
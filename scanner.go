@@ -0,0 +1,92 @@
+package rjson
+
+import (
+	"io"
+)
+
+// Scanner iterates over a series of concatenated json values like `{"a":1}{"a":2} [3]`. Values may be separated by
+// any amount of json whitespace, including none.
+//
+// Scanner doesn't allocate after its first few values. Use Reset to reuse a Scanner with new data.
+type Scanner struct {
+	data    []byte
+	p       int
+	start   int
+	end     int
+	tknType TokenType
+	err     error
+	buffer  Buffer
+}
+
+// NewScanner returns a new Scanner that reads from data.
+func NewScanner(data []byte) *Scanner {
+	return &Scanner{
+		data: data,
+	}
+}
+
+// Reset sets the Scanner to read from data while keeping its buffer.
+func (s *Scanner) Reset(data []byte) {
+	*s = Scanner{
+		data:   data,
+		buffer: s.buffer,
+	}
+}
+
+// Next advances to the next value. It returns false when there are no more values or when it encounters an invalid
+// value.
+func (s *Scanner) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	tknType, p, err := NextTokenType(s.data[s.p:])
+	if err == io.EOF {
+		s.p = len(s.data)
+		s.tknType = InvalidType
+		return false
+	}
+	start := s.p + p - 1
+	switch tknType {
+	case ObjectStartType, ArrayStartType, StringType, NumberType, NullType, TrueType, FalseType:
+	default:
+		s.err = toSyntaxError(s.data, start, ErrNoValidToken, "")
+		s.p = start
+		return false
+	}
+	p, err = SkipValue(s.data[start:], &s.buffer)
+	if err != nil {
+		s.err = toSyntaxError(s.data, start+p, err, "")
+		s.p = start + p
+		return false
+	}
+	s.start = start
+	s.end = start + p
+	s.p = s.end
+	s.tknType = tknType
+	return true
+}
+
+// Value returns the current value. It is a subslice of data.
+func (s *Scanner) Value() []byte {
+	return s.data[s.start:s.end]
+}
+
+// Start returns the position of the current value in data.
+func (s *Scanner) Start() int {
+	return s.start
+}
+
+// End returns the position in data after the current value.
+func (s *Scanner) End() int {
+	return s.end
+}
+
+// TokenType returns the TokenType of the first token in the current value.
+func (s *Scanner) TokenType() TokenType {
+	return s.tknType
+}
+
+// Err returns the error that stopped Next. It is nil when Next stopped at the end of data.
+func (s *Scanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,46 @@
+package rjson
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanner(t *testing.T) {
+	s := NewScanner([]byte(` {"a":1}{"a":2} [3]"x"12 null` + "\n"))
+	var got []string
+	for s.Next() {
+		got = append(got, fmt.Sprintf("%d:%d:%v:%s", s.Start(), s.End(), s.TokenType(), s.Value()))
+	}
+	require.NoError(t, s.Err())
+	require.Equal(t, []string{
+		`1:8:object start:{"a":1}`,
+		`8:15:object start:{"a":2}`,
+		`16:19:array start:[3]`,
+		`19:22:string:"x"`,
+		`22:24:number:12`,
+		`25:29:null:null`,
+	}, got)
+
+	s.Reset([]byte(`[1] ] 2`))
+	require.True(t, s.Next())
+	require.False(t, s.Next())
+	require.EqualError(t, s.Err(), "no valid json token found at offset 4: expected value but found ']'")
+
+	s.Reset([]byte(`[1] {"a"}`))
+	require.True(t, s.Next())
+	require.False(t, s.Next())
+	require.EqualError(t, s.Err(), "invalid json object at offset 8: expected ':' but found '}'")
+
+	data := getTestdataJSONGz(t, "twitter.json")
+	data = append(append(data, data...), data...)
+	s.Reset(data)
+	allocs := testing.AllocsPerRun(10, func() {
+		s.Reset(data)
+		for s.Next() {
+		}
+	})
+	require.Zero(t, allocs)
+	require.NoError(t, s.Err())
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
+	"strings"
 
 	"github.com/willabides/rjson/internal/fp"
 )
@@ -16,7 +18,7 @@ func ReadUint64(data []byte) (val uint64, p int, err error) {
 	const zero = uint64('0')
 	p = countWhitespace(data)
 	if p == len(data) {
-		return 0, p, errInvalidUInt
+		return 0, p, ErrInvalidUInt
 	}
 	if data[p] == '0' {
 		p++
@@ -25,7 +27,7 @@ func ReadUint64(data []byte) (val uint64, p int, err error) {
 		}
 		switch data[p] {
 		case '.', 'e', 'E':
-			return 0, p, errInvalidUInt
+			return 0, p, ErrInvalidUInt
 		}
 		return 0, p, nil
 	}
@@ -55,14 +57,14 @@ func ReadUint64(data []byte) (val uint64, p int, err error) {
 		}
 	}
 	if p-startP == 0 {
-		return 0, p, errInvalidUInt
+		return 0, p, ErrInvalidUInt
 	}
 	if p == len(data) {
 		return val, p, nil
 	}
 	switch data[p] {
 	case '.', 'e', 'E':
-		return 0, p, errInvalidUInt
+		return 0, p, ErrInvalidUInt
 	}
 	return val, p, nil
 }
@@ -77,7 +79,7 @@ func readUint64Compat(data []byte) (val uint64, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -90,7 +92,7 @@ func ReadUint32(data []byte) (val uint32, p int, err error) {
 	val64, p, err = ReadUint64(data)
 	if err == nil && val64 > math.MaxUint32 {
 		val64 = 0
-		err = errInvalidUInt
+		err = ErrInvalidUInt
 	}
 	return uint32(val64), p, err
 }
@@ -105,7 +107,67 @@ func readUint32Compat(data []byte) (val uint32, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	return val, int(decoder.InputOffset()), err
+}
+
+// ReadUint16 reads a uint16 value at the beginning of data. p is the first position in data after the value.
+func ReadUint16(data []byte) (val uint16, p int, err error) {
+	var val64 uint64
+	val64, p, err = ReadUint64(data)
+	if err != nil {
+		return 0, p, err
+	}
+	if val64 > math.MaxUint16 {
+		return 0, p, ErrInvalidUInt
+	}
+	return uint16(val64), p, nil
+}
+
+func readUint16Compat(data []byte) (val uint16, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	return val, int(decoder.InputOffset()), err
+}
+
+// ReadUint8 reads a uint8 value at the beginning of data. p is the first position in data after the value.
+func ReadUint8(data []byte) (val uint8, p int, err error) {
+	var val64 uint64
+	val64, p, err = ReadUint64(data)
+	if err != nil {
+		return 0, p, err
+	}
+	if val64 > math.MaxUint8 {
+		return 0, p, ErrInvalidUInt
+	}
+	return uint8(val64), p, nil
+}
+
+func readUint8Compat(data []byte) (val uint8, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -117,13 +179,13 @@ func ReadInt64(data []byte) (val int64, p int, err error) {
 	const cutoff = uint64(1 << uint64(63))
 	p = countWhitespace(data)
 	if p == len(data) {
-		return 0, p, errInvalidInt
+		return 0, p, ErrInvalidInt
 	}
 	neg := data[p] == '-'
 	if neg {
 		p++
 		if p == len(data) || whitespace[data[p]] {
-			return 0, p, errInvalidInt
+			return 0, p, ErrInvalidInt
 		}
 	}
 	var u64Val uint64
@@ -155,7 +217,7 @@ func readInt64Compat(data []byte) (val int64, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -170,7 +232,7 @@ func ReadInt32(data []byte) (val int32, p int, err error) {
 		return 0, p, err
 	}
 	if val64 > math.MaxInt32 || val64 < math.MinInt32 {
-		return 0, p, errInvalidInt
+		return 0, p, ErrInvalidInt
 	}
 	return int32(val64), p, nil
 }
@@ -185,7 +247,67 @@ func readInt32Compat(data []byte) (val int32, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	return val, int(decoder.InputOffset()), err
+}
+
+// ReadInt16 reads an int16 value at the beginning of data. p is the first position in data after the value.
+func ReadInt16(data []byte) (val int16, p int, err error) {
+	var val64 int64
+	val64, p, err = ReadInt64(data)
+	if err != nil {
+		return 0, p, err
+	}
+	if val64 > math.MaxInt16 || val64 < math.MinInt16 {
+		return 0, p, ErrInvalidInt
+	}
+	return int16(val64), p, nil
+}
+
+func readInt16Compat(data []byte) (val int16, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	return val, int(decoder.InputOffset()), err
+}
+
+// ReadInt8 reads an int8 value at the beginning of data. p is the first position in data after the value.
+func ReadInt8(data []byte) (val int8, p int, err error) {
+	var val64 int64
+	val64, p, err = ReadInt64(data)
+	if err != nil {
+		return 0, p, err
+	}
+	if val64 > math.MaxInt8 || val64 < math.MinInt8 {
+		return 0, p, ErrInvalidInt
+	}
+	return int8(val64), p, nil
+}
+
+func readInt8Compat(data []byte) (val int8, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -216,7 +338,7 @@ func readIntCompat(data []byte) (val, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -247,7 +369,7 @@ func readUintCompat(data []byte) (val uint, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -258,7 +380,7 @@ func readUintCompat(data []byte) (val uint, p int, err error) {
 func ReadFloat64(data []byte) (val float64, p int, err error) {
 	p = countWhitespace(data)
 	if p == len(data) {
-		return 0, p, errInvalidNumber
+		return 0, p, ErrInvalidNumber
 	}
 	var pp int
 	val, pp, err = fp.ParseJSONFloatPrefix(data[p:])
@@ -275,13 +397,242 @@ func readFloat64Compat(data []byte) (val float64, p int, err error) {
 	}
 	_, ok := token.(json.Number)
 	if !ok {
-		return 0, 0, errInvalidNumber
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	return val, int(decoder.InputOffset()), err
+}
+
+// ReadFloat32 reads a float32 value at the beginning of data. p is the first position in data after the value. The
+// value is rounded directly to float32 instead of being rounded to float64 first.
+func ReadFloat32(data []byte) (val float32, p int, err error) {
+	p = countWhitespace(data)
+	if p == len(data) {
+		return 0, p, ErrInvalidNumber
+	}
+	var pp int
+	val, pp, err = fp.ParseJSONFloat32Prefix(data[p:])
+	return val, p + pp, err
+}
+
+func readFloat32Compat(data []byte) (val float32, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return 0, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return 0, 0, ErrInvalidNumber
+	}
+	decoder = json.NewDecoder(bytes.NewReader(data))
+	err = decoder.Decode(&val)
+	if err != nil {
+		// encoding/json sets numbers that are out of range to an infinity, but ReadFloat32 returns 0 with its errors
+		return 0, int(decoder.InputOffset()), err
+	}
+	return val, int(decoder.InputOffset()), nil
+}
+
+// ReadBigInt reads an integer value of any size at the beginning of data. Like ReadInt64, it is an error for the value
+// to have a fraction or exponent. p is the first position in data after the value.
+func ReadBigInt(data []byte) (val *big.Int, p int, err error) {
+	p = countWhitespace(data)
+	n, pp, err := scanNumber(data[p:])
+	if err != nil {
+		return nil, p + pp, ErrInvalidInt
+	}
+	if n.hasFrac() || n.hasExp() {
+		return nil, p + n.intEnd, ErrInvalidInt
+	}
+	data = data[p:]
+	val = setBigIntDigits(new(big.Int), n.neg(), data[n.intStart:n.intEnd], nil)
+	return val, p + pp, nil
+}
+
+func readBigIntCompat(data []byte) (val *big.Int, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	_, ok := token.(json.Number)
+	if !ok {
+		return nil, 0, ErrInvalidNumber
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
 	return val, int(decoder.InputOffset()), err
 }
 
+// ReadBigFloat reads a number at the beginning of data as a *big.Float with enough precision to hold every digit of
+// the number. p is the first position in data after the value.
+func ReadBigFloat(data []byte) (val *big.Float, p int, err error) {
+	p = countWhitespace(data)
+	_, pp, err := scanNumber(data[p:])
+	if err != nil {
+		return nil, p + pp, err
+	}
+	val, err = parseBigFloat(data[p : p+pp])
+	if err != nil {
+		return nil, p, err
+	}
+	return val, p + pp, nil
+}
+
+func readBigFloatCompat(data []byte) (val *big.Float, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	num, ok := token.(json.Number)
+	if !ok {
+		return nil, 0, ErrInvalidNumber
+	}
+	val, err = parseBigFloat([]byte(num))
+	return val, int(decoder.InputOffset()), err
+}
+
+// parseBigFloat parses a valid json number literal. The only errors big.ParseFloat can return for one are for
+// exponents that are out of range.
+func parseBigFloat(literal []byte) (*big.Float, error) {
+	val, _, err := big.ParseFloat(string(literal), 10, bigFloatPrec(len(literal)), big.ToNearestEven)
+	if err != nil || val.IsInf() {
+		return nil, ErrNumberRange
+	}
+	return val, nil
+}
+
+// ReadDecimal reads a number at the beginning of data as a Decimal. Every digit of the number is kept and nothing is
+// rounded. p is the first position in data after the value.
+func ReadDecimal(data []byte) (val Decimal, p int, err error) {
+	p = countWhitespace(data)
+	n, pp, err := scanNumber(data[p:])
+	if err != nil {
+		return val, p + pp, err
+	}
+	data = data[p:]
+	var exp int64
+	if n.hasExp() {
+		expDigits := data[n.expStart:n.expEnd]
+		neg := expDigits[0] == '-'
+		if signBytes[expDigits[0]] {
+			expDigits = expDigits[1:]
+		}
+		for _, c := range expDigits {
+			exp = exp*10 + int64(c-'0')
+			if exp > -math.MinInt32 {
+				return val, p, ErrExponentRange
+			}
+		}
+		if neg {
+			exp = -exp
+		}
+		if exp > math.MaxInt32 {
+			return val, p, ErrExponentRange
+		}
+	}
+	exp -= int64(n.fracEnd - n.fracStart)
+	if exp < math.MinInt32 {
+		return val, p, ErrExponentRange
+	}
+	val.Mantissa = setBigIntDigits(new(big.Int), n.neg(), data[n.intStart:n.intEnd], data[n.fracStart:n.fracEnd])
+	val.Exponent = int(exp)
+	return val, p + pp, nil
+}
+
+func readDecimalCompat(data []byte) (val Decimal, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return val, 0, err
+	}
+	num, ok := token.(json.Number)
+	if !ok {
+		return val, 0, ErrInvalidNumber
+	}
+	mantissa := strings.ToLower(string(num))
+	var exp int64
+	if i := strings.IndexByte(mantissa, 'e'); i != -1 {
+		exp, err = strconv.ParseInt(strings.TrimLeft(mantissa[i+1:], "+"), 10, 32)
+		if err != nil {
+			return val, 0, err
+		}
+		mantissa = mantissa[:i]
+	}
+	if i := strings.IndexByte(mantissa, '.'); i != -1 {
+		exp -= int64(len(mantissa) - i - 1)
+		mantissa = mantissa[:i] + mantissa[i+1:]
+	}
+	if exp < math.MinInt32 {
+		return val, 0, ErrExponentRange
+	}
+	val.Mantissa, ok = new(big.Int).SetString(mantissa, 10)
+	if !ok {
+		return val, 0, ErrInvalidNumber
+	}
+	val.Exponent = int(exp)
+	return val, int(decoder.InputOffset()), nil
+}
+
+// setBigIntDigits sets z to the integer with the decimal digits of intDigits followed by fracDigits.
+func setBigIntDigits(z *big.Int, neg bool, intDigits, fracDigits []byte) *big.Int {
+	if len(intDigits)+len(fracDigits) <= 19 {
+		var val uint64
+		for _, c := range intDigits {
+			val = val*10 + uint64(c-'0')
+		}
+		for _, c := range fracDigits {
+			val = val*10 + uint64(c-'0')
+		}
+		z.SetUint64(val)
+	} else {
+		buf := make([]byte, 0, len(intDigits)+len(fracDigits))
+		buf = append(append(buf, intDigits...), fracDigits...)
+		z.SetString(string(buf), 10)
+	}
+	if neg {
+		z.Neg(z)
+	}
+	return z
+}
+
+// ReadNumberBytes reads a json number at the beginning of data and returns its literal text without converting it.
+// raw is a subslice of data, so it is only valid as long as data is. p is the first position in data after the value.
+func ReadNumberBytes(data []byte) (raw []byte, p int, err error) {
+	p = countWhitespace(data)
+	_, pp, err := scanNumber(data[p:])
+	if err != nil {
+		return nil, p + pp, err
+	}
+	return data[p : p+pp], p + pp, nil
+}
+
+func readNumberBytesCompat(data []byte) (raw []byte, p int, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var token json.Token
+	token, err = decoder.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	num, ok := token.(json.Number)
+	if !ok {
+		return nil, 0, ErrInvalidNumber
+	}
+	return []byte(num), int(decoder.InputOffset()), nil
+}
+
 // ReadStringBytes reads a string value at the beginning of data and appends it to buf. p is the first position in
 // data after the value.
 func ReadStringBytes(data, buf []byte) (val []byte, p int, err error) {
@@ -321,7 +672,7 @@ func readStringBytesCompat(data []byte) (val []byte, p int, err error) {
 	}
 	_, ok := token.(string)
 	if !ok {
-		return nil, 0, errInvalidString
+		return nil, 0, ErrInvalidString
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	var strVal string
@@ -380,7 +731,7 @@ func readStringCompat(data []byte) (val string, p int, err error) {
 	}
 	_, ok := token.(string)
 	if !ok {
-		return "", 0, errInvalidString
+		return "", 0, ErrInvalidString
 	}
 	decoder = json.NewDecoder(bytes.NewReader(data))
 	err = decoder.Decode(&val)
@@ -402,7 +753,7 @@ func readBoolCompat(data []byte) (val bool, p int, err error) {
 	var ok bool
 	val, ok = token.(bool)
 	if !ok {
-		return false, 0, errNotBool
+		return false, 0, ErrNotBool
 	}
 	return val, int(decoder.InputOffset()), nil
 }
@@ -417,10 +768,10 @@ func readNullCompat(data []byte) (p int, err error) {
 	var token json.Token
 	token, err = decoder.Token()
 	if err != nil {
-		return 0, errNotNull
+		return 0, ErrNotNull
 	}
 	if token != nil {
-		return 0, errNotNull
+		return 0, ErrNotNull
 	}
 	return int(decoder.InputOffset()), nil
 }
@@ -5,11 +5,41 @@ machine skipper;
 
 include common "common.rl";
 
-skip_json_number = json_int
+# The expect actions record what would have been valid when the machine fails. They only run on errors, so they
+# cost nothing while the data is valid.
+action expect_value { expected = "value" }
+action expect_value_or_array_end { expected = "value or ']'" }
+action expect_comma_or_array_end { expected = "',' or ']'" }
+action expect_string_or_object_end { expected = "string or '}'" }
+action expect_string { expected = "string" }
+action expect_colon { expected = "':'" }
+action expect_comma_or_object_end { expected = "',' or '}'" }
+action expect_string_char { expected = "string character" }
+action expect_quote { expected = "'\"'" }
+action expect_escape { expected = "escape sequence" }
+action expect_hex_digit { expected = "hex digit" }
+action expect_digit { expected = "digit" }
+action expect_true { expected = "true" }
+action expect_false { expected = "false" }
+action expect_null { expected = "null" }
+action expect_array { expected = "array" }
+action expect_object { expected = "object" }
+
+skip_json_string = double_quote (
+    (
+      not_double_quote_or_escape
+      | '\\' ( escape_code >err(expect_escape) <>err(expect_hex_digit) )
+    ) >err(expect_string_char) >eof(expect_quote)
+  )* double_quote;
+
+skip_json_literal = json_true <>err(expect_true) | json_false <>err(expect_false) | json_null <>err(expect_null);
+
+skip_json_number = ( json_int <>err(expect_digit) )
   (
     '.' @{
         p, err = skipFloatDec(data, p+1, pe)
         if err != nil {
+          expected = "digit"
           fbreak;
         }
       }
@@ -17,39 +47,180 @@ skip_json_number = json_int
     [eE] @{
         p, err = skipFloatExp(data, p+1, pe)
         if err != nil {
+          expected = "digit"
           fbreak;
         }
       }
   )?
 ;
 
-skip_json_value = (json_bool |  json_null | json_string | skip_json_number
+# wait_for_value stops a handler machine at the start of a value that isn't all in data yet when StreamReader reads the
+# input in pieces. The machine takes the same transition again when there is more input, so the handler always gets
+# the complete value. Machines that use it have state in scope.
+action wait_for_value {
+  if state != nil && !state.valueBuffered(data, p) {
+    state.wait = fcurs
+    fhold; fbreak;
+  }
+}
+
+skip_json_value = ( skip_json_literal | skip_json_string | skip_json_number
   | '['@{fcall skip_array;}
   | '{'@{fcall skip_object;}
   );
 
 skip_array_def = (
-  json_space*
-  ( skip_json_value ( json_space* ',' json_space*  skip_json_value )* )? json_space*
-  ']'
+  json_space* (
+    ']'
+    | skip_json_value
+      ( json_space* $err(expect_comma_or_array_end) ',' json_space* skip_json_value >err(expect_value) )*
+      json_space* $err(expect_comma_or_array_end) ']'
+  ) >err(expect_value_or_array_end)
   ) @{fret;}
-    @eof{err = errUnexpectedEOF; fbreak;}
-    @err{err = errInvalidArray; fbreak;};
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidArray; fhold; fbreak;};
+
+skip_object_member = json_space* $err(expect_colon) ':' json_space* skip_json_value >err(expect_value);
 
 skip_object_def = (
-    json_space*
-  (
-    json_string json_space* ':' json_space* skip_json_value
-    ( json_space* ',' json_space*
-        json_string json_space* ':' json_space*
-        skip_json_value
-    )*
-  )?
-  json_space*
-  '}'
+  json_space* (
+    '}'
+    | skip_json_string skip_object_member
+      (
+        json_space* $err(expect_comma_or_object_end) ','
+        json_space* skip_json_string >err(expect_string) skip_object_member
+      )*
+      json_space* $err(expect_comma_or_object_end) '}'
+  ) >err(expect_string_or_object_end)
+  ) @{fret;}
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidObject; fhold; fbreak;};
+
+# The checked_ definitions are the skip_ ones with actions that enforce a Buffer's Limits, StrictUTF8 and
+# RejectDuplicateKeys and that read JSONC and non-finite numbers. They are only used when one of those is set, so the
+# skip_ definitions don't pay for them. Machines that use them have buffer and tokenStart in scope, and their prepush calls buffer.enterLevel.
+action start_token { tokenStart = p }
+
+action check_string {
+  if buffer.Limits.MaxStringLen > 0 && p - tokenStart - 1 > buffer.Limits.MaxStringLen {
+    return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+  }
+}
+
+action check_number {
+  if buffer.Limits.MaxNumberLen > 0 && p - tokenStart > buffer.Limits.MaxNumberLen {
+    return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+  }
+}
+
+action count_element {
+  buffer.checkLevels[top].count++
+  if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+    return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+  }
+}
+
+action count_key {
+  buffer.checkLevels[top].count++
+  if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+    return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+  }
+}
+
+action add_key {
+  if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+    return tokenStart, stack, "unique object key", ErrDuplicateKey
+  }
+}
+
+# Strings are checked against the utf8_ rules when buffer.StrictUTF8 is set. The checks run from actions on the first
+# byte of a character or the last digit of a \u escape and move p past what they checked, so the rules don't add
+# states to every machine with a string in it. Errors are reported at the start of the character or escape.
+action check_utf8 {
+  if buffer.StrictUTF8 {
+    n := utf8CharLen(data[p:pe])
+    if n == 0 {
+      err, expected = ErrInvalidUTF8, "valid utf-8"
+      fhold; fbreak;
+    }
+    p += n - 1
+  }
+}
+
+action check_unicode_escape {
+  if buffer.StrictUTF8 {
+    n := unicodeEscapeLen(data[p-5:pe])
+    if n == 0 {
+      p -= 5
+      err, expected = ErrInvalidUTF8, "valid utf-8"
+      fhold; fbreak;
+    }
+    p += n - 6
+  }
+}
+
+checked_json_string = (
+  double_quote (
+    (
+      ( not_double_quote_or_escape - 0x80..0xff )
+      | 0x80..0xff @check_utf8
+      | '\\' ( ( ["/\\bfnrt] | 'u' xdigit{4} @check_unicode_escape ) >err(expect_escape) <>err(expect_hex_digit) )
+    ) >err(expect_string_char) >eof(expect_quote)
+  )* double_quote
+) >start_token @check_string;
+
+# NaN, Infinity and -Infinity are numbers when buffer.AllowNonFinite is set. The condition is only on their first
+# letter, so the literals don't add states to the number rule.
+action allow_non_finite { buffer.AllowNonFinite }
+action expect_nan { expected = "NaN" }
+action expect_infinity { expected = "Infinity" }
+
+non_finite_number = ( 'N' when allow_non_finite ) ( 'aN' @err(expect_nan) )
+  | '-'? ( 'I' when allow_non_finite ) ( 'nfinity' @err(expect_infinity) );
+
+checked_json_number = ( skip_json_number | non_finite_number ) >start_token %check_number;
+
+checked_json_scalar = skip_json_literal | checked_json_string | checked_json_number;
+
+checked_json_value = ( checked_json_scalar
+  | '['@{fcall checked_array;}
+  | '{'@{fcall checked_object;}
+  );
+
+# Elements are counted before a nested array or object is called.
+checked_array_element = ( checked_json_scalar >count_element
+  | '[' >count_element @{fcall checked_array;}
+  | '{' >count_element @{fcall checked_object;}
+  );
+
+checked_array_def = (
+  jsonc_space* (
+    ']'
+    | checked_array_element
+      ( jsonc_space* $err(expect_comma_or_array_end) ',' jsonc_space* checked_array_element >err(expect_value) )*
+      jsonc_space* $err(expect_comma_or_array_end) ( ']' | ',' jsonc_space* ( ']' when jsonc ) )
+  ) >err(expect_value_or_array_end)
   ) @{fret;}
-    @eof{err = errUnexpectedEOF; fbreak;}
-    @err{err = errInvalidObject; fbreak;};
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidArray; fhold; fbreak;};
+
+checked_object_key = checked_json_string >count_key @add_key;
+
+checked_object_member = jsonc_space* $err(expect_colon) ':' jsonc_space* checked_json_value >err(expect_value);
+
+checked_object_def = (
+  jsonc_space* (
+    '}'
+    | checked_object_key checked_object_member
+      (
+        jsonc_space* $err(expect_comma_or_object_end) ','
+        jsonc_space* checked_object_key >err(expect_string) checked_object_member
+      )*
+      jsonc_space* $err(expect_comma_or_object_end) ( '}' | ',' jsonc_space* ( '}' when jsonc ) )
+  ) >err(expect_string_or_object_end)
+  ) @{fret;}
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidObject; fhold; fbreak;};
 
 skip_json_value_fast = (json_bool | json_null | json_string | json_number
   | '['@{fcall skip_array_fast;}
@@ -64,8 +235,8 @@ skip_array_fast_def = (
   )*
   ']'
   ) @{fret;}
-    @eof{err = errUnexpectedEOF; fbreak;}
-    @err{err = errInvalidArray; fbreak;};
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidArray; fhold; fbreak;};
 
 skip_object_fast_def = (
   [^{}"]* (
@@ -75,8 +246,8 @@ skip_object_fast_def = (
   )*
   '}'
   ) @{fret;}
-    @eof{err = errUnexpectedEOF; fbreak;}
-    @err{err = errInvalidObject; fbreak;};
+    @eof{err = ErrUnexpectedEOF; fhold; fbreak;}
+    @err{err = ErrInvalidObject; fhold; fbreak;};
 
 }%%
 
@@ -97,7 +268,7 @@ skip_object_fast := skip_object_fast_def;
 
 prepush {
   if top == skipMaxDepth {
-    err = errMaxDepth
+    err = ErrMaxDepth
     fbreak;
   }
   if top + 1 >= len(stack) {
@@ -106,7 +277,7 @@ prepush {
 }
 
 main := json_space* skip_json_value_fast @err{
-  return p, stack, errNoValidToken
+  return p, stack, ErrNoValidToken
 };
 
 write data; write init;  write exec;
@@ -116,12 +287,16 @@ write data; write init;  write exec;
   return p,stack, err
 }
 
-func skipValue(data []byte, stack []int) (int, []int ,error) {
+// skipValue is SkipValue without the Buffer options. depth is the number of objects and arrays that data is nested in.
+// It counts toward the depth limit. When data is invalid, p is the position of the byte that made it invalid and
+// expected describes what would have been valid there.
+func skipValue(data []byte, stack []int, depth int) (int, []int, string, error) {
   var top int
   cs, p := 0, 0
 	pe := len(data)
 	eof := len(data)
 	var err error
+	var expected string
 
 %%{
 machine skipValue;
@@ -132,8 +307,8 @@ skip_array := skip_array_def;
 skip_object := skip_object_def;
 
 prepush {
-  if top == skipMaxDepth {
-    err = errMaxDepth
+  if top + depth == skipMaxDepth {
+    err = ErrMaxDepth
     fbreak;
   }
   if top + 1 >= len(stack) {
@@ -141,15 +316,62 @@ prepush {
   }
 }
 
-main := json_space* skip_json_value @err{
-  return p, stack, errNoValidToken
+main := json_space* skip_json_value >err(expect_value) @err{
+  return p, stack, expected, ErrNoValidToken
 };
 
 write data; write init;  write exec;
 
 }%%
 
-  return p,stack, err
+  return p, stack, expected, err
+}
+
+// skipValueChecked is skipValue with the checks for buffer's Limits, StrictUTF8 and RejectDuplicateKeys. It also
+// reads JSONC when buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func skipValueChecked(data []byte, stack []int, depth int, buffer *Buffer) (int, []int, string, error) {
+  var top, tokenStart int
+  cs, p := 0, 0
+	pe := buffer.documentEnd(data)
+	eof := pe
+	var err error
+	var expected string
+
+%%{
+machine skipValueChecked;
+
+include skipper "skip_machine.rl";
+
+checked_array := checked_array_def;
+checked_object := checked_object_def;
+
+prepush {
+  if top + depth == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if buffer.Limits.MaxDepth > 0 && top + depth >= buffer.Limits.MaxDepth {
+    return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+  }
+  if top + 1 >= len(stack) {
+    stack = append(stack, make([]int, 1 + top - len(stack))...)
+  }
+  buffer.enterLevel(top + 1)
+}
+
+main := jsonc_space* checked_json_value >err(expect_value) @err{
+  err = ErrNoValidToken
+  fhold; fbreak;
+};
+
+write data; write init;  write exec;
+
+}%%
+
+  if pe < len(data) && p >= pe {
+    return pe - 1, stack, "", buffer.documentSizeError(data)
+  }
+  return p, stack, expected, err
 }
 
 func skipStringFast(data []byte) (int, error) {
@@ -162,10 +384,76 @@ func skipStringFast(data []byte) (int, error) {
 machine skipStringFast;
 include common "common.rl";
 
-main := ('"' ([^"\\] | '\\' any)* '"') @err{err = errInvalidString};
+main := ('"' ([^"\\] | '\\' any)* '"') @err{err = ErrInvalidString};
 
 write data; write init;  write exec;
 }%%
 
   return p, err
 }
+
+// resumeState is the state a machine keeps between runs on input that arrives in pieces: its cs, top and call stack.
+// StreamReader and PushParser keep one for the value they are reading.
+type resumeState struct {
+  cs, top int
+  stack []int
+}
+
+%%{
+machine resumableSkip;
+
+include skipper "skip_machine.rl";
+
+variable cs s.cs;
+variable top s.top;
+variable stack s.stack;
+
+skip_array := skip_array_def;
+skip_object := skip_object_def;
+
+prepush {
+  if s.top == skipMaxDepth {
+    err = ErrMaxDepth
+    fbreak;
+  }
+  if s.top + 1 >= len(s.stack) {
+    s.stack = append(s.stack, make([]int, 1 + s.top - len(s.stack))...)
+  }
+}
+
+main := json_space* skip_json_value >err(expect_value) @err{
+  err = ErrNoValidToken
+  fhold; fbreak;
+};
+
+write data;
+}%%
+
+// initSkip starts skipValue's machine on a new value.
+func (s *resumeState) initSkip() {
+%%{
+write init;
+}%%
+}
+
+// skip runs skipValue's machine on data[p:pe]. data must hold the data from earlier runs since initSkip at the same
+// positions. atEOF means no more data will follow pe. The returned bool is true when the value is complete and ends at
+// the returned p. pe must not be in the middle of a number unless atEOF is set. See resumeEnd.
+func (s *resumeState) skip(data []byte, p, pe int, atEOF bool) (int, bool, error) {
+  eof := -1
+  if atEOF {
+    eof = pe
+  }
+  var err error
+  var expected string
+
+%%{
+write exec;
+}%%
+
+  _ = expected
+  if err != nil {
+    return p, false, err
+  }
+  return p, p < pe || s.cs >= resumableSkip_first_final, nil
+}
@@ -369,11 +369,12 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 		goto tr0
 	tr0:
 
-		return p, stack, errNoValidToken
+		return p, stack, ErrNoValidToken
 
 		goto st0
 	tr38:
-		err = errInvalidArray
+		err = ErrInvalidArray
+		p--
 		{
 			p++
 			cs = 0
@@ -381,7 +382,8 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 		}
 		goto st0
 	tr55:
-		err = errInvalidObject
+		err = ErrInvalidObject
+		p--
 		{
 			p++
 			cs = 0
@@ -736,7 +738,7 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 	tr6:
 		{
 			if top == skipMaxDepth {
-				err = errMaxDepth
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -870,7 +872,7 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 	tr10:
 		{
 			if top == skipMaxDepth {
-				err = errMaxDepth
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -1010,7 +1012,7 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 	tr35:
 		{
 			if top == skipMaxDepth {
-				err = errMaxDepth
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -1299,7 +1301,7 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 	tr52:
 		{
 			if top == skipMaxDepth {
-				err = errMaxDepth
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -1696,29 +1698,33 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 			switch cs {
 			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25:
 
-				return p, stack, errNoValidToken
+				return p, stack, ErrNoValidToken
 
 			case 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41:
-				err = errUnexpectedEOF
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidArray
+				err = ErrInvalidArray
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
 			case 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57:
-				err = errUnexpectedEOF
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidObject
+				err = ErrInvalidObject
+				p--
 				{
 					p++
 					cs = 0
@@ -1735,12 +1741,16 @@ func skipValueFast(data []byte, stack []int) (int, []int, error) {
 	return p, stack, err
 }
 
-func skipValue(data []byte, stack []int) (int, []int, error) {
+// skipValue is SkipValue without the Buffer options. depth is the number of objects and arrays that data is nested in.
+// It counts toward the depth limit. When data is invalid, p is the position of the byte that made it invalid and
+// expected describes what would have been valid there.
+func skipValue(data []byte, stack []int, depth int) (int, []int, string, error) {
 	var top int
 	cs, p := 0, 0
 	pe := len(data)
 	eof := len(data)
 	var err error
+	var expected string
 
 	const skipValue_start int = 1
 	const skipValue_first_final int = 183
@@ -1939,6 +1949,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st74
 		case 75:
 			goto st75
+		case 195:
+			goto st195
 		case 76:
 			goto st76
 		case 77:
@@ -2013,8 +2025,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st111
 		case 112:
 			goto st112
-		case 195:
-			goto st195
+		case 196:
+			goto st196
 		case 113:
 			goto st113
 		case 114:
@@ -2155,6 +2167,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st181
 		case 182:
 			goto st182
+		case 197:
+			goto st197
 		}
 
 		if p++; p == pe {
@@ -2338,6 +2352,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st_case_74
 		case 75:
 			goto st_case_75
+		case 195:
+			goto st_case_195
 		case 76:
 			goto st_case_76
 		case 77:
@@ -2412,8 +2428,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st_case_111
 		case 112:
 			goto st_case_112
-		case 195:
-			goto st_case_195
+		case 196:
+			goto st_case_196
 		case 113:
 			goto st_case_113
 		case 114:
@@ -2554,6 +2570,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st_case_181
 		case 182:
 			goto st_case_182
+		case 197:
+			goto st_case_197
 		}
 		goto st_out
 	st1:
@@ -2593,20 +2611,267 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		}
 		goto tr0
 	tr0:
+		expected = "value"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr11:
+		expected = "string character"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr15:
+		expected = "escape sequence"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr18:
+		expected = "hex digit"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr23:
+		expected = "digit"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr24:
+		expected = "false"
+
+		return p, stack, expected, ErrNoValidToken
 
-		return p, stack, errNoValidToken
+		goto st0
+	tr29:
+		expected = "null"
+
+		return p, stack, expected, ErrNoValidToken
+
+		goto st0
+	tr33:
+		expected = "true"
+
+		return p, stack, expected, ErrNoValidToken
 
 		goto st0
-	tr30:
-		err = errInvalidArray
+	tr37:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
 		{
 			p++
 			cs = 0
 			goto _out
 		}
 		goto st0
-	tr98:
-		err = errInvalidObject
+	tr49:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr53:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr57:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr71:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr74:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr79:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr83:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr88:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr92:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr115:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr119:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr123:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr126:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr140:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr144:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr165:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr168:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr173:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr177:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr182:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr186:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
 		{
 			p++
 			cs = 0
@@ -2665,7 +2930,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st5
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr11
 		}
 		goto st4
 	st4:
@@ -2680,7 +2945,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st5
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr11
 		}
 		goto st4
 	st183:
@@ -2714,7 +2979,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st7
 		}
-		goto tr0
+		goto tr15
 	st6:
 		if p++; p == pe {
 			goto _test_eof6
@@ -2727,7 +2992,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st5
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr11
 		}
 		goto st4
 	st7:
@@ -2747,7 +3012,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st8
 		}
-		goto tr0
+		goto tr18
 	st8:
 		if p++; p == pe {
 			goto _test_eof8
@@ -2765,7 +3030,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st9
 		}
-		goto tr0
+		goto tr18
 	st9:
 		if p++; p == pe {
 			goto _test_eof9
@@ -2783,7 +3048,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st10
 		}
-		goto tr0
+		goto tr18
 	st10:
 		if p++; p == pe {
 			goto _test_eof10
@@ -2801,7 +3066,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st11
 		}
-		goto tr0
+		goto tr18
 	st11:
 		if p++; p == pe {
 			goto _test_eof11
@@ -2814,7 +3079,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st5
 		}
 		if data[p] <= 31 {
-			goto tr0
+			goto tr11
 		}
 		goto st4
 	st12:
@@ -2828,7 +3093,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st187
 		}
-		goto tr0
+		goto tr23
 	st184:
 		if p++; p == pe {
 			goto _test_eof184
@@ -2836,17 +3101,18 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	st_case_184:
 		switch data[p] {
 		case 46:
-			goto tr193
+			goto tr222
 		case 69:
-			goto tr194
+			goto tr223
 		case 101:
-			goto tr194
+			goto tr223
 		}
 		goto st0
-	tr193:
+	tr222:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 185
@@ -2861,10 +3127,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		}
 	st_case_185:
 		goto st0
-	tr194:
+	tr223:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 186
@@ -2886,11 +3153,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	st_case_187:
 		switch data[p] {
 		case 46:
-			goto tr193
+			goto tr222
 		case 69:
-			goto tr194
+			goto tr223
 		case 101:
-			goto tr194
+			goto tr223
 		}
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st188
@@ -2903,11 +3170,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	st_case_188:
 		switch data[p] {
 		case 46:
-			goto tr193
+			goto tr222
 		case 69:
-			goto tr194
+			goto tr223
 		case 101:
-			goto tr194
+			goto tr223
 		}
 		if 48 <= data[p] && data[p] <= 57 {
 			goto st188
@@ -2915,8 +3182,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		goto st0
 	tr6:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -2947,7 +3214,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 97 {
 			goto st14
 		}
-		goto tr0
+		goto tr24
 	st14:
 		if p++; p == pe {
 			goto _test_eof14
@@ -2956,7 +3223,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st15
 		}
-		goto tr0
+		goto tr24
 	st15:
 		if p++; p == pe {
 			goto _test_eof15
@@ -2965,7 +3232,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 115 {
 			goto st16
 		}
-		goto tr0
+		goto tr24
 	st16:
 		if p++; p == pe {
 			goto _test_eof16
@@ -2974,7 +3241,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st190
 		}
-		goto tr0
+		goto tr24
 	st190:
 		if p++; p == pe {
 			goto _test_eof190
@@ -2989,7 +3256,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st18
 		}
-		goto tr0
+		goto tr29
 	st18:
 		if p++; p == pe {
 			goto _test_eof18
@@ -2998,7 +3265,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st19
 		}
-		goto tr0
+		goto tr29
 	st19:
 		if p++; p == pe {
 			goto _test_eof19
@@ -3007,7 +3274,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st191
 		}
-		goto tr0
+		goto tr29
 	st191:
 		if p++; p == pe {
 			goto _test_eof191
@@ -3022,7 +3289,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 114 {
 			goto st21
 		}
-		goto tr0
+		goto tr33
 	st21:
 		if p++; p == pe {
 			goto _test_eof21
@@ -3031,7 +3298,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st22
 		}
-		goto tr0
+		goto tr33
 	st22:
 		if p++; p == pe {
 			goto _test_eof22
@@ -3040,7 +3307,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st192
 		}
-		goto tr0
+		goto tr33
 	st192:
 		if p++; p == pe {
 			goto _test_eof192
@@ -3049,8 +3316,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		goto st0
 	tr10:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -3090,9 +3357,9 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st70
 		case 91:
-			goto tr36
+			goto tr43
 		case 93:
-			goto tr37
+			goto tr44
 		case 102:
 			goto st76
 		case 110:
@@ -3100,7 +3367,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st85
 		case 123:
-			goto tr41
+			goto tr48
 		}
 		switch {
 		case data[p] > 10:
@@ -3110,7 +3377,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st24
 		}
-		goto tr30
+		goto tr37
 	st24:
 		if p++; p == pe {
 			goto _test_eof24
@@ -3128,9 +3395,9 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st70
 		case 91:
-			goto tr36
+			goto tr43
 		case 93:
-			goto tr37
+			goto tr44
 		case 102:
 			goto st76
 		case 110:
@@ -3138,7 +3405,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st85
 		case 123:
-			goto tr41
+			goto tr48
 		}
 		switch {
 		case data[p] > 10:
@@ -3148,7 +3415,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st24
 		}
-		goto tr30
+		goto tr37
 	st25:
 		if p++; p == pe {
 			goto _test_eof25
@@ -3161,7 +3428,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st26
 	st26:
@@ -3176,7 +3443,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st26
 	st27:
@@ -3192,12 +3459,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st28:
 		if p++; p == pe {
 			goto _test_eof28
@@ -3211,12 +3478,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st29:
 		if p++; p == pe {
 			goto _test_eof29
@@ -3234,7 +3501,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st42
 		case 91:
-			goto tr52
+			goto tr63
 		case 102:
 			goto st48
 		case 110:
@@ -3242,7 +3509,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st57
 		case 123:
-			goto tr56
+			goto tr67
 		}
 		switch {
 		case data[p] > 10:
@@ -3252,7 +3519,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st30
 		}
-		goto tr30
+		goto tr57
 	st30:
 		if p++; p == pe {
 			goto _test_eof30
@@ -3270,7 +3537,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st42
 		case 91:
-			goto tr52
+			goto tr63
 		case 102:
 			goto st48
 		case 110:
@@ -3278,7 +3545,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st57
 		case 123:
-			goto tr56
+			goto tr67
 		}
 		switch {
 		case data[p] > 10:
@@ -3288,7 +3555,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st30
 		}
-		goto tr30
+		goto tr57
 	st31:
 		if p++; p == pe {
 			goto _test_eof31
@@ -3301,7 +3568,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st34
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st32
 	st32:
@@ -3316,7 +3583,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st34
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st32
 	st33:
@@ -3332,13 +3599,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr37:
+		goto tr53
+	tr56:
 		{
 			top--
 			cs = stack[top]
@@ -3376,7 +3643,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st36
 		}
-		goto tr30
+		goto tr71
 	st35:
 		if p++; p == pe {
 			goto _test_eof35
@@ -3389,7 +3656,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st34
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st32
 	st36:
@@ -3409,7 +3676,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st37
 		}
-		goto tr30
+		goto tr74
 	st37:
 		if p++; p == pe {
 			goto _test_eof37
@@ -3427,7 +3694,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st38
 		}
-		goto tr30
+		goto tr74
 	st38:
 		if p++; p == pe {
 			goto _test_eof38
@@ -3445,7 +3712,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st39
 		}
-		goto tr30
+		goto tr74
 	st39:
 		if p++; p == pe {
 			goto _test_eof39
@@ -3463,7 +3730,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st40
 		}
-		goto tr30
+		goto tr74
 	st40:
 		if p++; p == pe {
 			goto _test_eof40
@@ -3476,7 +3743,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st34
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st32
 	st41:
@@ -3490,7 +3757,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st45
 		}
-		goto tr30
+		goto tr79
 	st42:
 		if p++; p == pe {
 			goto _test_eof42
@@ -3504,22 +3771,23 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr66
+			goto tr80
 		case 69:
-			goto tr67
+			goto tr81
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr67
+			goto tr81
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr66:
+		goto tr53
+	tr80:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 43
@@ -3541,16 +3809,17 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr67:
+		goto tr53
+	tr81:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 44
@@ -3572,12 +3841,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st45:
 		if p++; p == pe {
 			goto _test_eof45
@@ -3591,13 +3860,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr66
+			goto tr80
 		case 69:
-			goto tr67
+			goto tr81
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr67
+			goto tr81
 		}
 		switch {
 		case data[p] > 10:
@@ -3607,7 +3876,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st46:
 		if p++; p == pe {
 			goto _test_eof46
@@ -3621,13 +3890,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr66
+			goto tr80
 		case 69:
-			goto tr67
+			goto tr81
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr67
+			goto tr81
 		}
 		switch {
 		case data[p] > 10:
@@ -3637,11 +3906,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st28
 		}
-		goto tr30
-	tr52:
+		goto tr53
+	tr63:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -3671,12 +3940,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st48:
 		if p++; p == pe {
 			goto _test_eof48
@@ -3685,7 +3954,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 97 {
 			goto st49
 		}
-		goto tr30
+		goto tr83
 	st49:
 		if p++; p == pe {
 			goto _test_eof49
@@ -3694,7 +3963,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st50
 		}
-		goto tr30
+		goto tr83
 	st50:
 		if p++; p == pe {
 			goto _test_eof50
@@ -3703,7 +3972,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 115 {
 			goto st51
 		}
-		goto tr30
+		goto tr83
 	st51:
 		if p++; p == pe {
 			goto _test_eof51
@@ -3712,7 +3981,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st52
 		}
-		goto tr30
+		goto tr83
 	st52:
 		if p++; p == pe {
 			goto _test_eof52
@@ -3726,12 +3995,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st53:
 		if p++; p == pe {
 			goto _test_eof53
@@ -3740,7 +4009,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st54
 		}
-		goto tr30
+		goto tr88
 	st54:
 		if p++; p == pe {
 			goto _test_eof54
@@ -3749,7 +4018,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st55
 		}
-		goto tr30
+		goto tr88
 	st55:
 		if p++; p == pe {
 			goto _test_eof55
@@ -3758,7 +4027,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st56
 		}
-		goto tr30
+		goto tr88
 	st56:
 		if p++; p == pe {
 			goto _test_eof56
@@ -3772,12 +4041,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st57:
 		if p++; p == pe {
 			goto _test_eof57
@@ -3786,7 +4055,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 114 {
 			goto st58
 		}
-		goto tr30
+		goto tr92
 	st58:
 		if p++; p == pe {
 			goto _test_eof58
@@ -3795,7 +4064,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st59
 		}
-		goto tr30
+		goto tr92
 	st59:
 		if p++; p == pe {
 			goto _test_eof59
@@ -3804,7 +4073,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st60
 		}
-		goto tr30
+		goto tr92
 	st60:
 		if p++; p == pe {
 			goto _test_eof60
@@ -3818,16 +4087,16 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr56:
+		goto tr53
+	tr67:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -3857,12 +4126,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st62:
 		if p++; p == pe {
 			goto _test_eof62
@@ -3888,7 +4157,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st64
 		}
-		goto tr30
+		goto tr71
 	st63:
 		if p++; p == pe {
 			goto _test_eof63
@@ -3901,7 +4170,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st26
 	st64:
@@ -3921,7 +4190,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st65
 		}
-		goto tr30
+		goto tr74
 	st65:
 		if p++; p == pe {
 			goto _test_eof65
@@ -3939,7 +4208,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st66
 		}
-		goto tr30
+		goto tr74
 	st66:
 		if p++; p == pe {
 			goto _test_eof66
@@ -3957,7 +4226,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st67
 		}
-		goto tr30
+		goto tr74
 	st67:
 		if p++; p == pe {
 			goto _test_eof67
@@ -3975,7 +4244,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st68
 		}
-		goto tr30
+		goto tr74
 	st68:
 		if p++; p == pe {
 			goto _test_eof68
@@ -3988,7 +4257,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st62
 		}
 		if data[p] <= 31 {
-			goto tr30
+			goto tr49
 		}
 		goto st26
 	st69:
@@ -4002,7 +4271,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st73
 		}
-		goto tr30
+		goto tr79
 	st70:
 		if p++; p == pe {
 			goto _test_eof70
@@ -4016,22 +4285,23 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr85
+			goto tr102
 		case 69:
-			goto tr86
+			goto tr103
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr86
+			goto tr103
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr85:
+		goto tr53
+	tr102:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 71
@@ -4053,16 +4323,17 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr86:
+		goto tr53
+	tr103:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 72
@@ -4084,12 +4355,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st73:
 		if p++; p == pe {
 			goto _test_eof73
@@ -4103,13 +4374,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr85
+			goto tr102
 		case 69:
-			goto tr86
+			goto tr103
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr86
+			goto tr103
 		}
 		switch {
 		case data[p] > 10:
@@ -4119,7 +4390,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st74:
 		if p++; p == pe {
 			goto _test_eof74
@@ -4133,13 +4404,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 46:
-			goto tr85
+			goto tr102
 		case 69:
-			goto tr86
+			goto tr103
 		case 93:
-			goto tr37
+			goto tr56
 		case 101:
-			goto tr86
+			goto tr103
 		}
 		switch {
 		case data[p] > 10:
@@ -4149,11 +4420,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st28
 		}
-		goto tr30
-	tr36:
+		goto tr53
+	tr43:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -4183,12 +4454,25 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
+	tr44:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st195
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		goto st0
 	st76:
 		if p++; p == pe {
 			goto _test_eof76
@@ -4197,7 +4481,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 97 {
 			goto st77
 		}
-		goto tr30
+		goto tr83
 	st77:
 		if p++; p == pe {
 			goto _test_eof77
@@ -4206,7 +4490,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st78
 		}
-		goto tr30
+		goto tr83
 	st78:
 		if p++; p == pe {
 			goto _test_eof78
@@ -4215,7 +4499,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 115 {
 			goto st79
 		}
-		goto tr30
+		goto tr83
 	st79:
 		if p++; p == pe {
 			goto _test_eof79
@@ -4224,7 +4508,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st80
 		}
-		goto tr30
+		goto tr83
 	st80:
 		if p++; p == pe {
 			goto _test_eof80
@@ -4238,12 +4522,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st81:
 		if p++; p == pe {
 			goto _test_eof81
@@ -4252,7 +4536,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st82
 		}
-		goto tr30
+		goto tr88
 	st82:
 		if p++; p == pe {
 			goto _test_eof82
@@ -4261,7 +4545,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st83
 		}
-		goto tr30
+		goto tr88
 	st83:
 		if p++; p == pe {
 			goto _test_eof83
@@ -4270,7 +4554,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st84
 		}
-		goto tr30
+		goto tr88
 	st84:
 		if p++; p == pe {
 			goto _test_eof84
@@ -4284,12 +4568,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st85:
 		if p++; p == pe {
 			goto _test_eof85
@@ -4298,7 +4582,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 114 {
 			goto st86
 		}
-		goto tr30
+		goto tr92
 	st86:
 		if p++; p == pe {
 			goto _test_eof86
@@ -4307,7 +4591,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st87
 		}
-		goto tr30
+		goto tr92
 	st87:
 		if p++; p == pe {
 			goto _test_eof87
@@ -4316,7 +4600,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st88
 		}
-		goto tr30
+		goto tr92
 	st88:
 		if p++; p == pe {
 			goto _test_eof88
@@ -4330,16 +4614,16 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
-	tr41:
+		goto tr53
+	tr48:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -4369,12 +4653,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st29
 		case 93:
-			goto tr37
+			goto tr56
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st28
 		}
-		goto tr30
+		goto tr53
 	st90:
 		if p++; p == pe {
 			goto _test_eof90
@@ -4388,12 +4672,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 34:
 			goto st92
 		case 125:
-			goto tr101
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st91
 		}
-		goto tr98
+		goto tr115
 	st91:
 		if p++; p == pe {
 			goto _test_eof91
@@ -4407,12 +4691,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 34:
 			goto st92
 		case 125:
-			goto tr101
+			goto tr118
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st91
 		}
-		goto tr98
+		goto tr115
 	st92:
 		if p++; p == pe {
 			goto _test_eof92
@@ -4425,7 +4709,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st176
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st93
 	st93:
@@ -4440,7 +4724,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st176
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st93
 	st94:
@@ -4459,7 +4743,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st95
 		}
-		goto tr98
+		goto tr123
 	st95:
 		if p++; p == pe {
 			goto _test_eof95
@@ -4476,7 +4760,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st95
 		}
-		goto tr98
+		goto tr123
 	st96:
 		if p++; p == pe {
 			goto _test_eof96
@@ -4494,7 +4778,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st156
 		case 91:
-			goto tr112
+			goto tr132
 		case 102:
 			goto st162
 		case 110:
@@ -4502,7 +4786,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st171
 		case 123:
-			goto tr116
+			goto tr136
 		}
 		switch {
 		case data[p] > 10:
@@ -4512,7 +4796,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st97
 		}
-		goto tr98
+		goto tr126
 	st97:
 		if p++; p == pe {
 			goto _test_eof97
@@ -4530,7 +4814,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st156
 		case 91:
-			goto tr112
+			goto tr132
 		case 102:
 			goto st162
 		case 110:
@@ -4538,7 +4822,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st171
 		case 123:
-			goto tr116
+			goto tr136
 		}
 		switch {
 		case data[p] > 10:
@@ -4548,7 +4832,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st97
 		}
-		goto tr98
+		goto tr126
 	st98:
 		if p++; p == pe {
 			goto _test_eof98
@@ -4561,7 +4845,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st99
 	st99:
@@ -4576,7 +4860,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st99
 	st100:
@@ -4592,12 +4876,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st101:
 		if p++; p == pe {
 			goto _test_eof101
@@ -4611,12 +4895,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st102:
 		if p++; p == pe {
 			goto _test_eof102
@@ -4633,7 +4917,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st103
 		}
-		goto tr98
+		goto tr144
 	st103:
 		if p++; p == pe {
 			goto _test_eof103
@@ -4650,7 +4934,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st103
 		}
-		goto tr98
+		goto tr144
 	st104:
 		if p++; p == pe {
 			goto _test_eof104
@@ -4663,7 +4947,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st141
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st105
 	st105:
@@ -4678,7 +4962,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st141
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st105
 	st106:
@@ -4697,7 +4981,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st107
 		}
-		goto tr98
+		goto tr123
 	st107:
 		if p++; p == pe {
 			goto _test_eof107
@@ -4714,7 +4998,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st107
 		}
-		goto tr98
+		goto tr123
 	st108:
 		if p++; p == pe {
 			goto _test_eof108
@@ -4732,7 +5016,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st121
 		case 91:
-			goto tr134
+			goto tr157
 		case 102:
 			goto st127
 		case 110:
@@ -4740,7 +5024,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st136
 		case 123:
-			goto tr138
+			goto tr161
 		}
 		switch {
 		case data[p] > 10:
@@ -4750,7 +5034,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st109
 		}
-		goto tr98
+		goto tr126
 	st109:
 		if p++; p == pe {
 			goto _test_eof109
@@ -4768,7 +5052,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 48:
 			goto st121
 		case 91:
-			goto tr134
+			goto tr157
 		case 102:
 			goto st127
 		case 110:
@@ -4776,7 +5060,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 116:
 			goto st136
 		case 123:
-			goto tr138
+			goto tr161
 		}
 		switch {
 		case data[p] > 10:
@@ -4786,7 +5070,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st109
 		}
-		goto tr98
+		goto tr126
 	st110:
 		if p++; p == pe {
 			goto _test_eof110
@@ -4799,7 +5083,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st113
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st111
 	st111:
@@ -4814,7 +5098,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st113
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st111
 	st112:
@@ -4830,24 +5114,24 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr101:
+		goto tr140
+	tr143:
 		{
 			top--
 			cs = stack[top]
 			goto _again
 		}
-		goto st195
-	st195:
+		goto st196
+	st196:
 		if p++; p == pe {
-			goto _test_eof195
+			goto _test_eof196
 		}
-	st_case_195:
+	st_case_196:
 		goto st0
 	st113:
 		if p++; p == pe {
@@ -4874,7 +5158,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st115
 		}
-		goto tr98
+		goto tr165
 	st114:
 		if p++; p == pe {
 			goto _test_eof114
@@ -4887,7 +5171,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st113
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st111
 	st115:
@@ -4907,7 +5191,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st116
 		}
-		goto tr98
+		goto tr168
 	st116:
 		if p++; p == pe {
 			goto _test_eof116
@@ -4925,7 +5209,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st117
 		}
-		goto tr98
+		goto tr168
 	st117:
 		if p++; p == pe {
 			goto _test_eof117
@@ -4943,7 +5227,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st118
 		}
-		goto tr98
+		goto tr168
 	st118:
 		if p++; p == pe {
 			goto _test_eof118
@@ -4961,7 +5245,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st119
 		}
-		goto tr98
+		goto tr168
 	st119:
 		if p++; p == pe {
 			goto _test_eof119
@@ -4974,7 +5258,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st113
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st111
 	st120:
@@ -4988,7 +5272,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st124
 		}
-		goto tr98
+		goto tr173
 	st121:
 		if p++; p == pe {
 			goto _test_eof121
@@ -5002,22 +5286,23 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr148
+			goto tr174
 		case 69:
-			goto tr149
+			goto tr175
 		case 101:
-			goto tr149
+			goto tr175
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr148:
+		goto tr140
+	tr174:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 122
@@ -5039,16 +5324,17 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr149:
+		goto tr140
+	tr175:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 123
@@ -5070,12 +5356,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st124:
 		if p++; p == pe {
 			goto _test_eof124
@@ -5089,13 +5375,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr148
+			goto tr174
 		case 69:
-			goto tr149
+			goto tr175
 		case 101:
-			goto tr149
+			goto tr175
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -5105,7 +5391,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st125:
 		if p++; p == pe {
 			goto _test_eof125
@@ -5119,13 +5405,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr148
+			goto tr174
 		case 69:
-			goto tr149
+			goto tr175
 		case 101:
-			goto tr149
+			goto tr175
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -5135,11 +5421,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st101
 		}
-		goto tr98
-	tr134:
+		goto tr140
+	tr157:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -5169,12 +5455,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st127:
 		if p++; p == pe {
 			goto _test_eof127
@@ -5183,7 +5469,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 97 {
 			goto st128
 		}
-		goto tr98
+		goto tr177
 	st128:
 		if p++; p == pe {
 			goto _test_eof128
@@ -5192,7 +5478,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st129
 		}
-		goto tr98
+		goto tr177
 	st129:
 		if p++; p == pe {
 			goto _test_eof129
@@ -5201,7 +5487,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 115 {
 			goto st130
 		}
-		goto tr98
+		goto tr177
 	st130:
 		if p++; p == pe {
 			goto _test_eof130
@@ -5210,7 +5496,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st131
 		}
-		goto tr98
+		goto tr177
 	st131:
 		if p++; p == pe {
 			goto _test_eof131
@@ -5224,12 +5510,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st132:
 		if p++; p == pe {
 			goto _test_eof132
@@ -5238,7 +5524,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st133
 		}
-		goto tr98
+		goto tr182
 	st133:
 		if p++; p == pe {
 			goto _test_eof133
@@ -5247,7 +5533,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st134
 		}
-		goto tr98
+		goto tr182
 	st134:
 		if p++; p == pe {
 			goto _test_eof134
@@ -5256,7 +5542,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st135
 		}
-		goto tr98
+		goto tr182
 	st135:
 		if p++; p == pe {
 			goto _test_eof135
@@ -5270,12 +5556,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st136:
 		if p++; p == pe {
 			goto _test_eof136
@@ -5284,7 +5570,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 114 {
 			goto st137
 		}
-		goto tr98
+		goto tr186
 	st137:
 		if p++; p == pe {
 			goto _test_eof137
@@ -5293,7 +5579,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st138
 		}
-		goto tr98
+		goto tr186
 	st138:
 		if p++; p == pe {
 			goto _test_eof138
@@ -5302,7 +5588,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st139
 		}
-		goto tr98
+		goto tr186
 	st139:
 		if p++; p == pe {
 			goto _test_eof139
@@ -5316,16 +5602,16 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr138:
+		goto tr140
+	tr161:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -5355,12 +5641,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st141:
 		if p++; p == pe {
 			goto _test_eof141
@@ -5386,7 +5672,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st143
 		}
-		goto tr98
+		goto tr165
 	st142:
 		if p++; p == pe {
 			goto _test_eof142
@@ -5399,7 +5685,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st141
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st105
 	st143:
@@ -5419,7 +5705,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st144
 		}
-		goto tr98
+		goto tr168
 	st144:
 		if p++; p == pe {
 			goto _test_eof144
@@ -5437,7 +5723,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st145
 		}
-		goto tr98
+		goto tr168
 	st145:
 		if p++; p == pe {
 			goto _test_eof145
@@ -5455,7 +5741,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st146
 		}
-		goto tr98
+		goto tr168
 	st146:
 		if p++; p == pe {
 			goto _test_eof146
@@ -5473,7 +5759,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st147
 		}
-		goto tr98
+		goto tr168
 	st147:
 		if p++; p == pe {
 			goto _test_eof147
@@ -5486,7 +5772,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st141
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st105
 	st148:
@@ -5514,7 +5800,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st150
 		}
-		goto tr98
+		goto tr165
 	st149:
 		if p++; p == pe {
 			goto _test_eof149
@@ -5527,7 +5813,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st99
 	st150:
@@ -5547,7 +5833,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st151
 		}
-		goto tr98
+		goto tr168
 	st151:
 		if p++; p == pe {
 			goto _test_eof151
@@ -5565,7 +5851,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st152
 		}
-		goto tr98
+		goto tr168
 	st152:
 		if p++; p == pe {
 			goto _test_eof152
@@ -5583,7 +5869,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st153
 		}
-		goto tr98
+		goto tr168
 	st153:
 		if p++; p == pe {
 			goto _test_eof153
@@ -5601,7 +5887,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st154
 		}
-		goto tr98
+		goto tr168
 	st154:
 		if p++; p == pe {
 			goto _test_eof154
@@ -5614,7 +5900,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st148
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st99
 	st155:
@@ -5628,7 +5914,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if 49 <= data[p] && data[p] <= 57 {
 			goto st159
 		}
-		goto tr98
+		goto tr173
 	st156:
 		if p++; p == pe {
 			goto _test_eof156
@@ -5642,22 +5928,23 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr173
+			goto tr202
 		case 69:
-			goto tr174
+			goto tr203
 		case 101:
-			goto tr174
+			goto tr203
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr173:
+		goto tr140
+	tr202:
 
 		p, err = skipFloatDec(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 157
@@ -5679,16 +5966,17 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr174:
+		goto tr140
+	tr203:
 
 		p, err = skipFloatExp(data, p+1, pe)
 		if err != nil {
+			expected = "digit"
 			{
 				p++
 				cs = 158
@@ -5710,12 +5998,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st159:
 		if p++; p == pe {
 			goto _test_eof159
@@ -5729,13 +6017,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr173
+			goto tr202
 		case 69:
-			goto tr174
+			goto tr203
 		case 101:
-			goto tr174
+			goto tr203
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -5745,7 +6033,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st160:
 		if p++; p == pe {
 			goto _test_eof160
@@ -5759,13 +6047,13 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 46:
-			goto tr173
+			goto tr202
 		case 69:
-			goto tr174
+			goto tr203
 		case 101:
-			goto tr174
+			goto tr203
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		switch {
 		case data[p] > 10:
@@ -5775,11 +6063,11 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case data[p] >= 9:
 			goto st101
 		}
-		goto tr98
-	tr112:
+		goto tr140
+	tr132:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -5809,12 +6097,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st162:
 		if p++; p == pe {
 			goto _test_eof162
@@ -5823,7 +6111,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 97 {
 			goto st163
 		}
-		goto tr98
+		goto tr177
 	st163:
 		if p++; p == pe {
 			goto _test_eof163
@@ -5832,7 +6120,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st164
 		}
-		goto tr98
+		goto tr177
 	st164:
 		if p++; p == pe {
 			goto _test_eof164
@@ -5841,7 +6129,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 115 {
 			goto st165
 		}
-		goto tr98
+		goto tr177
 	st165:
 		if p++; p == pe {
 			goto _test_eof165
@@ -5850,7 +6138,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st166
 		}
-		goto tr98
+		goto tr177
 	st166:
 		if p++; p == pe {
 			goto _test_eof166
@@ -5864,12 +6152,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st167:
 		if p++; p == pe {
 			goto _test_eof167
@@ -5878,7 +6166,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st168
 		}
-		goto tr98
+		goto tr182
 	st168:
 		if p++; p == pe {
 			goto _test_eof168
@@ -5887,7 +6175,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st169
 		}
-		goto tr98
+		goto tr182
 	st169:
 		if p++; p == pe {
 			goto _test_eof169
@@ -5896,7 +6184,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 108 {
 			goto st170
 		}
-		goto tr98
+		goto tr182
 	st170:
 		if p++; p == pe {
 			goto _test_eof170
@@ -5910,12 +6198,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st171:
 		if p++; p == pe {
 			goto _test_eof171
@@ -5924,7 +6212,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 114 {
 			goto st172
 		}
-		goto tr98
+		goto tr186
 	st172:
 		if p++; p == pe {
 			goto _test_eof172
@@ -5933,7 +6221,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 117 {
 			goto st173
 		}
-		goto tr98
+		goto tr186
 	st173:
 		if p++; p == pe {
 			goto _test_eof173
@@ -5942,7 +6230,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		if data[p] == 101 {
 			goto st174
 		}
-		goto tr98
+		goto tr186
 	st174:
 		if p++; p == pe {
 			goto _test_eof174
@@ -5956,16 +6244,16 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
-	tr116:
+		goto tr140
+	tr136:
 		{
-			if top == skipMaxDepth {
-				err = errMaxDepth
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
 				{
 					p++
 					cs = 0
@@ -5995,12 +6283,12 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 44:
 			goto st102
 		case 125:
-			goto tr101
+			goto tr143
 		}
 		if 9 <= data[p] && data[p] <= 10 {
 			goto st101
 		}
-		goto tr98
+		goto tr140
 	st176:
 		if p++; p == pe {
 			goto _test_eof176
@@ -6026,7 +6314,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		case 117:
 			goto st178
 		}
-		goto tr98
+		goto tr165
 	st177:
 		if p++; p == pe {
 			goto _test_eof177
@@ -6039,7 +6327,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st176
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st93
 	st178:
@@ -6059,7 +6347,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st179
 		}
-		goto tr98
+		goto tr168
 	st179:
 		if p++; p == pe {
 			goto _test_eof179
@@ -6077,7 +6365,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st180
 		}
-		goto tr98
+		goto tr168
 	st180:
 		if p++; p == pe {
 			goto _test_eof180
@@ -6095,7 +6383,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st181
 		}
-		goto tr98
+		goto tr168
 	st181:
 		if p++; p == pe {
 			goto _test_eof181
@@ -6113,7 +6401,7 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 		default:
 			goto st182
 		}
-		goto tr98
+		goto tr168
 	st182:
 		if p++; p == pe {
 			goto _test_eof182
@@ -6126,9 +6414,22 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 			goto st176
 		}
 		if data[p] <= 31 {
-			goto tr98
+			goto tr119
 		}
 		goto st93
+	tr118:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st197
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		goto st0
 	st_out:
 	_test_eof1:
 		cs = 1
@@ -6391,6 +6692,9 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	_test_eof75:
 		cs = 75
 		goto _test_eof
+	_test_eof195:
+		cs = 195
+		goto _test_eof
 	_test_eof76:
 		cs = 76
 		goto _test_eof
@@ -6502,8 +6806,8 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	_test_eof112:
 		cs = 112
 		goto _test_eof
-	_test_eof195:
-		cs = 195
+	_test_eof196:
+		cs = 196
 		goto _test_eof
 	_test_eof113:
 		cs = 113
@@ -6715,181 +7019,16179 @@ func skipValue(data []byte, stack []int) (int, []int, error) {
 	_test_eof182:
 		cs = 182
 		goto _test_eof
+	_test_eof197:
+		cs = 197
+		goto _test_eof
 
 	_test_eof:
 		{
 		}
 		if p == eof {
 			switch cs {
-			case 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22:
+			case 1, 2:
+				expected = "value"
+
+				return p, stack, expected, ErrNoValidToken
+
+			case 5:
+				expected = "escape sequence"
+
+				return p, stack, expected, ErrNoValidToken
+
+			case 7, 8, 9, 10:
+				expected = "hex digit"
+
+				return p, stack, expected, ErrNoValidToken
+
+			case 12:
+				expected = "digit"
+
+				return p, stack, expected, ErrNoValidToken
 
-				return p, stack, errNoValidToken
+			case 20, 21, 22:
+				expected = "true"
 
-			case 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89:
-				err = errUnexpectedEOF
+				return p, stack, expected, ErrNoValidToken
+
+			case 13, 14, 15, 16:
+				expected = "false"
+
+				return p, stack, expected, ErrNoValidToken
+
+			case 17, 18, 19:
+				expected = "null"
+
+				return p, stack, expected, ErrNoValidToken
+
+			case 29, 30:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidArray
+				err = ErrInvalidArray
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-			case 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107, 108, 109, 110, 111, 112, 113, 114, 115, 116, 117, 118, 119, 120, 121, 122, 123, 124, 125, 126, 127, 128, 129, 130, 131, 132, 133, 134, 135, 136, 137, 138, 139, 140, 141, 142, 143, 144, 145, 146, 147, 148, 149, 150, 151, 152, 153, 154, 155, 156, 157, 158, 159, 160, 161, 162, 163, 164, 165, 166, 167, 168, 169, 170, 171, 172, 173, 174, 175, 176, 177, 178, 179, 180, 181, 182:
-				err = errUnexpectedEOF
+			case 96, 97, 108, 109:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-				err = errInvalidObject
+				err = ErrInvalidObject
+				p--
 				{
 					p++
 					cs = 0
 					goto _out
 				}
-			}
-		}
-
-	_out:
-		{
-		}
-	}
-
-	return p, stack, err
-}
-
-func skipStringFast(data []byte) (int, error) {
-	cs, p := 0, 0
-	pe := len(data)
-	eof := len(data)
-	var err error
-
-	const skipStringFast_start int = 1
-	const skipStringFast_first_final int = 6
-	const skipStringFast_error int = 0
-
-	const skipStringFast_en_main int = 1
-
-	{
-		cs = skipStringFast_start
-	}
-
-	{
-		if p == pe {
-			goto _test_eof
-		}
-		switch cs {
-		case 1:
-			goto st_case_1
-		case 0:
-			goto st_case_0
-		case 2:
-			goto st_case_2
-		case 3:
-			goto st_case_3
-		case 6:
-			goto st_case_6
-		case 4:
-			goto st_case_4
-		case 5:
-			goto st_case_5
-		}
-		goto st_out
-	st_case_1:
-		if data[p] == 34 {
-			goto st2
-		}
-		goto tr0
-	tr0:
-		err = errInvalidString
-		goto st0
-	st_case_0:
-	st0:
-		cs = 0
-		goto _out
-	st2:
-		if p++; p == pe {
-			goto _test_eof2
-		}
-	st_case_2:
-		switch data[p] {
-		case 34:
-			goto st6
-		case 92:
-			goto st4
-		}
-		goto st3
-	st3:
-		if p++; p == pe {
-			goto _test_eof3
-		}
-	st_case_3:
-		switch data[p] {
-		case 34:
-			goto st6
-		case 92:
-			goto st4
-		}
-		goto st3
-	st6:
-		if p++; p == pe {
-			goto _test_eof6
-		}
-	st_case_6:
-		goto st0
-	st4:
-		if p++; p == pe {
-			goto _test_eof4
-		}
-	st_case_4:
-		goto st5
-	st5:
-		if p++; p == pe {
-			goto _test_eof5
-		}
-	st_case_5:
-		switch data[p] {
-		case 34:
-			goto st6
-		case 92:
-			goto st4
-		}
-		goto st3
-	st_out:
-	_test_eof2:
-		cs = 2
-		goto _test_eof
-	_test_eof3:
-		cs = 3
-		goto _test_eof
-	_test_eof6:
-		cs = 6
-		goto _test_eof
-	_test_eof4:
-		cs = 4
-		goto _test_eof
-	_test_eof5:
-		cs = 5
-		goto _test_eof
+			case 23, 24:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 27, 28, 33, 42, 43, 44, 45, 46, 47, 52, 56, 60, 61, 70, 71, 72, 73, 74, 75, 80, 84, 88, 89:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 90, 91:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 102, 103:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 94, 95, 106, 107:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 100, 101, 112, 121, 122, 123, 124, 125, 126, 131, 135, 139, 140, 156, 157, 158, 159, 160, 161, 166, 170, 174, 175:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 3, 4, 6, 11:
+				expected = "string character"
+				expected = "'\"'"
 
-	_test_eof:
-		{
-		}
-		if p == eof {
-			switch cs {
-			case 1, 2, 3, 4, 5:
-				err = errInvalidString
-			}
-		}
+				return p, stack, expected, ErrNoValidToken
 
-	_out:
-		{
-		}
-	}
+			case 34, 62:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 113, 141, 148, 176:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 36, 37, 38, 39, 64, 65, 66, 67:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 115, 116, 117, 118, 143, 144, 145, 146, 150, 151, 152, 153, 178, 179, 180, 181:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 41, 69:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 120, 155:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 57, 58, 59, 85, 86, 87:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 136, 137, 138, 171, 172, 173:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 48, 49, 50, 51, 76, 77, 78, 79:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 127, 128, 129, 130, 162, 163, 164, 165:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 53, 54, 55, 81, 82, 83:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 132, 133, 134, 167, 168, 169:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 25, 26, 31, 32, 35, 40, 63, 68:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 92, 93, 98, 99, 104, 105, 110, 111, 114, 119, 142, 147, 149, 154, 177, 182:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	return p, stack, expected, err
+}
+
+// skipValueChecked is skipValue with the checks for buffer's Limits, StrictUTF8 and RejectDuplicateKeys. It also
+// reads JSONC when buffer.JSONC is set and NaN and Infinity when buffer.AllowNonFinite is set.
+func skipValueChecked(data []byte, stack []int, depth int, buffer *Buffer) (int, []int, string, error) {
+	var top, tokenStart int
+	cs, p := 0, 0
+	pe := buffer.documentEnd(data)
+	eof := pe
+	var err error
+	var expected string
+
+	const skipValueChecked_start int = 1
+	const skipValueChecked_first_final int = 265
+	const skipValueChecked_error int = 0
+
+	const skipValueChecked_en_checked_array int = 35
+	const skipValueChecked_en_checked_object int = 132
+	const skipValueChecked_en_main int = 1
+
+	{
+		cs = skipValueChecked_start
+		top = 0
+	}
+
+	{
+		var _widec int16
+		if p == pe {
+			goto _test_eof
+		}
+		goto _resume
+
+	_again:
+		switch cs {
+		case 1:
+			goto st1
+		case 0:
+			goto st0
+		case 2:
+			goto st2
+		case 3:
+			goto st3
+		case 4:
+			goto st4
+		case 265:
+			goto st265
+		case 5:
+			goto st5
+		case 6:
+			goto st6
+		case 7:
+			goto st7
+		case 8:
+			goto st8
+		case 9:
+			goto st9
+		case 10:
+			goto st10
+		case 11:
+			goto st11
+		case 12:
+			goto st12
+		case 13:
+			goto st13
+		case 266:
+			goto st266
+		case 267:
+			goto st267
+		case 268:
+			goto st268
+		case 269:
+			goto st269
+		case 270:
+			goto st270
+		case 14:
+			goto st14
+		case 15:
+			goto st15
+		case 16:
+			goto st16
+		case 17:
+			goto st17
+		case 18:
+			goto st18
+		case 19:
+			goto st19
+		case 20:
+			goto st20
+		case 271:
+			goto st271
+		case 272:
+			goto st272
+		case 21:
+			goto st21
+		case 22:
+			goto st22
+		case 23:
+			goto st23
+		case 24:
+			goto st24
+		case 273:
+			goto st273
+		case 25:
+			goto st25
+		case 26:
+			goto st26
+		case 27:
+			goto st27
+		case 274:
+			goto st274
+		case 28:
+			goto st28
+		case 29:
+			goto st29
+		case 30:
+			goto st30
+		case 275:
+			goto st275
+		case 276:
+			goto st276
+		case 31:
+			goto st31
+		case 32:
+			goto st32
+		case 33:
+			goto st33
+		case 34:
+			goto st34
+		case 277:
+			goto st277
+		case 35:
+			goto st35
+		case 36:
+			goto st36
+		case 37:
+			goto st37
+		case 38:
+			goto st38
+		case 39:
+			goto st39
+		case 40:
+			goto st40
+		case 41:
+			goto st41
+		case 42:
+			goto st42
+		case 43:
+			goto st43
+		case 44:
+			goto st44
+		case 45:
+			goto st45
+		case 278:
+			goto st278
+		case 46:
+			goto st46
+		case 47:
+			goto st47
+		case 48:
+			goto st48
+		case 49:
+			goto st49
+		case 50:
+			goto st50
+		case 51:
+			goto st51
+		case 52:
+			goto st52
+		case 53:
+			goto st53
+		case 54:
+			goto st54
+		case 55:
+			goto st55
+		case 56:
+			goto st56
+		case 57:
+			goto st57
+		case 58:
+			goto st58
+		case 59:
+			goto st59
+		case 60:
+			goto st60
+		case 61:
+			goto st61
+		case 62:
+			goto st62
+		case 63:
+			goto st63
+		case 64:
+			goto st64
+		case 65:
+			goto st65
+		case 66:
+			goto st66
+		case 67:
+			goto st67
+		case 68:
+			goto st68
+		case 69:
+			goto st69
+		case 70:
+			goto st70
+		case 71:
+			goto st71
+		case 72:
+			goto st72
+		case 73:
+			goto st73
+		case 74:
+			goto st74
+		case 75:
+			goto st75
+		case 76:
+			goto st76
+		case 77:
+			goto st77
+		case 78:
+			goto st78
+		case 79:
+			goto st79
+		case 80:
+			goto st80
+		case 81:
+			goto st81
+		case 82:
+			goto st82
+		case 83:
+			goto st83
+		case 84:
+			goto st84
+		case 85:
+			goto st85
+		case 86:
+			goto st86
+		case 279:
+			goto st279
+		case 87:
+			goto st87
+		case 88:
+			goto st88
+		case 89:
+			goto st89
+		case 90:
+			goto st90
+		case 91:
+			goto st91
+		case 92:
+			goto st92
+		case 93:
+			goto st93
+		case 94:
+			goto st94
+		case 95:
+			goto st95
+		case 96:
+			goto st96
+		case 97:
+			goto st97
+		case 98:
+			goto st98
+		case 99:
+			goto st99
+		case 100:
+			goto st100
+		case 101:
+			goto st101
+		case 102:
+			goto st102
+		case 103:
+			goto st103
+		case 104:
+			goto st104
+		case 105:
+			goto st105
+		case 106:
+			goto st106
+		case 107:
+			goto st107
+		case 108:
+			goto st108
+		case 109:
+			goto st109
+		case 110:
+			goto st110
+		case 111:
+			goto st111
+		case 112:
+			goto st112
+		case 280:
+			goto st280
+		case 113:
+			goto st113
+		case 114:
+			goto st114
+		case 115:
+			goto st115
+		case 116:
+			goto st116
+		case 117:
+			goto st117
+		case 118:
+			goto st118
+		case 119:
+			goto st119
+		case 120:
+			goto st120
+		case 121:
+			goto st121
+		case 122:
+			goto st122
+		case 123:
+			goto st123
+		case 124:
+			goto st124
+		case 125:
+			goto st125
+		case 126:
+			goto st126
+		case 127:
+			goto st127
+		case 128:
+			goto st128
+		case 129:
+			goto st129
+		case 130:
+			goto st130
+		case 131:
+			goto st131
+		case 132:
+			goto st132
+		case 133:
+			goto st133
+		case 134:
+			goto st134
+		case 135:
+			goto st135
+		case 136:
+			goto st136
+		case 137:
+			goto st137
+		case 138:
+			goto st138
+		case 139:
+			goto st139
+		case 140:
+			goto st140
+		case 141:
+			goto st141
+		case 142:
+			goto st142
+		case 143:
+			goto st143
+		case 144:
+			goto st144
+		case 145:
+			goto st145
+		case 146:
+			goto st146
+		case 147:
+			goto st147
+		case 148:
+			goto st148
+		case 149:
+			goto st149
+		case 150:
+			goto st150
+		case 151:
+			goto st151
+		case 152:
+			goto st152
+		case 153:
+			goto st153
+		case 154:
+			goto st154
+		case 281:
+			goto st281
+		case 155:
+			goto st155
+		case 156:
+			goto st156
+		case 157:
+			goto st157
+		case 158:
+			goto st158
+		case 159:
+			goto st159
+		case 160:
+			goto st160
+		case 161:
+			goto st161
+		case 162:
+			goto st162
+		case 163:
+			goto st163
+		case 164:
+			goto st164
+		case 165:
+			goto st165
+		case 166:
+			goto st166
+		case 167:
+			goto st167
+		case 168:
+			goto st168
+		case 169:
+			goto st169
+		case 170:
+			goto st170
+		case 171:
+			goto st171
+		case 172:
+			goto st172
+		case 173:
+			goto st173
+		case 174:
+			goto st174
+		case 175:
+			goto st175
+		case 176:
+			goto st176
+		case 177:
+			goto st177
+		case 178:
+			goto st178
+		case 179:
+			goto st179
+		case 180:
+			goto st180
+		case 181:
+			goto st181
+		case 182:
+			goto st182
+		case 183:
+			goto st183
+		case 184:
+			goto st184
+		case 185:
+			goto st185
+		case 186:
+			goto st186
+		case 187:
+			goto st187
+		case 188:
+			goto st188
+		case 189:
+			goto st189
+		case 190:
+			goto st190
+		case 191:
+			goto st191
+		case 192:
+			goto st192
+		case 193:
+			goto st193
+		case 194:
+			goto st194
+		case 195:
+			goto st195
+		case 196:
+			goto st196
+		case 197:
+			goto st197
+		case 198:
+			goto st198
+		case 199:
+			goto st199
+		case 200:
+			goto st200
+		case 201:
+			goto st201
+		case 202:
+			goto st202
+		case 203:
+			goto st203
+		case 204:
+			goto st204
+		case 205:
+			goto st205
+		case 206:
+			goto st206
+		case 207:
+			goto st207
+		case 208:
+			goto st208
+		case 209:
+			goto st209
+		case 210:
+			goto st210
+		case 282:
+			goto st282
+		case 211:
+			goto st211
+		case 212:
+			goto st212
+		case 213:
+			goto st213
+		case 214:
+			goto st214
+		case 215:
+			goto st215
+		case 216:
+			goto st216
+		case 217:
+			goto st217
+		case 218:
+			goto st218
+		case 219:
+			goto st219
+		case 220:
+			goto st220
+		case 221:
+			goto st221
+		case 222:
+			goto st222
+		case 223:
+			goto st223
+		case 224:
+			goto st224
+		case 225:
+			goto st225
+		case 226:
+			goto st226
+		case 227:
+			goto st227
+		case 228:
+			goto st228
+		case 229:
+			goto st229
+		case 230:
+			goto st230
+		case 231:
+			goto st231
+		case 232:
+			goto st232
+		case 233:
+			goto st233
+		case 234:
+			goto st234
+		case 235:
+			goto st235
+		case 236:
+			goto st236
+		case 237:
+			goto st237
+		case 238:
+			goto st238
+		case 239:
+			goto st239
+		case 240:
+			goto st240
+		case 241:
+			goto st241
+		case 242:
+			goto st242
+		case 243:
+			goto st243
+		case 244:
+			goto st244
+		case 245:
+			goto st245
+		case 246:
+			goto st246
+		case 247:
+			goto st247
+		case 248:
+			goto st248
+		case 249:
+			goto st249
+		case 250:
+			goto st250
+		case 251:
+			goto st251
+		case 252:
+			goto st252
+		case 253:
+			goto st253
+		case 254:
+			goto st254
+		case 255:
+			goto st255
+		case 256:
+			goto st256
+		case 257:
+			goto st257
+		case 258:
+			goto st258
+		case 259:
+			goto st259
+		case 260:
+			goto st260
+		case 261:
+			goto st261
+		case 262:
+			goto st262
+		case 283:
+			goto st283
+		case 263:
+			goto st263
+		case 264:
+			goto st264
+		}
+
+		if p++; p == pe {
+			goto _test_eof
+		}
+	_resume:
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 265:
+			goto st_case_265
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 13:
+			goto st_case_13
+		case 266:
+			goto st_case_266
+		case 267:
+			goto st_case_267
+		case 268:
+			goto st_case_268
+		case 269:
+			goto st_case_269
+		case 270:
+			goto st_case_270
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 19:
+			goto st_case_19
+		case 20:
+			goto st_case_20
+		case 271:
+			goto st_case_271
+		case 272:
+			goto st_case_272
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 273:
+			goto st_case_273
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 274:
+			goto st_case_274
+		case 28:
+			goto st_case_28
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 275:
+			goto st_case_275
+		case 276:
+			goto st_case_276
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 34:
+			goto st_case_34
+		case 277:
+			goto st_case_277
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 278:
+			goto st_case_278
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		case 51:
+			goto st_case_51
+		case 52:
+			goto st_case_52
+		case 53:
+			goto st_case_53
+		case 54:
+			goto st_case_54
+		case 55:
+			goto st_case_55
+		case 56:
+			goto st_case_56
+		case 57:
+			goto st_case_57
+		case 58:
+			goto st_case_58
+		case 59:
+			goto st_case_59
+		case 60:
+			goto st_case_60
+		case 61:
+			goto st_case_61
+		case 62:
+			goto st_case_62
+		case 63:
+			goto st_case_63
+		case 64:
+			goto st_case_64
+		case 65:
+			goto st_case_65
+		case 66:
+			goto st_case_66
+		case 67:
+			goto st_case_67
+		case 68:
+			goto st_case_68
+		case 69:
+			goto st_case_69
+		case 70:
+			goto st_case_70
+		case 71:
+			goto st_case_71
+		case 72:
+			goto st_case_72
+		case 73:
+			goto st_case_73
+		case 74:
+			goto st_case_74
+		case 75:
+			goto st_case_75
+		case 76:
+			goto st_case_76
+		case 77:
+			goto st_case_77
+		case 78:
+			goto st_case_78
+		case 79:
+			goto st_case_79
+		case 80:
+			goto st_case_80
+		case 81:
+			goto st_case_81
+		case 82:
+			goto st_case_82
+		case 83:
+			goto st_case_83
+		case 84:
+			goto st_case_84
+		case 85:
+			goto st_case_85
+		case 86:
+			goto st_case_86
+		case 279:
+			goto st_case_279
+		case 87:
+			goto st_case_87
+		case 88:
+			goto st_case_88
+		case 89:
+			goto st_case_89
+		case 90:
+			goto st_case_90
+		case 91:
+			goto st_case_91
+		case 92:
+			goto st_case_92
+		case 93:
+			goto st_case_93
+		case 94:
+			goto st_case_94
+		case 95:
+			goto st_case_95
+		case 96:
+			goto st_case_96
+		case 97:
+			goto st_case_97
+		case 98:
+			goto st_case_98
+		case 99:
+			goto st_case_99
+		case 100:
+			goto st_case_100
+		case 101:
+			goto st_case_101
+		case 102:
+			goto st_case_102
+		case 103:
+			goto st_case_103
+		case 104:
+			goto st_case_104
+		case 105:
+			goto st_case_105
+		case 106:
+			goto st_case_106
+		case 107:
+			goto st_case_107
+		case 108:
+			goto st_case_108
+		case 109:
+			goto st_case_109
+		case 110:
+			goto st_case_110
+		case 111:
+			goto st_case_111
+		case 112:
+			goto st_case_112
+		case 280:
+			goto st_case_280
+		case 113:
+			goto st_case_113
+		case 114:
+			goto st_case_114
+		case 115:
+			goto st_case_115
+		case 116:
+			goto st_case_116
+		case 117:
+			goto st_case_117
+		case 118:
+			goto st_case_118
+		case 119:
+			goto st_case_119
+		case 120:
+			goto st_case_120
+		case 121:
+			goto st_case_121
+		case 122:
+			goto st_case_122
+		case 123:
+			goto st_case_123
+		case 124:
+			goto st_case_124
+		case 125:
+			goto st_case_125
+		case 126:
+			goto st_case_126
+		case 127:
+			goto st_case_127
+		case 128:
+			goto st_case_128
+		case 129:
+			goto st_case_129
+		case 130:
+			goto st_case_130
+		case 131:
+			goto st_case_131
+		case 132:
+			goto st_case_132
+		case 133:
+			goto st_case_133
+		case 134:
+			goto st_case_134
+		case 135:
+			goto st_case_135
+		case 136:
+			goto st_case_136
+		case 137:
+			goto st_case_137
+		case 138:
+			goto st_case_138
+		case 139:
+			goto st_case_139
+		case 140:
+			goto st_case_140
+		case 141:
+			goto st_case_141
+		case 142:
+			goto st_case_142
+		case 143:
+			goto st_case_143
+		case 144:
+			goto st_case_144
+		case 145:
+			goto st_case_145
+		case 146:
+			goto st_case_146
+		case 147:
+			goto st_case_147
+		case 148:
+			goto st_case_148
+		case 149:
+			goto st_case_149
+		case 150:
+			goto st_case_150
+		case 151:
+			goto st_case_151
+		case 152:
+			goto st_case_152
+		case 153:
+			goto st_case_153
+		case 154:
+			goto st_case_154
+		case 281:
+			goto st_case_281
+		case 155:
+			goto st_case_155
+		case 156:
+			goto st_case_156
+		case 157:
+			goto st_case_157
+		case 158:
+			goto st_case_158
+		case 159:
+			goto st_case_159
+		case 160:
+			goto st_case_160
+		case 161:
+			goto st_case_161
+		case 162:
+			goto st_case_162
+		case 163:
+			goto st_case_163
+		case 164:
+			goto st_case_164
+		case 165:
+			goto st_case_165
+		case 166:
+			goto st_case_166
+		case 167:
+			goto st_case_167
+		case 168:
+			goto st_case_168
+		case 169:
+			goto st_case_169
+		case 170:
+			goto st_case_170
+		case 171:
+			goto st_case_171
+		case 172:
+			goto st_case_172
+		case 173:
+			goto st_case_173
+		case 174:
+			goto st_case_174
+		case 175:
+			goto st_case_175
+		case 176:
+			goto st_case_176
+		case 177:
+			goto st_case_177
+		case 178:
+			goto st_case_178
+		case 179:
+			goto st_case_179
+		case 180:
+			goto st_case_180
+		case 181:
+			goto st_case_181
+		case 182:
+			goto st_case_182
+		case 183:
+			goto st_case_183
+		case 184:
+			goto st_case_184
+		case 185:
+			goto st_case_185
+		case 186:
+			goto st_case_186
+		case 187:
+			goto st_case_187
+		case 188:
+			goto st_case_188
+		case 189:
+			goto st_case_189
+		case 190:
+			goto st_case_190
+		case 191:
+			goto st_case_191
+		case 192:
+			goto st_case_192
+		case 193:
+			goto st_case_193
+		case 194:
+			goto st_case_194
+		case 195:
+			goto st_case_195
+		case 196:
+			goto st_case_196
+		case 197:
+			goto st_case_197
+		case 198:
+			goto st_case_198
+		case 199:
+			goto st_case_199
+		case 200:
+			goto st_case_200
+		case 201:
+			goto st_case_201
+		case 202:
+			goto st_case_202
+		case 203:
+			goto st_case_203
+		case 204:
+			goto st_case_204
+		case 205:
+			goto st_case_205
+		case 206:
+			goto st_case_206
+		case 207:
+			goto st_case_207
+		case 208:
+			goto st_case_208
+		case 209:
+			goto st_case_209
+		case 210:
+			goto st_case_210
+		case 282:
+			goto st_case_282
+		case 211:
+			goto st_case_211
+		case 212:
+			goto st_case_212
+		case 213:
+			goto st_case_213
+		case 214:
+			goto st_case_214
+		case 215:
+			goto st_case_215
+		case 216:
+			goto st_case_216
+		case 217:
+			goto st_case_217
+		case 218:
+			goto st_case_218
+		case 219:
+			goto st_case_219
+		case 220:
+			goto st_case_220
+		case 221:
+			goto st_case_221
+		case 222:
+			goto st_case_222
+		case 223:
+			goto st_case_223
+		case 224:
+			goto st_case_224
+		case 225:
+			goto st_case_225
+		case 226:
+			goto st_case_226
+		case 227:
+			goto st_case_227
+		case 228:
+			goto st_case_228
+		case 229:
+			goto st_case_229
+		case 230:
+			goto st_case_230
+		case 231:
+			goto st_case_231
+		case 232:
+			goto st_case_232
+		case 233:
+			goto st_case_233
+		case 234:
+			goto st_case_234
+		case 235:
+			goto st_case_235
+		case 236:
+			goto st_case_236
+		case 237:
+			goto st_case_237
+		case 238:
+			goto st_case_238
+		case 239:
+			goto st_case_239
+		case 240:
+			goto st_case_240
+		case 241:
+			goto st_case_241
+		case 242:
+			goto st_case_242
+		case 243:
+			goto st_case_243
+		case 244:
+			goto st_case_244
+		case 245:
+			goto st_case_245
+		case 246:
+			goto st_case_246
+		case 247:
+			goto st_case_247
+		case 248:
+			goto st_case_248
+		case 249:
+			goto st_case_249
+		case 250:
+			goto st_case_250
+		case 251:
+			goto st_case_251
+		case 252:
+			goto st_case_252
+		case 253:
+			goto st_case_253
+		case 254:
+			goto st_case_254
+		case 255:
+			goto st_case_255
+		case 256:
+			goto st_case_256
+		case 257:
+			goto st_case_257
+		case 258:
+			goto st_case_258
+		case 259:
+			goto st_case_259
+		case 260:
+			goto st_case_260
+		case 261:
+			goto st_case_261
+		case 262:
+			goto st_case_262
+		case 283:
+			goto st_case_283
+		case 263:
+			goto st_case_263
+		case 264:
+			goto st_case_264
+		}
+		goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto tr2
+		case 45:
+			goto tr3
+		case 48:
+			goto tr4
+		case 91:
+			goto tr6
+		case 102:
+			goto st21
+		case 110:
+			goto st25
+		case 116:
+			goto st28
+		case 123:
+			goto tr10
+		case 559:
+			goto st31
+		case 1097:
+			goto tr12
+		case 1102:
+			goto tr13
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr5
+			}
+		case _widec >= 9:
+			goto st2
+		}
+		goto tr0
+	tr0:
+		expected = "value"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr14:
+		expected = "string character"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr19:
+		expected = "escape sequence"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr22:
+		expected = "hex digit"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr27:
+		expected = "digit"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr31:
+		expected = "Infinity"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr39:
+		expected = "false"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr44:
+		expected = "null"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr48:
+		expected = "true"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr52:
+		expected = "'/' or '*'"
+		goto st0
+	tr54:
+		expected = "NaN"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+
+		goto st0
+	tr57:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr72:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr77:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr82:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr101:
+		expected = "'/' or '*'"
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr103:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr106:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr111:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr122:
+		expected = "Infinity"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr130:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr135:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr139:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr143:
+		expected = "'/' or '*'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr145:
+		expected = "NaN"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr180:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr185:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr190:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr194:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr212:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr217:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr246:
+		expected = "'/' or '*'"
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr248:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr251:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr256:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr267:
+		expected = "Infinity"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr275:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr280:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr284:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr288:
+		expected = "'/' or '*'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr290:
+		expected = "NaN"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	tr293:
+		expected = "'/' or '*'"
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			cs = 0
+			goto _out
+		}
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto tr2
+		case 45:
+			goto tr3
+		case 48:
+			goto tr4
+		case 91:
+			goto tr6
+		case 102:
+			goto st21
+		case 110:
+			goto st25
+		case 116:
+			goto st28
+		case 123:
+			goto tr10
+		case 559:
+			goto st31
+		case 1097:
+			goto tr12
+		case 1102:
+			goto tr13
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr5
+			}
+		case _widec >= 9:
+			goto st2
+		}
+		goto tr0
+	tr2:
+		tokenStart = p
+		goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st5
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st4
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st5
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st4
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	tr16:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st265
+	st265:
+		if p++; p == pe {
+			goto _test_eof265
+		}
+	st_case_265:
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 34:
+			goto st6
+		case 47:
+			goto st6
+		case 92:
+			goto st6
+		case 98:
+			goto st6
+		case 102:
+			goto st6
+		case 110:
+			goto st6
+		case 114:
+			goto st6
+		case 116:
+			goto st6
+		case 117:
+			goto st8
+		}
+		goto tr19
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st5
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st4
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	tr18:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 7
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st7
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st5
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st4
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st9
+			}
+		default:
+			goto st9
+		}
+		goto tr22
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto tr22
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st11
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto tr22
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr26
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr26
+			}
+		default:
+			goto tr26
+		}
+		goto tr22
+	tr26:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 12
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st12
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		switch data[p] {
+		case 34:
+			goto tr16
+		case 92:
+			goto st5
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st4
+			}
+		default:
+			goto tr14
+		}
+		goto tr18
+	tr3:
+		tokenStart = p
+		goto st13
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st266
+		case 1097:
+			goto st14
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st269
+		}
+		goto tr27
+	tr4:
+		tokenStart = p
+		goto st266
+	st266:
+		if p++; p == pe {
+			goto _test_eof266
+		}
+	st_case_266:
+		switch data[p] {
+		case 46:
+			goto tr343
+		case 69:
+			goto tr344
+		case 101:
+			goto tr344
+		}
+		goto st0
+	tr343:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 267
+				goto _out
+			}
+		}
+
+		goto st267
+	st267:
+		if p++; p == pe {
+			goto _test_eof267
+		}
+	st_case_267:
+		goto st0
+	tr344:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 268
+				goto _out
+			}
+		}
+
+		goto st268
+	st268:
+		if p++; p == pe {
+			goto _test_eof268
+		}
+	st_case_268:
+		goto st0
+	tr5:
+		tokenStart = p
+		goto st269
+	st269:
+		if p++; p == pe {
+			goto _test_eof269
+		}
+	st_case_269:
+		switch data[p] {
+		case 46:
+			goto tr343
+		case 69:
+			goto tr344
+		case 101:
+			goto tr344
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st270
+		}
+		goto st0
+	st270:
+		if p++; p == pe {
+			goto _test_eof270
+		}
+	st_case_270:
+		switch data[p] {
+		case 46:
+			goto tr343
+		case 69:
+			goto tr344
+		case 101:
+			goto tr344
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st270
+		}
+		goto st0
+	tr12:
+		tokenStart = p
+		goto st14
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 110 {
+			goto st15
+		}
+		goto tr31
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if data[p] == 102 {
+			goto st16
+		}
+		goto tr31
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 105 {
+			goto st17
+		}
+		goto tr31
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 110 {
+			goto st18
+		}
+		goto tr31
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 105 {
+			goto st19
+		}
+		goto tr31
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 116 {
+			goto st20
+		}
+		goto tr31
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 121 {
+			goto st271
+		}
+		goto tr31
+	st271:
+		if p++; p == pe {
+			goto _test_eof271
+		}
+	st_case_271:
+		goto st0
+	tr6:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 272
+				top++
+				goto st35
+			}
+		}
+		goto st272
+	st272:
+		if p++; p == pe {
+			goto _test_eof272
+		}
+	st_case_272:
+		goto st0
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 97 {
+			goto st22
+		}
+		goto tr39
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 108 {
+			goto st23
+		}
+		goto tr39
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		if data[p] == 115 {
+			goto st24
+		}
+		goto tr39
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		if data[p] == 101 {
+			goto st273
+		}
+		goto tr39
+	st273:
+		if p++; p == pe {
+			goto _test_eof273
+		}
+	st_case_273:
+		goto st0
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		if data[p] == 117 {
+			goto st26
+		}
+		goto tr44
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		if data[p] == 108 {
+			goto st27
+		}
+		goto tr44
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		if data[p] == 108 {
+			goto st274
+		}
+		goto tr44
+	st274:
+		if p++; p == pe {
+			goto _test_eof274
+		}
+	st_case_274:
+		goto st0
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		if data[p] == 114 {
+			goto st29
+		}
+		goto tr48
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		if data[p] == 117 {
+			goto st30
+		}
+		goto tr48
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		if data[p] == 101 {
+			goto st275
+		}
+		goto tr48
+	st275:
+		if p++; p == pe {
+			goto _test_eof275
+		}
+	st_case_275:
+		goto st0
+	tr10:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 276
+				top++
+				goto st132
+			}
+		}
+		goto st276
+	st276:
+		if p++; p == pe {
+			goto _test_eof276
+		}
+	st_case_276:
+		goto st0
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 42:
+			goto tr53
+		case 47:
+			goto tr53
+		}
+		goto tr52
+	tr53:
+		p = skipComment(data, p, pe)
+		goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto tr2
+		case 45:
+			goto tr3
+		case 48:
+			goto tr4
+		case 91:
+			goto tr6
+		case 102:
+			goto st21
+		case 110:
+			goto st25
+		case 116:
+			goto st28
+		case 123:
+			goto tr10
+		case 559:
+			goto st31
+		case 1097:
+			goto tr12
+		case 1102:
+			goto tr13
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr5
+			}
+		case _widec >= 9:
+			goto st2
+		}
+		goto tr0
+	tr13:
+		tokenStart = p
+		goto st33
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		if data[p] == 97 {
+			goto st34
+		}
+		goto tr54
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		if data[p] == 78 {
+			goto st277
+		}
+		goto tr54
+	st277:
+		if p++; p == pe {
+			goto _test_eof277
+		}
+	st_case_277:
+		goto st0
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st36
+		case 32:
+			goto st36
+		case 34:
+			goto tr59
+		case 45:
+			goto tr60
+		case 48:
+			goto tr61
+		case 91:
+			goto tr63
+		case 93:
+			goto tr64
+		case 102:
+			goto tr65
+		case 110:
+			goto tr66
+		case 116:
+			goto tr67
+		case 123:
+			goto tr68
+		case 559:
+			goto st127
+		case 1097:
+			goto tr70
+		case 1102:
+			goto tr71
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr62
+			}
+		case _widec >= 9:
+			goto st36
+		}
+		goto tr57
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st36
+		case 32:
+			goto st36
+		case 34:
+			goto tr59
+		case 45:
+			goto tr60
+		case 48:
+			goto tr61
+		case 91:
+			goto tr63
+		case 93:
+			goto tr64
+		case 102:
+			goto tr65
+		case 110:
+			goto tr66
+		case 116:
+			goto tr67
+		case 123:
+			goto tr68
+		case 559:
+			goto st127
+		case 1097:
+			goto tr70
+		case 1102:
+			goto tr71
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr62
+			}
+		case _widec >= 9:
+			goto st36
+		}
+		goto tr57
+	tr59:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st37
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch data[p] {
+		case 34:
+			goto tr74
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st38
+			}
+		default:
+			goto tr72
+		}
+		goto tr76
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch data[p] {
+		case 34:
+			goto tr74
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st38
+			}
+		default:
+			goto tr72
+		}
+		goto tr76
+	tr74:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st39
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr115:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st40
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr116:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st41
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st42
+		case 32:
+			goto st42
+		case 34:
+			goto tr84
+		case 45:
+			goto tr85
+		case 48:
+			goto tr86
+		case 91:
+			goto tr88
+		case 102:
+			goto tr89
+		case 110:
+			goto tr90
+		case 116:
+			goto tr91
+		case 123:
+			goto tr92
+		case 559:
+			goto st85
+		case 605:
+			goto tr94
+		case 1097:
+			goto tr95
+		case 1102:
+			goto tr96
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr87
+			}
+		case _widec >= 9:
+			goto st42
+		}
+		goto tr82
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st42
+		case 32:
+			goto st42
+		case 34:
+			goto tr84
+		case 45:
+			goto tr85
+		case 48:
+			goto tr86
+		case 91:
+			goto tr88
+		case 102:
+			goto tr89
+		case 110:
+			goto tr90
+		case 116:
+			goto tr91
+		case 123:
+			goto tr92
+		case 559:
+			goto st85
+		case 605:
+			goto tr94
+		case 1097:
+			goto tr95
+		case 1102:
+			goto tr96
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr87
+			}
+		case _widec >= 9:
+			goto st42
+		}
+		goto tr82
+	tr84:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 34:
+			goto tr98
+		case 92:
+			goto st48
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st44
+			}
+		default:
+			goto tr72
+		}
+		goto tr100
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 34:
+			goto tr98
+		case 92:
+			goto st48
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st44
+			}
+		default:
+			goto tr72
+		}
+		goto tr100
+	tr98:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st45
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr80:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st278
+	tr119:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st278
+	st278:
+		if p++; p == pe {
+			goto _test_eof278
+		}
+	st_case_278:
+		goto st0
+	tr120:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st46
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 42:
+			goto tr102
+		case 47:
+			goto tr102
+		}
+		goto tr101
+	tr102:
+		p = skipComment(data, p, pe)
+		goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		switch data[p] {
+		case 34:
+			goto st49
+		case 47:
+			goto st49
+		case 92:
+			goto st49
+		case 98:
+			goto st49
+		case 102:
+			goto st49
+		case 110:
+			goto st49
+		case 114:
+			goto st49
+		case 116:
+			goto st49
+		case 117:
+			goto st51
+		}
+		goto tr103
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		switch data[p] {
+		case 34:
+			goto tr98
+		case 92:
+			goto st48
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st44
+			}
+		default:
+			goto tr72
+		}
+		goto tr100
+	tr100:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 50
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st50
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		switch data[p] {
+		case 34:
+			goto tr98
+		case 92:
+			goto st48
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st44
+			}
+		default:
+			goto tr72
+		}
+		goto tr100
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st52
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st52
+			}
+		default:
+			goto st52
+		}
+		goto tr106
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st53
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st53
+			}
+		default:
+			goto st53
+		}
+		goto tr106
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st54
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st54
+			}
+		default:
+			goto st54
+		}
+		goto tr106
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr110
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr110
+			}
+		default:
+			goto tr110
+		}
+		goto tr106
+	tr110:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 55
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st55
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		switch data[p] {
+		case 34:
+			goto tr98
+		case 92:
+			goto st48
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st44
+			}
+		default:
+			goto tr72
+		}
+		goto tr100
+	tr85:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st56
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st57
+		case 1097:
+			goto st62
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st60
+		}
+		goto tr111
+	tr86:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st57
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr117
+		case 69:
+			goto tr118
+		case 93:
+			goto tr119
+		case 101:
+			goto tr118
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr117:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 58
+				goto _out
+			}
+		}
+
+		goto st58
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr118:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 59
+				goto _out
+			}
+		}
+
+		goto st59
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr87:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st60
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr117
+		case 69:
+			goto tr118
+		case 93:
+			goto tr119
+		case 101:
+			goto tr118
+		case 559:
+			goto tr120
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st61
+			}
+		case _widec >= 9:
+			goto tr115
+		}
+		goto tr77
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr117
+		case 69:
+			goto tr118
+		case 93:
+			goto tr119
+		case 101:
+			goto tr118
+		case 559:
+			goto tr120
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st61
+			}
+		case _widec >= 9:
+			goto tr115
+		}
+		goto tr77
+	tr95:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st62
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		if data[p] == 110 {
+			goto st63
+		}
+		goto tr122
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		if data[p] == 102 {
+			goto st64
+		}
+		goto tr122
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		if data[p] == 105 {
+			goto st65
+		}
+		goto tr122
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		if data[p] == 110 {
+			goto st66
+		}
+		goto tr122
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		if data[p] == 105 {
+			goto st67
+		}
+		goto tr122
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		if data[p] == 116 {
+			goto st68
+		}
+		goto tr122
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		if data[p] == 121 {
+			goto st69
+		}
+		goto tr122
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr88:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 70
+				top++
+				goto st35
+			}
+		}
+		goto st70
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr89:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		if data[p] == 97 {
+			goto st72
+		}
+		goto tr130
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		if data[p] == 108 {
+			goto st73
+		}
+		goto tr130
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		if data[p] == 115 {
+			goto st74
+		}
+		goto tr130
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		if data[p] == 101 {
+			goto st75
+		}
+		goto tr130
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr90:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st76
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 117 {
+			goto st77
+		}
+		goto tr135
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 108 {
+			goto st78
+		}
+		goto tr135
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 108 {
+			goto st79
+		}
+		goto tr135
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr91:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st80
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		if data[p] == 114 {
+			goto st81
+		}
+		goto tr139
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 117 {
+			goto st82
+		}
+		goto tr139
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 101 {
+			goto st83
+		}
+		goto tr139
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr92:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 84
+				top++
+				goto st132
+			}
+		}
+		goto st84
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		switch data[p] {
+		case 42:
+			goto tr144
+		case 47:
+			goto tr144
+		}
+		goto tr143
+	tr144:
+		p = skipComment(data, p, pe)
+		goto st86
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			switch {
+			case data[p] > 78:
+				if 93 <= data[p] && data[p] <= 93 {
+					_widec = 256 + (int16(data[p]) - 0)
+					if buffer.JSONC {
+						_widec += 256
+					}
+				}
+			case data[p] >= 78:
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st42
+		case 32:
+			goto st42
+		case 34:
+			goto tr84
+		case 45:
+			goto tr85
+		case 48:
+			goto tr86
+		case 91:
+			goto tr88
+		case 102:
+			goto tr89
+		case 110:
+			goto tr90
+		case 116:
+			goto tr91
+		case 123:
+			goto tr92
+		case 559:
+			goto st85
+		case 605:
+			goto tr94
+		case 1097:
+			goto tr95
+		case 1102:
+			goto tr96
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr87
+			}
+		case _widec >= 9:
+			goto st42
+		}
+		goto tr82
+	tr94:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st279
+	st279:
+		if p++; p == pe {
+			goto _test_eof279
+		}
+	st_case_279:
+		goto st0
+	tr96:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st87
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 97 {
+			goto st88
+		}
+		goto tr145
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		if data[p] == 78 {
+			goto st89
+		}
+		goto tr145
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 34:
+			goto st91
+		case 47:
+			goto st91
+		case 92:
+			goto st91
+		case 98:
+			goto st91
+		case 102:
+			goto st91
+		case 110:
+			goto st91
+		case 114:
+			goto st91
+		case 116:
+			goto st91
+		case 117:
+			goto st93
+		}
+		goto tr103
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch data[p] {
+		case 34:
+			goto tr74
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st38
+			}
+		default:
+			goto tr72
+		}
+		goto tr76
+	tr76:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 92
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st92
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch data[p] {
+		case 34:
+			goto tr74
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st38
+			}
+		default:
+			goto tr72
+		}
+		goto tr76
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st94
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st94
+			}
+		default:
+			goto st94
+		}
+		goto tr106
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st95
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st95
+			}
+		default:
+			goto st95
+		}
+		goto tr106
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st96
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st96
+			}
+		default:
+			goto st96
+		}
+		goto tr106
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr153
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr153
+			}
+		default:
+			goto tr153
+		}
+		goto tr106
+	tr153:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 97
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st97
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		switch data[p] {
+		case 34:
+			goto tr74
+		case 92:
+			goto st90
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st38
+			}
+		default:
+			goto tr72
+		}
+		goto tr76
+	tr60:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st98
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st99
+		case 1097:
+			goto st104
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st102
+		}
+		goto tr111
+	tr61:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st99
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr157
+		case 69:
+			goto tr158
+		case 93:
+			goto tr119
+		case 101:
+			goto tr158
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr157:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 100
+				goto _out
+			}
+		}
+
+		goto st100
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr158:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 101
+				goto _out
+			}
+		}
+
+		goto st101
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr62:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st102
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr157
+		case 69:
+			goto tr158
+		case 93:
+			goto tr119
+		case 101:
+			goto tr158
+		case 559:
+			goto tr120
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st103
+			}
+		case _widec >= 9:
+			goto tr115
+		}
+		goto tr77
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 46:
+			goto tr157
+		case 69:
+			goto tr158
+		case 93:
+			goto tr119
+		case 101:
+			goto tr158
+		case 559:
+			goto tr120
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st103
+			}
+		case _widec >= 9:
+			goto tr115
+		}
+		goto tr77
+	tr70:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st104
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		if data[p] == 110 {
+			goto st105
+		}
+		goto tr122
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		if data[p] == 102 {
+			goto st106
+		}
+		goto tr122
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		if data[p] == 105 {
+			goto st107
+		}
+		goto tr122
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		if data[p] == 110 {
+			goto st108
+		}
+		goto tr122
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		if data[p] == 105 {
+			goto st109
+		}
+		goto tr122
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		if data[p] == 116 {
+			goto st110
+		}
+		goto tr122
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		if data[p] == 121 {
+			goto st111
+		}
+		goto tr122
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	tr63:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 112
+				top++
+				goto st35
+			}
+		}
+		goto st112
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr64:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st280
+	st280:
+		if p++; p == pe {
+			goto _test_eof280
+		}
+	st_case_280:
+		goto st0
+	tr65:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st113
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		if data[p] == 97 {
+			goto st114
+		}
+		goto tr130
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		if data[p] == 108 {
+			goto st115
+		}
+		goto tr130
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		if data[p] == 115 {
+			goto st116
+		}
+		goto tr130
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		if data[p] == 101 {
+			goto st117
+		}
+		goto tr130
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr66:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st118
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		if data[p] == 117 {
+			goto st119
+		}
+		goto tr135
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		if data[p] == 108 {
+			goto st120
+		}
+		goto tr135
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 108 {
+			goto st121
+		}
+		goto tr135
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr67:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		goto st122
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		if data[p] == 114 {
+			goto st123
+		}
+		goto tr139
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		if data[p] == 117 {
+			goto st124
+		}
+		goto tr139
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		if data[p] == 101 {
+			goto st125
+		}
+		goto tr139
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	tr68:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 126
+				top++
+				goto st132
+			}
+		}
+		goto st126
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st40
+		case 32:
+			goto st40
+		case 44:
+			goto st41
+		case 93:
+			goto tr80
+		case 559:
+			goto st46
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st40
+		}
+		goto tr77
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		switch data[p] {
+		case 42:
+			goto tr177
+		case 47:
+			goto tr177
+		}
+		goto tr143
+	tr177:
+		p = skipComment(data, p, pe)
+		goto st128
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st36
+		case 32:
+			goto st36
+		case 34:
+			goto tr59
+		case 45:
+			goto tr60
+		case 48:
+			goto tr61
+		case 91:
+			goto tr63
+		case 93:
+			goto tr64
+		case 102:
+			goto tr65
+		case 110:
+			goto tr66
+		case 116:
+			goto tr67
+		case 123:
+			goto tr68
+		case 559:
+			goto st127
+		case 1097:
+			goto tr70
+		case 1102:
+			goto tr71
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr62
+			}
+		case _widec >= 9:
+			goto st36
+		}
+		goto tr57
+	tr71:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxArrayElements > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxArrayElements {
+			return p, stack, "", limitError("MaxArrayElements", buffer.Limits.MaxArrayElements, p, data)
+		}
+
+		tokenStart = p
+		goto st129
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 97 {
+			goto st130
+		}
+		goto tr145
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if data[p] == 78 {
+			goto st131
+		}
+		goto tr145
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr115
+		case 32:
+			goto tr115
+		case 44:
+			goto tr116
+		case 93:
+			goto tr119
+		case 559:
+			goto tr120
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr115
+		}
+		goto tr77
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st133
+		case 32:
+			goto st133
+		case 34:
+			goto tr182
+		case 125:
+			goto tr183
+		case 559:
+			goto st263
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st133
+		}
+		goto tr180
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st133
+		case 32:
+			goto st133
+		case 34:
+			goto tr182
+		case 125:
+			goto tr183
+		case 559:
+			goto st263
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st133
+		}
+		goto tr180
+	tr182:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st134
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		switch data[p] {
+		case 34:
+			goto tr187
+		case 92:
+			goto st255
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st135
+			}
+		default:
+			goto tr185
+		}
+		goto tr189
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		switch data[p] {
+		case 34:
+			goto tr187
+		case 92:
+			goto st255
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st135
+			}
+		default:
+			goto tr185
+		}
+		goto tr189
+	tr187:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st136
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st137
+		case 32:
+			goto st137
+		case 58:
+			goto st138
+		case 559:
+			goto st253
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st137
+		}
+		goto tr190
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st137
+		case 32:
+			goto st137
+		case 58:
+			goto st138
+		case 559:
+			goto st253
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st137
+		}
+		goto tr190
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st139
+		case 32:
+			goto st139
+		case 34:
+			goto tr196
+		case 45:
+			goto tr197
+		case 48:
+			goto tr198
+		case 91:
+			goto tr200
+		case 102:
+			goto st234
+		case 110:
+			goto st239
+		case 116:
+			goto st243
+		case 123:
+			goto tr204
+		case 559:
+			goto st248
+		case 1097:
+			goto tr206
+		case 1102:
+			goto tr207
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr199
+			}
+		case _widec >= 9:
+			goto st139
+		}
+		goto tr194
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st139
+		case 32:
+			goto st139
+		case 34:
+			goto tr196
+		case 45:
+			goto tr197
+		case 48:
+			goto tr198
+		case 91:
+			goto tr200
+		case 102:
+			goto st234
+		case 110:
+			goto st239
+		case 116:
+			goto st243
+		case 123:
+			goto tr204
+		case 559:
+			goto st248
+		case 1097:
+			goto tr206
+		case 1102:
+			goto tr207
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr199
+			}
+		case _widec >= 9:
+			goto st139
+		}
+		goto tr194
+	tr196:
+		tokenStart = p
+		goto st140
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch data[p] {
+		case 34:
+			goto tr209
+		case 92:
+			goto st211
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st141
+			}
+		default:
+			goto tr185
+		}
+		goto tr211
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch data[p] {
+		case 34:
+			goto tr209
+		case 92:
+			goto st211
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st141
+			}
+		default:
+			goto tr185
+		}
+		goto tr211
+	tr209:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st142
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	tr260:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st143
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	tr261:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st144
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st145
+		case 32:
+			goto st145
+		case 34:
+			goto tr219
+		case 559:
+			goto st209
+		case 637:
+			goto tr221
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st145
+		}
+		goto tr217
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st145
+		case 32:
+			goto st145
+		case 34:
+			goto tr219
+		case 559:
+			goto st209
+		case 637:
+			goto tr221
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st145
+		}
+		goto tr217
+	tr219:
+
+		buffer.checkLevels[top].count++
+		if buffer.Limits.MaxObjectKeys > 0 && buffer.checkLevels[top].count > buffer.Limits.MaxObjectKeys {
+			return p, stack, "", limitError("MaxObjectKeys", buffer.Limits.MaxObjectKeys, p, data)
+		}
+
+		tokenStart = p
+		goto st146
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		switch data[p] {
+		case 34:
+			goto tr223
+		case 92:
+			goto st201
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st147
+			}
+		default:
+			goto tr185
+		}
+		goto tr225
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		switch data[p] {
+		case 34:
+			goto tr223
+		case 92:
+			goto st201
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st147
+			}
+		default:
+			goto tr185
+		}
+		goto tr225
+	tr223:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		if buffer.RejectDuplicateKeys && !buffer.keys.add(data, buffer.checkLevels[top].object, tokenStart+1, p) {
+			return tokenStart, stack, "unique object key", ErrDuplicateKey
+		}
+
+		goto st148
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st149
+		case 32:
+			goto st149
+		case 58:
+			goto st150
+		case 559:
+			goto st199
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st149
+		}
+		goto tr190
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st149
+		case 32:
+			goto st149
+		case 58:
+			goto st150
+		case 559:
+			goto st199
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st149
+		}
+		goto tr190
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st151
+		case 32:
+			goto st151
+		case 34:
+			goto tr230
+		case 45:
+			goto tr231
+		case 48:
+			goto tr232
+		case 91:
+			goto tr234
+		case 102:
+			goto st180
+		case 110:
+			goto st185
+		case 116:
+			goto st189
+		case 123:
+			goto tr238
+		case 559:
+			goto st194
+		case 1097:
+			goto tr240
+		case 1102:
+			goto tr241
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr233
+			}
+		case _widec >= 9:
+			goto st151
+		}
+		goto tr194
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st151
+		case 32:
+			goto st151
+		case 34:
+			goto tr230
+		case 45:
+			goto tr231
+		case 48:
+			goto tr232
+		case 91:
+			goto tr234
+		case 102:
+			goto st180
+		case 110:
+			goto st185
+		case 116:
+			goto st189
+		case 123:
+			goto tr238
+		case 559:
+			goto st194
+		case 1097:
+			goto tr240
+		case 1102:
+			goto tr241
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr233
+			}
+		case _widec >= 9:
+			goto st151
+		}
+		goto tr194
+	tr230:
+		tokenStart = p
+		goto st152
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 92:
+			goto st157
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st153
+			}
+		default:
+			goto tr185
+		}
+		goto tr245
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 92:
+			goto st157
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st153
+			}
+		default:
+			goto tr185
+		}
+		goto tr245
+	tr243:
+
+		if buffer.Limits.MaxStringLen > 0 && p-tokenStart-1 > buffer.Limits.MaxStringLen {
+			return tokenStart, stack, "", limitError("MaxStringLen", buffer.Limits.MaxStringLen, tokenStart, data)
+		}
+
+		goto st154
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	tr215:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st281
+	tr264:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st281
+	st281:
+		if p++; p == pe {
+			goto _test_eof281
+		}
+	st_case_281:
+		goto st0
+	tr265:
+
+		if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+			return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+		}
+
+		goto st155
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		switch data[p] {
+		case 42:
+			goto tr247
+		case 47:
+			goto tr247
+		}
+		goto tr246
+	tr247:
+		p = skipComment(data, p, pe)
+		goto st156
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		switch data[p] {
+		case 34:
+			goto st158
+		case 47:
+			goto st158
+		case 92:
+			goto st158
+		case 98:
+			goto st158
+		case 102:
+			goto st158
+		case 110:
+			goto st158
+		case 114:
+			goto st158
+		case 116:
+			goto st158
+		case 117:
+			goto st160
+		}
+		goto tr248
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 92:
+			goto st157
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st153
+			}
+		default:
+			goto tr185
+		}
+		goto tr245
+	tr245:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 159
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st159
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 92:
+			goto st157
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st153
+			}
+		default:
+			goto tr185
+		}
+		goto tr245
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st161
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st161
+			}
+		default:
+			goto st161
+		}
+		goto tr251
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st162
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st162
+			}
+		default:
+			goto st162
+		}
+		goto tr251
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st163
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st163
+			}
+		default:
+			goto st163
+		}
+		goto tr251
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr255
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr255
+			}
+		default:
+			goto tr255
+		}
+		goto tr251
+	tr255:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 164
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st164
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		switch data[p] {
+		case 34:
+			goto tr243
+		case 92:
+			goto st157
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st153
+			}
+		default:
+			goto tr185
+		}
+		goto tr245
+	tr231:
+		tokenStart = p
+		goto st165
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st166
+		case 1097:
+			goto st171
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st169
+		}
+		goto tr256
+	tr232:
+		tokenStart = p
+		goto st166
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr262
+		case 69:
+			goto tr263
+		case 101:
+			goto tr263
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr262:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 167
+				goto _out
+			}
+		}
+
+		goto st167
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr263:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 168
+				goto _out
+			}
+		}
+
+		goto st168
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr233:
+		tokenStart = p
+		goto st169
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr262
+		case 69:
+			goto tr263
+		case 101:
+			goto tr263
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st170
+			}
+		case _widec >= 9:
+			goto tr260
+		}
+		goto tr212
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr262
+		case 69:
+			goto tr263
+		case 101:
+			goto tr263
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st170
+			}
+		case _widec >= 9:
+			goto tr260
+		}
+		goto tr212
+	tr240:
+		tokenStart = p
+		goto st171
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if data[p] == 110 {
+			goto st172
+		}
+		goto tr267
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		if data[p] == 102 {
+			goto st173
+		}
+		goto tr267
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		if data[p] == 105 {
+			goto st174
+		}
+		goto tr267
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		if data[p] == 110 {
+			goto st175
+		}
+		goto tr267
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		if data[p] == 105 {
+			goto st176
+		}
+		goto tr267
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		if data[p] == 116 {
+			goto st177
+		}
+		goto tr267
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		if data[p] == 121 {
+			goto st178
+		}
+		goto tr267
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr234:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 179
+				top++
+				goto st35
+			}
+		}
+		goto st179
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		if data[p] == 97 {
+			goto st181
+		}
+		goto tr275
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		if data[p] == 108 {
+			goto st182
+		}
+		goto tr275
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		if data[p] == 115 {
+			goto st183
+		}
+		goto tr275
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		if data[p] == 101 {
+			goto st184
+		}
+		goto tr275
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		if data[p] == 117 {
+			goto st186
+		}
+		goto tr280
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		if data[p] == 108 {
+			goto st187
+		}
+		goto tr280
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		if data[p] == 108 {
+			goto st188
+		}
+		goto tr280
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		if data[p] == 114 {
+			goto st190
+		}
+		goto tr284
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		if data[p] == 117 {
+			goto st191
+		}
+		goto tr284
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		if data[p] == 101 {
+			goto st192
+		}
+		goto tr284
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	tr238:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 193
+				top++
+				goto st132
+			}
+		}
+		goto st193
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		switch data[p] {
+		case 42:
+			goto tr289
+		case 47:
+			goto tr289
+		}
+		goto tr288
+	tr289:
+		p = skipComment(data, p, pe)
+		goto st195
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st151
+		case 32:
+			goto st151
+		case 34:
+			goto tr230
+		case 45:
+			goto tr231
+		case 48:
+			goto tr232
+		case 91:
+			goto tr234
+		case 102:
+			goto st180
+		case 110:
+			goto st185
+		case 116:
+			goto st189
+		case 123:
+			goto tr238
+		case 559:
+			goto st194
+		case 1097:
+			goto tr240
+		case 1102:
+			goto tr241
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr233
+			}
+		case _widec >= 9:
+			goto st151
+		}
+		goto tr194
+	tr241:
+		tokenStart = p
+		goto st196
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		if data[p] == 97 {
+			goto st197
+		}
+		goto tr290
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		if data[p] == 78 {
+			goto st198
+		}
+		goto tr290
+	st198:
+		if p++; p == pe {
+			goto _test_eof198
+		}
+	st_case_198:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	st199:
+		if p++; p == pe {
+			goto _test_eof199
+		}
+	st_case_199:
+		switch data[p] {
+		case 42:
+			goto tr294
+		case 47:
+			goto tr294
+		}
+		goto tr293
+	tr294:
+		p = skipComment(data, p, pe)
+		goto st200
+	st200:
+		if p++; p == pe {
+			goto _test_eof200
+		}
+	st_case_200:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st149
+		case 32:
+			goto st149
+		case 58:
+			goto st150
+		case 559:
+			goto st199
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st149
+		}
+		goto tr190
+	st201:
+		if p++; p == pe {
+			goto _test_eof201
+		}
+	st_case_201:
+		switch data[p] {
+		case 34:
+			goto st202
+		case 47:
+			goto st202
+		case 92:
+			goto st202
+		case 98:
+			goto st202
+		case 102:
+			goto st202
+		case 110:
+			goto st202
+		case 114:
+			goto st202
+		case 116:
+			goto st202
+		case 117:
+			goto st204
+		}
+		goto tr248
+	st202:
+		if p++; p == pe {
+			goto _test_eof202
+		}
+	st_case_202:
+		switch data[p] {
+		case 34:
+			goto tr223
+		case 92:
+			goto st201
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st147
+			}
+		default:
+			goto tr185
+		}
+		goto tr225
+	tr225:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 203
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st203
+	st203:
+		if p++; p == pe {
+			goto _test_eof203
+		}
+	st_case_203:
+		switch data[p] {
+		case 34:
+			goto tr223
+		case 92:
+			goto st201
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st147
+			}
+		default:
+			goto tr185
+		}
+		goto tr225
+	st204:
+		if p++; p == pe {
+			goto _test_eof204
+		}
+	st_case_204:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st205
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st205
+			}
+		default:
+			goto st205
+		}
+		goto tr251
+	st205:
+		if p++; p == pe {
+			goto _test_eof205
+		}
+	st_case_205:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st206
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st206
+			}
+		default:
+			goto st206
+		}
+		goto tr251
+	st206:
+		if p++; p == pe {
+			goto _test_eof206
+		}
+	st_case_206:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st207
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st207
+			}
+		default:
+			goto st207
+		}
+		goto tr251
+	st207:
+		if p++; p == pe {
+			goto _test_eof207
+		}
+	st_case_207:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr300
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr300
+			}
+		default:
+			goto tr300
+		}
+		goto tr251
+	tr300:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 208
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st208
+	st208:
+		if p++; p == pe {
+			goto _test_eof208
+		}
+	st_case_208:
+		switch data[p] {
+		case 34:
+			goto tr223
+		case 92:
+			goto st201
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st147
+			}
+		default:
+			goto tr185
+		}
+		goto tr225
+	st209:
+		if p++; p == pe {
+			goto _test_eof209
+		}
+	st_case_209:
+		switch data[p] {
+		case 42:
+			goto tr301
+		case 47:
+			goto tr301
+		}
+		goto tr288
+	tr301:
+		p = skipComment(data, p, pe)
+		goto st210
+	st210:
+		if p++; p == pe {
+			goto _test_eof210
+		}
+	st_case_210:
+		_widec = int16(data[p])
+		switch {
+		case data[p] > 47:
+			if 125 <= data[p] && data[p] <= 125 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] >= 47:
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st145
+		case 32:
+			goto st145
+		case 34:
+			goto tr219
+		case 559:
+			goto st209
+		case 637:
+			goto tr221
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st145
+		}
+		goto tr217
+	tr221:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st282
+	st282:
+		if p++; p == pe {
+			goto _test_eof282
+		}
+	st_case_282:
+		goto st0
+	st211:
+		if p++; p == pe {
+			goto _test_eof211
+		}
+	st_case_211:
+		switch data[p] {
+		case 34:
+			goto st212
+		case 47:
+			goto st212
+		case 92:
+			goto st212
+		case 98:
+			goto st212
+		case 102:
+			goto st212
+		case 110:
+			goto st212
+		case 114:
+			goto st212
+		case 116:
+			goto st212
+		case 117:
+			goto st214
+		}
+		goto tr248
+	st212:
+		if p++; p == pe {
+			goto _test_eof212
+		}
+	st_case_212:
+		switch data[p] {
+		case 34:
+			goto tr209
+		case 92:
+			goto st211
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st141
+			}
+		default:
+			goto tr185
+		}
+		goto tr211
+	tr211:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 213
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st213
+	st213:
+		if p++; p == pe {
+			goto _test_eof213
+		}
+	st_case_213:
+		switch data[p] {
+		case 34:
+			goto tr209
+		case 92:
+			goto st211
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st141
+			}
+		default:
+			goto tr185
+		}
+		goto tr211
+	st214:
+		if p++; p == pe {
+			goto _test_eof214
+		}
+	st_case_214:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st215
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st215
+			}
+		default:
+			goto st215
+		}
+		goto tr251
+	st215:
+		if p++; p == pe {
+			goto _test_eof215
+		}
+	st_case_215:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st216
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st216
+			}
+		default:
+			goto st216
+		}
+		goto tr251
+	st216:
+		if p++; p == pe {
+			goto _test_eof216
+		}
+	st_case_216:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st217
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st217
+			}
+		default:
+			goto st217
+		}
+		goto tr251
+	st217:
+		if p++; p == pe {
+			goto _test_eof217
+		}
+	st_case_217:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr307
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr307
+			}
+		default:
+			goto tr307
+		}
+		goto tr251
+	tr307:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 218
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st218
+	st218:
+		if p++; p == pe {
+			goto _test_eof218
+		}
+	st_case_218:
+		switch data[p] {
+		case 34:
+			goto tr209
+		case 92:
+			goto st211
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st141
+			}
+		default:
+			goto tr185
+		}
+		goto tr211
+	tr197:
+		tokenStart = p
+		goto st219
+	st219:
+		if p++; p == pe {
+			goto _test_eof219
+		}
+	st_case_219:
+		_widec = int16(data[p])
+		if 73 <= data[p] && data[p] <= 73 {
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 48:
+			goto st220
+		case 1097:
+			goto st225
+		}
+		if 49 <= _widec && _widec <= 57 {
+			goto st223
+		}
+		goto tr256
+	tr198:
+		tokenStart = p
+		goto st220
+	st220:
+		if p++; p == pe {
+			goto _test_eof220
+		}
+	st_case_220:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr311
+		case 69:
+			goto tr312
+		case 101:
+			goto tr312
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr311:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 221
+				goto _out
+			}
+		}
+
+		goto st221
+	st221:
+		if p++; p == pe {
+			goto _test_eof221
+		}
+	st_case_221:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr312:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				cs = 222
+				goto _out
+			}
+		}
+
+		goto st222
+	st222:
+		if p++; p == pe {
+			goto _test_eof222
+		}
+	st_case_222:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr199:
+		tokenStart = p
+		goto st223
+	st223:
+		if p++; p == pe {
+			goto _test_eof223
+		}
+	st_case_223:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr311
+		case 69:
+			goto tr312
+		case 101:
+			goto tr312
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st224
+			}
+		case _widec >= 9:
+			goto tr260
+		}
+		goto tr212
+	st224:
+		if p++; p == pe {
+			goto _test_eof224
+		}
+	st_case_224:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 46:
+			goto tr311
+		case 69:
+			goto tr312
+		case 101:
+			goto tr312
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		switch {
+		case _widec > 10:
+			if 48 <= _widec && _widec <= 57 {
+				goto st224
+			}
+		case _widec >= 9:
+			goto tr260
+		}
+		goto tr212
+	tr206:
+		tokenStart = p
+		goto st225
+	st225:
+		if p++; p == pe {
+			goto _test_eof225
+		}
+	st_case_225:
+		if data[p] == 110 {
+			goto st226
+		}
+		goto tr267
+	st226:
+		if p++; p == pe {
+			goto _test_eof226
+		}
+	st_case_226:
+		if data[p] == 102 {
+			goto st227
+		}
+		goto tr267
+	st227:
+		if p++; p == pe {
+			goto _test_eof227
+		}
+	st_case_227:
+		if data[p] == 105 {
+			goto st228
+		}
+		goto tr267
+	st228:
+		if p++; p == pe {
+			goto _test_eof228
+		}
+	st_case_228:
+		if data[p] == 110 {
+			goto st229
+		}
+		goto tr267
+	st229:
+		if p++; p == pe {
+			goto _test_eof229
+		}
+	st_case_229:
+		if data[p] == 105 {
+			goto st230
+		}
+		goto tr267
+	st230:
+		if p++; p == pe {
+			goto _test_eof230
+		}
+	st_case_230:
+		if data[p] == 116 {
+			goto st231
+		}
+		goto tr267
+	st231:
+		if p++; p == pe {
+			goto _test_eof231
+		}
+	st_case_231:
+		if data[p] == 121 {
+			goto st232
+		}
+		goto tr267
+	st232:
+		if p++; p == pe {
+			goto _test_eof232
+		}
+	st_case_232:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	tr200:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 233
+				top++
+				goto st35
+			}
+		}
+		goto st233
+	st233:
+		if p++; p == pe {
+			goto _test_eof233
+		}
+	st_case_233:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st234:
+		if p++; p == pe {
+			goto _test_eof234
+		}
+	st_case_234:
+		if data[p] == 97 {
+			goto st235
+		}
+		goto tr275
+	st235:
+		if p++; p == pe {
+			goto _test_eof235
+		}
+	st_case_235:
+		if data[p] == 108 {
+			goto st236
+		}
+		goto tr275
+	st236:
+		if p++; p == pe {
+			goto _test_eof236
+		}
+	st_case_236:
+		if data[p] == 115 {
+			goto st237
+		}
+		goto tr275
+	st237:
+		if p++; p == pe {
+			goto _test_eof237
+		}
+	st_case_237:
+		if data[p] == 101 {
+			goto st238
+		}
+		goto tr275
+	st238:
+		if p++; p == pe {
+			goto _test_eof238
+		}
+	st_case_238:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st239:
+		if p++; p == pe {
+			goto _test_eof239
+		}
+	st_case_239:
+		if data[p] == 117 {
+			goto st240
+		}
+		goto tr280
+	st240:
+		if p++; p == pe {
+			goto _test_eof240
+		}
+	st_case_240:
+		if data[p] == 108 {
+			goto st241
+		}
+		goto tr280
+	st241:
+		if p++; p == pe {
+			goto _test_eof241
+		}
+	st_case_241:
+		if data[p] == 108 {
+			goto st242
+		}
+		goto tr280
+	st242:
+		if p++; p == pe {
+			goto _test_eof242
+		}
+	st_case_242:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st243:
+		if p++; p == pe {
+			goto _test_eof243
+		}
+	st_case_243:
+		if data[p] == 114 {
+			goto st244
+		}
+		goto tr284
+	st244:
+		if p++; p == pe {
+			goto _test_eof244
+		}
+	st_case_244:
+		if data[p] == 117 {
+			goto st245
+		}
+		goto tr284
+	st245:
+		if p++; p == pe {
+			goto _test_eof245
+		}
+	st_case_245:
+		if data[p] == 101 {
+			goto st246
+		}
+		goto tr284
+	st246:
+		if p++; p == pe {
+			goto _test_eof246
+		}
+	st_case_246:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	tr204:
+		{
+			if top+depth == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+			if buffer.Limits.MaxDepth > 0 && top+depth >= buffer.Limits.MaxDepth {
+				return p, stack, "", limitError("MaxDepth", buffer.Limits.MaxDepth, p, data)
+			}
+			if top+1 >= len(stack) {
+				stack = append(stack, make([]int, 1+top-len(stack))...)
+			}
+			buffer.enterLevel(top + 1)
+			{
+				stack[top] = 247
+				top++
+				goto st132
+			}
+		}
+		goto st247
+	st247:
+		if p++; p == pe {
+			goto _test_eof247
+		}
+	st_case_247:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st143
+		case 32:
+			goto st143
+		case 44:
+			goto st144
+		case 125:
+			goto tr215
+		case 559:
+			goto st155
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st143
+		}
+		goto tr212
+	st248:
+		if p++; p == pe {
+			goto _test_eof248
+		}
+	st_case_248:
+		switch data[p] {
+		case 42:
+			goto tr331
+		case 47:
+			goto tr331
+		}
+		goto tr288
+	tr331:
+		p = skipComment(data, p, pe)
+		goto st249
+	st249:
+		if p++; p == pe {
+			goto _test_eof249
+		}
+	st_case_249:
+		_widec = int16(data[p])
+		switch {
+		case data[p] < 73:
+			if 47 <= data[p] && data[p] <= 47 {
+				_widec = 256 + (int16(data[p]) - 0)
+				if buffer.JSONC {
+					_widec += 256
+				}
+			}
+		case data[p] > 73:
+			if 78 <= data[p] && data[p] <= 78 {
+				_widec = 768 + (int16(data[p]) - 0)
+				if buffer.AllowNonFinite {
+					_widec += 256
+				}
+			}
+		default:
+			_widec = 768 + (int16(data[p]) - 0)
+			if buffer.AllowNonFinite {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st139
+		case 32:
+			goto st139
+		case 34:
+			goto tr196
+		case 45:
+			goto tr197
+		case 48:
+			goto tr198
+		case 91:
+			goto tr200
+		case 102:
+			goto st234
+		case 110:
+			goto st239
+		case 116:
+			goto st243
+		case 123:
+			goto tr204
+		case 559:
+			goto st248
+		case 1097:
+			goto tr206
+		case 1102:
+			goto tr207
+		}
+		switch {
+		case _widec > 10:
+			if 49 <= _widec && _widec <= 57 {
+				goto tr199
+			}
+		case _widec >= 9:
+			goto st139
+		}
+		goto tr194
+	tr207:
+		tokenStart = p
+		goto st250
+	st250:
+		if p++; p == pe {
+			goto _test_eof250
+		}
+	st_case_250:
+		if data[p] == 97 {
+			goto st251
+		}
+		goto tr290
+	st251:
+		if p++; p == pe {
+			goto _test_eof251
+		}
+	st_case_251:
+		if data[p] == 78 {
+			goto st252
+		}
+		goto tr290
+	st252:
+		if p++; p == pe {
+			goto _test_eof252
+		}
+	st_case_252:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto tr260
+		case 32:
+			goto tr260
+		case 44:
+			goto tr261
+		case 125:
+			goto tr264
+		case 559:
+			goto tr265
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto tr260
+		}
+		goto tr212
+	st253:
+		if p++; p == pe {
+			goto _test_eof253
+		}
+	st_case_253:
+		switch data[p] {
+		case 42:
+			goto tr334
+		case 47:
+			goto tr334
+		}
+		goto tr293
+	tr334:
+		p = skipComment(data, p, pe)
+		goto st254
+	st254:
+		if p++; p == pe {
+			goto _test_eof254
+		}
+	st_case_254:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st137
+		case 32:
+			goto st137
+		case 58:
+			goto st138
+		case 559:
+			goto st253
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st137
+		}
+		goto tr190
+	st255:
+		if p++; p == pe {
+			goto _test_eof255
+		}
+	st_case_255:
+		switch data[p] {
+		case 34:
+			goto st256
+		case 47:
+			goto st256
+		case 92:
+			goto st256
+		case 98:
+			goto st256
+		case 102:
+			goto st256
+		case 110:
+			goto st256
+		case 114:
+			goto st256
+		case 116:
+			goto st256
+		case 117:
+			goto st258
+		}
+		goto tr248
+	st256:
+		if p++; p == pe {
+			goto _test_eof256
+		}
+	st_case_256:
+		switch data[p] {
+		case 34:
+			goto tr187
+		case 92:
+			goto st255
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st135
+			}
+		default:
+			goto tr185
+		}
+		goto tr189
+	tr189:
+
+		if buffer.StrictUTF8 {
+			n := utf8CharLen(data[p:pe])
+			if n == 0 {
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 257
+					goto _out
+				}
+			}
+			p += n - 1
+		}
+
+		goto st257
+	st257:
+		if p++; p == pe {
+			goto _test_eof257
+		}
+	st_case_257:
+		switch data[p] {
+		case 34:
+			goto tr187
+		case 92:
+			goto st255
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st135
+			}
+		default:
+			goto tr185
+		}
+		goto tr189
+	st258:
+		if p++; p == pe {
+			goto _test_eof258
+		}
+	st_case_258:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st259
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st259
+			}
+		default:
+			goto st259
+		}
+		goto tr251
+	st259:
+		if p++; p == pe {
+			goto _test_eof259
+		}
+	st_case_259:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st260
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st260
+			}
+		default:
+			goto st260
+		}
+		goto tr251
+	st260:
+		if p++; p == pe {
+			goto _test_eof260
+		}
+	st_case_260:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st261
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st261
+			}
+		default:
+			goto st261
+		}
+		goto tr251
+	st261:
+		if p++; p == pe {
+			goto _test_eof261
+		}
+	st_case_261:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto tr340
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto tr340
+			}
+		default:
+			goto tr340
+		}
+		goto tr251
+	tr340:
+
+		if buffer.StrictUTF8 {
+			n := unicodeEscapeLen(data[p-5 : pe])
+			if n == 0 {
+				p -= 5
+				err, expected = ErrInvalidUTF8, "valid utf-8"
+				p--
+				{
+					p++
+					cs = 262
+					goto _out
+				}
+			}
+			p += n - 6
+		}
+
+		goto st262
+	st262:
+		if p++; p == pe {
+			goto _test_eof262
+		}
+	st_case_262:
+		switch data[p] {
+		case 34:
+			goto tr187
+		case 92:
+			goto st255
+		}
+		switch {
+		case data[p] > 31:
+			if 32 <= data[p] && data[p] <= 127 {
+				goto st135
+			}
+		default:
+			goto tr185
+		}
+		goto tr189
+	tr183:
+		{
+			top--
+			cs = stack[top]
+			goto _again
+		}
+		goto st283
+	st283:
+		if p++; p == pe {
+			goto _test_eof283
+		}
+	st_case_283:
+		goto st0
+	st263:
+		if p++; p == pe {
+			goto _test_eof263
+		}
+	st_case_263:
+		switch data[p] {
+		case 42:
+			goto tr341
+		case 47:
+			goto tr341
+		}
+		goto tr288
+	tr341:
+		p = skipComment(data, p, pe)
+		goto st264
+	st264:
+		if p++; p == pe {
+			goto _test_eof264
+		}
+	st_case_264:
+		_widec = int16(data[p])
+		if 47 <= data[p] && data[p] <= 47 {
+			_widec = 256 + (int16(data[p]) - 0)
+			if buffer.JSONC {
+				_widec += 256
+			}
+		}
+		switch _widec {
+		case 13:
+			goto st133
+		case 32:
+			goto st133
+		case 34:
+			goto tr182
+		case 125:
+			goto tr183
+		case 559:
+			goto st263
+		}
+		if 9 <= _widec && _widec <= 10 {
+			goto st133
+		}
+		goto tr180
+	st_out:
+	_test_eof1:
+		cs = 1
+		goto _test_eof
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof265:
+		cs = 265
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof7:
+		cs = 7
+		goto _test_eof
+	_test_eof8:
+		cs = 8
+		goto _test_eof
+	_test_eof9:
+		cs = 9
+		goto _test_eof
+	_test_eof10:
+		cs = 10
+		goto _test_eof
+	_test_eof11:
+		cs = 11
+		goto _test_eof
+	_test_eof12:
+		cs = 12
+		goto _test_eof
+	_test_eof13:
+		cs = 13
+		goto _test_eof
+	_test_eof266:
+		cs = 266
+		goto _test_eof
+	_test_eof267:
+		cs = 267
+		goto _test_eof
+	_test_eof268:
+		cs = 268
+		goto _test_eof
+	_test_eof269:
+		cs = 269
+		goto _test_eof
+	_test_eof270:
+		cs = 270
+		goto _test_eof
+	_test_eof14:
+		cs = 14
+		goto _test_eof
+	_test_eof15:
+		cs = 15
+		goto _test_eof
+	_test_eof16:
+		cs = 16
+		goto _test_eof
+	_test_eof17:
+		cs = 17
+		goto _test_eof
+	_test_eof18:
+		cs = 18
+		goto _test_eof
+	_test_eof19:
+		cs = 19
+		goto _test_eof
+	_test_eof20:
+		cs = 20
+		goto _test_eof
+	_test_eof271:
+		cs = 271
+		goto _test_eof
+	_test_eof272:
+		cs = 272
+		goto _test_eof
+	_test_eof21:
+		cs = 21
+		goto _test_eof
+	_test_eof22:
+		cs = 22
+		goto _test_eof
+	_test_eof23:
+		cs = 23
+		goto _test_eof
+	_test_eof24:
+		cs = 24
+		goto _test_eof
+	_test_eof273:
+		cs = 273
+		goto _test_eof
+	_test_eof25:
+		cs = 25
+		goto _test_eof
+	_test_eof26:
+		cs = 26
+		goto _test_eof
+	_test_eof27:
+		cs = 27
+		goto _test_eof
+	_test_eof274:
+		cs = 274
+		goto _test_eof
+	_test_eof28:
+		cs = 28
+		goto _test_eof
+	_test_eof29:
+		cs = 29
+		goto _test_eof
+	_test_eof30:
+		cs = 30
+		goto _test_eof
+	_test_eof275:
+		cs = 275
+		goto _test_eof
+	_test_eof276:
+		cs = 276
+		goto _test_eof
+	_test_eof31:
+		cs = 31
+		goto _test_eof
+	_test_eof32:
+		cs = 32
+		goto _test_eof
+	_test_eof33:
+		cs = 33
+		goto _test_eof
+	_test_eof34:
+		cs = 34
+		goto _test_eof
+	_test_eof277:
+		cs = 277
+		goto _test_eof
+	_test_eof35:
+		cs = 35
+		goto _test_eof
+	_test_eof36:
+		cs = 36
+		goto _test_eof
+	_test_eof37:
+		cs = 37
+		goto _test_eof
+	_test_eof38:
+		cs = 38
+		goto _test_eof
+	_test_eof39:
+		cs = 39
+		goto _test_eof
+	_test_eof40:
+		cs = 40
+		goto _test_eof
+	_test_eof41:
+		cs = 41
+		goto _test_eof
+	_test_eof42:
+		cs = 42
+		goto _test_eof
+	_test_eof43:
+		cs = 43
+		goto _test_eof
+	_test_eof44:
+		cs = 44
+		goto _test_eof
+	_test_eof45:
+		cs = 45
+		goto _test_eof
+	_test_eof278:
+		cs = 278
+		goto _test_eof
+	_test_eof46:
+		cs = 46
+		goto _test_eof
+	_test_eof47:
+		cs = 47
+		goto _test_eof
+	_test_eof48:
+		cs = 48
+		goto _test_eof
+	_test_eof49:
+		cs = 49
+		goto _test_eof
+	_test_eof50:
+		cs = 50
+		goto _test_eof
+	_test_eof51:
+		cs = 51
+		goto _test_eof
+	_test_eof52:
+		cs = 52
+		goto _test_eof
+	_test_eof53:
+		cs = 53
+		goto _test_eof
+	_test_eof54:
+		cs = 54
+		goto _test_eof
+	_test_eof55:
+		cs = 55
+		goto _test_eof
+	_test_eof56:
+		cs = 56
+		goto _test_eof
+	_test_eof57:
+		cs = 57
+		goto _test_eof
+	_test_eof58:
+		cs = 58
+		goto _test_eof
+	_test_eof59:
+		cs = 59
+		goto _test_eof
+	_test_eof60:
+		cs = 60
+		goto _test_eof
+	_test_eof61:
+		cs = 61
+		goto _test_eof
+	_test_eof62:
+		cs = 62
+		goto _test_eof
+	_test_eof63:
+		cs = 63
+		goto _test_eof
+	_test_eof64:
+		cs = 64
+		goto _test_eof
+	_test_eof65:
+		cs = 65
+		goto _test_eof
+	_test_eof66:
+		cs = 66
+		goto _test_eof
+	_test_eof67:
+		cs = 67
+		goto _test_eof
+	_test_eof68:
+		cs = 68
+		goto _test_eof
+	_test_eof69:
+		cs = 69
+		goto _test_eof
+	_test_eof70:
+		cs = 70
+		goto _test_eof
+	_test_eof71:
+		cs = 71
+		goto _test_eof
+	_test_eof72:
+		cs = 72
+		goto _test_eof
+	_test_eof73:
+		cs = 73
+		goto _test_eof
+	_test_eof74:
+		cs = 74
+		goto _test_eof
+	_test_eof75:
+		cs = 75
+		goto _test_eof
+	_test_eof76:
+		cs = 76
+		goto _test_eof
+	_test_eof77:
+		cs = 77
+		goto _test_eof
+	_test_eof78:
+		cs = 78
+		goto _test_eof
+	_test_eof79:
+		cs = 79
+		goto _test_eof
+	_test_eof80:
+		cs = 80
+		goto _test_eof
+	_test_eof81:
+		cs = 81
+		goto _test_eof
+	_test_eof82:
+		cs = 82
+		goto _test_eof
+	_test_eof83:
+		cs = 83
+		goto _test_eof
+	_test_eof84:
+		cs = 84
+		goto _test_eof
+	_test_eof85:
+		cs = 85
+		goto _test_eof
+	_test_eof86:
+		cs = 86
+		goto _test_eof
+	_test_eof279:
+		cs = 279
+		goto _test_eof
+	_test_eof87:
+		cs = 87
+		goto _test_eof
+	_test_eof88:
+		cs = 88
+		goto _test_eof
+	_test_eof89:
+		cs = 89
+		goto _test_eof
+	_test_eof90:
+		cs = 90
+		goto _test_eof
+	_test_eof91:
+		cs = 91
+		goto _test_eof
+	_test_eof92:
+		cs = 92
+		goto _test_eof
+	_test_eof93:
+		cs = 93
+		goto _test_eof
+	_test_eof94:
+		cs = 94
+		goto _test_eof
+	_test_eof95:
+		cs = 95
+		goto _test_eof
+	_test_eof96:
+		cs = 96
+		goto _test_eof
+	_test_eof97:
+		cs = 97
+		goto _test_eof
+	_test_eof98:
+		cs = 98
+		goto _test_eof
+	_test_eof99:
+		cs = 99
+		goto _test_eof
+	_test_eof100:
+		cs = 100
+		goto _test_eof
+	_test_eof101:
+		cs = 101
+		goto _test_eof
+	_test_eof102:
+		cs = 102
+		goto _test_eof
+	_test_eof103:
+		cs = 103
+		goto _test_eof
+	_test_eof104:
+		cs = 104
+		goto _test_eof
+	_test_eof105:
+		cs = 105
+		goto _test_eof
+	_test_eof106:
+		cs = 106
+		goto _test_eof
+	_test_eof107:
+		cs = 107
+		goto _test_eof
+	_test_eof108:
+		cs = 108
+		goto _test_eof
+	_test_eof109:
+		cs = 109
+		goto _test_eof
+	_test_eof110:
+		cs = 110
+		goto _test_eof
+	_test_eof111:
+		cs = 111
+		goto _test_eof
+	_test_eof112:
+		cs = 112
+		goto _test_eof
+	_test_eof280:
+		cs = 280
+		goto _test_eof
+	_test_eof113:
+		cs = 113
+		goto _test_eof
+	_test_eof114:
+		cs = 114
+		goto _test_eof
+	_test_eof115:
+		cs = 115
+		goto _test_eof
+	_test_eof116:
+		cs = 116
+		goto _test_eof
+	_test_eof117:
+		cs = 117
+		goto _test_eof
+	_test_eof118:
+		cs = 118
+		goto _test_eof
+	_test_eof119:
+		cs = 119
+		goto _test_eof
+	_test_eof120:
+		cs = 120
+		goto _test_eof
+	_test_eof121:
+		cs = 121
+		goto _test_eof
+	_test_eof122:
+		cs = 122
+		goto _test_eof
+	_test_eof123:
+		cs = 123
+		goto _test_eof
+	_test_eof124:
+		cs = 124
+		goto _test_eof
+	_test_eof125:
+		cs = 125
+		goto _test_eof
+	_test_eof126:
+		cs = 126
+		goto _test_eof
+	_test_eof127:
+		cs = 127
+		goto _test_eof
+	_test_eof128:
+		cs = 128
+		goto _test_eof
+	_test_eof129:
+		cs = 129
+		goto _test_eof
+	_test_eof130:
+		cs = 130
+		goto _test_eof
+	_test_eof131:
+		cs = 131
+		goto _test_eof
+	_test_eof132:
+		cs = 132
+		goto _test_eof
+	_test_eof133:
+		cs = 133
+		goto _test_eof
+	_test_eof134:
+		cs = 134
+		goto _test_eof
+	_test_eof135:
+		cs = 135
+		goto _test_eof
+	_test_eof136:
+		cs = 136
+		goto _test_eof
+	_test_eof137:
+		cs = 137
+		goto _test_eof
+	_test_eof138:
+		cs = 138
+		goto _test_eof
+	_test_eof139:
+		cs = 139
+		goto _test_eof
+	_test_eof140:
+		cs = 140
+		goto _test_eof
+	_test_eof141:
+		cs = 141
+		goto _test_eof
+	_test_eof142:
+		cs = 142
+		goto _test_eof
+	_test_eof143:
+		cs = 143
+		goto _test_eof
+	_test_eof144:
+		cs = 144
+		goto _test_eof
+	_test_eof145:
+		cs = 145
+		goto _test_eof
+	_test_eof146:
+		cs = 146
+		goto _test_eof
+	_test_eof147:
+		cs = 147
+		goto _test_eof
+	_test_eof148:
+		cs = 148
+		goto _test_eof
+	_test_eof149:
+		cs = 149
+		goto _test_eof
+	_test_eof150:
+		cs = 150
+		goto _test_eof
+	_test_eof151:
+		cs = 151
+		goto _test_eof
+	_test_eof152:
+		cs = 152
+		goto _test_eof
+	_test_eof153:
+		cs = 153
+		goto _test_eof
+	_test_eof154:
+		cs = 154
+		goto _test_eof
+	_test_eof281:
+		cs = 281
+		goto _test_eof
+	_test_eof155:
+		cs = 155
+		goto _test_eof
+	_test_eof156:
+		cs = 156
+		goto _test_eof
+	_test_eof157:
+		cs = 157
+		goto _test_eof
+	_test_eof158:
+		cs = 158
+		goto _test_eof
+	_test_eof159:
+		cs = 159
+		goto _test_eof
+	_test_eof160:
+		cs = 160
+		goto _test_eof
+	_test_eof161:
+		cs = 161
+		goto _test_eof
+	_test_eof162:
+		cs = 162
+		goto _test_eof
+	_test_eof163:
+		cs = 163
+		goto _test_eof
+	_test_eof164:
+		cs = 164
+		goto _test_eof
+	_test_eof165:
+		cs = 165
+		goto _test_eof
+	_test_eof166:
+		cs = 166
+		goto _test_eof
+	_test_eof167:
+		cs = 167
+		goto _test_eof
+	_test_eof168:
+		cs = 168
+		goto _test_eof
+	_test_eof169:
+		cs = 169
+		goto _test_eof
+	_test_eof170:
+		cs = 170
+		goto _test_eof
+	_test_eof171:
+		cs = 171
+		goto _test_eof
+	_test_eof172:
+		cs = 172
+		goto _test_eof
+	_test_eof173:
+		cs = 173
+		goto _test_eof
+	_test_eof174:
+		cs = 174
+		goto _test_eof
+	_test_eof175:
+		cs = 175
+		goto _test_eof
+	_test_eof176:
+		cs = 176
+		goto _test_eof
+	_test_eof177:
+		cs = 177
+		goto _test_eof
+	_test_eof178:
+		cs = 178
+		goto _test_eof
+	_test_eof179:
+		cs = 179
+		goto _test_eof
+	_test_eof180:
+		cs = 180
+		goto _test_eof
+	_test_eof181:
+		cs = 181
+		goto _test_eof
+	_test_eof182:
+		cs = 182
+		goto _test_eof
+	_test_eof183:
+		cs = 183
+		goto _test_eof
+	_test_eof184:
+		cs = 184
+		goto _test_eof
+	_test_eof185:
+		cs = 185
+		goto _test_eof
+	_test_eof186:
+		cs = 186
+		goto _test_eof
+	_test_eof187:
+		cs = 187
+		goto _test_eof
+	_test_eof188:
+		cs = 188
+		goto _test_eof
+	_test_eof189:
+		cs = 189
+		goto _test_eof
+	_test_eof190:
+		cs = 190
+		goto _test_eof
+	_test_eof191:
+		cs = 191
+		goto _test_eof
+	_test_eof192:
+		cs = 192
+		goto _test_eof
+	_test_eof193:
+		cs = 193
+		goto _test_eof
+	_test_eof194:
+		cs = 194
+		goto _test_eof
+	_test_eof195:
+		cs = 195
+		goto _test_eof
+	_test_eof196:
+		cs = 196
+		goto _test_eof
+	_test_eof197:
+		cs = 197
+		goto _test_eof
+	_test_eof198:
+		cs = 198
+		goto _test_eof
+	_test_eof199:
+		cs = 199
+		goto _test_eof
+	_test_eof200:
+		cs = 200
+		goto _test_eof
+	_test_eof201:
+		cs = 201
+		goto _test_eof
+	_test_eof202:
+		cs = 202
+		goto _test_eof
+	_test_eof203:
+		cs = 203
+		goto _test_eof
+	_test_eof204:
+		cs = 204
+		goto _test_eof
+	_test_eof205:
+		cs = 205
+		goto _test_eof
+	_test_eof206:
+		cs = 206
+		goto _test_eof
+	_test_eof207:
+		cs = 207
+		goto _test_eof
+	_test_eof208:
+		cs = 208
+		goto _test_eof
+	_test_eof209:
+		cs = 209
+		goto _test_eof
+	_test_eof210:
+		cs = 210
+		goto _test_eof
+	_test_eof282:
+		cs = 282
+		goto _test_eof
+	_test_eof211:
+		cs = 211
+		goto _test_eof
+	_test_eof212:
+		cs = 212
+		goto _test_eof
+	_test_eof213:
+		cs = 213
+		goto _test_eof
+	_test_eof214:
+		cs = 214
+		goto _test_eof
+	_test_eof215:
+		cs = 215
+		goto _test_eof
+	_test_eof216:
+		cs = 216
+		goto _test_eof
+	_test_eof217:
+		cs = 217
+		goto _test_eof
+	_test_eof218:
+		cs = 218
+		goto _test_eof
+	_test_eof219:
+		cs = 219
+		goto _test_eof
+	_test_eof220:
+		cs = 220
+		goto _test_eof
+	_test_eof221:
+		cs = 221
+		goto _test_eof
+	_test_eof222:
+		cs = 222
+		goto _test_eof
+	_test_eof223:
+		cs = 223
+		goto _test_eof
+	_test_eof224:
+		cs = 224
+		goto _test_eof
+	_test_eof225:
+		cs = 225
+		goto _test_eof
+	_test_eof226:
+		cs = 226
+		goto _test_eof
+	_test_eof227:
+		cs = 227
+		goto _test_eof
+	_test_eof228:
+		cs = 228
+		goto _test_eof
+	_test_eof229:
+		cs = 229
+		goto _test_eof
+	_test_eof230:
+		cs = 230
+		goto _test_eof
+	_test_eof231:
+		cs = 231
+		goto _test_eof
+	_test_eof232:
+		cs = 232
+		goto _test_eof
+	_test_eof233:
+		cs = 233
+		goto _test_eof
+	_test_eof234:
+		cs = 234
+		goto _test_eof
+	_test_eof235:
+		cs = 235
+		goto _test_eof
+	_test_eof236:
+		cs = 236
+		goto _test_eof
+	_test_eof237:
+		cs = 237
+		goto _test_eof
+	_test_eof238:
+		cs = 238
+		goto _test_eof
+	_test_eof239:
+		cs = 239
+		goto _test_eof
+	_test_eof240:
+		cs = 240
+		goto _test_eof
+	_test_eof241:
+		cs = 241
+		goto _test_eof
+	_test_eof242:
+		cs = 242
+		goto _test_eof
+	_test_eof243:
+		cs = 243
+		goto _test_eof
+	_test_eof244:
+		cs = 244
+		goto _test_eof
+	_test_eof245:
+		cs = 245
+		goto _test_eof
+	_test_eof246:
+		cs = 246
+		goto _test_eof
+	_test_eof247:
+		cs = 247
+		goto _test_eof
+	_test_eof248:
+		cs = 248
+		goto _test_eof
+	_test_eof249:
+		cs = 249
+		goto _test_eof
+	_test_eof250:
+		cs = 250
+		goto _test_eof
+	_test_eof251:
+		cs = 251
+		goto _test_eof
+	_test_eof252:
+		cs = 252
+		goto _test_eof
+	_test_eof253:
+		cs = 253
+		goto _test_eof
+	_test_eof254:
+		cs = 254
+		goto _test_eof
+	_test_eof255:
+		cs = 255
+		goto _test_eof
+	_test_eof256:
+		cs = 256
+		goto _test_eof
+	_test_eof257:
+		cs = 257
+		goto _test_eof
+	_test_eof258:
+		cs = 258
+		goto _test_eof
+	_test_eof259:
+		cs = 259
+		goto _test_eof
+	_test_eof260:
+		cs = 260
+		goto _test_eof
+	_test_eof261:
+		cs = 261
+		goto _test_eof
+	_test_eof262:
+		cs = 262
+		goto _test_eof
+	_test_eof283:
+		cs = 283
+		goto _test_eof
+	_test_eof263:
+		cs = 263
+		goto _test_eof
+	_test_eof264:
+		cs = 264
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 31:
+				expected = "'/' or '*'"
+			case 266, 267, 268, 269, 270, 271, 277:
+
+				if buffer.Limits.MaxNumberLen > 0 && p-tokenStart > buffer.Limits.MaxNumberLen {
+					return tokenStart, stack, "", limitError("MaxNumberLen", buffer.Limits.MaxNumberLen, tokenStart, data)
+				}
+
+			case 1, 2, 32:
+				expected = "value"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 5:
+				expected = "escape sequence"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 8, 9, 10, 11:
+				expected = "hex digit"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 13:
+				expected = "digit"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 28, 29, 30:
+				expected = "true"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 21, 22, 23, 24:
+				expected = "false"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 25, 26, 27:
+				expected = "null"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 33, 34:
+				expected = "NaN"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 14, 15, 16, 17, 18, 19, 20:
+				expected = "Infinity"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 85, 127:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 194, 209, 248, 263:
+				expected = "'/' or '*'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 41, 42, 86:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 138, 139, 150, 151, 195, 249:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 35, 36, 128:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 39, 40, 45, 47, 57, 58, 59, 60, 61, 69, 70, 75, 79, 83, 84, 89, 99, 100, 101, 102, 103, 111, 112, 117, 121, 125, 126, 131:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 132, 133, 264:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 144, 145, 210:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 136, 137, 148, 149, 200, 254:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 142, 143, 154, 156, 166, 167, 168, 169, 170, 178, 179, 184, 188, 192, 193, 198, 220, 221, 222, 223, 224, 232, 233, 238, 242, 246, 247, 252:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 3, 4, 6, 7, 12:
+				expected = "string character"
+				expected = "'\"'"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+
+			case 48, 90:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 157, 201, 211, 255:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 51, 52, 53, 54, 93, 94, 95, 96:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 160, 161, 162, 163, 204, 205, 206, 207, 214, 215, 216, 217, 258, 259, 260, 261:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 56, 98:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 165, 219:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 80, 81, 82, 122, 123, 124:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 189, 190, 191, 243, 244, 245:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 71, 72, 73, 74, 113, 114, 115, 116:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 180, 181, 182, 183, 234, 235, 236, 237:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 76, 77, 78, 118, 119, 120:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 185, 186, 187, 239, 240, 241:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 87, 88, 129, 130:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 196, 197, 250, 251:
+				expected = "NaN"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 62, 63, 64, 65, 66, 67, 68, 104, 105, 106, 107, 108, 109, 110:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 171, 172, 173, 174, 175, 176, 177, 225, 226, 227, 228, 229, 230, 231:
+				expected = "Infinity"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 46:
+				expected = "'/' or '*'"
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 199, 253:
+				expected = "'/' or '*'"
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 155:
+				expected = "'/' or '*'"
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 37, 38, 43, 44, 49, 50, 55, 91, 92, 97:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			case 134, 135, 140, 141, 146, 147, 152, 153, 158, 159, 164, 202, 203, 208, 212, 213, 218, 256, 257, 262:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					cs = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	if pe < len(data) && p >= pe {
+		return pe - 1, stack, "", buffer.documentSizeError(data)
+	}
+	return p, stack, expected, err
+}
+
+func skipStringFast(data []byte) (int, error) {
+	cs, p := 0, 0
+	pe := len(data)
+	eof := len(data)
+	var err error
+
+	const skipStringFast_start int = 1
+	const skipStringFast_first_final int = 6
+	const skipStringFast_error int = 0
+
+	const skipStringFast_en_main int = 1
+
+	{
+		cs = skipStringFast_start
+	}
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		switch cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 6:
+			goto st_case_6
+		case 4:
+			goto st_case_4
+		case 5:
+			goto st_case_5
+		}
+		goto st_out
+	st_case_1:
+		if data[p] == 34 {
+			goto st2
+		}
+		goto tr0
+	tr0:
+		err = ErrInvalidString
+		goto st0
+	st_case_0:
+	st0:
+		cs = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 34:
+			goto st6
+		case 92:
+			goto st4
+		}
+		goto st3
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 34:
+			goto st6
+		case 92:
+			goto st4
+		}
+		goto st3
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		goto st0
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		goto st5
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 34:
+			goto st6
+		case 92:
+			goto st4
+		}
+		goto st3
+	st_out:
+	_test_eof2:
+		cs = 2
+		goto _test_eof
+	_test_eof3:
+		cs = 3
+		goto _test_eof
+	_test_eof6:
+		cs = 6
+		goto _test_eof
+	_test_eof4:
+		cs = 4
+		goto _test_eof
+	_test_eof5:
+		cs = 5
+		goto _test_eof
+
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch cs {
+			case 1, 2, 3, 4, 5:
+				err = ErrInvalidString
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	return p, err
+}
+
+// resumeState is the state a machine keeps between runs on input that arrives in pieces: its cs, top and call stack.
+// StreamReader and PushParser keep one for the value they are reading.
+type resumeState struct {
+	cs, top int
+	stack   []int
+}
+
+const (
+	resumableSkip_start       int = 1
+	resumableSkip_first_final int = 183
+	resumableSkip_error       int = 0
+)
+
+const (
+	resumableSkip_en_skip_array  int = 23
+	resumableSkip_en_skip_object int = 90
+	resumableSkip_en_main        int = 1
+)
+
+// initSkip starts skipValue's machine on a new value.
+func (s *resumeState) initSkip() {
+	{
+		(s.cs) = resumableSkip_start
+		(s.top) = 0
+	}
+}
+
+// skip runs skipValue's machine on data[p:pe]. data must hold the data from earlier runs since initSkip at the same
+// positions. atEOF means no more data will follow pe. The returned bool is true when the value is complete and ends at
+// the returned p. pe must not be in the middle of a number unless atEOF is set. See resumeEnd.
+func (s *resumeState) skip(data []byte, p, pe int, atEOF bool) (int, bool, error) {
+	eof := -1
+	if atEOF {
+		eof = pe
+	}
+	var err error
+	var expected string
+
+	{
+		if p == pe {
+			goto _test_eof
+		}
+		goto _resume
+
+	_again:
+		switch s.cs {
+		case 1:
+			goto st1
+		case 0:
+			goto st0
+		case 2:
+			goto st2
+		case 3:
+			goto st3
+		case 4:
+			goto st4
+		case 183:
+			goto st183
+		case 5:
+			goto st5
+		case 6:
+			goto st6
+		case 7:
+			goto st7
+		case 8:
+			goto st8
+		case 9:
+			goto st9
+		case 10:
+			goto st10
+		case 11:
+			goto st11
+		case 12:
+			goto st12
+		case 184:
+			goto st184
+		case 185:
+			goto st185
+		case 186:
+			goto st186
+		case 187:
+			goto st187
+		case 188:
+			goto st188
+		case 189:
+			goto st189
+		case 13:
+			goto st13
+		case 14:
+			goto st14
+		case 15:
+			goto st15
+		case 16:
+			goto st16
+		case 190:
+			goto st190
+		case 17:
+			goto st17
+		case 18:
+			goto st18
+		case 19:
+			goto st19
+		case 191:
+			goto st191
+		case 20:
+			goto st20
+		case 21:
+			goto st21
+		case 22:
+			goto st22
+		case 192:
+			goto st192
+		case 193:
+			goto st193
+		case 23:
+			goto st23
+		case 24:
+			goto st24
+		case 25:
+			goto st25
+		case 26:
+			goto st26
+		case 27:
+			goto st27
+		case 28:
+			goto st28
+		case 29:
+			goto st29
+		case 30:
+			goto st30
+		case 31:
+			goto st31
+		case 32:
+			goto st32
+		case 33:
+			goto st33
+		case 194:
+			goto st194
+		case 34:
+			goto st34
+		case 35:
+			goto st35
+		case 36:
+			goto st36
+		case 37:
+			goto st37
+		case 38:
+			goto st38
+		case 39:
+			goto st39
+		case 40:
+			goto st40
+		case 41:
+			goto st41
+		case 42:
+			goto st42
+		case 43:
+			goto st43
+		case 44:
+			goto st44
+		case 45:
+			goto st45
+		case 46:
+			goto st46
+		case 47:
+			goto st47
+		case 48:
+			goto st48
+		case 49:
+			goto st49
+		case 50:
+			goto st50
+		case 51:
+			goto st51
+		case 52:
+			goto st52
+		case 53:
+			goto st53
+		case 54:
+			goto st54
+		case 55:
+			goto st55
+		case 56:
+			goto st56
+		case 57:
+			goto st57
+		case 58:
+			goto st58
+		case 59:
+			goto st59
+		case 60:
+			goto st60
+		case 61:
+			goto st61
+		case 62:
+			goto st62
+		case 63:
+			goto st63
+		case 64:
+			goto st64
+		case 65:
+			goto st65
+		case 66:
+			goto st66
+		case 67:
+			goto st67
+		case 68:
+			goto st68
+		case 69:
+			goto st69
+		case 70:
+			goto st70
+		case 71:
+			goto st71
+		case 72:
+			goto st72
+		case 73:
+			goto st73
+		case 74:
+			goto st74
+		case 75:
+			goto st75
+		case 195:
+			goto st195
+		case 76:
+			goto st76
+		case 77:
+			goto st77
+		case 78:
+			goto st78
+		case 79:
+			goto st79
+		case 80:
+			goto st80
+		case 81:
+			goto st81
+		case 82:
+			goto st82
+		case 83:
+			goto st83
+		case 84:
+			goto st84
+		case 85:
+			goto st85
+		case 86:
+			goto st86
+		case 87:
+			goto st87
+		case 88:
+			goto st88
+		case 89:
+			goto st89
+		case 90:
+			goto st90
+		case 91:
+			goto st91
+		case 92:
+			goto st92
+		case 93:
+			goto st93
+		case 94:
+			goto st94
+		case 95:
+			goto st95
+		case 96:
+			goto st96
+		case 97:
+			goto st97
+		case 98:
+			goto st98
+		case 99:
+			goto st99
+		case 100:
+			goto st100
+		case 101:
+			goto st101
+		case 102:
+			goto st102
+		case 103:
+			goto st103
+		case 104:
+			goto st104
+		case 105:
+			goto st105
+		case 106:
+			goto st106
+		case 107:
+			goto st107
+		case 108:
+			goto st108
+		case 109:
+			goto st109
+		case 110:
+			goto st110
+		case 111:
+			goto st111
+		case 112:
+			goto st112
+		case 196:
+			goto st196
+		case 113:
+			goto st113
+		case 114:
+			goto st114
+		case 115:
+			goto st115
+		case 116:
+			goto st116
+		case 117:
+			goto st117
+		case 118:
+			goto st118
+		case 119:
+			goto st119
+		case 120:
+			goto st120
+		case 121:
+			goto st121
+		case 122:
+			goto st122
+		case 123:
+			goto st123
+		case 124:
+			goto st124
+		case 125:
+			goto st125
+		case 126:
+			goto st126
+		case 127:
+			goto st127
+		case 128:
+			goto st128
+		case 129:
+			goto st129
+		case 130:
+			goto st130
+		case 131:
+			goto st131
+		case 132:
+			goto st132
+		case 133:
+			goto st133
+		case 134:
+			goto st134
+		case 135:
+			goto st135
+		case 136:
+			goto st136
+		case 137:
+			goto st137
+		case 138:
+			goto st138
+		case 139:
+			goto st139
+		case 140:
+			goto st140
+		case 141:
+			goto st141
+		case 142:
+			goto st142
+		case 143:
+			goto st143
+		case 144:
+			goto st144
+		case 145:
+			goto st145
+		case 146:
+			goto st146
+		case 147:
+			goto st147
+		case 148:
+			goto st148
+		case 149:
+			goto st149
+		case 150:
+			goto st150
+		case 151:
+			goto st151
+		case 152:
+			goto st152
+		case 153:
+			goto st153
+		case 154:
+			goto st154
+		case 155:
+			goto st155
+		case 156:
+			goto st156
+		case 157:
+			goto st157
+		case 158:
+			goto st158
+		case 159:
+			goto st159
+		case 160:
+			goto st160
+		case 161:
+			goto st161
+		case 162:
+			goto st162
+		case 163:
+			goto st163
+		case 164:
+			goto st164
+		case 165:
+			goto st165
+		case 166:
+			goto st166
+		case 167:
+			goto st167
+		case 168:
+			goto st168
+		case 169:
+			goto st169
+		case 170:
+			goto st170
+		case 171:
+			goto st171
+		case 172:
+			goto st172
+		case 173:
+			goto st173
+		case 174:
+			goto st174
+		case 175:
+			goto st175
+		case 176:
+			goto st176
+		case 177:
+			goto st177
+		case 178:
+			goto st178
+		case 179:
+			goto st179
+		case 180:
+			goto st180
+		case 181:
+			goto st181
+		case 182:
+			goto st182
+		case 197:
+			goto st197
+		}
+
+		if p++; p == pe {
+			goto _test_eof
+		}
+	_resume:
+		switch s.cs {
+		case 1:
+			goto st_case_1
+		case 0:
+			goto st_case_0
+		case 2:
+			goto st_case_2
+		case 3:
+			goto st_case_3
+		case 4:
+			goto st_case_4
+		case 183:
+			goto st_case_183
+		case 5:
+			goto st_case_5
+		case 6:
+			goto st_case_6
+		case 7:
+			goto st_case_7
+		case 8:
+			goto st_case_8
+		case 9:
+			goto st_case_9
+		case 10:
+			goto st_case_10
+		case 11:
+			goto st_case_11
+		case 12:
+			goto st_case_12
+		case 184:
+			goto st_case_184
+		case 185:
+			goto st_case_185
+		case 186:
+			goto st_case_186
+		case 187:
+			goto st_case_187
+		case 188:
+			goto st_case_188
+		case 189:
+			goto st_case_189
+		case 13:
+			goto st_case_13
+		case 14:
+			goto st_case_14
+		case 15:
+			goto st_case_15
+		case 16:
+			goto st_case_16
+		case 190:
+			goto st_case_190
+		case 17:
+			goto st_case_17
+		case 18:
+			goto st_case_18
+		case 19:
+			goto st_case_19
+		case 191:
+			goto st_case_191
+		case 20:
+			goto st_case_20
+		case 21:
+			goto st_case_21
+		case 22:
+			goto st_case_22
+		case 192:
+			goto st_case_192
+		case 193:
+			goto st_case_193
+		case 23:
+			goto st_case_23
+		case 24:
+			goto st_case_24
+		case 25:
+			goto st_case_25
+		case 26:
+			goto st_case_26
+		case 27:
+			goto st_case_27
+		case 28:
+			goto st_case_28
+		case 29:
+			goto st_case_29
+		case 30:
+			goto st_case_30
+		case 31:
+			goto st_case_31
+		case 32:
+			goto st_case_32
+		case 33:
+			goto st_case_33
+		case 194:
+			goto st_case_194
+		case 34:
+			goto st_case_34
+		case 35:
+			goto st_case_35
+		case 36:
+			goto st_case_36
+		case 37:
+			goto st_case_37
+		case 38:
+			goto st_case_38
+		case 39:
+			goto st_case_39
+		case 40:
+			goto st_case_40
+		case 41:
+			goto st_case_41
+		case 42:
+			goto st_case_42
+		case 43:
+			goto st_case_43
+		case 44:
+			goto st_case_44
+		case 45:
+			goto st_case_45
+		case 46:
+			goto st_case_46
+		case 47:
+			goto st_case_47
+		case 48:
+			goto st_case_48
+		case 49:
+			goto st_case_49
+		case 50:
+			goto st_case_50
+		case 51:
+			goto st_case_51
+		case 52:
+			goto st_case_52
+		case 53:
+			goto st_case_53
+		case 54:
+			goto st_case_54
+		case 55:
+			goto st_case_55
+		case 56:
+			goto st_case_56
+		case 57:
+			goto st_case_57
+		case 58:
+			goto st_case_58
+		case 59:
+			goto st_case_59
+		case 60:
+			goto st_case_60
+		case 61:
+			goto st_case_61
+		case 62:
+			goto st_case_62
+		case 63:
+			goto st_case_63
+		case 64:
+			goto st_case_64
+		case 65:
+			goto st_case_65
+		case 66:
+			goto st_case_66
+		case 67:
+			goto st_case_67
+		case 68:
+			goto st_case_68
+		case 69:
+			goto st_case_69
+		case 70:
+			goto st_case_70
+		case 71:
+			goto st_case_71
+		case 72:
+			goto st_case_72
+		case 73:
+			goto st_case_73
+		case 74:
+			goto st_case_74
+		case 75:
+			goto st_case_75
+		case 195:
+			goto st_case_195
+		case 76:
+			goto st_case_76
+		case 77:
+			goto st_case_77
+		case 78:
+			goto st_case_78
+		case 79:
+			goto st_case_79
+		case 80:
+			goto st_case_80
+		case 81:
+			goto st_case_81
+		case 82:
+			goto st_case_82
+		case 83:
+			goto st_case_83
+		case 84:
+			goto st_case_84
+		case 85:
+			goto st_case_85
+		case 86:
+			goto st_case_86
+		case 87:
+			goto st_case_87
+		case 88:
+			goto st_case_88
+		case 89:
+			goto st_case_89
+		case 90:
+			goto st_case_90
+		case 91:
+			goto st_case_91
+		case 92:
+			goto st_case_92
+		case 93:
+			goto st_case_93
+		case 94:
+			goto st_case_94
+		case 95:
+			goto st_case_95
+		case 96:
+			goto st_case_96
+		case 97:
+			goto st_case_97
+		case 98:
+			goto st_case_98
+		case 99:
+			goto st_case_99
+		case 100:
+			goto st_case_100
+		case 101:
+			goto st_case_101
+		case 102:
+			goto st_case_102
+		case 103:
+			goto st_case_103
+		case 104:
+			goto st_case_104
+		case 105:
+			goto st_case_105
+		case 106:
+			goto st_case_106
+		case 107:
+			goto st_case_107
+		case 108:
+			goto st_case_108
+		case 109:
+			goto st_case_109
+		case 110:
+			goto st_case_110
+		case 111:
+			goto st_case_111
+		case 112:
+			goto st_case_112
+		case 196:
+			goto st_case_196
+		case 113:
+			goto st_case_113
+		case 114:
+			goto st_case_114
+		case 115:
+			goto st_case_115
+		case 116:
+			goto st_case_116
+		case 117:
+			goto st_case_117
+		case 118:
+			goto st_case_118
+		case 119:
+			goto st_case_119
+		case 120:
+			goto st_case_120
+		case 121:
+			goto st_case_121
+		case 122:
+			goto st_case_122
+		case 123:
+			goto st_case_123
+		case 124:
+			goto st_case_124
+		case 125:
+			goto st_case_125
+		case 126:
+			goto st_case_126
+		case 127:
+			goto st_case_127
+		case 128:
+			goto st_case_128
+		case 129:
+			goto st_case_129
+		case 130:
+			goto st_case_130
+		case 131:
+			goto st_case_131
+		case 132:
+			goto st_case_132
+		case 133:
+			goto st_case_133
+		case 134:
+			goto st_case_134
+		case 135:
+			goto st_case_135
+		case 136:
+			goto st_case_136
+		case 137:
+			goto st_case_137
+		case 138:
+			goto st_case_138
+		case 139:
+			goto st_case_139
+		case 140:
+			goto st_case_140
+		case 141:
+			goto st_case_141
+		case 142:
+			goto st_case_142
+		case 143:
+			goto st_case_143
+		case 144:
+			goto st_case_144
+		case 145:
+			goto st_case_145
+		case 146:
+			goto st_case_146
+		case 147:
+			goto st_case_147
+		case 148:
+			goto st_case_148
+		case 149:
+			goto st_case_149
+		case 150:
+			goto st_case_150
+		case 151:
+			goto st_case_151
+		case 152:
+			goto st_case_152
+		case 153:
+			goto st_case_153
+		case 154:
+			goto st_case_154
+		case 155:
+			goto st_case_155
+		case 156:
+			goto st_case_156
+		case 157:
+			goto st_case_157
+		case 158:
+			goto st_case_158
+		case 159:
+			goto st_case_159
+		case 160:
+			goto st_case_160
+		case 161:
+			goto st_case_161
+		case 162:
+			goto st_case_162
+		case 163:
+			goto st_case_163
+		case 164:
+			goto st_case_164
+		case 165:
+			goto st_case_165
+		case 166:
+			goto st_case_166
+		case 167:
+			goto st_case_167
+		case 168:
+			goto st_case_168
+		case 169:
+			goto st_case_169
+		case 170:
+			goto st_case_170
+		case 171:
+			goto st_case_171
+		case 172:
+			goto st_case_172
+		case 173:
+			goto st_case_173
+		case 174:
+			goto st_case_174
+		case 175:
+			goto st_case_175
+		case 176:
+			goto st_case_176
+		case 177:
+			goto st_case_177
+		case 178:
+			goto st_case_178
+		case 179:
+			goto st_case_179
+		case 180:
+			goto st_case_180
+		case 181:
+			goto st_case_181
+		case 182:
+			goto st_case_182
+		case 197:
+			goto st_case_197
+		}
+		goto st_out
+	st1:
+		if p++; p == pe {
+			goto _test_eof1
+		}
+	st_case_1:
+		switch data[p] {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto st3
+		case 45:
+			goto st12
+		case 48:
+			goto st184
+		case 91:
+			goto tr6
+		case 102:
+			goto st13
+		case 110:
+			goto st17
+		case 116:
+			goto st20
+		case 123:
+			goto tr10
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st187
+			}
+		case data[p] >= 9:
+			goto st2
+		}
+		goto tr0
+	tr0:
+		expected = "value"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr11:
+		expected = "string character"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr15:
+		expected = "escape sequence"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr18:
+		expected = "hex digit"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr23:
+		expected = "digit"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr24:
+		expected = "false"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr29:
+		expected = "null"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr33:
+		expected = "true"
+
+		err = ErrNoValidToken
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+
+		goto st0
+	tr37:
+		expected = "value or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr49:
+		expected = "string character"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr53:
+		expected = "',' or ']'"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr57:
+		expected = "value"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr71:
+		expected = "escape sequence"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr74:
+		expected = "hex digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr79:
+		expected = "digit"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr83:
+		expected = "false"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr88:
+		expected = "null"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr92:
+		expected = "true"
+		err = ErrInvalidArray
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr115:
+		expected = "string or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr119:
+		expected = "string character"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr123:
+		expected = "':'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr126:
+		expected = "value"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr140:
+		expected = "',' or '}'"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr144:
+		expected = "string"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr165:
+		expected = "escape sequence"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr168:
+		expected = "hex digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr173:
+		expected = "digit"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr177:
+		expected = "false"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr182:
+		expected = "null"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	tr186:
+		expected = "true"
+		err = ErrInvalidObject
+		p--
+		{
+			p++
+			(s.cs) = 0
+			goto _out
+		}
+		goto st0
+	st_case_0:
+	st0:
+		(s.cs) = 0
+		goto _out
+	st2:
+		if p++; p == pe {
+			goto _test_eof2
+		}
+	st_case_2:
+		switch data[p] {
+		case 13:
+			goto st2
+		case 32:
+			goto st2
+		case 34:
+			goto st3
+		case 45:
+			goto st12
+		case 48:
+			goto st184
+		case 91:
+			goto tr6
+		case 102:
+			goto st13
+		case 110:
+			goto st17
+		case 116:
+			goto st20
+		case 123:
+			goto tr10
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st187
+			}
+		case data[p] >= 9:
+			goto st2
+		}
+		goto tr0
+	st3:
+		if p++; p == pe {
+			goto _test_eof3
+		}
+	st_case_3:
+		switch data[p] {
+		case 34:
+			goto st183
+		case 92:
+			goto st5
+		}
+		if data[p] <= 31 {
+			goto tr11
+		}
+		goto st4
+	st4:
+		if p++; p == pe {
+			goto _test_eof4
+		}
+	st_case_4:
+		switch data[p] {
+		case 34:
+			goto st183
+		case 92:
+			goto st5
+		}
+		if data[p] <= 31 {
+			goto tr11
+		}
+		goto st4
+	st183:
+		if p++; p == pe {
+			goto _test_eof183
+		}
+	st_case_183:
+		goto st0
+	st5:
+		if p++; p == pe {
+			goto _test_eof5
+		}
+	st_case_5:
+		switch data[p] {
+		case 34:
+			goto st6
+		case 47:
+			goto st6
+		case 92:
+			goto st6
+		case 98:
+			goto st6
+		case 102:
+			goto st6
+		case 110:
+			goto st6
+		case 114:
+			goto st6
+		case 116:
+			goto st6
+		case 117:
+			goto st7
+		}
+		goto tr15
+	st6:
+		if p++; p == pe {
+			goto _test_eof6
+		}
+	st_case_6:
+		switch data[p] {
+		case 34:
+			goto st183
+		case 92:
+			goto st5
+		}
+		if data[p] <= 31 {
+			goto tr11
+		}
+		goto st4
+	st7:
+		if p++; p == pe {
+			goto _test_eof7
+		}
+	st_case_7:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st8
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st8
+			}
+		default:
+			goto st8
+		}
+		goto tr18
+	st8:
+		if p++; p == pe {
+			goto _test_eof8
+		}
+	st_case_8:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st9
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st9
+			}
+		default:
+			goto st9
+		}
+		goto tr18
+	st9:
+		if p++; p == pe {
+			goto _test_eof9
+		}
+	st_case_9:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st10
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st10
+			}
+		default:
+			goto st10
+		}
+		goto tr18
+	st10:
+		if p++; p == pe {
+			goto _test_eof10
+		}
+	st_case_10:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st11
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st11
+			}
+		default:
+			goto st11
+		}
+		goto tr18
+	st11:
+		if p++; p == pe {
+			goto _test_eof11
+		}
+	st_case_11:
+		switch data[p] {
+		case 34:
+			goto st183
+		case 92:
+			goto st5
+		}
+		if data[p] <= 31 {
+			goto tr11
+		}
+		goto st4
+	st12:
+		if p++; p == pe {
+			goto _test_eof12
+		}
+	st_case_12:
+		if data[p] == 48 {
+			goto st184
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st187
+		}
+		goto tr23
+	st184:
+		if p++; p == pe {
+			goto _test_eof184
+		}
+	st_case_184:
+		switch data[p] {
+		case 46:
+			goto tr222
+		case 69:
+			goto tr223
+		case 101:
+			goto tr223
+		}
+		goto st0
+	tr222:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 185
+				goto _out
+			}
+		}
+
+		goto st185
+	st185:
+		if p++; p == pe {
+			goto _test_eof185
+		}
+	st_case_185:
+		goto st0
+	tr223:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 186
+				goto _out
+			}
+		}
+
+		goto st186
+	st186:
+		if p++; p == pe {
+			goto _test_eof186
+		}
+	st_case_186:
+		goto st0
+	st187:
+		if p++; p == pe {
+			goto _test_eof187
+		}
+	st_case_187:
+		switch data[p] {
+		case 46:
+			goto tr222
+		case 69:
+			goto tr223
+		case 101:
+			goto tr223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st188
+		}
+		goto st0
+	st188:
+		if p++; p == pe {
+			goto _test_eof188
+		}
+	st_case_188:
+		switch data[p] {
+		case 46:
+			goto tr222
+		case 69:
+			goto tr223
+		case 101:
+			goto tr223
+		}
+		if 48 <= data[p] && data[p] <= 57 {
+			goto st188
+		}
+		goto st0
+	tr6:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 189
+				(s.top)++
+				goto st23
+			}
+		}
+		goto st189
+	st189:
+		if p++; p == pe {
+			goto _test_eof189
+		}
+	st_case_189:
+		goto st0
+	st13:
+		if p++; p == pe {
+			goto _test_eof13
+		}
+	st_case_13:
+		if data[p] == 97 {
+			goto st14
+		}
+		goto tr24
+	st14:
+		if p++; p == pe {
+			goto _test_eof14
+		}
+	st_case_14:
+		if data[p] == 108 {
+			goto st15
+		}
+		goto tr24
+	st15:
+		if p++; p == pe {
+			goto _test_eof15
+		}
+	st_case_15:
+		if data[p] == 115 {
+			goto st16
+		}
+		goto tr24
+	st16:
+		if p++; p == pe {
+			goto _test_eof16
+		}
+	st_case_16:
+		if data[p] == 101 {
+			goto st190
+		}
+		goto tr24
+	st190:
+		if p++; p == pe {
+			goto _test_eof190
+		}
+	st_case_190:
+		goto st0
+	st17:
+		if p++; p == pe {
+			goto _test_eof17
+		}
+	st_case_17:
+		if data[p] == 117 {
+			goto st18
+		}
+		goto tr29
+	st18:
+		if p++; p == pe {
+			goto _test_eof18
+		}
+	st_case_18:
+		if data[p] == 108 {
+			goto st19
+		}
+		goto tr29
+	st19:
+		if p++; p == pe {
+			goto _test_eof19
+		}
+	st_case_19:
+		if data[p] == 108 {
+			goto st191
+		}
+		goto tr29
+	st191:
+		if p++; p == pe {
+			goto _test_eof191
+		}
+	st_case_191:
+		goto st0
+	st20:
+		if p++; p == pe {
+			goto _test_eof20
+		}
+	st_case_20:
+		if data[p] == 114 {
+			goto st21
+		}
+		goto tr33
+	st21:
+		if p++; p == pe {
+			goto _test_eof21
+		}
+	st_case_21:
+		if data[p] == 117 {
+			goto st22
+		}
+		goto tr33
+	st22:
+		if p++; p == pe {
+			goto _test_eof22
+		}
+	st_case_22:
+		if data[p] == 101 {
+			goto st192
+		}
+		goto tr33
+	st192:
+		if p++; p == pe {
+			goto _test_eof192
+		}
+	st_case_192:
+		goto st0
+	tr10:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 193
+				(s.top)++
+				goto st90
+			}
+		}
+		goto st193
+	st193:
+		if p++; p == pe {
+			goto _test_eof193
+		}
+	st_case_193:
+		goto st0
+	st23:
+		if p++; p == pe {
+			goto _test_eof23
+		}
+	st_case_23:
+		switch data[p] {
+		case 13:
+			goto st24
+		case 32:
+			goto st24
+		case 34:
+			goto st25
+		case 45:
+			goto st69
+		case 48:
+			goto st70
+		case 91:
+			goto tr43
+		case 93:
+			goto tr44
+		case 102:
+			goto st76
+		case 110:
+			goto st81
+		case 116:
+			goto st85
+		case 123:
+			goto tr48
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st73
+			}
+		case data[p] >= 9:
+			goto st24
+		}
+		goto tr37
+	st24:
+		if p++; p == pe {
+			goto _test_eof24
+		}
+	st_case_24:
+		switch data[p] {
+		case 13:
+			goto st24
+		case 32:
+			goto st24
+		case 34:
+			goto st25
+		case 45:
+			goto st69
+		case 48:
+			goto st70
+		case 91:
+			goto tr43
+		case 93:
+			goto tr44
+		case 102:
+			goto st76
+		case 110:
+			goto st81
+		case 116:
+			goto st85
+		case 123:
+			goto tr48
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st73
+			}
+		case data[p] >= 9:
+			goto st24
+		}
+		goto tr37
+	st25:
+		if p++; p == pe {
+			goto _test_eof25
+		}
+	st_case_25:
+		switch data[p] {
+		case 34:
+			goto st27
+		case 92:
+			goto st62
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st26
+	st26:
+		if p++; p == pe {
+			goto _test_eof26
+		}
+	st_case_26:
+		switch data[p] {
+		case 34:
+			goto st27
+		case 92:
+			goto st62
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st26
+	st27:
+		if p++; p == pe {
+			goto _test_eof27
+		}
+	st_case_27:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st28:
+		if p++; p == pe {
+			goto _test_eof28
+		}
+	st_case_28:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st29:
+		if p++; p == pe {
+			goto _test_eof29
+		}
+	st_case_29:
+		switch data[p] {
+		case 13:
+			goto st30
+		case 32:
+			goto st30
+		case 34:
+			goto st31
+		case 45:
+			goto st41
+		case 48:
+			goto st42
+		case 91:
+			goto tr63
+		case 102:
+			goto st48
+		case 110:
+			goto st53
+		case 116:
+			goto st57
+		case 123:
+			goto tr67
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st45
+			}
+		case data[p] >= 9:
+			goto st30
+		}
+		goto tr57
+	st30:
+		if p++; p == pe {
+			goto _test_eof30
+		}
+	st_case_30:
+		switch data[p] {
+		case 13:
+			goto st30
+		case 32:
+			goto st30
+		case 34:
+			goto st31
+		case 45:
+			goto st41
+		case 48:
+			goto st42
+		case 91:
+			goto tr63
+		case 102:
+			goto st48
+		case 110:
+			goto st53
+		case 116:
+			goto st57
+		case 123:
+			goto tr67
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st45
+			}
+		case data[p] >= 9:
+			goto st30
+		}
+		goto tr57
+	st31:
+		if p++; p == pe {
+			goto _test_eof31
+		}
+	st_case_31:
+		switch data[p] {
+		case 34:
+			goto st33
+		case 92:
+			goto st34
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st32
+	st32:
+		if p++; p == pe {
+			goto _test_eof32
+		}
+	st_case_32:
+		switch data[p] {
+		case 34:
+			goto st33
+		case 92:
+			goto st34
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st32
+	st33:
+		if p++; p == pe {
+			goto _test_eof33
+		}
+	st_case_33:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr56:
+		{
+			(s.top)--
+			(s.cs) = (s.stack)[(s.top)]
+			goto _again
+		}
+		goto st194
+	st194:
+		if p++; p == pe {
+			goto _test_eof194
+		}
+	st_case_194:
+		goto st0
+	st34:
+		if p++; p == pe {
+			goto _test_eof34
+		}
+	st_case_34:
+		switch data[p] {
+		case 34:
+			goto st35
+		case 47:
+			goto st35
+		case 92:
+			goto st35
+		case 98:
+			goto st35
+		case 102:
+			goto st35
+		case 110:
+			goto st35
+		case 114:
+			goto st35
+		case 116:
+			goto st35
+		case 117:
+			goto st36
+		}
+		goto tr71
+	st35:
+		if p++; p == pe {
+			goto _test_eof35
+		}
+	st_case_35:
+		switch data[p] {
+		case 34:
+			goto st33
+		case 92:
+			goto st34
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st32
+	st36:
+		if p++; p == pe {
+			goto _test_eof36
+		}
+	st_case_36:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st37
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st37
+			}
+		default:
+			goto st37
+		}
+		goto tr74
+	st37:
+		if p++; p == pe {
+			goto _test_eof37
+		}
+	st_case_37:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st38
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st38
+			}
+		default:
+			goto st38
+		}
+		goto tr74
+	st38:
+		if p++; p == pe {
+			goto _test_eof38
+		}
+	st_case_38:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st39
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st39
+			}
+		default:
+			goto st39
+		}
+		goto tr74
+	st39:
+		if p++; p == pe {
+			goto _test_eof39
+		}
+	st_case_39:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st40
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st40
+			}
+		default:
+			goto st40
+		}
+		goto tr74
+	st40:
+		if p++; p == pe {
+			goto _test_eof40
+		}
+	st_case_40:
+		switch data[p] {
+		case 34:
+			goto st33
+		case 92:
+			goto st34
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st32
+	st41:
+		if p++; p == pe {
+			goto _test_eof41
+		}
+	st_case_41:
+		if data[p] == 48 {
+			goto st42
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st45
+		}
+		goto tr79
+	st42:
+		if p++; p == pe {
+			goto _test_eof42
+		}
+	st_case_42:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr80
+		case 69:
+			goto tr81
+		case 93:
+			goto tr56
+		case 101:
+			goto tr81
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr80:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 43
+				goto _out
+			}
+		}
+
+		goto st43
+	st43:
+		if p++; p == pe {
+			goto _test_eof43
+		}
+	st_case_43:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr81:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 44
+				goto _out
+			}
+		}
+
+		goto st44
+	st44:
+		if p++; p == pe {
+			goto _test_eof44
+		}
+	st_case_44:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st45:
+		if p++; p == pe {
+			goto _test_eof45
+		}
+	st_case_45:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr80
+		case 69:
+			goto tr81
+		case 93:
+			goto tr56
+		case 101:
+			goto tr81
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st46
+			}
+		case data[p] >= 9:
+			goto st28
+		}
+		goto tr53
+	st46:
+		if p++; p == pe {
+			goto _test_eof46
+		}
+	st_case_46:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr80
+		case 69:
+			goto tr81
+		case 93:
+			goto tr56
+		case 101:
+			goto tr81
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st46
+			}
+		case data[p] >= 9:
+			goto st28
+		}
+		goto tr53
+	tr63:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 47
+				(s.top)++
+				goto st23
+			}
+		}
+		goto st47
+	st47:
+		if p++; p == pe {
+			goto _test_eof47
+		}
+	st_case_47:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st48:
+		if p++; p == pe {
+			goto _test_eof48
+		}
+	st_case_48:
+		if data[p] == 97 {
+			goto st49
+		}
+		goto tr83
+	st49:
+		if p++; p == pe {
+			goto _test_eof49
+		}
+	st_case_49:
+		if data[p] == 108 {
+			goto st50
+		}
+		goto tr83
+	st50:
+		if p++; p == pe {
+			goto _test_eof50
+		}
+	st_case_50:
+		if data[p] == 115 {
+			goto st51
+		}
+		goto tr83
+	st51:
+		if p++; p == pe {
+			goto _test_eof51
+		}
+	st_case_51:
+		if data[p] == 101 {
+			goto st52
+		}
+		goto tr83
+	st52:
+		if p++; p == pe {
+			goto _test_eof52
+		}
+	st_case_52:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st53:
+		if p++; p == pe {
+			goto _test_eof53
+		}
+	st_case_53:
+		if data[p] == 117 {
+			goto st54
+		}
+		goto tr88
+	st54:
+		if p++; p == pe {
+			goto _test_eof54
+		}
+	st_case_54:
+		if data[p] == 108 {
+			goto st55
+		}
+		goto tr88
+	st55:
+		if p++; p == pe {
+			goto _test_eof55
+		}
+	st_case_55:
+		if data[p] == 108 {
+			goto st56
+		}
+		goto tr88
+	st56:
+		if p++; p == pe {
+			goto _test_eof56
+		}
+	st_case_56:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st57:
+		if p++; p == pe {
+			goto _test_eof57
+		}
+	st_case_57:
+		if data[p] == 114 {
+			goto st58
+		}
+		goto tr92
+	st58:
+		if p++; p == pe {
+			goto _test_eof58
+		}
+	st_case_58:
+		if data[p] == 117 {
+			goto st59
+		}
+		goto tr92
+	st59:
+		if p++; p == pe {
+			goto _test_eof59
+		}
+	st_case_59:
+		if data[p] == 101 {
+			goto st60
+		}
+		goto tr92
+	st60:
+		if p++; p == pe {
+			goto _test_eof60
+		}
+	st_case_60:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr67:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 61
+				(s.top)++
+				goto st90
+			}
+		}
+		goto st61
+	st61:
+		if p++; p == pe {
+			goto _test_eof61
+		}
+	st_case_61:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st62:
+		if p++; p == pe {
+			goto _test_eof62
+		}
+	st_case_62:
+		switch data[p] {
+		case 34:
+			goto st63
+		case 47:
+			goto st63
+		case 92:
+			goto st63
+		case 98:
+			goto st63
+		case 102:
+			goto st63
+		case 110:
+			goto st63
+		case 114:
+			goto st63
+		case 116:
+			goto st63
+		case 117:
+			goto st64
+		}
+		goto tr71
+	st63:
+		if p++; p == pe {
+			goto _test_eof63
+		}
+	st_case_63:
+		switch data[p] {
+		case 34:
+			goto st27
+		case 92:
+			goto st62
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st26
+	st64:
+		if p++; p == pe {
+			goto _test_eof64
+		}
+	st_case_64:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st65
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st65
+			}
+		default:
+			goto st65
+		}
+		goto tr74
+	st65:
+		if p++; p == pe {
+			goto _test_eof65
+		}
+	st_case_65:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st66
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st66
+			}
+		default:
+			goto st66
+		}
+		goto tr74
+	st66:
+		if p++; p == pe {
+			goto _test_eof66
+		}
+	st_case_66:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st67
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st67
+			}
+		default:
+			goto st67
+		}
+		goto tr74
+	st67:
+		if p++; p == pe {
+			goto _test_eof67
+		}
+	st_case_67:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st68
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st68
+			}
+		default:
+			goto st68
+		}
+		goto tr74
+	st68:
+		if p++; p == pe {
+			goto _test_eof68
+		}
+	st_case_68:
+		switch data[p] {
+		case 34:
+			goto st27
+		case 92:
+			goto st62
+		}
+		if data[p] <= 31 {
+			goto tr49
+		}
+		goto st26
+	st69:
+		if p++; p == pe {
+			goto _test_eof69
+		}
+	st_case_69:
+		if data[p] == 48 {
+			goto st70
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st73
+		}
+		goto tr79
+	st70:
+		if p++; p == pe {
+			goto _test_eof70
+		}
+	st_case_70:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr102
+		case 69:
+			goto tr103
+		case 93:
+			goto tr56
+		case 101:
+			goto tr103
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr102:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 71
+				goto _out
+			}
+		}
+
+		goto st71
+	st71:
+		if p++; p == pe {
+			goto _test_eof71
+		}
+	st_case_71:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr103:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 72
+				goto _out
+			}
+		}
+
+		goto st72
+	st72:
+		if p++; p == pe {
+			goto _test_eof72
+		}
+	st_case_72:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st73:
+		if p++; p == pe {
+			goto _test_eof73
+		}
+	st_case_73:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr102
+		case 69:
+			goto tr103
+		case 93:
+			goto tr56
+		case 101:
+			goto tr103
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st74
+			}
+		case data[p] >= 9:
+			goto st28
+		}
+		goto tr53
+	st74:
+		if p++; p == pe {
+			goto _test_eof74
+		}
+	st_case_74:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 46:
+			goto tr102
+		case 69:
+			goto tr103
+		case 93:
+			goto tr56
+		case 101:
+			goto tr103
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st74
+			}
+		case data[p] >= 9:
+			goto st28
+		}
+		goto tr53
+	tr43:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 75
+				(s.top)++
+				goto st23
+			}
+		}
+		goto st75
+	st75:
+		if p++; p == pe {
+			goto _test_eof75
+		}
+	st_case_75:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr44:
+		{
+			(s.top)--
+			(s.cs) = (s.stack)[(s.top)]
+			goto _again
+		}
+		goto st195
+	st195:
+		if p++; p == pe {
+			goto _test_eof195
+		}
+	st_case_195:
+		goto st0
+	st76:
+		if p++; p == pe {
+			goto _test_eof76
+		}
+	st_case_76:
+		if data[p] == 97 {
+			goto st77
+		}
+		goto tr83
+	st77:
+		if p++; p == pe {
+			goto _test_eof77
+		}
+	st_case_77:
+		if data[p] == 108 {
+			goto st78
+		}
+		goto tr83
+	st78:
+		if p++; p == pe {
+			goto _test_eof78
+		}
+	st_case_78:
+		if data[p] == 115 {
+			goto st79
+		}
+		goto tr83
+	st79:
+		if p++; p == pe {
+			goto _test_eof79
+		}
+	st_case_79:
+		if data[p] == 101 {
+			goto st80
+		}
+		goto tr83
+	st80:
+		if p++; p == pe {
+			goto _test_eof80
+		}
+	st_case_80:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st81:
+		if p++; p == pe {
+			goto _test_eof81
+		}
+	st_case_81:
+		if data[p] == 117 {
+			goto st82
+		}
+		goto tr88
+	st82:
+		if p++; p == pe {
+			goto _test_eof82
+		}
+	st_case_82:
+		if data[p] == 108 {
+			goto st83
+		}
+		goto tr88
+	st83:
+		if p++; p == pe {
+			goto _test_eof83
+		}
+	st_case_83:
+		if data[p] == 108 {
+			goto st84
+		}
+		goto tr88
+	st84:
+		if p++; p == pe {
+			goto _test_eof84
+		}
+	st_case_84:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st85:
+		if p++; p == pe {
+			goto _test_eof85
+		}
+	st_case_85:
+		if data[p] == 114 {
+			goto st86
+		}
+		goto tr92
+	st86:
+		if p++; p == pe {
+			goto _test_eof86
+		}
+	st_case_86:
+		if data[p] == 117 {
+			goto st87
+		}
+		goto tr92
+	st87:
+		if p++; p == pe {
+			goto _test_eof87
+		}
+	st_case_87:
+		if data[p] == 101 {
+			goto st88
+		}
+		goto tr92
+	st88:
+		if p++; p == pe {
+			goto _test_eof88
+		}
+	st_case_88:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	tr48:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 89
+				(s.top)++
+				goto st90
+			}
+		}
+		goto st89
+	st89:
+		if p++; p == pe {
+			goto _test_eof89
+		}
+	st_case_89:
+		switch data[p] {
+		case 13:
+			goto st28
+		case 32:
+			goto st28
+		case 44:
+			goto st29
+		case 93:
+			goto tr56
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st28
+		}
+		goto tr53
+	st90:
+		if p++; p == pe {
+			goto _test_eof90
+		}
+	st_case_90:
+		switch data[p] {
+		case 13:
+			goto st91
+		case 32:
+			goto st91
+		case 34:
+			goto st92
+		case 125:
+			goto tr118
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st91
+		}
+		goto tr115
+	st91:
+		if p++; p == pe {
+			goto _test_eof91
+		}
+	st_case_91:
+		switch data[p] {
+		case 13:
+			goto st91
+		case 32:
+			goto st91
+		case 34:
+			goto st92
+		case 125:
+			goto tr118
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st91
+		}
+		goto tr115
+	st92:
+		if p++; p == pe {
+			goto _test_eof92
+		}
+	st_case_92:
+		switch data[p] {
+		case 34:
+			goto st94
+		case 92:
+			goto st176
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st93
+	st93:
+		if p++; p == pe {
+			goto _test_eof93
+		}
+	st_case_93:
+		switch data[p] {
+		case 34:
+			goto st94
+		case 92:
+			goto st176
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st93
+	st94:
+		if p++; p == pe {
+			goto _test_eof94
+		}
+	st_case_94:
+		switch data[p] {
+		case 13:
+			goto st95
+		case 32:
+			goto st95
+		case 58:
+			goto st96
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st95
+		}
+		goto tr123
+	st95:
+		if p++; p == pe {
+			goto _test_eof95
+		}
+	st_case_95:
+		switch data[p] {
+		case 13:
+			goto st95
+		case 32:
+			goto st95
+		case 58:
+			goto st96
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st95
+		}
+		goto tr123
+	st96:
+		if p++; p == pe {
+			goto _test_eof96
+		}
+	st_case_96:
+		switch data[p] {
+		case 13:
+			goto st97
+		case 32:
+			goto st97
+		case 34:
+			goto st98
+		case 45:
+			goto st155
+		case 48:
+			goto st156
+		case 91:
+			goto tr132
+		case 102:
+			goto st162
+		case 110:
+			goto st167
+		case 116:
+			goto st171
+		case 123:
+			goto tr136
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st159
+			}
+		case data[p] >= 9:
+			goto st97
+		}
+		goto tr126
+	st97:
+		if p++; p == pe {
+			goto _test_eof97
+		}
+	st_case_97:
+		switch data[p] {
+		case 13:
+			goto st97
+		case 32:
+			goto st97
+		case 34:
+			goto st98
+		case 45:
+			goto st155
+		case 48:
+			goto st156
+		case 91:
+			goto tr132
+		case 102:
+			goto st162
+		case 110:
+			goto st167
+		case 116:
+			goto st171
+		case 123:
+			goto tr136
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st159
+			}
+		case data[p] >= 9:
+			goto st97
+		}
+		goto tr126
+	st98:
+		if p++; p == pe {
+			goto _test_eof98
+		}
+	st_case_98:
+		switch data[p] {
+		case 34:
+			goto st100
+		case 92:
+			goto st148
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st99
+	st99:
+		if p++; p == pe {
+			goto _test_eof99
+		}
+	st_case_99:
+		switch data[p] {
+		case 34:
+			goto st100
+		case 92:
+			goto st148
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st99
+	st100:
+		if p++; p == pe {
+			goto _test_eof100
+		}
+	st_case_100:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st101:
+		if p++; p == pe {
+			goto _test_eof101
+		}
+	st_case_101:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st102:
+		if p++; p == pe {
+			goto _test_eof102
+		}
+	st_case_102:
+		switch data[p] {
+		case 13:
+			goto st103
+		case 32:
+			goto st103
+		case 34:
+			goto st104
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st103
+		}
+		goto tr144
+	st103:
+		if p++; p == pe {
+			goto _test_eof103
+		}
+	st_case_103:
+		switch data[p] {
+		case 13:
+			goto st103
+		case 32:
+			goto st103
+		case 34:
+			goto st104
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st103
+		}
+		goto tr144
+	st104:
+		if p++; p == pe {
+			goto _test_eof104
+		}
+	st_case_104:
+		switch data[p] {
+		case 34:
+			goto st106
+		case 92:
+			goto st141
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st105
+	st105:
+		if p++; p == pe {
+			goto _test_eof105
+		}
+	st_case_105:
+		switch data[p] {
+		case 34:
+			goto st106
+		case 92:
+			goto st141
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st105
+	st106:
+		if p++; p == pe {
+			goto _test_eof106
+		}
+	st_case_106:
+		switch data[p] {
+		case 13:
+			goto st107
+		case 32:
+			goto st107
+		case 58:
+			goto st108
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st107
+		}
+		goto tr123
+	st107:
+		if p++; p == pe {
+			goto _test_eof107
+		}
+	st_case_107:
+		switch data[p] {
+		case 13:
+			goto st107
+		case 32:
+			goto st107
+		case 58:
+			goto st108
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st107
+		}
+		goto tr123
+	st108:
+		if p++; p == pe {
+			goto _test_eof108
+		}
+	st_case_108:
+		switch data[p] {
+		case 13:
+			goto st109
+		case 32:
+			goto st109
+		case 34:
+			goto st110
+		case 45:
+			goto st120
+		case 48:
+			goto st121
+		case 91:
+			goto tr157
+		case 102:
+			goto st127
+		case 110:
+			goto st132
+		case 116:
+			goto st136
+		case 123:
+			goto tr161
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st124
+			}
+		case data[p] >= 9:
+			goto st109
+		}
+		goto tr126
+	st109:
+		if p++; p == pe {
+			goto _test_eof109
+		}
+	st_case_109:
+		switch data[p] {
+		case 13:
+			goto st109
+		case 32:
+			goto st109
+		case 34:
+			goto st110
+		case 45:
+			goto st120
+		case 48:
+			goto st121
+		case 91:
+			goto tr157
+		case 102:
+			goto st127
+		case 110:
+			goto st132
+		case 116:
+			goto st136
+		case 123:
+			goto tr161
+		}
+		switch {
+		case data[p] > 10:
+			if 49 <= data[p] && data[p] <= 57 {
+				goto st124
+			}
+		case data[p] >= 9:
+			goto st109
+		}
+		goto tr126
+	st110:
+		if p++; p == pe {
+			goto _test_eof110
+		}
+	st_case_110:
+		switch data[p] {
+		case 34:
+			goto st112
+		case 92:
+			goto st113
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st111
+	st111:
+		if p++; p == pe {
+			goto _test_eof111
+		}
+	st_case_111:
+		switch data[p] {
+		case 34:
+			goto st112
+		case 92:
+			goto st113
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st111
+	st112:
+		if p++; p == pe {
+			goto _test_eof112
+		}
+	st_case_112:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr143:
+		{
+			(s.top)--
+			(s.cs) = (s.stack)[(s.top)]
+			goto _again
+		}
+		goto st196
+	st196:
+		if p++; p == pe {
+			goto _test_eof196
+		}
+	st_case_196:
+		goto st0
+	st113:
+		if p++; p == pe {
+			goto _test_eof113
+		}
+	st_case_113:
+		switch data[p] {
+		case 34:
+			goto st114
+		case 47:
+			goto st114
+		case 92:
+			goto st114
+		case 98:
+			goto st114
+		case 102:
+			goto st114
+		case 110:
+			goto st114
+		case 114:
+			goto st114
+		case 116:
+			goto st114
+		case 117:
+			goto st115
+		}
+		goto tr165
+	st114:
+		if p++; p == pe {
+			goto _test_eof114
+		}
+	st_case_114:
+		switch data[p] {
+		case 34:
+			goto st112
+		case 92:
+			goto st113
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st111
+	st115:
+		if p++; p == pe {
+			goto _test_eof115
+		}
+	st_case_115:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st116
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st116
+			}
+		default:
+			goto st116
+		}
+		goto tr168
+	st116:
+		if p++; p == pe {
+			goto _test_eof116
+		}
+	st_case_116:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st117
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st117
+			}
+		default:
+			goto st117
+		}
+		goto tr168
+	st117:
+		if p++; p == pe {
+			goto _test_eof117
+		}
+	st_case_117:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st118
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st118
+			}
+		default:
+			goto st118
+		}
+		goto tr168
+	st118:
+		if p++; p == pe {
+			goto _test_eof118
+		}
+	st_case_118:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st119
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st119
+			}
+		default:
+			goto st119
+		}
+		goto tr168
+	st119:
+		if p++; p == pe {
+			goto _test_eof119
+		}
+	st_case_119:
+		switch data[p] {
+		case 34:
+			goto st112
+		case 92:
+			goto st113
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st111
+	st120:
+		if p++; p == pe {
+			goto _test_eof120
+		}
+	st_case_120:
+		if data[p] == 48 {
+			goto st121
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st124
+		}
+		goto tr173
+	st121:
+		if p++; p == pe {
+			goto _test_eof121
+		}
+	st_case_121:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr174
+		case 69:
+			goto tr175
+		case 101:
+			goto tr175
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr174:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 122
+				goto _out
+			}
+		}
+
+		goto st122
+	st122:
+		if p++; p == pe {
+			goto _test_eof122
+		}
+	st_case_122:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr175:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 123
+				goto _out
+			}
+		}
+
+		goto st123
+	st123:
+		if p++; p == pe {
+			goto _test_eof123
+		}
+	st_case_123:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st124:
+		if p++; p == pe {
+			goto _test_eof124
+		}
+	st_case_124:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr174
+		case 69:
+			goto tr175
+		case 101:
+			goto tr175
+		case 125:
+			goto tr143
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st125
+			}
+		case data[p] >= 9:
+			goto st101
+		}
+		goto tr140
+	st125:
+		if p++; p == pe {
+			goto _test_eof125
+		}
+	st_case_125:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr174
+		case 69:
+			goto tr175
+		case 101:
+			goto tr175
+		case 125:
+			goto tr143
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st125
+			}
+		case data[p] >= 9:
+			goto st101
+		}
+		goto tr140
+	tr157:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 126
+				(s.top)++
+				goto st23
+			}
+		}
+		goto st126
+	st126:
+		if p++; p == pe {
+			goto _test_eof126
+		}
+	st_case_126:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st127:
+		if p++; p == pe {
+			goto _test_eof127
+		}
+	st_case_127:
+		if data[p] == 97 {
+			goto st128
+		}
+		goto tr177
+	st128:
+		if p++; p == pe {
+			goto _test_eof128
+		}
+	st_case_128:
+		if data[p] == 108 {
+			goto st129
+		}
+		goto tr177
+	st129:
+		if p++; p == pe {
+			goto _test_eof129
+		}
+	st_case_129:
+		if data[p] == 115 {
+			goto st130
+		}
+		goto tr177
+	st130:
+		if p++; p == pe {
+			goto _test_eof130
+		}
+	st_case_130:
+		if data[p] == 101 {
+			goto st131
+		}
+		goto tr177
+	st131:
+		if p++; p == pe {
+			goto _test_eof131
+		}
+	st_case_131:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st132:
+		if p++; p == pe {
+			goto _test_eof132
+		}
+	st_case_132:
+		if data[p] == 117 {
+			goto st133
+		}
+		goto tr182
+	st133:
+		if p++; p == pe {
+			goto _test_eof133
+		}
+	st_case_133:
+		if data[p] == 108 {
+			goto st134
+		}
+		goto tr182
+	st134:
+		if p++; p == pe {
+			goto _test_eof134
+		}
+	st_case_134:
+		if data[p] == 108 {
+			goto st135
+		}
+		goto tr182
+	st135:
+		if p++; p == pe {
+			goto _test_eof135
+		}
+	st_case_135:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st136:
+		if p++; p == pe {
+			goto _test_eof136
+		}
+	st_case_136:
+		if data[p] == 114 {
+			goto st137
+		}
+		goto tr186
+	st137:
+		if p++; p == pe {
+			goto _test_eof137
+		}
+	st_case_137:
+		if data[p] == 117 {
+			goto st138
+		}
+		goto tr186
+	st138:
+		if p++; p == pe {
+			goto _test_eof138
+		}
+	st_case_138:
+		if data[p] == 101 {
+			goto st139
+		}
+		goto tr186
+	st139:
+		if p++; p == pe {
+			goto _test_eof139
+		}
+	st_case_139:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr161:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 140
+				(s.top)++
+				goto st90
+			}
+		}
+		goto st140
+	st140:
+		if p++; p == pe {
+			goto _test_eof140
+		}
+	st_case_140:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st141:
+		if p++; p == pe {
+			goto _test_eof141
+		}
+	st_case_141:
+		switch data[p] {
+		case 34:
+			goto st142
+		case 47:
+			goto st142
+		case 92:
+			goto st142
+		case 98:
+			goto st142
+		case 102:
+			goto st142
+		case 110:
+			goto st142
+		case 114:
+			goto st142
+		case 116:
+			goto st142
+		case 117:
+			goto st143
+		}
+		goto tr165
+	st142:
+		if p++; p == pe {
+			goto _test_eof142
+		}
+	st_case_142:
+		switch data[p] {
+		case 34:
+			goto st106
+		case 92:
+			goto st141
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st105
+	st143:
+		if p++; p == pe {
+			goto _test_eof143
+		}
+	st_case_143:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st144
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st144
+			}
+		default:
+			goto st144
+		}
+		goto tr168
+	st144:
+		if p++; p == pe {
+			goto _test_eof144
+		}
+	st_case_144:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st145
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st145
+			}
+		default:
+			goto st145
+		}
+		goto tr168
+	st145:
+		if p++; p == pe {
+			goto _test_eof145
+		}
+	st_case_145:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st146
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st146
+			}
+		default:
+			goto st146
+		}
+		goto tr168
+	st146:
+		if p++; p == pe {
+			goto _test_eof146
+		}
+	st_case_146:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st147
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st147
+			}
+		default:
+			goto st147
+		}
+		goto tr168
+	st147:
+		if p++; p == pe {
+			goto _test_eof147
+		}
+	st_case_147:
+		switch data[p] {
+		case 34:
+			goto st106
+		case 92:
+			goto st141
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st105
+	st148:
+		if p++; p == pe {
+			goto _test_eof148
+		}
+	st_case_148:
+		switch data[p] {
+		case 34:
+			goto st149
+		case 47:
+			goto st149
+		case 92:
+			goto st149
+		case 98:
+			goto st149
+		case 102:
+			goto st149
+		case 110:
+			goto st149
+		case 114:
+			goto st149
+		case 116:
+			goto st149
+		case 117:
+			goto st150
+		}
+		goto tr165
+	st149:
+		if p++; p == pe {
+			goto _test_eof149
+		}
+	st_case_149:
+		switch data[p] {
+		case 34:
+			goto st100
+		case 92:
+			goto st148
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st99
+	st150:
+		if p++; p == pe {
+			goto _test_eof150
+		}
+	st_case_150:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st151
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st151
+			}
+		default:
+			goto st151
+		}
+		goto tr168
+	st151:
+		if p++; p == pe {
+			goto _test_eof151
+		}
+	st_case_151:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st152
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st152
+			}
+		default:
+			goto st152
+		}
+		goto tr168
+	st152:
+		if p++; p == pe {
+			goto _test_eof152
+		}
+	st_case_152:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st153
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st153
+			}
+		default:
+			goto st153
+		}
+		goto tr168
+	st153:
+		if p++; p == pe {
+			goto _test_eof153
+		}
+	st_case_153:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st154
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st154
+			}
+		default:
+			goto st154
+		}
+		goto tr168
+	st154:
+		if p++; p == pe {
+			goto _test_eof154
+		}
+	st_case_154:
+		switch data[p] {
+		case 34:
+			goto st100
+		case 92:
+			goto st148
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st99
+	st155:
+		if p++; p == pe {
+			goto _test_eof155
+		}
+	st_case_155:
+		if data[p] == 48 {
+			goto st156
+		}
+		if 49 <= data[p] && data[p] <= 57 {
+			goto st159
+		}
+		goto tr173
+	st156:
+		if p++; p == pe {
+			goto _test_eof156
+		}
+	st_case_156:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 101:
+			goto tr203
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr202:
+
+		p, err = skipFloatDec(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 157
+				goto _out
+			}
+		}
+
+		goto st157
+	st157:
+		if p++; p == pe {
+			goto _test_eof157
+		}
+	st_case_157:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr203:
+
+		p, err = skipFloatExp(data, p+1, pe)
+		if err != nil {
+			expected = "digit"
+			{
+				p++
+				(s.cs) = 158
+				goto _out
+			}
+		}
+
+		goto st158
+	st158:
+		if p++; p == pe {
+			goto _test_eof158
+		}
+	st_case_158:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st159:
+		if p++; p == pe {
+			goto _test_eof159
+		}
+	st_case_159:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 101:
+			goto tr203
+		case 125:
+			goto tr143
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st160
+			}
+		case data[p] >= 9:
+			goto st101
+		}
+		goto tr140
+	st160:
+		if p++; p == pe {
+			goto _test_eof160
+		}
+	st_case_160:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 46:
+			goto tr202
+		case 69:
+			goto tr203
+		case 101:
+			goto tr203
+		case 125:
+			goto tr143
+		}
+		switch {
+		case data[p] > 10:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st160
+			}
+		case data[p] >= 9:
+			goto st101
+		}
+		goto tr140
+	tr132:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 161
+				(s.top)++
+				goto st23
+			}
+		}
+		goto st161
+	st161:
+		if p++; p == pe {
+			goto _test_eof161
+		}
+	st_case_161:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st162:
+		if p++; p == pe {
+			goto _test_eof162
+		}
+	st_case_162:
+		if data[p] == 97 {
+			goto st163
+		}
+		goto tr177
+	st163:
+		if p++; p == pe {
+			goto _test_eof163
+		}
+	st_case_163:
+		if data[p] == 108 {
+			goto st164
+		}
+		goto tr177
+	st164:
+		if p++; p == pe {
+			goto _test_eof164
+		}
+	st_case_164:
+		if data[p] == 115 {
+			goto st165
+		}
+		goto tr177
+	st165:
+		if p++; p == pe {
+			goto _test_eof165
+		}
+	st_case_165:
+		if data[p] == 101 {
+			goto st166
+		}
+		goto tr177
+	st166:
+		if p++; p == pe {
+			goto _test_eof166
+		}
+	st_case_166:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st167:
+		if p++; p == pe {
+			goto _test_eof167
+		}
+	st_case_167:
+		if data[p] == 117 {
+			goto st168
+		}
+		goto tr182
+	st168:
+		if p++; p == pe {
+			goto _test_eof168
+		}
+	st_case_168:
+		if data[p] == 108 {
+			goto st169
+		}
+		goto tr182
+	st169:
+		if p++; p == pe {
+			goto _test_eof169
+		}
+	st_case_169:
+		if data[p] == 108 {
+			goto st170
+		}
+		goto tr182
+	st170:
+		if p++; p == pe {
+			goto _test_eof170
+		}
+	st_case_170:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st171:
+		if p++; p == pe {
+			goto _test_eof171
+		}
+	st_case_171:
+		if data[p] == 114 {
+			goto st172
+		}
+		goto tr186
+	st172:
+		if p++; p == pe {
+			goto _test_eof172
+		}
+	st_case_172:
+		if data[p] == 117 {
+			goto st173
+		}
+		goto tr186
+	st173:
+		if p++; p == pe {
+			goto _test_eof173
+		}
+	st_case_173:
+		if data[p] == 101 {
+			goto st174
+		}
+		goto tr186
+	st174:
+		if p++; p == pe {
+			goto _test_eof174
+		}
+	st_case_174:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	tr136:
+		{
+			if s.top == skipMaxDepth {
+				err = ErrMaxDepth
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+			if s.top+1 >= len(s.stack) {
+				s.stack = append(s.stack, make([]int, 1+s.top-len(s.stack))...)
+			}
+			{
+				(s.stack)[(s.top)] = 175
+				(s.top)++
+				goto st90
+			}
+		}
+		goto st175
+	st175:
+		if p++; p == pe {
+			goto _test_eof175
+		}
+	st_case_175:
+		switch data[p] {
+		case 13:
+			goto st101
+		case 32:
+			goto st101
+		case 44:
+			goto st102
+		case 125:
+			goto tr143
+		}
+		if 9 <= data[p] && data[p] <= 10 {
+			goto st101
+		}
+		goto tr140
+	st176:
+		if p++; p == pe {
+			goto _test_eof176
+		}
+	st_case_176:
+		switch data[p] {
+		case 34:
+			goto st177
+		case 47:
+			goto st177
+		case 92:
+			goto st177
+		case 98:
+			goto st177
+		case 102:
+			goto st177
+		case 110:
+			goto st177
+		case 114:
+			goto st177
+		case 116:
+			goto st177
+		case 117:
+			goto st178
+		}
+		goto tr165
+	st177:
+		if p++; p == pe {
+			goto _test_eof177
+		}
+	st_case_177:
+		switch data[p] {
+		case 34:
+			goto st94
+		case 92:
+			goto st176
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st93
+	st178:
+		if p++; p == pe {
+			goto _test_eof178
+		}
+	st_case_178:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st179
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st179
+			}
+		default:
+			goto st179
+		}
+		goto tr168
+	st179:
+		if p++; p == pe {
+			goto _test_eof179
+		}
+	st_case_179:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st180
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st180
+			}
+		default:
+			goto st180
+		}
+		goto tr168
+	st180:
+		if p++; p == pe {
+			goto _test_eof180
+		}
+	st_case_180:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st181
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st181
+			}
+		default:
+			goto st181
+		}
+		goto tr168
+	st181:
+		if p++; p == pe {
+			goto _test_eof181
+		}
+	st_case_181:
+		switch {
+		case data[p] < 65:
+			if 48 <= data[p] && data[p] <= 57 {
+				goto st182
+			}
+		case data[p] > 70:
+			if 97 <= data[p] && data[p] <= 102 {
+				goto st182
+			}
+		default:
+			goto st182
+		}
+		goto tr168
+	st182:
+		if p++; p == pe {
+			goto _test_eof182
+		}
+	st_case_182:
+		switch data[p] {
+		case 34:
+			goto st94
+		case 92:
+			goto st176
+		}
+		if data[p] <= 31 {
+			goto tr119
+		}
+		goto st93
+	tr118:
+		{
+			(s.top)--
+			(s.cs) = (s.stack)[(s.top)]
+			goto _again
+		}
+		goto st197
+	st197:
+		if p++; p == pe {
+			goto _test_eof197
+		}
+	st_case_197:
+		goto st0
+	st_out:
+	_test_eof1:
+		(s.cs) = 1
+		goto _test_eof
+	_test_eof2:
+		(s.cs) = 2
+		goto _test_eof
+	_test_eof3:
+		(s.cs) = 3
+		goto _test_eof
+	_test_eof4:
+		(s.cs) = 4
+		goto _test_eof
+	_test_eof183:
+		(s.cs) = 183
+		goto _test_eof
+	_test_eof5:
+		(s.cs) = 5
+		goto _test_eof
+	_test_eof6:
+		(s.cs) = 6
+		goto _test_eof
+	_test_eof7:
+		(s.cs) = 7
+		goto _test_eof
+	_test_eof8:
+		(s.cs) = 8
+		goto _test_eof
+	_test_eof9:
+		(s.cs) = 9
+		goto _test_eof
+	_test_eof10:
+		(s.cs) = 10
+		goto _test_eof
+	_test_eof11:
+		(s.cs) = 11
+		goto _test_eof
+	_test_eof12:
+		(s.cs) = 12
+		goto _test_eof
+	_test_eof184:
+		(s.cs) = 184
+		goto _test_eof
+	_test_eof185:
+		(s.cs) = 185
+		goto _test_eof
+	_test_eof186:
+		(s.cs) = 186
+		goto _test_eof
+	_test_eof187:
+		(s.cs) = 187
+		goto _test_eof
+	_test_eof188:
+		(s.cs) = 188
+		goto _test_eof
+	_test_eof189:
+		(s.cs) = 189
+		goto _test_eof
+	_test_eof13:
+		(s.cs) = 13
+		goto _test_eof
+	_test_eof14:
+		(s.cs) = 14
+		goto _test_eof
+	_test_eof15:
+		(s.cs) = 15
+		goto _test_eof
+	_test_eof16:
+		(s.cs) = 16
+		goto _test_eof
+	_test_eof190:
+		(s.cs) = 190
+		goto _test_eof
+	_test_eof17:
+		(s.cs) = 17
+		goto _test_eof
+	_test_eof18:
+		(s.cs) = 18
+		goto _test_eof
+	_test_eof19:
+		(s.cs) = 19
+		goto _test_eof
+	_test_eof191:
+		(s.cs) = 191
+		goto _test_eof
+	_test_eof20:
+		(s.cs) = 20
+		goto _test_eof
+	_test_eof21:
+		(s.cs) = 21
+		goto _test_eof
+	_test_eof22:
+		(s.cs) = 22
+		goto _test_eof
+	_test_eof192:
+		(s.cs) = 192
+		goto _test_eof
+	_test_eof193:
+		(s.cs) = 193
+		goto _test_eof
+	_test_eof23:
+		(s.cs) = 23
+		goto _test_eof
+	_test_eof24:
+		(s.cs) = 24
+		goto _test_eof
+	_test_eof25:
+		(s.cs) = 25
+		goto _test_eof
+	_test_eof26:
+		(s.cs) = 26
+		goto _test_eof
+	_test_eof27:
+		(s.cs) = 27
+		goto _test_eof
+	_test_eof28:
+		(s.cs) = 28
+		goto _test_eof
+	_test_eof29:
+		(s.cs) = 29
+		goto _test_eof
+	_test_eof30:
+		(s.cs) = 30
+		goto _test_eof
+	_test_eof31:
+		(s.cs) = 31
+		goto _test_eof
+	_test_eof32:
+		(s.cs) = 32
+		goto _test_eof
+	_test_eof33:
+		(s.cs) = 33
+		goto _test_eof
+	_test_eof194:
+		(s.cs) = 194
+		goto _test_eof
+	_test_eof34:
+		(s.cs) = 34
+		goto _test_eof
+	_test_eof35:
+		(s.cs) = 35
+		goto _test_eof
+	_test_eof36:
+		(s.cs) = 36
+		goto _test_eof
+	_test_eof37:
+		(s.cs) = 37
+		goto _test_eof
+	_test_eof38:
+		(s.cs) = 38
+		goto _test_eof
+	_test_eof39:
+		(s.cs) = 39
+		goto _test_eof
+	_test_eof40:
+		(s.cs) = 40
+		goto _test_eof
+	_test_eof41:
+		(s.cs) = 41
+		goto _test_eof
+	_test_eof42:
+		(s.cs) = 42
+		goto _test_eof
+	_test_eof43:
+		(s.cs) = 43
+		goto _test_eof
+	_test_eof44:
+		(s.cs) = 44
+		goto _test_eof
+	_test_eof45:
+		(s.cs) = 45
+		goto _test_eof
+	_test_eof46:
+		(s.cs) = 46
+		goto _test_eof
+	_test_eof47:
+		(s.cs) = 47
+		goto _test_eof
+	_test_eof48:
+		(s.cs) = 48
+		goto _test_eof
+	_test_eof49:
+		(s.cs) = 49
+		goto _test_eof
+	_test_eof50:
+		(s.cs) = 50
+		goto _test_eof
+	_test_eof51:
+		(s.cs) = 51
+		goto _test_eof
+	_test_eof52:
+		(s.cs) = 52
+		goto _test_eof
+	_test_eof53:
+		(s.cs) = 53
+		goto _test_eof
+	_test_eof54:
+		(s.cs) = 54
+		goto _test_eof
+	_test_eof55:
+		(s.cs) = 55
+		goto _test_eof
+	_test_eof56:
+		(s.cs) = 56
+		goto _test_eof
+	_test_eof57:
+		(s.cs) = 57
+		goto _test_eof
+	_test_eof58:
+		(s.cs) = 58
+		goto _test_eof
+	_test_eof59:
+		(s.cs) = 59
+		goto _test_eof
+	_test_eof60:
+		(s.cs) = 60
+		goto _test_eof
+	_test_eof61:
+		(s.cs) = 61
+		goto _test_eof
+	_test_eof62:
+		(s.cs) = 62
+		goto _test_eof
+	_test_eof63:
+		(s.cs) = 63
+		goto _test_eof
+	_test_eof64:
+		(s.cs) = 64
+		goto _test_eof
+	_test_eof65:
+		(s.cs) = 65
+		goto _test_eof
+	_test_eof66:
+		(s.cs) = 66
+		goto _test_eof
+	_test_eof67:
+		(s.cs) = 67
+		goto _test_eof
+	_test_eof68:
+		(s.cs) = 68
+		goto _test_eof
+	_test_eof69:
+		(s.cs) = 69
+		goto _test_eof
+	_test_eof70:
+		(s.cs) = 70
+		goto _test_eof
+	_test_eof71:
+		(s.cs) = 71
+		goto _test_eof
+	_test_eof72:
+		(s.cs) = 72
+		goto _test_eof
+	_test_eof73:
+		(s.cs) = 73
+		goto _test_eof
+	_test_eof74:
+		(s.cs) = 74
+		goto _test_eof
+	_test_eof75:
+		(s.cs) = 75
+		goto _test_eof
+	_test_eof195:
+		(s.cs) = 195
+		goto _test_eof
+	_test_eof76:
+		(s.cs) = 76
+		goto _test_eof
+	_test_eof77:
+		(s.cs) = 77
+		goto _test_eof
+	_test_eof78:
+		(s.cs) = 78
+		goto _test_eof
+	_test_eof79:
+		(s.cs) = 79
+		goto _test_eof
+	_test_eof80:
+		(s.cs) = 80
+		goto _test_eof
+	_test_eof81:
+		(s.cs) = 81
+		goto _test_eof
+	_test_eof82:
+		(s.cs) = 82
+		goto _test_eof
+	_test_eof83:
+		(s.cs) = 83
+		goto _test_eof
+	_test_eof84:
+		(s.cs) = 84
+		goto _test_eof
+	_test_eof85:
+		(s.cs) = 85
+		goto _test_eof
+	_test_eof86:
+		(s.cs) = 86
+		goto _test_eof
+	_test_eof87:
+		(s.cs) = 87
+		goto _test_eof
+	_test_eof88:
+		(s.cs) = 88
+		goto _test_eof
+	_test_eof89:
+		(s.cs) = 89
+		goto _test_eof
+	_test_eof90:
+		(s.cs) = 90
+		goto _test_eof
+	_test_eof91:
+		(s.cs) = 91
+		goto _test_eof
+	_test_eof92:
+		(s.cs) = 92
+		goto _test_eof
+	_test_eof93:
+		(s.cs) = 93
+		goto _test_eof
+	_test_eof94:
+		(s.cs) = 94
+		goto _test_eof
+	_test_eof95:
+		(s.cs) = 95
+		goto _test_eof
+	_test_eof96:
+		(s.cs) = 96
+		goto _test_eof
+	_test_eof97:
+		(s.cs) = 97
+		goto _test_eof
+	_test_eof98:
+		(s.cs) = 98
+		goto _test_eof
+	_test_eof99:
+		(s.cs) = 99
+		goto _test_eof
+	_test_eof100:
+		(s.cs) = 100
+		goto _test_eof
+	_test_eof101:
+		(s.cs) = 101
+		goto _test_eof
+	_test_eof102:
+		(s.cs) = 102
+		goto _test_eof
+	_test_eof103:
+		(s.cs) = 103
+		goto _test_eof
+	_test_eof104:
+		(s.cs) = 104
+		goto _test_eof
+	_test_eof105:
+		(s.cs) = 105
+		goto _test_eof
+	_test_eof106:
+		(s.cs) = 106
+		goto _test_eof
+	_test_eof107:
+		(s.cs) = 107
+		goto _test_eof
+	_test_eof108:
+		(s.cs) = 108
+		goto _test_eof
+	_test_eof109:
+		(s.cs) = 109
+		goto _test_eof
+	_test_eof110:
+		(s.cs) = 110
+		goto _test_eof
+	_test_eof111:
+		(s.cs) = 111
+		goto _test_eof
+	_test_eof112:
+		(s.cs) = 112
+		goto _test_eof
+	_test_eof196:
+		(s.cs) = 196
+		goto _test_eof
+	_test_eof113:
+		(s.cs) = 113
+		goto _test_eof
+	_test_eof114:
+		(s.cs) = 114
+		goto _test_eof
+	_test_eof115:
+		(s.cs) = 115
+		goto _test_eof
+	_test_eof116:
+		(s.cs) = 116
+		goto _test_eof
+	_test_eof117:
+		(s.cs) = 117
+		goto _test_eof
+	_test_eof118:
+		(s.cs) = 118
+		goto _test_eof
+	_test_eof119:
+		(s.cs) = 119
+		goto _test_eof
+	_test_eof120:
+		(s.cs) = 120
+		goto _test_eof
+	_test_eof121:
+		(s.cs) = 121
+		goto _test_eof
+	_test_eof122:
+		(s.cs) = 122
+		goto _test_eof
+	_test_eof123:
+		(s.cs) = 123
+		goto _test_eof
+	_test_eof124:
+		(s.cs) = 124
+		goto _test_eof
+	_test_eof125:
+		(s.cs) = 125
+		goto _test_eof
+	_test_eof126:
+		(s.cs) = 126
+		goto _test_eof
+	_test_eof127:
+		(s.cs) = 127
+		goto _test_eof
+	_test_eof128:
+		(s.cs) = 128
+		goto _test_eof
+	_test_eof129:
+		(s.cs) = 129
+		goto _test_eof
+	_test_eof130:
+		(s.cs) = 130
+		goto _test_eof
+	_test_eof131:
+		(s.cs) = 131
+		goto _test_eof
+	_test_eof132:
+		(s.cs) = 132
+		goto _test_eof
+	_test_eof133:
+		(s.cs) = 133
+		goto _test_eof
+	_test_eof134:
+		(s.cs) = 134
+		goto _test_eof
+	_test_eof135:
+		(s.cs) = 135
+		goto _test_eof
+	_test_eof136:
+		(s.cs) = 136
+		goto _test_eof
+	_test_eof137:
+		(s.cs) = 137
+		goto _test_eof
+	_test_eof138:
+		(s.cs) = 138
+		goto _test_eof
+	_test_eof139:
+		(s.cs) = 139
+		goto _test_eof
+	_test_eof140:
+		(s.cs) = 140
+		goto _test_eof
+	_test_eof141:
+		(s.cs) = 141
+		goto _test_eof
+	_test_eof142:
+		(s.cs) = 142
+		goto _test_eof
+	_test_eof143:
+		(s.cs) = 143
+		goto _test_eof
+	_test_eof144:
+		(s.cs) = 144
+		goto _test_eof
+	_test_eof145:
+		(s.cs) = 145
+		goto _test_eof
+	_test_eof146:
+		(s.cs) = 146
+		goto _test_eof
+	_test_eof147:
+		(s.cs) = 147
+		goto _test_eof
+	_test_eof148:
+		(s.cs) = 148
+		goto _test_eof
+	_test_eof149:
+		(s.cs) = 149
+		goto _test_eof
+	_test_eof150:
+		(s.cs) = 150
+		goto _test_eof
+	_test_eof151:
+		(s.cs) = 151
+		goto _test_eof
+	_test_eof152:
+		(s.cs) = 152
+		goto _test_eof
+	_test_eof153:
+		(s.cs) = 153
+		goto _test_eof
+	_test_eof154:
+		(s.cs) = 154
+		goto _test_eof
+	_test_eof155:
+		(s.cs) = 155
+		goto _test_eof
+	_test_eof156:
+		(s.cs) = 156
+		goto _test_eof
+	_test_eof157:
+		(s.cs) = 157
+		goto _test_eof
+	_test_eof158:
+		(s.cs) = 158
+		goto _test_eof
+	_test_eof159:
+		(s.cs) = 159
+		goto _test_eof
+	_test_eof160:
+		(s.cs) = 160
+		goto _test_eof
+	_test_eof161:
+		(s.cs) = 161
+		goto _test_eof
+	_test_eof162:
+		(s.cs) = 162
+		goto _test_eof
+	_test_eof163:
+		(s.cs) = 163
+		goto _test_eof
+	_test_eof164:
+		(s.cs) = 164
+		goto _test_eof
+	_test_eof165:
+		(s.cs) = 165
+		goto _test_eof
+	_test_eof166:
+		(s.cs) = 166
+		goto _test_eof
+	_test_eof167:
+		(s.cs) = 167
+		goto _test_eof
+	_test_eof168:
+		(s.cs) = 168
+		goto _test_eof
+	_test_eof169:
+		(s.cs) = 169
+		goto _test_eof
+	_test_eof170:
+		(s.cs) = 170
+		goto _test_eof
+	_test_eof171:
+		(s.cs) = 171
+		goto _test_eof
+	_test_eof172:
+		(s.cs) = 172
+		goto _test_eof
+	_test_eof173:
+		(s.cs) = 173
+		goto _test_eof
+	_test_eof174:
+		(s.cs) = 174
+		goto _test_eof
+	_test_eof175:
+		(s.cs) = 175
+		goto _test_eof
+	_test_eof176:
+		(s.cs) = 176
+		goto _test_eof
+	_test_eof177:
+		(s.cs) = 177
+		goto _test_eof
+	_test_eof178:
+		(s.cs) = 178
+		goto _test_eof
+	_test_eof179:
+		(s.cs) = 179
+		goto _test_eof
+	_test_eof180:
+		(s.cs) = 180
+		goto _test_eof
+	_test_eof181:
+		(s.cs) = 181
+		goto _test_eof
+	_test_eof182:
+		(s.cs) = 182
+		goto _test_eof
+	_test_eof197:
+		(s.cs) = 197
+		goto _test_eof
 
-	return p, err
+	_test_eof:
+		{
+		}
+		if p == eof {
+			switch s.cs {
+			case 1, 2:
+				expected = "value"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 5:
+				expected = "escape sequence"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 7, 8, 9, 10:
+				expected = "hex digit"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 12:
+				expected = "digit"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 20, 21, 22:
+				expected = "true"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 13, 14, 15, 16:
+				expected = "false"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 17, 18, 19:
+				expected = "null"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 29, 30:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 96, 97, 108, 109:
+				expected = "value"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 23, 24:
+				expected = "value or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 27, 28, 33, 42, 43, 44, 45, 46, 47, 52, 56, 60, 61, 70, 71, 72, 73, 74, 75, 80, 84, 88, 89:
+				expected = "',' or ']'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 90, 91:
+				expected = "string or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 102, 103:
+				expected = "string"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 94, 95, 106, 107:
+				expected = "':'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 100, 101, 112, 121, 122, 123, 124, 125, 126, 131, 135, 139, 140, 156, 157, 158, 159, 160, 161, 166, 170, 174, 175:
+				expected = "',' or '}'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 3, 4, 6, 11:
+				expected = "string character"
+				expected = "'\"'"
+
+				err = ErrNoValidToken
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+
+			case 34, 62:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 113, 141, 148, 176:
+				expected = "escape sequence"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 36, 37, 38, 39, 64, 65, 66, 67:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 115, 116, 117, 118, 143, 144, 145, 146, 150, 151, 152, 153, 178, 179, 180, 181:
+				expected = "hex digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 41, 69:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 120, 155:
+				expected = "digit"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 57, 58, 59, 85, 86, 87:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 136, 137, 138, 171, 172, 173:
+				expected = "true"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 48, 49, 50, 51, 76, 77, 78, 79:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 127, 128, 129, 130, 162, 163, 164, 165:
+				expected = "false"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 53, 54, 55, 81, 82, 83:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 132, 133, 134, 167, 168, 169:
+				expected = "null"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 25, 26, 31, 32, 35, 40, 63, 68:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidArray
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			case 92, 93, 98, 99, 104, 105, 110, 111, 114, 119, 142, 147, 149, 154, 177, 182:
+				expected = "string character"
+				expected = "'\"'"
+				err = ErrUnexpectedEOF
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+				err = ErrInvalidObject
+				p--
+				{
+					p++
+					(s.cs) = 0
+					goto _out
+				}
+			}
+		}
+
+	_out:
+		{
+		}
+	}
+
+	_ = expected
+	if err != nil {
+		return p, false, err
+	}
+	return p, p < pe || s.cs >= resumableSkip_first_final, nil
 }
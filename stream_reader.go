@@ -0,0 +1,258 @@
+package rjson
+
+import (
+	"io"
+)
+
+const defaultStreamReaderSize = 4096
+
+// StreamReader reads json values from an io.Reader. It keeps a window of unread input that is refilled from the
+// reader as needed. Handlers are called as soon as the value they handle is complete, so a document never needs to
+// be read into memory all at once. The window only has to be large enough to hold a single object field or array
+// item.
+//
+// The data passed to handlers is only valid until the handler returns. Handlers must not retain it.
+//
+// StreamReader is not thread-safe.
+type StreamReader struct {
+	r      io.Reader
+	buf    []byte
+	p      int
+	offset int64
+	eof    bool
+	err    error
+	state  handlerState
+}
+
+// NewStreamReader returns a new StreamReader that reads from r.
+func NewStreamReader(r io.Reader) *StreamReader {
+	return &StreamReader{
+		r:   r,
+		buf: make([]byte, 0, defaultStreamReaderSize),
+	}
+}
+
+// InputOffset returns the offset in the input stream of the next unread byte.
+func (s *StreamReader) InputOffset() int64 {
+	return s.offset + int64(s.p)
+}
+
+// HandleObjectValues reads the next object from the stream and runs handler.HandleObjectValue on each of its fields.
+// It works like the package level HandleObjectValues except that data passed to the handler only extends to the end
+// of the currently buffered input. data always contains the complete field value.
+// It returns io.EOF when the stream contains nothing but whitespace.
+func (s *StreamReader) HandleObjectValues(handler ObjectValueHandler) error {
+	return s.handleValues(func(data []byte) error {
+		_, _, _, err := handleObjectValues(data, handler, nil, 0, &s.state)
+		return err
+	})
+}
+
+// HandleArrayValues reads the next array from the stream and runs handler.HandleArrayValue on each of its items.
+// It works like the package level HandleArrayValues except that data passed to the handler only extends to the end
+// of the currently buffered input. data always contains the complete item.
+// It returns io.EOF when the stream contains nothing but whitespace.
+func (s *StreamReader) HandleArrayValues(handler ArrayValueHandler) error {
+	return s.handleValues(func(data []byte) error {
+		_, _, _, err := handleArrayValues(data, handler, nil, 0, &s.state)
+		return err
+	})
+}
+
+// SkipValue skips the next json value in the stream. It returns io.EOF when the stream contains nothing but
+// whitespace.
+func (s *StreamReader) SkipValue() error {
+	n, err := s.skipValueSpace()
+	if err != nil {
+		return err
+	}
+	s.p += n
+	s.state.initSkip()
+	for {
+		pe := len(s.buf)
+		if !s.eof {
+			pe = resumeEnd(s.buf, s.p)
+		}
+		var done bool
+		s.p, done, err = s.state.skip(s.buf, s.p, pe, s.eof)
+		if err != nil || done {
+			return err
+		}
+		err = s.more()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handleValues runs a handler machine on the next value in the stream. run runs the machine on data from s.state.
+func (s *StreamReader) handleValues(run func(data []byte) error) error {
+	n, err := s.skipValueSpace()
+	if err != nil {
+		return err
+	}
+	s.state.start(s.p + n)
+	for {
+		s.state.atEOF = s.eof
+		err = run(s.buf)
+		if err != nil {
+			return err
+		}
+		if s.state.done {
+			s.p = s.state.p
+			return nil
+		}
+		s.p = s.state.keep()
+		keep := s.p
+		err = s.more()
+		if err != nil {
+			return err
+		}
+		s.state.shift(keep - s.p)
+	}
+}
+
+// more reads more input after a machine got to the end of the buffered input without finishing its value.
+func (s *StreamReader) more() error {
+	if s.eof {
+		return ErrUnexpectedEOF
+	}
+	err := s.fill()
+	if err == ErrUnexpectedEOF {
+		return nil
+	}
+	return err
+}
+
+// skipValueSpace is skipSpace for the start of a top-level value. It returns io.EOF when the stream contains nothing
+// but whitespace.
+func (s *StreamReader) skipValueSpace() (int, error) {
+	n, err := s.skipSpace(0)
+	if err == ErrUnexpectedEOF {
+		return 0, io.EOF
+	}
+	return n, err
+}
+
+// skipSpace returns the position of the first non-whitespace byte at or after s.p+from relative to s.p.
+func (s *StreamReader) skipSpace(from int) (int, error) {
+	for {
+		n := from + countWhitespace(s.buf[s.p+from:])
+		if s.p+n < len(s.buf) {
+			return n, nil
+		}
+		from = n
+		err := s.fill()
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// fill discards consumed input and reads more from s.r. It returns ErrUnexpectedEOF when no more input is available.
+func (s *StreamReader) fill() error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.eof {
+		return ErrUnexpectedEOF
+	}
+	if s.p > 0 {
+		n := copy(s.buf, s.buf[s.p:])
+		s.buf = s.buf[:n]
+		s.offset += int64(s.p)
+		s.p = 0
+	}
+	if len(s.buf) == cap(s.buf) {
+		s.buf = growBytesSliceCapacity(s.buf, 2*cap(s.buf)+defaultStreamReaderSize)
+	}
+	for i := 0; i < 100; i++ {
+		n, err := s.r.Read(s.buf[len(s.buf):cap(s.buf)])
+		s.buf = s.buf[:len(s.buf)+n]
+		if err == io.EOF {
+			s.eof = true
+			if n == 0 {
+				return ErrUnexpectedEOF
+			}
+			return nil
+		}
+		if err != nil {
+			s.err = err
+			return err
+		}
+		if n > 0 {
+			return nil
+		}
+	}
+	s.err = io.ErrNoProgress
+	return s.err
+}
+
+// handlerState is the state of a handler machine that StreamReader runs on input in pieces. p is where the machine
+// continues, and fieldStart and fieldEnd are the object handler machine's current field. atEOF means no more input
+// will follow, and done means the machine got to the end of its value. The machine waits for each value to be
+// complete before it passes it to the handler. value finds the end of that value, and valueP is where value stopped
+// relative to p. wait is the state the machine continues from after it waited, and handled is the position of the last
+// value that was passed to the handler.
+type handlerState struct {
+	resumeState
+	p, fieldStart, fieldEnd int
+	atEOF, done             bool
+	value                   resumeState
+	valueP                  int
+	wait                    int
+	handled                 int
+}
+
+// start starts a handler machine on the value at p. The machine starts from its start state when cs is 0.
+func (s *handlerState) start(p int) {
+	s.cs, s.p, s.done = 0, p, false
+	s.fieldStart, s.fieldEnd, s.handled = -1, -1, -1
+	s.valueP, s.wait = 0, 0
+}
+
+// save saves the machine's state at the end of a run. done means the machine got to the end of the value. A machine
+// that stopped to wait for a value continues from the state it was in before the value.
+func (s *handlerState) save(cs, top, p int, stack []int, done bool) {
+	if s.wait != 0 {
+		cs, s.wait = s.wait, 0
+	}
+	s.cs, s.top, s.p, s.stack, s.done = cs, top, p, stack, done
+}
+
+// valueBuffered reports whether the value at data[p:] is complete or more input is needed to find its end. The part
+// of a value that was already scanned isn't scanned again when there is more input. An invalid value is as complete
+// as it needs to be for the machine to find the error.
+func (s *handlerState) valueBuffered(data []byte, p int) bool {
+	if !s.atEOF {
+		if s.valueP == 0 {
+			s.value.initSkip()
+		}
+		q := p + s.valueP
+		end, done, err := s.value.skip(data, q, resumeEnd(data, q), false)
+		if err == nil && !done {
+			s.valueP = end - p
+			return false
+		}
+	}
+	s.valueP = 0
+	s.handled = p
+	return true
+}
+
+// keep returns the position of the first byte the machine still needs. That is the current field's name when the
+// object handler machine hasn't passed its value to the handler yet.
+func (s *handlerState) keep() int {
+	if s.fieldStart > s.handled {
+		return s.fieldStart
+	}
+	return s.p
+}
+
+// shift moves the positions in s n bytes back after the input before them was discarded.
+func (s *handlerState) shift(n int) {
+	s.p -= n
+	s.fieldStart -= n
+	s.fieldEnd -= n
+	s.handled -= n
+}
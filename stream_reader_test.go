@@ -0,0 +1,140 @@
+package rjson
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/require"
+)
+
+type streamTestValue struct {
+	fieldname string
+	value     string
+}
+
+func collectObjectValues(values *[]streamTestValue) ObjectValueHandlerFunc {
+	return func(fieldname, data []byte) (p int, err error) {
+		p, err = SkipValue(data, nil)
+		if err != nil {
+			return p, err
+		}
+		*values = append(*values, streamTestValue{
+			fieldname: string(fieldname),
+			value:     string(data[:p]),
+		})
+		return p, nil
+	}
+}
+
+func TestStreamReader_HandleObjectValues(t *testing.T) {
+	t.Parallel()
+	for _, file := range []string{"github_repo.json", "github_user.json", "twitter.json", "citm_catalog.json"} {
+		file := file
+		t.Run(file, func(t *testing.T) {
+			t.Parallel()
+			data := getTestdataJSONGz(t, file)
+			var want []streamTestValue
+			_, err := HandleObjectValues(data, collectObjectValues(&want), nil)
+			require.NoError(t, err)
+			readers := map[string]io.Reader{
+				"plain": bytes.NewReader(data),
+				"half":  iotest.HalfReader(bytes.NewReader(data)),
+			}
+			if len(data) < 10_000 {
+				readers["oneByte"] = iotest.OneByteReader(bytes.NewReader(data))
+			}
+			for name, r := range readers {
+				var got []streamTestValue
+				sr := NewStreamReader(r)
+				err = sr.HandleObjectValues(collectObjectValues(&got))
+				require.NoError(t, err, name)
+				require.Equal(t, want, got, name)
+				require.Equal(t, io.EOF, sr.SkipValue(), name)
+			}
+		})
+	}
+}
+
+func TestStreamReader_HandleArrayValues(t *testing.T) {
+	t.Parallel()
+	data := ` [1, "a", {"b": [2, 3]}, [], null , 12.5e3] [4] null `
+	var got []string
+	handler := ArrayValueHandlerFunc(func(data []byte) (p int, err error) {
+		p, err = SkipValue(data, nil)
+		got = append(got, string(data[:p]))
+		return p, err
+	})
+	sr := NewStreamReader(iotest.OneByteReader(strings.NewReader(data)))
+	require.NoError(t, sr.HandleArrayValues(handler))
+	require.Equal(t, []string{`1`, `"a"`, `{"b": [2, 3]}`, `[]`, `null`, `12.5e3`}, got)
+	require.EqualValues(t, 43, sr.InputOffset())
+	require.NoError(t, sr.HandleArrayValues(handler))
+	require.NoError(t, sr.HandleArrayValues(handler))
+	require.Equal(t, io.EOF, sr.HandleArrayValues(handler))
+	require.Len(t, got, 7)
+}
+
+func TestStreamReader_errors(t *testing.T) {
+	t.Parallel()
+	// The errors are the ones HandleObjectValues returns for the same data.
+	for _, data := range []string{
+		`{"a": 1`,
+		`{"a": "b`,
+		`{"a": 1,}`,
+		`{"a" 1}`,
+		`{"a": [1 2]}`,
+		`{"a": 1.}`,
+		`[1 2]`,
+		`"foo"`,
+	} {
+		handler := ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+			return 0, nil
+		})
+		_, want := HandleObjectValues([]byte(data), handler, nil)
+		require.Error(t, want, data)
+		sr := NewStreamReader(iotest.OneByteReader(strings.NewReader(data)))
+		err := sr.HandleObjectValues(handler)
+		require.True(t, errors.Is(want, err), "%s: want %v got %v", data, want, err)
+	}
+
+	sr := NewStreamReader(iotest.TimeoutReader(strings.NewReader(`[1, 2`)))
+	err := sr.SkipValue()
+	require.Equal(t, iotest.ErrTimeout, err)
+}
+
+func TestResumeState_skip(t *testing.T) {
+	t.Parallel()
+	for _, data := range []string{
+		`{"a": [1, 2.5e3, "x\"y", true, null], "b": {}}`,
+		`-12.5e-3 `,
+		`-12.5e-3`,
+		` true,`,
+		`"abc"`,
+		`[1, 2`,
+		`[1 2]`,
+		`{"a" 1}`,
+		`{"a": 1.}`,
+		`[1.5e]`,
+		`nul`,
+		`x`,
+	} {
+		wantP, _, _, wantErr := skipValue([]byte(data), nil, 0)
+		for split := 0; split <= len(data); split++ {
+			var s resumeState
+			s.initSkip()
+			p, done, err := s.skip([]byte(data[:split]), 0, resumeEnd([]byte(data[:split]), 0), false)
+			if err == nil && !done {
+				p, done, err = s.skip([]byte(data), p, len(data), true)
+			}
+			require.Equal(t, wantErr, err, "%s split at %d", data, split)
+			if wantErr == nil {
+				require.True(t, done, "%s split at %d", data, split)
+				require.Equal(t, wantP, p, "%s split at %d", data, split)
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package rjson
+
+import "fmt"
+
+// surrogateError returns true when a \u escape that starts at start and fails at p failed because it is a surrogate
+// that isn't part of a high-low pair instead of because of a syntax error.
+func surrogateError(data []byte, start, p int) bool {
+	return p-start >= 6 || p-start >= 2 && getu4Digit(data[p]) != -1
+}
+
+// getu4Digit returns the value of the hex digit c or -1 if c isn't a hex digit.
+func getu4Digit(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'a' && c <= 'f':
+		return int(c - 'a' + 10)
+	case c >= 'A' && c <= 'F':
+		return int(c - 'A' + 10)
+	default:
+		return -1
+	}
+}
+
+// ReadStringStrict is like ReadString but it returns ErrInvalidUTF8 when the string isn't valid utf-8 or has an
+// unpaired surrogate escape like \ud800 instead of keeping the invalid bytes or replacing the escape. When it returns
+// ErrInvalidUTF8, p is the position of the problem.
+func ReadStringStrict(data []byte, buf *[]byte) (val string, p int, err error) {
+	var bBuf []byte
+	if buf != nil {
+		bBuf = (*buf)[:0]
+	}
+	bBuf, p, err = ReadStringBytesStrict(data, bBuf)
+	if buf != nil {
+		*buf = bBuf
+	}
+	if err != nil {
+		return "", p, err
+	}
+	return string(bBuf), p, nil
+}
+
+// ReadStringBytesStrict is like ReadStringBytes but it returns ErrInvalidUTF8 when the string isn't valid utf-8 or has
+// an unpaired surrogate escape like \ud800 instead of keeping the invalid bytes or replacing the escape. When it
+// returns ErrInvalidUTF8, p is the position of the problem and buf is returned unchanged.
+func ReadStringBytesStrict(data, buf []byte) (val []byte, p int, err error) {
+	p = countWhitespace(data)
+	if p == len(data) || data[p] != '"' {
+		return buf, p, fmt.Errorf("not a string")
+	}
+	p++
+	val, pp, err := appendStringStrict(data[p:], buf)
+	p += pp
+	if err != nil {
+		return buf, p, err
+	}
+	return val, p, nil
+}
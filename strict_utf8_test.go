@@ -0,0 +1,84 @@
+package rjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStringStrict(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data    string
+		want    string
+		offset  int
+		wantErr bool
+	}{
+		{data: `"abc"`, want: "abc"},
+		{data: " \"h\xc3\xa9llo\"", want: "h\xc3\xa9llo"},
+		{data: `"\ud83d\ude00"`, want: "\U0001F600"},
+		{data: `"\\ud800"`, want: `\ud800`},
+		{data: `"\u00e9\n"`, want: "\u00e9\n"},
+		{data: "\"\xff\"", offset: 1},
+		{data: "\"ab\xed\xa0\x80\"", offset: 3},
+		{data: "\"\xc0\xaf\"", offset: 1},
+		{data: "\"\xe2\x82\"", offset: 1},
+		{data: `"a\ud800"`, offset: 2},
+		{data: `"\udc00"`, offset: 1},
+		{data: `"\ud83d\u0041"`, offset: 1},
+		{data: `"\ud83d"`, offset: 1},
+		{data: `"\ud800`, wantErr: true},
+	} {
+		got, p, err := ReadStringStrict([]byte(td.data), nil)
+		gotBytes, pBytes, errBytes := ReadStringBytesStrict([]byte(td.data), nil)
+		require.Equal(t, p, pBytes, td.data)
+		if td.wantErr {
+			require.Error(t, err, td.data)
+			require.Error(t, errBytes, td.data)
+			continue
+		}
+		if td.want == "" {
+			require.Equal(t, ErrInvalidUTF8, err, td.data)
+			require.Equal(t, ErrInvalidUTF8, errBytes, td.data)
+			require.Equal(t, td.offset, p, td.data)
+			continue
+		}
+		require.NoError(t, err, td.data)
+		require.NoError(t, errBytes, td.data)
+		require.Equal(t, td.want, got, td.data)
+		require.Equal(t, td.want, string(gotBytes), td.data)
+		require.Equal(t, len(td.data), p, td.data)
+	}
+}
+
+func TestStrictUTF8(t *testing.T) {
+	t.Parallel()
+	data := []byte("{\"a\": [\"ok\", \"\\ud800\"], \"b\xff\": 1}")
+	require.True(t, Valid(data, nil))
+	require.True(t, Valid(data, &Buffer{Limits: Limits{MaxStringLen: 10}}))
+	require.False(t, Valid(data, &Buffer{StrictUTF8: true}))
+
+	_, err := SkipValue(data, &Buffer{StrictUTF8: true})
+	require.True(t, errors.Is(err, ErrInvalidUTF8))
+	require.True(t, errors.Is(err, ErrInvalidString))
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 14, syntaxErr.Offset)
+	require.Equal(t, "valid utf-8", syntaxErr.Expected)
+
+	_, err = HandleObjectValues(data, ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+		return 0, nil
+	}), &Buffer{StrictUTF8: true})
+	require.True(t, errors.Is(err, ErrInvalidUTF8))
+
+	vr := ValueReader{StrictUTF8: true}
+	_, _, err = vr.ReadValue(data)
+	require.True(t, errors.Is(err, ErrInvalidUTF8))
+	_, _, err = vr.ReadValue(data[6:22])
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 8, syntaxErr.Offset)
+
+	_, _, err = vr.ReadValue([]byte("[\"ok\", \"\xe2\x82\xac\"]"))
+	require.NoError(t, err)
+}
@@ -0,0 +1,147 @@
+package rjson
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SyntaxError describes where a json document is invalid. It is returned by the functions that read complete values:
+// SkipValue, SkipValueFast, HandleObjectValues, HandleArrayValues, HandlePathValues, Get, Unmarshal, DecodeValue and
+// ValueReader's Read methods. The Read functions for simple values, NextToken, PushParser and StreamReader return
+// the bare sentinel errors instead.
+//
+// Err is one of the sentinel errors like ErrInvalidObject, so errors.Is(err, ErrInvalidObject) works on a
+// *SyntaxError.
+type SyntaxError struct {
+	// Offset is the position in data of the byte that made it invalid. It is len(data) when data ended too soon.
+	Offset int
+
+	// Byte is the byte at Offset. It is 0 when Offset is at the end of data.
+	Byte byte
+
+	// Expected describes what would have been valid at Offset, like "',' or ']'" or "string".
+	Expected string
+
+	// Err is the underlying error.
+	Err error
+
+	// dataLen is the length of the data Offset is relative to. It is used to move Offset to the start of a larger
+	// document when the error is returned from a handler.
+	dataLen int
+}
+
+func (e *SyntaxError) Error() string {
+	found := "end of data"
+	if e.Offset < e.dataLen {
+		found = fmt.Sprintf("%q", e.Byte)
+	}
+	if e.Expected == "" {
+		return fmt.Sprintf("%v at offset %d: found %s", e.Err, e.Offset, found)
+	}
+	return fmt.Sprintf("%v at offset %d: expected %s but found %s", e.Err, e.Offset, e.Expected, found)
+}
+
+// Unwrap returns e.Err.
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// LineCol returns the 1-based line and column of e.Offset in data. data must be the document that was passed to the
+// function that returned e. Columns count bytes, not characters.
+func (e *SyntaxError) LineCol(data []byte) (line, col int) {
+	offset := e.Offset
+	if offset > len(data) {
+		offset = len(data)
+	}
+	line, col = 1, 1
+	for _, c := range data[:offset] {
+		if c == '\n' {
+			line++
+			col = 1
+			continue
+		}
+		col++
+	}
+	return line, col
+}
+
+// syntaxSentinels are the errors that toSyntaxError wraps, along with what was expected when they happen.
+var syntaxSentinels = []struct {
+	err      error
+	expected string
+}{
+	{err: ErrUnexpectedEOF, expected: "more json"},
+	{err: ErrInvalidUTF8, expected: "valid utf-8"},
+	{err: ErrInvalidString, expected: "string"},
+	{err: ErrInvalidArray, expected: "array"},
+	{err: ErrInvalidObject, expected: "object"},
+	{err: ErrInvalidUInt, expected: "unsigned integer"},
+	{err: ErrInvalidInt, expected: "integer"},
+	{err: ErrInvalidNumber, expected: "number"},
+	{err: ErrNoValidToken, expected: "value"},
+	{err: ErrNotNull, expected: "null"},
+	{err: ErrNotBool, expected: "true or false"},
+	{err: ErrTrailingData, expected: "end of data"},
+}
+
+// toSyntaxError returns err as a *SyntaxError relative to data. p is the position the function that failed returned.
+// expected is what the state machine that found the error expected at p. It is empty when err didn't come from a
+// machine, and then what was expected is taken from the sentinel error.
+//
+// Errors that already have an offset are moved to be relative to data by moveError. Other errors that aren't sentinel
+// errors are returned unchanged.
+func toSyntaxError(data []byte, p int, err error, expected string) error {
+	if moveError(err, len(data)) {
+		return err
+	}
+	if expected == "" {
+		for _, sentinel := range syntaxSentinels {
+			if errors.Is(err, sentinel.err) {
+				expected = sentinel.expected
+				break
+			}
+		}
+	}
+	if expected == "" {
+		return err
+	}
+	if p > len(data) {
+		p = len(data)
+	}
+	if p < 0 {
+		p = 0
+	}
+	syntaxErr := &SyntaxError{
+		Offset:   p,
+		Expected: expected,
+		Err:      err,
+		dataLen:  len(data),
+	}
+	if p < len(data) {
+		syntaxErr.Byte = data[p]
+	}
+	return syntaxErr
+}
+
+// moveError moves the offset of a *SyntaxError or *LimitError from a handler to be relative to data of length dataLen.
+// The offset is relative to the data the handler was given, which always runs to the end of data. A *PathError is moved
+// by wrapPathError, but the error it wraps is moved here. moveError returns false when err is none of these.
+func moveError(err error, dataLen int) bool {
+	switch e := err.(type) {
+	case *SyntaxError:
+		if e.dataLen < dataLen {
+			e.Offset += dataLen - e.dataLen
+			e.dataLen = dataLen
+		}
+	case *LimitError:
+		if e.dataLen < dataLen {
+			e.Offset += dataLen - e.dataLen
+			e.dataLen = dataLen
+		}
+	case *PathError:
+		moveError(e.Err, dataLen)
+	default:
+		return false
+	}
+	return true
+}
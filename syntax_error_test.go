@@ -0,0 +1,155 @@
+package rjson
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyntaxError(t *testing.T) {
+	t.Parallel()
+	for _, td := range []struct {
+		data     string
+		offset   int
+		expected string
+		err      error
+	}{
+		{data: `[1 2]`, offset: 3, expected: `',' or ']'`, err: ErrInvalidArray},
+		{data: `[1,]`, offset: 3, expected: `value`, err: ErrInvalidArray},
+		{data: `[}`, offset: 1, expected: `value or ']'`, err: ErrInvalidArray},
+		{data: `["\u12x"]`, offset: 6, expected: `hex digit`, err: ErrInvalidArray},
+		{data: `{"a" 1}`, offset: 5, expected: `':'`, err: ErrInvalidObject},
+		{data: `{"a":1,}`, offset: 7, expected: `string`, err: ErrInvalidObject},
+		{data: `{"a":1`, offset: 6, expected: `',' or '}'`, err: ErrUnexpectedEOF},
+		{data: `{1:2}`, offset: 1, expected: `string or '}'`, err: ErrInvalidObject},
+		{data: `"a\x"`, offset: 3, expected: `escape sequence`, err: ErrNoValidToken},
+		{data: `"abc`, offset: 4, expected: `'"'`, err: ErrNoValidToken},
+		{data: `  x`, offset: 2, expected: `value`, err: ErrNoValidToken},
+		{data: `[1.]`, offset: 3, expected: `digit`, err: ErrInvalidNumber},
+		{data: "[\n  true,\n  tru]", offset: 15, expected: `true`, err: ErrInvalidArray},
+	} {
+		_, err := SkipValue([]byte(td.data), nil)
+		require.True(t, errors.Is(err, td.err), "%s: %v", td.data, err)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), td.data)
+		require.Equal(t, td.offset, syntaxErr.Offset, td.data)
+		require.Equal(t, td.expected, syntaxErr.Expected, td.data)
+		if td.offset < len(td.data) {
+			require.Equal(t, td.data[td.offset], syntaxErr.Byte, td.data)
+		} else {
+			require.Zero(t, syntaxErr.Byte, td.data)
+		}
+	}
+}
+
+func TestSyntaxError_handlerMachines(t *testing.T) {
+	t.Parallel()
+	_, err := HandleObjectValues([]byte(`{"a": 1 "b": 2}`), ObjectValueHandlerFunc(func(_, _ []byte) (int, error) {
+		return 0, nil
+	}), nil)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, ErrInvalidObject, syntaxErr.Err)
+	require.Equal(t, 8, syntaxErr.Offset)
+	require.Equal(t, `',' or '}'`, syntaxErr.Expected)
+
+	_, err = HandleArrayValues([]byte(`[1, [2 3]]`), ArrayValueHandlerFunc(func([]byte) (int, error) {
+		return 0, nil
+	}), nil)
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, ErrInvalidArray, syntaxErr.Err)
+	require.Equal(t, 7, syntaxErr.Offset)
+	require.Equal(t, `',' or ']'`, syntaxErr.Expected)
+}
+
+func TestSyntaxError_Error(t *testing.T) {
+	t.Parallel()
+	_, err := SkipValue([]byte(`[1 2]`), nil)
+	require.EqualError(t, err, `invalid json array at offset 3: expected ',' or ']' but found '2'`)
+	_, err = SkipValue([]byte(`[1, 2`), nil)
+	require.EqualError(t, err, `unexpected end of json at offset 5: expected ',' or ']' but found end of data`)
+}
+
+func TestSyntaxError_LineCol(t *testing.T) {
+	t.Parallel()
+	data := []byte("{\n  \"a\": [\n    1,\n    2 3\n  ]\n}")
+	_, err := SkipValue(data, nil)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	line, col := syntaxErr.LineCol(data)
+	require.Equal(t, 4, line)
+	require.Equal(t, 7, col)
+}
+
+func TestSyntaxError_nested(t *testing.T) {
+	t.Parallel()
+	data := []byte(`{"a": [1, 2], "b": {"c": [true, fals]}}`)
+
+	var handler ObjectValueHandlerFunc
+	handler = func(_, data []byte) (int, error) {
+		tknType, _, err := NextTokenType(data)
+		if err != nil {
+			return 0, err
+		}
+		if tknType == ObjectStartType {
+			return HandleObjectValues(data, handler, nil)
+		}
+		return SkipValue(data, nil)
+	}
+	_, err := HandleObjectValues(data, handler, nil)
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 36, syntaxErr.Offset)
+	require.Equal(t, byte(']'), syntaxErr.Byte)
+
+	// ValueReader reads false with ReadBool, so the error is at the start of the value
+	_, _, err = ReadValue(data)
+	require.True(t, errors.Is(err, ErrNotBool))
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 32, syntaxErr.Offset)
+
+	// Unmarshal reads interface values with a ValueReader
+	var v map[string]interface{}
+	err = Unmarshal(data, &v)
+	require.True(t, errors.Is(err, ErrNotBool))
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 32, syntaxErr.Offset)
+}
+
+func TestSyntaxError_handlerError(t *testing.T) {
+	t.Parallel()
+	data := []byte(`[true, "x", tru]`)
+	_, err := HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+		_, p, err := ReadBool(data)
+		return p, err
+	}), nil)
+	require.True(t, errors.Is(err, ErrNotBool))
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	require.Equal(t, 7, syntaxErr.Offset)
+	require.Equal(t, "true or false", syntaxErr.Expected)
+
+	doneErr := errors.New("done")
+	_, err = HandleArrayValues(data, ArrayValueHandlerFunc(func(data []byte) (int, error) {
+		return 0, doneErr
+	}), nil)
+	require.Equal(t, doneErr, err)
+}
+
+func TestToSyntaxError_allocs(t *testing.T) {
+	data := []byte(`{"a": 1}`)
+	doneErr := errors.New("done")
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = toSyntaxError(data, 6, doneErr, "")
+	})
+	require.Zero(t, allocs)
+}
+
+func TestSyntaxError_trailingData(t *testing.T) {
+	t.Parallel()
+	var v interface{}
+	err := Unmarshal([]byte(`{"a": 1} x`), &v)
+	require.True(t, errors.Is(err, ErrTrailingData))
+	require.EqualError(t, err, `unexpected data after json value at offset 9: expected end of data but found 'x'`)
+}
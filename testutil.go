@@ -1,6 +1,7 @@
 package rjson
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -53,6 +54,23 @@ func stdLibCompatibleValue(rjsonVal interface{}) interface{} {
 	}
 }
 
+// stdLibNumbers converts Number values in an rjson value to json.Number recursively.
+func stdLibNumbers(rjsonVal interface{}) interface{} {
+	switch v := rjsonVal.(type) {
+	case Number:
+		return json.Number(v)
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = stdLibNumbers(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = stdLibNumbers(val)
+		}
+	}
+	return rjsonVal
+}
+
 type multiPathErr []*pathErr
 
 func (m multiPathErr) Error() string {
@@ -111,6 +129,15 @@ func ifaceCompare(want, got interface{}, path []string) error {
 			return wrongValErr(path, wantVal, gotVal)
 		}
 		return nil
+	case json.Number:
+		gotVal, ok := got.(json.Number)
+		if !ok {
+			return wrongTypeErr(path, wantVal, got)
+		}
+		if wantVal != gotVal {
+			return wrongValErr(path, wantVal, gotVal)
+		}
+		return nil
 	case float64:
 		bVal, ok := got.(float64)
 		if !ok {
@@ -208,3 +235,101 @@ func dirtyStringBuffer() *[]byte {
 	buf := []byte(`this is a dirty buffer`)
 	return &buf
 }
+
+type unmarshalEmbedded struct {
+	E  string `json:"e"`
+	ID int
+}
+
+type unmarshalTarget struct {
+	*unmarshalEmbedded
+	A        string           `json:"a"`
+	B        int              `json:"b,omitempty"`
+	C        *float64         `json:"c"`
+	D        []string         `json:"d"`
+	M        map[string]int   `json:"m"`
+	IntKeys  map[int8]bool    `json:"int_keys"`
+	F        bool             `json:"f,string"`
+	G        int64            `json:"g,string"`
+	H        interface{}      `json:"h"`
+	I        [2]uint8         `json:"i"`
+	J        []byte           `json:"j"`
+	K        float32          `json:"k"`
+	L        uint16           `json:"l"`
+	N        json.Number      `json:"n"`
+	P        *unmarshalTarget `json:"p"`
+	S        *string          `json:"s,string"`
+	Ignored  string           `json:"-"`
+	Dash     string           `json:"-,"`
+	Tagless  string
+	Items    []unmarshalTarget     `json:"items"`
+	T        unmarshalText         `json:"t"`
+	TextKeys map[unmarshalText]int `json:"text_keys"`
+	R        unmarshalRaw          `json:"r"`
+	RP       *unmarshalRaw         `json:"rp"`
+	U        unmarshalJSON         `json:"u"`
+}
+
+// unmarshalText implements encoding.TextUnmarshaler.
+type unmarshalText string
+
+func (u *unmarshalText) UnmarshalText(text []byte) error {
+	if strings.HasPrefix(string(text), "bad") {
+		return errors.New("bad text")
+	}
+	*u = unmarshalText("text:" + string(text))
+	return nil
+}
+
+// unmarshalRaw implements Unmarshaler and json.Unmarshaler the same way so fuzzUnmarshal can compare them.
+type unmarshalRaw struct {
+	raw string
+}
+
+func (u *unmarshalRaw) UnmarshalRJSON(data []byte, buffer *Buffer) (int, error) {
+	p, err := SkipValue(data, buffer)
+	if err != nil {
+		return p, err
+	}
+	u.raw = string(data[:p])
+	return p, nil
+}
+
+func (u *unmarshalRaw) UnmarshalJSON(data []byte) error {
+	u.raw = string(data)
+	return nil
+}
+
+// unmarshalJSON implements json.Unmarshaler.
+type unmarshalJSON struct {
+	raw string
+}
+
+func (u *unmarshalJSON) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '[' {
+		return errors.New("no arrays")
+	}
+	u.raw = string(data)
+	return nil
+}
+
+// unmarshalTargets make new values for fuzzUnmarshal to unmarshal into.
+var unmarshalTargets = []func() interface{}{
+	func() interface{} { return new(interface{}) },
+	func() interface{} { return new(unmarshalTarget) },
+	func() interface{} { return new([]unmarshalTarget) },
+	func() interface{} { return new(map[string]*unmarshalTarget) },
+	func() interface{} { return new([]interface{}) },
+	func() interface{} { return new([]float64) },
+	func() interface{} { return new(map[string]string) },
+	func() interface{} { return new(int32) },
+	func() interface{} { return new(uint8) },
+	func() interface{} { return new(float32) },
+	func() interface{} { return new(string) },
+	func() interface{} { return new(bool) },
+	func() interface{} { return new(json.Number) },
+	func() interface{} { return new(unmarshalText) },
+	func() interface{} { return new(unmarshalRaw) },
+	func() interface{} { return new(*unmarshalJSON) },
+	func() interface{} { return new(map[unmarshalText]unmarshalJSON) },
+}
@@ -84,7 +84,7 @@ func NextToken(data []byte) (token byte, p int, err error) {
 		return data[0], 1, nil
 	}
 	if !whitespace[data[0]] {
-		return data[0], 1, errNoValidToken
+		return data[0], 1, ErrNoValidToken
 	}
 	p = countWhitespace(data)
 	if p >= len(data) {
@@ -92,7 +92,7 @@ func NextToken(data []byte) (token byte, p int, err error) {
 	}
 	b := data[p]
 	if tokenTypes[b] == InvalidType {
-		return b, p + 1, errNoValidToken
+		return b, p + 1, ErrNoValidToken
 	}
 	return b, p + 1, nil
 }
@@ -140,7 +140,7 @@ func nextTokenCompat(data []byte) (token byte, p int, err error) {
 	case nil:
 		return 'n', p - len(`null`) + 1, nil
 	}
-	return 0, p, errNoValidToken
+	return 0, p, ErrNoValidToken
 }
 
 // NextTokenType finds the first json token in data and returns its TokenType. p is the position in data immediately
@@ -211,3 +211,37 @@ func nextTokenTypeCompat(data []byte) (TokenType, int, error) {
 	}
 	return InvalidType, p, nil
 }
+
+// NextToken is like NextToken, but when buffer.JSONC is set it also skips comments before the token, and when
+// buffer.AllowNonFinite is set it accepts the N of NaN and the I of Infinity. buffer may be nil.
+func (buffer *Buffer) NextToken(data []byte) (token byte, p int, err error) {
+	if buffer == nil || (!buffer.JSONC && !buffer.AllowNonFinite) {
+		return NextToken(data)
+	}
+	p = buffer.countWhitespace(data)
+	token, pp, err := NextToken(data[p:])
+	p += pp
+	if err == ErrNoValidToken && buffer.AllowNonFinite {
+		if _, n := nonFiniteLiteral(data[p-1:]); n > 0 {
+			err = nil
+		}
+	}
+	return token, p, err
+}
+
+// NextTokenType is like NextTokenType, but when buffer.JSONC is set it also skips comments before the token, and when
+// buffer.AllowNonFinite is set NaN and Infinity are NumberType. buffer may be nil.
+func (buffer *Buffer) NextTokenType(data []byte) (TokenType, int, error) {
+	if buffer == nil || (!buffer.JSONC && !buffer.AllowNonFinite) {
+		return NextTokenType(data)
+	}
+	p := buffer.countWhitespace(data)
+	tp, pp, err := NextTokenType(data[p:])
+	p += pp
+	if tp == InvalidType && err == nil && buffer.AllowNonFinite {
+		if _, n := nonFiniteLiteral(data[p-1:]); n > 0 {
+			tp = NumberType
+		}
+	}
+	return tp, p, err
+}
@@ -0,0 +1,1099 @@
+package rjson
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	errUnmarshalTarget = fmt.Errorf("unmarshal target must be a non-nil pointer")
+
+	jsonNumberType      = reflect.TypeOf(json.Number(""))
+	numberType          = reflect.TypeOf(Number(""))
+	unmarshalerType     = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Unmarshaler is implemented by types that decode themselves from json. UnmarshalRJSON decodes the json value at the
+// beginning of data and returns the position after it the same way the Decode* functions do. data may hold more json
+// after the value. buffer is optional.
+//
+// Unmarshal and DecodeValue call UnmarshalRJSON when the target implements Unmarshaler. Like json.Unmarshaler, it is
+// called with null values too.
+type Unmarshaler interface {
+	UnmarshalRJSON(data []byte, buffer *Buffer) (p int, err error)
+}
+
+// UnmarshalTypeError describes a json value that can't be decoded into a Go type.
+type UnmarshalTypeError struct {
+	Value  string       // description of the json value - "bool", "array", "number -5"
+	Type   reflect.Type // type of the Go value it couldn't be decoded into
+	Offset int          // position of the json value in data
+	Struct string       // name of the struct type that has the field
+	Field  string       // dotted path of json field names from the root to the field that holds the value
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	if e.Struct != "" || e.Field != "" {
+		return "cannot unmarshal " + e.Value + " into Go struct field " + e.Struct + "." + e.Field + " of type " +
+			e.Type.String()
+	}
+	return "cannot unmarshal " + e.Value + " into Go value of type " + e.Type.String()
+}
+
+// Unmarshal decodes the json value in data into the value pointed to by v. It follows the same rules as
+// json.Unmarshal including struct tags, embedded structs, the "string" tag option and case-insensitive field name
+// matching.
+//
+// The first time Unmarshal sees a type, it uses reflection to build a plan for decoding it. The plan is cached and
+// reused for every later value of that type.
+//
+// Values that implement Unmarshaler are decoded with UnmarshalRJSON. Otherwise values that implement json.Unmarshaler
+// are handed exactly the bytes of their json value, and values that implement encoding.TextUnmarshaler are handed the
+// contents of json strings. Number and json.Number values hold the literal text of json numbers.
+//
+// Like json.Unmarshal, when a value can't be decoded into its Go type, Unmarshal skips it, decodes the rest of data and
+// then returns an *UnmarshalTypeError for the first such value. Unlike json.Unmarshal, data is validated while it is
+// decoded instead of before, so v may be partially decoded when data isn't valid json.
+func Unmarshal(data []byte, v interface{}) error {
+	d := decodeStatePool.Get().(*decodeState)
+	defer decodeStatePool.Put(d)
+	p, err := d.unmarshal(data, v)
+	if err != nil {
+		return toSyntaxError(data, p, err, "")
+	}
+	p += countWhitespace(data[p:])
+	if p != len(data) {
+		return toSyntaxError(data, p, ErrTrailingData, "")
+	}
+	return d.takeTypeError()
+}
+
+// DecodeValue decodes the first json value in data into the value pointed to by v and returns the position after the
+// value. It decodes the same way Unmarshal does, so it can be used in handlers to decode values into types that
+// implement json.Unmarshaler or encoding.TextUnmarshaler, or that would otherwise need to be decoded by hand.
+//
+// When v implements Unmarshaler, DecodeValue returns the result of v.UnmarshalRJSON. Otherwise it returns the first
+// *UnmarshalTypeError after decoding the rest of the value like Unmarshal does. buffer is optional.
+func DecodeValue(data []byte, v interface{}, buffer *Buffer) (p int, err error) {
+	if u, ok := v.(Unmarshaler); ok {
+		return u.UnmarshalRJSON(data, buffer)
+	}
+	d := decodeStatePool.Get().(*decodeState)
+	defer decodeStatePool.Put(d)
+	p, err = d.unmarshal(data, v)
+	if err != nil {
+		return p, toSyntaxError(data, p, err, "")
+	}
+	return p, d.takeTypeError()
+}
+
+// unmarshal decodes the json value at the beginning of data into v and returns the position after the value. Type
+// errors are kept in d.typeErr for takeTypeError.
+func (d *decodeState) unmarshal(data []byte, v interface{}) (int, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return 0, errUnmarshalTarget
+	}
+	d.dataLen = len(data)
+	d.depth = 0
+	d.fields = d.fields[:0]
+	d.structType = nil
+	d.typeErr = nil
+	p := countWhitespace(data)
+	if p == len(data) {
+		return p, ErrNoValidToken
+	}
+	n, err := typeDecoder(rv.Type().Elem())(d, data[p:], rv.Elem())
+	d.clearLevels()
+	return p + n, err
+}
+
+// takeTypeError returns the first type error from the last unmarshal and clears it so the pool doesn't hold on to it.
+func (d *decodeState) takeTypeError() error {
+	if d.typeErr == nil {
+		return nil
+	}
+	err := d.typeErr
+	d.typeErr = nil
+	return err
+}
+
+var decodeStatePool = sync.Pool{
+	New: func() interface{} {
+		return new(decodeState)
+	},
+}
+
+// decodeFunc decodes the value at the beginning of data into v. data has no leading whitespace and isn't empty. The
+// value is validated as it is decoded.
+type decodeFunc func(d *decodeState, data []byte, v reflect.Value) (p int, err error)
+
+var decoderCache sync.Map // map[reflect.Type]decodeFunc
+
+// typeDecoder returns the cached decodeFunc for t, building it if needed.
+func typeDecoder(t reflect.Type) decodeFunc {
+	if fn, ok := decoderCache.Load(t); ok {
+		return fn.(decodeFunc)
+	}
+
+	// Store a placeholder that waits for the real decoder so recursive types can refer to themselves.
+	var wg sync.WaitGroup
+	var fn decodeFunc
+	wg.Add(1)
+	placeholder, loaded := decoderCache.LoadOrStore(t, decodeFunc(func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+		wg.Wait()
+		return fn(d, data, v)
+	}))
+	if loaded {
+		return placeholder.(decodeFunc)
+	}
+	fn = newTypeDecoder(t)
+	wg.Done()
+	decoderCache.Store(t, fn)
+	return fn
+}
+
+func newTypeDecoder(t reflect.Type) decodeFunc {
+	kindDecoder := newKindDecoder(t)
+	// Like encoding/json, only named types are checked for unmarshalers here. Pointers to unnamed types are checked
+	// by newPtrDecoder.
+	if t.Kind() != reflect.Ptr && t.Name() != "" {
+		fn := newUnmarshalerDecoder(reflect.PtrTo(t), kindDecoder)
+		if fn != nil {
+			return fn
+		}
+	}
+	return kindDecoder
+}
+
+// newKindDecoder returns a decodeFunc for t based on its kind without checking for unmarshalers.
+func newKindDecoder(t reflect.Type) decodeFunc {
+	switch t.Kind() {
+	case reflect.Bool:
+		return decodeBoolValue
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return decodeIntValue
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return decodeUintValue
+	case reflect.Float32, reflect.Float64:
+		return decodeFloatValue
+	case reflect.String:
+		if t == jsonNumberType || t == numberType {
+			return decodeJSONNumberValue
+		}
+		return decodeStringValue
+	case reflect.Interface:
+		return decodeInterfaceValue
+	case reflect.Ptr:
+		return newPtrDecoder(t)
+	case reflect.Struct:
+		return newStructDecoder(t)
+	case reflect.Map:
+		return newMapDecoder(t)
+	case reflect.Slice, reflect.Array:
+		return newArrayDecoder(t)
+	default:
+		return decodeUnsupportedValue
+	}
+}
+
+type decodeState struct {
+	buffer      Buffer
+	dataLen     int
+	levels      []*decodeLevel
+	depth       int
+	fields      []string
+	structType  reflect.Type // struct type that has the field being decoded
+	strBuf      []byte
+	keyBuf      []byte
+	foldBuf     []byte
+	quoteBuf    []byte
+	valueReader ValueReader
+	typeErr     *UnmarshalTypeError
+	strs        *stringCache
+}
+
+// stringCache holds short strings from earlier values so decoding the same strings again doesn't allocate. It is kept
+// in the pooled decodeState. encoding/json has a cache like it.
+type stringCache [256]string
+
+// maxCachedString is the length of the longest string that is cached.
+const maxCachedString = 16
+
+// str returns b as a string. Short strings come from d.strs when they were seen before.
+func (d *decodeState) str(b []byte) string {
+	if len(b) == 0 || len(b) > maxCachedString {
+		return string(b)
+	}
+	if d.strs == nil {
+		d.strs = new(stringCache)
+	}
+	h := uint32(2166136261)
+	for _, c := range b {
+		h = (h ^ uint32(c)) * 16777619
+	}
+	i := h % uint32(len(d.strs))
+	if d.strs[i] != string(b) {
+		d.strs[i] = string(b)
+	}
+	return d.strs[i]
+}
+
+// decodeLevel is the handler for the object or array being decoded at one depth.
+type decodeLevel struct {
+	d       *decodeState
+	buffer  Buffer
+	v       reflect.Value
+	plan    *structPlan
+	elem    reflect.Value
+	key     reflect.Value
+	textKey bool
+	elemDec decodeFunc
+	count   int
+}
+
+// level returns the handler for a new object or array. Nothing else checks the depth because each level is read by its
+// own call to HandleObjectValues or HandleArrayValues.
+func (d *decodeState) level() (*decodeLevel, error) {
+	if d.depth == skipMaxDepth {
+		return nil, ErrMaxDepth
+	}
+	if d.depth == len(d.levels) {
+		d.levels = append(d.levels, &decodeLevel{d: d})
+	}
+	l := d.levels[d.depth]
+	l.buffer.depth = d.depth
+	d.depth++
+	return l, nil
+}
+
+func (d *decodeState) release() {
+	d.depth--
+}
+
+// clearLevels drops the references levels hold to decoded values so a pooled decodeState doesn't keep them alive.
+func (d *decodeState) clearLevels() {
+	for _, l := range d.levels {
+		l.v, l.plan, l.elemDec = reflect.Value{}, nil, nil
+		if l.elem.IsValid() {
+			l.elem.Set(reflect.Zero(l.elem.Type()))
+			l.key.Set(reflect.Zero(l.key.Type()))
+		}
+	}
+}
+
+// skipTypeError records a type error for the value at the beginning of data unless there already is one and skips
+// the value. Like encoding/json, decoding continues after a type error.
+func (d *decodeState) skipTypeError(data []byte, t reflect.Type) (int, error) {
+	if d.typeErr == nil {
+		d.typeErr = d.typeError(data, t)
+	}
+	d.buffer.depth = d.depth
+	return SkipValue(data, &d.buffer)
+}
+
+func (d *decodeState) typeError(data []byte, t reflect.Type) *UnmarshalTypeError {
+	var value string
+	switch data[0] {
+	case '"':
+		value = "string"
+	case '{':
+		value = "object"
+	case '[':
+		value = "array"
+	case 't', 'f':
+		value = "bool"
+	case 'n':
+		value = "null"
+	default:
+		p, _ := SkipValueFast(data, nil)
+		value = "number " + string(data[:p])
+	}
+	typeErr := &UnmarshalTypeError{
+		Value:  value,
+		Type:   t,
+		Offset: d.dataLen - len(data),
+		Field:  strings.Join(d.fields, "."),
+	}
+	if d.structType != nil {
+		typeErr.Struct = d.structType.Name()
+	}
+	return typeErr
+}
+
+func decodeBoolValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch data[0] {
+	case 't', 'f':
+		val, p, err := ReadBool(data)
+		if err != nil {
+			return p, err
+		}
+		v.SetBool(val)
+		return p, nil
+	case 'n':
+		return ReadNull(data)
+	default:
+		return d.skipTypeError(data, v.Type())
+	}
+}
+
+func decodeIntValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch c := data[0]; {
+	case c == 'n':
+		return ReadNull(data)
+	case c != '-' && (c < '0' || c > '9'):
+		return d.skipTypeError(data, v.Type())
+	}
+	val, p, err := ReadInt64(data)
+	if err != nil || v.OverflowInt(val) {
+		return d.skipTypeError(data, v.Type())
+	}
+	v.SetInt(val)
+	return p, nil
+}
+
+func decodeUintValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch c := data[0]; {
+	case c == 'n':
+		return ReadNull(data)
+	case c != '-' && (c < '0' || c > '9'):
+		return d.skipTypeError(data, v.Type())
+	}
+	val, p, err := ReadUint64(data)
+	if err != nil || v.OverflowUint(val) {
+		return d.skipTypeError(data, v.Type())
+	}
+	v.SetUint(val)
+	return p, nil
+}
+
+func decodeFloatValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch c := data[0]; {
+	case c == 'n':
+		return ReadNull(data)
+	case c != '-' && (c < '0' || c > '9'):
+		return d.skipTypeError(data, v.Type())
+	}
+	if v.Kind() == reflect.Float32 {
+		val, p, err := ReadFloat32(data)
+		if err != nil {
+			return d.floatRangeError(data, v)
+		}
+		v.SetFloat(float64(val))
+		return p, nil
+	}
+	val, p, err := ReadFloat64(data)
+	if err != nil {
+		return d.floatRangeError(data, v)
+	}
+	v.SetFloat(val)
+	return p, nil
+}
+
+// floatRangeError handles a number that ReadFloat32 or ReadFloat64 couldn't read. When it is out of range, v is set to
+// the infinity with its sign and a type error is recorded like encoding/json does.
+func (d *decodeState) floatRangeError(data []byte, v reflect.Value) (int, error) {
+	raw, p, err := ReadNumberBytes(data)
+	if err != nil {
+		return p, err
+	}
+	val, _ := strconv.ParseFloat(string(raw), v.Type().Bits())
+	v.SetFloat(val)
+	if d.typeErr == nil {
+		d.typeErr = d.typeError(data, v.Type())
+	}
+	return p, nil
+}
+
+func decodeStringValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch data[0] {
+	case '"':
+		var p int
+		var err error
+		d.strBuf, p, err = ReadStringBytes(data, d.strBuf[:0])
+		if err != nil {
+			return p, err
+		}
+		v.SetString(d.str(d.strBuf))
+		return p, nil
+	case 'n':
+		return ReadNull(data)
+	default:
+		return d.skipTypeError(data, v.Type())
+	}
+}
+
+func decodeJSONNumberValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	switch c := data[0]; {
+	case c == 'n':
+		return ReadNull(data)
+	case c == '"':
+		var err error
+		var p int
+		d.strBuf, p, err = ReadStringBytes(data, d.strBuf[:0])
+		if err != nil {
+			return p, err
+		}
+		if !isValidNumber(d.strBuf) {
+			return 0, fmt.Errorf("invalid number literal, trying to unmarshal %q into Number", data[:p])
+		}
+		v.SetString(string(d.strBuf))
+		return p, nil
+	case c == '-' || c >= '0' && c <= '9':
+		raw, p, err := ReadNumberBytes(data)
+		if err != nil {
+			return p, err
+		}
+		v.SetString(string(raw))
+		return p, nil
+	default:
+		return d.skipTypeError(data, v.Type())
+	}
+}
+
+// isValidNumber returns true if data is exactly one json number.
+func isValidNumber(data []byte) bool {
+	_, ok := scanNumberLiteral(data)
+	return ok
+}
+
+func decodeInterfaceValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	if !v.IsNil() {
+		// decode into a non-nil pointer held by the interface like encoding/json does
+		e := v.Elem()
+		if e.Kind() == reflect.Ptr && !e.IsNil() && (data[0] != 'n' || e.Elem().Kind() == reflect.Ptr) {
+			e = e.Elem()
+			return typeDecoder(e.Type())(d, data, e)
+		}
+	}
+	if data[0] == 'n' {
+		v.Set(reflect.Zero(v.Type()))
+		return ReadNull(data)
+	}
+	if v.NumMethod() != 0 {
+		return d.skipTypeError(data, v.Type())
+	}
+	// the value reader counts its depth from the depth of the value
+	d.valueReader.depth = d.depth
+	if d.valueReader.rangeError == nil {
+		d.valueReader.rangeError = d.interfaceRangeError
+	}
+	val, p, err := d.valueReader.ReadValue(data)
+	d.valueReader.depth = 0
+	if err != nil {
+		return p, err
+	}
+	v.Set(reflect.ValueOf(val))
+	return p, nil
+}
+
+// interfaceRangeError records a type error for a number out of float64 range that the ValueReader read as an infinity
+// in an interface{} value.
+func (d *decodeState) interfaceRangeError(data []byte) {
+	if d.typeErr == nil {
+		d.typeErr = d.typeError(data, reflect.TypeOf(float64(0)))
+	}
+}
+
+func decodeUnsupportedValue(d *decodeState, data []byte, v reflect.Value) (int, error) {
+	if data[0] == 'n' {
+		return ReadNull(data)
+	}
+	return d.skipTypeError(data, v.Type())
+}
+
+// newUnmarshalerDecoder returns a decodeFunc that decodes with the unmarshaler methods of pt, which is a pointer to the
+// type being decoded. kindDecoder is used for nulls that a TextUnmarshaler doesn't handle. newUnmarshalerDecoder
+// returns nil when pt implements none of Unmarshaler, json.Unmarshaler or encoding.TextUnmarshaler.
+func newUnmarshalerDecoder(pt reflect.Type, kindDecoder decodeFunc) decodeFunc {
+	switch {
+	case pt.Implements(unmarshalerType):
+		return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+			d.buffer.depth = d.depth
+			return v.Addr().Interface().(Unmarshaler).UnmarshalRJSON(data, &d.buffer)
+		}
+	case pt.Implements(jsonUnmarshalerType):
+		return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+			d.buffer.depth = d.depth
+			p, err := SkipValue(data, &d.buffer)
+			if err != nil {
+				return p, err
+			}
+			return p, v.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(data[:p])
+		}
+	case pt.Implements(textUnmarshalerType):
+		return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+			switch data[0] {
+			case '"':
+			case 'n':
+				return kindDecoder(d, data, v)
+			default:
+				return d.skipTypeError(data, v.Type())
+			}
+			var p int
+			var err error
+			d.strBuf, p, err = ReadStringBytes(data, d.strBuf[:0])
+			if err != nil {
+				return p, err
+			}
+			return p, v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText(d.strBuf)
+		}
+	default:
+		return nil
+	}
+}
+
+func newPtrDecoder(t reflect.Type) decodeFunc {
+	elemDecoder := typeDecoder(t.Elem())
+	if t.Elem().Name() == "" {
+		// A pointer to an unnamed struct can have methods promoted from embedded fields.
+		fn := newUnmarshalerDecoder(t, elemDecoder)
+		if fn != nil {
+			elemDecoder = fn
+		}
+	}
+	return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+		if data[0] == 'n' {
+			v.Set(reflect.Zero(t))
+			return ReadNull(data)
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(t.Elem()))
+		}
+		return elemDecoder(d, data, v.Elem())
+	}
+}
+
+func newArrayDecoder(t reflect.Type) decodeFunc {
+	elemDecoder := typeDecoder(t.Elem())
+	isSlice := t.Kind() == reflect.Slice
+	isBytes := isSlice && t.Elem().Kind() == reflect.Uint8
+	return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+		switch data[0] {
+		case '[':
+		case 'n':
+			if isSlice {
+				v.Set(reflect.Zero(t))
+			}
+			return ReadNull(data)
+		case '"':
+			if isBytes {
+				return d.decodeBase64(data, v)
+			}
+			return d.skipTypeError(data, t)
+		default:
+			return d.skipTypeError(data, t)
+		}
+		l, err := d.level()
+		if err != nil {
+			return 0, err
+		}
+		l.v, l.plan, l.elemDec, l.count = v, nil, elemDecoder, 0
+		p, err := HandleArrayValues(data, l, &l.buffer)
+		count := l.count
+		d.release()
+		if err != nil {
+			return p, err
+		}
+		switch {
+		case !isSlice:
+			zero := reflect.Zero(t.Elem())
+			for i := count; i < v.Len(); i++ {
+				v.Index(i).Set(zero)
+			}
+		case count == 0:
+			v.Set(reflect.MakeSlice(t, 0, 0))
+		case count < v.Len():
+			v.SetLen(count)
+		}
+		return p, nil
+	}
+}
+
+func (d *decodeState) decodeBase64(data []byte, v reflect.Value) (int, error) {
+	var err error
+	var p int
+	d.strBuf, p, err = ReadStringBytes(data, d.strBuf[:0])
+	if err != nil {
+		return p, err
+	}
+	b := make([]byte, base64.StdEncoding.DecodedLen(len(d.strBuf)))
+	n, err := base64.StdEncoding.Decode(b, d.strBuf)
+	if err != nil {
+		return 0, err
+	}
+	v.SetBytes(b[:n])
+	return p, nil
+}
+
+// HandleArrayValue implements ArrayValueHandler.HandleArrayValue
+func (l *decodeLevel) HandleArrayValue(data []byte) (int, error) {
+	ws := countWhitespace(data)
+	data = data[ws:]
+	i := l.count
+	l.count++
+	v := l.v
+	if v.Kind() == reflect.Slice {
+		if i >= v.Cap() {
+			newCap := v.Cap() + v.Cap()/2
+			if newCap < 4 {
+				newCap = 4
+			}
+			newV := reflect.MakeSlice(v.Type(), v.Len(), newCap)
+			reflect.Copy(newV, v)
+			v.Set(newV)
+		}
+		if i >= v.Len() {
+			v.SetLen(i + 1)
+		}
+	}
+	if i >= v.Len() {
+		return 0, nil
+	}
+	p, err := l.elemDec(l.d, data, v.Index(i))
+	return ws + p, err
+}
+
+func newMapDecoder(t reflect.Type) decodeFunc {
+	switch t.Key().Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	default:
+		if !reflect.PtrTo(t.Key()).Implements(textUnmarshalerType) {
+			return decodeUnsupportedValue
+		}
+	}
+	elemDecoder := typeDecoder(t.Elem())
+	textKey := reflect.PtrTo(t.Key()).Implements(textUnmarshalerType)
+	return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+		switch data[0] {
+		case '{':
+		case 'n':
+			v.Set(reflect.Zero(t))
+			return ReadNull(data)
+		default:
+			return d.skipTypeError(data, t)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(t))
+		}
+		l, err := d.level()
+		if err != nil {
+			return 0, err
+		}
+		l.v, l.plan, l.elemDec, l.textKey = v, nil, elemDecoder, textKey
+		if !l.elem.IsValid() || l.elem.Type() != t.Elem() {
+			l.elem = reflect.New(t.Elem()).Elem()
+		}
+		if !l.key.IsValid() || l.key.Type() != t.Key() {
+			l.key = reflect.New(t.Key()).Elem()
+		}
+		p, err := HandleObjectValues(data, l, &l.buffer)
+		d.release()
+		return p, err
+	}
+}
+
+// HandleObjectValue implements ObjectValueHandler.HandleObjectValue
+func (l *decodeLevel) HandleObjectValue(fieldname, data []byte) (int, error) {
+	d := l.d
+	ws := countWhitespace(data)
+	data = data[ws:]
+	if bytes.IndexByte(fieldname, '\\') != -1 {
+		var err error
+		d.keyBuf, _, err = UnescapeStringContent(fieldname, d.keyBuf[:0])
+		if err != nil {
+			return 0, err
+		}
+		fieldname = d.keyBuf
+	}
+	var p int
+	var err error
+	if l.plan != nil {
+		p, err = l.decodeField(fieldname, data)
+	} else {
+		p, err = l.decodeMapEntry(fieldname, data)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if p == 0 {
+		return 0, nil
+	}
+	return ws + p, nil
+}
+
+func (l *decodeLevel) decodeMapEntry(key, data []byte) (int, error) {
+	d := l.d
+	l.elem.Set(reflect.Zero(l.elem.Type()))
+	p, err := l.elemDec(d, data, l.elem)
+	if err != nil {
+		return 0, err
+	}
+	kv := l.key
+	switch kind := kv.Kind(); {
+	case l.textKey:
+		kv.Set(reflect.Zero(kv.Type()))
+		err = kv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText(key)
+		if err != nil {
+			return 0, err
+		}
+	case kind == reflect.String:
+		kv.SetString(d.str(key))
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		n, err := strconv.ParseInt(string(key), 10, 64)
+		if err != nil || kv.OverflowInt(n) {
+			d.keyTypeError(key, kv.Type(), data)
+			return p, nil
+		}
+		kv.SetInt(n)
+	default:
+		n, err := strconv.ParseUint(string(key), 10, 64)
+		if err != nil || kv.OverflowUint(n) {
+			d.keyTypeError(key, kv.Type(), data)
+			return p, nil
+		}
+		kv.SetUint(n)
+	}
+	l.v.SetMapIndex(kv, l.elem)
+	return p, nil
+}
+
+// keyTypeError records a type error for a map key that isn't a number of the key type. The entry is left out of the
+// map.
+func (d *decodeState) keyTypeError(key []byte, t reflect.Type, data []byte) {
+	if d.typeErr == nil {
+		d.typeErr = &UnmarshalTypeError{Value: "number " + string(key), Type: t, Offset: d.dataLen - len(data)}
+	}
+}
+
+// structPlan is the cached plan for decoding a struct type.
+type structPlan struct {
+	fields []structField
+	exact  map[string]*structField
+	folded map[string]*structField
+}
+
+type structField struct {
+	name    string
+	index   []int
+	typ     reflect.Type
+	tagged  bool
+	quoted  bool
+	decoder decodeFunc
+}
+
+func newStructDecoder(t reflect.Type) decodeFunc {
+	plan := newStructPlan(t)
+	return func(d *decodeState, data []byte, v reflect.Value) (int, error) {
+		switch data[0] {
+		case '{':
+		case 'n':
+			return ReadNull(data)
+		default:
+			return d.skipTypeError(data, t)
+		}
+		l, err := d.level()
+		if err != nil {
+			return 0, err
+		}
+		l.v, l.plan = v, plan
+		p, err := HandleObjectValues(data, l, &l.buffer)
+		d.release()
+		return p, err
+	}
+}
+
+func (l *decodeLevel) decodeField(name, data []byte) (int, error) {
+	d := l.d
+	f := l.plan.exact[string(name)]
+	if f == nil {
+		d.foldBuf = appendFoldedName(d.foldBuf[:0], name)
+		f = l.plan.folded[string(d.foldBuf)]
+	}
+	if f == nil {
+		return 0, nil
+	}
+	v, err := fieldByIndex(l.v, f.index)
+	if err != nil {
+		return 0, err
+	}
+	d.fields = append(d.fields, f.name)
+	structType := d.structType
+	d.structType = l.v.Type()
+	var p int
+	if f.quoted {
+		p, err = d.decodeQuoted(f, data, v)
+	} else {
+		p, err = f.decoder(d, data, v)
+	}
+	d.fields = d.fields[:len(d.fields)-1]
+	d.structType = structType
+	return p, err
+}
+
+// fieldByIndex returns the field of v at index. It allocates embedded pointers that are nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return v, fmt.Errorf("cannot set embedded pointer to unexported struct: %v", v.Type().Elem())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, nil
+}
+
+// decodeQuoted decodes a value for a field with the "string" option.
+func (d *decodeState) decodeQuoted(f *structField, data []byte, v reflect.Value) (int, error) {
+	switch data[0] {
+	case 'n':
+		return f.decoder(d, data, v)
+	case '"':
+	default:
+		return 0, fmt.Errorf("invalid use of ,string struct tag, trying to unmarshal unquoted value into %v", v.Type())
+	}
+	var err error
+	var p int
+	d.quoteBuf, p, err = ReadStringBytes(data, d.quoteBuf[:0])
+	if err != nil {
+		return p, err
+	}
+	content := d.quoteBuf
+	pp := -1
+	typeErr := d.typeErr
+	if len(content) > 0 && !whitespace[content[0]] {
+		var tknType TokenType
+		tknType, _, err = NextTokenType(content)
+		if err == nil && tknType != ObjectStartType && tknType != ArrayStartType {
+			pp, err = f.decoder(d, content, v)
+		}
+	}
+	// a type error in the quoted value is reported as a misuse of the tag
+	typeErrInContent := d.typeErr != typeErr
+	d.typeErr = typeErr
+	if err != nil || pp != len(content) || typeErrInContent {
+		return 0, fmt.Errorf("invalid use of ,string struct tag, trying to unmarshal %q into %v", content, v.Type())
+	}
+	return p, nil
+}
+
+// newStructPlan finds the fields of t the same way encoding/json does.
+func newStructPlan(t reflect.Type) *structPlan {
+	type queued struct {
+		typ   reflect.Type
+		index []int
+	}
+	var fields []structField
+	var current []queued
+	next := []queued{{typ: t}}
+	var count, nextCount map[reflect.Type]int
+	visited := map[reflect.Type]bool{}
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+		for _, q := range current {
+			if visited[q.typ] {
+				continue
+			}
+			visited[q.typ] = true
+			for i := 0; i < q.typ.NumField(); i++ {
+				sf := q.typ.Field(i)
+				exported := sf.PkgPath == ""
+				if sf.Anonymous {
+					ft := sf.Type
+					if ft.Kind() == reflect.Ptr {
+						ft = ft.Elem()
+					}
+					if !exported && ft.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !exported {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts := tag, ""
+				if i := strings.IndexByte(tag, ','); i != -1 {
+					name, opts = tag[:i], tag[i:]
+				}
+				if !isValidTag(name) {
+					name = ""
+				}
+				index := make([]int, len(q.index)+1)
+				copy(index, q.index)
+				index[len(q.index)] = i
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if name == "" && sf.Anonymous && ft.Kind() == reflect.Struct {
+					nextCount[ft]++
+					if nextCount[ft] == 1 {
+						next = append(next, queued{typ: ft, index: index})
+					}
+					continue
+				}
+				quoted := false
+				if strings.Contains(opts+",", ",string,") {
+					switch ft.Kind() {
+					case reflect.Bool,
+						reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+						reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+						reflect.Float32, reflect.Float64,
+						reflect.String:
+						quoted = true
+					}
+				}
+				f := structField{
+					name:   name,
+					index:  index,
+					typ:    sf.Type,
+					tagged: name != "",
+					quoted: quoted,
+				}
+				if f.name == "" {
+					f.name = sf.Name
+				}
+				fields = append(fields, f)
+				if count[q.typ] > 1 {
+					// a second copy makes the field conflict with itself so it is dropped
+					fields = append(fields, f)
+				}
+			}
+		}
+	}
+	fields = dominantStructFields(fields)
+	plan := &structPlan{
+		fields: fields,
+		exact:  make(map[string]*structField, len(fields)),
+		folded: make(map[string]*structField, len(fields)),
+	}
+	for i := range fields {
+		f := &fields[i]
+		f.decoder = typeDecoder(f.typ)
+		plan.exact[f.name] = f
+		folded := string(appendFoldedName(nil, []byte(f.name)))
+		if _, ok := plan.folded[folded]; !ok {
+			plan.folded[folded] = f
+		}
+	}
+	return plan
+}
+
+// dominantStructFields removes fields that are hidden by Go's rules for embedded fields as modified by json tags. The
+// result is in index order.
+func dominantStructFields(fields []structField) []structField {
+	byName := map[string][]int{}
+	var names []string
+	for i, f := range fields {
+		if byName[f.name] == nil {
+			names = append(names, f.name)
+		}
+		byName[f.name] = append(byName[f.name], i)
+	}
+	var out []structField
+	for _, name := range names {
+		idxs := byName[name]
+		best := idxs[0]
+		conflict := false
+		for _, i := range idxs[1:] {
+			a, b := fields[i], fields[best]
+			switch {
+			case len(a.index) < len(b.index), len(a.index) == len(b.index) && a.tagged && !b.tagged:
+				best, conflict = i, false
+			case len(a.index) == len(b.index) && a.tagged == b.tagged:
+				conflict = true
+			}
+		}
+		if !conflict {
+			out = append(out, fields[best])
+		}
+	}
+	sortFieldsByIndex(out)
+	return out
+}
+
+func sortFieldsByIndex(fields []structField) {
+	less := func(a, b []int) bool {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if a[i] != b[i] {
+				return a[i] < b[i]
+			}
+		}
+		return len(a) < len(b)
+	}
+	for i := 1; i < len(fields); i++ {
+		for j := i; j > 0 && less(fields[j].index, fields[j-1].index); j-- {
+			fields[j], fields[j-1] = fields[j-1], fields[j]
+		}
+	}
+}
+
+func isValidTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+			// backslash and quote are reserved, but other punctuation is allowed
+		case !unicode.IsLetter(c) && !unicode.IsDigit(c):
+			return false
+		}
+	}
+	return true
+}
+
+// appendFoldedName appends a case-folded version of name to dst so that two names that are equal under simple
+// Unicode case folding produce the same result.
+func appendFoldedName(dst, name []byte) []byte {
+	for i := 0; i < len(name); {
+		c := name[i]
+		if c < utf8.RuneSelf {
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			dst = append(dst, c)
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRune(name[i:])
+		var runeBuf [utf8.UTFMax]byte
+		w := utf8.EncodeRune(runeBuf[:], foldRune(r))
+		dst = append(dst, runeBuf[:w]...)
+		i += n
+	}
+	return dst
+}
+
+// foldRune returns the smallest rune in r's case folding orbit.
+func foldRune(r rune) rune {
+	for {
+		r2 := unicode.SimpleFold(r)
+		if r2 <= r {
+			return r2
+		}
+		r = r2
+	}
+}
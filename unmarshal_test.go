@@ -0,0 +1,341 @@
+package rjson
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshal_matchesStdLib(t *testing.T) {
+	t.Parallel()
+	testFuzzerWithInput(t, fuzzUnmarshal,
+		`{"a": "x", "b": 1, "c": 1.5, "d": ["a", "b"], "m": {"x": 1, "y": 2}, "int_keys": {"-1": true, "2": false}}`,
+		`{"A": "upper", "B": 2, "TAGLESS": "folded", "tagless": "folded again"}`,
+		`{"f": "true", "g": "-12", "s": "\"quoted\"", "n": "1e3"}`,
+		`{"f": true}`,
+		`{"g": "1.5"}`,
+		`{"g": " 1"}`,
+		`{"g": "1 "}`,
+		`{"g": null, "s": null, "f": "null"}`,
+		`{"s": "null"}`,
+		`{"s": "\"\\u00e9\""}`,
+		`{"h": {"a": [1, "b", null, true, {}]}, "i": [1, 2, 3], "j": "aGVsbG8=", "k": 3.4028235e38, "l": 65535}`,
+		`{"i": [1]}`,
+		`{"j": "not base64"}`,
+		`{"j": [1, 2]}`,
+		`{"k": 1e39}`,
+		`{"l": 65536}`,
+		`{"l": -1}`,
+		`{"b": 1.0}`,
+		`{"b": 1e2}`,
+		`{"n": 12.5e-3}`,
+		`{"n": "abc"}`,
+		`{"p": {"p": {"a": "deep"}}, "items": [{"a": "x"}, {"b": 2, "items": []}]}`,
+		`{"p": null, "items": null, "d": null, "m": null, "c": null, "h": null}`,
+		`{"-": "dash", "Ignored": "ignored", "Dash": "not dash"}`,
+		`{"e": "embedded pointer to unexported struct"}`,
+		`{"a\u0041": "escaped", "\u0061": "escaped key"}`,
+		`{"int_keys": {"128": true}}`,
+		`{"int_keys": {"x": true}}`,
+		`{"a": 1}`,
+		`{"d": [1]}`,
+		`{"m": []}`,
+		`[{"a": "x"}, null, {"b": 2}]`,
+		`{"x": {"a": "1"}, "y": null}`,
+		`[1, 2.5, -3e2]`,
+		`"string"`,
+		`true`,
+		`null`,
+		`123`,
+		`-129`,
+		`256`,
+		`3.5`,
+		`{}`,
+		`[]`,
+		` {"a": "whitespace"} `,
+		`{"a": "x"} {"a": "y"}`,
+		`{"a": "x",}`,
+		`{"t": "x", "text_keys": {"a": 1, "b\u0032": 2}, "r": [1, {"a": null}], "rp": "x", "u": {"a": 1}}`,
+		`{"t": null, "r": null, "rp": null, "u": null}`,
+		`{"t": 1}`,
+		`{"t": {}}`,
+		`{"t": "bad"}`,
+		`{"text_keys": {"bad": 1}}`,
+		`{"u": [1]}`,
+		`{"x": {"a": 1}, "y": "2", "z": null}`,
+		`"bad"`,
+		``,
+		`1e400`,
+		`[1, -1e400, 2]`,
+		`{"h": {"a": [1e400, "b"], "b": {"c": 1e400}}, "a": "x"}`,
+		`{"c": 1e400}`,
+	)
+}
+
+type unmarshalConflicts struct {
+	unmarshalConflictA
+	unmarshalConflictB
+	Tagged string `json:"tagged"`
+}
+
+type unmarshalConflictA struct {
+	Same   string
+	Tagged string
+	OnlyA  string
+}
+
+type unmarshalConflictB struct {
+	Same  string
+	Other string `json:"Tagged"`
+	OnlyB string
+}
+
+type unmarshalRecursive struct {
+	Name     string                `json:"name"`
+	Children []*unmarshalRecursive `json:"children"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("embedded conflicts", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"Same": "same", "tagged": "tagged", "Tagged": "Tagged", "OnlyA": "a", "OnlyB": "b"}`)
+		var want, got unmarshalConflicts
+		require.NoError(t, json.Unmarshal(data, &want))
+		require.NoError(t, Unmarshal(data, &got))
+		require.Equal(t, want, got)
+		require.Equal(t, "", got.unmarshalConflictA.Same)
+		require.Equal(t, "a", got.OnlyA)
+	})
+
+	t.Run("recursive type", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"name": "a", "children": [{"name": "b", "children": [{"name": "c"}]}, null]}`)
+		var want, got unmarshalRecursive
+		require.NoError(t, json.Unmarshal(data, &want))
+		require.NoError(t, Unmarshal(data, &got))
+		require.Equal(t, want, got)
+	})
+
+	t.Run("reuses existing values", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"d": ["x"], "m": {"b": 2}, "p": {"b": 3}}`)
+		c := 1.5
+		newTarget := func() *unmarshalTarget {
+			return &unmarshalTarget{
+				C: &c,
+				D: []string{"a", "b", "c"},
+				M: map[string]int{"a": 1},
+				P: &unmarshalTarget{A: "kept"},
+			}
+		}
+		want, got := newTarget(), newTarget()
+		require.NoError(t, json.Unmarshal(data, want))
+		require.NoError(t, Unmarshal(data, got))
+		require.Equal(t, want, got)
+		require.Equal(t, "kept", got.P.A)
+	})
+
+	t.Run("interface holding a pointer", func(t *testing.T) {
+		t.Parallel()
+		var s string
+		var v interface{} = &s
+		require.NoError(t, Unmarshal([]byte(`"hi"`), &v))
+		require.Equal(t, "hi", s)
+	})
+
+	t.Run("type error", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalTarget
+		err := Unmarshal([]byte(`{"p": {"items": [{"b": "1"}]}}`), &got)
+		var typeErr *UnmarshalTypeError
+		require.True(t, errors.As(err, &typeErr))
+		require.Equal(t, &UnmarshalTypeError{
+			Value:  "string",
+			Type:   reflect.TypeOf(0),
+			Offset: 23,
+			Struct: "unmarshalTarget",
+			Field:  "p.items.b",
+		}, typeErr)
+		require.EqualError(t, err, "cannot unmarshal string into Go struct field unmarshalTarget.p.items.b of type int")
+
+		err = Unmarshal([]byte(`[1.5]`), &[]int{})
+		require.EqualError(t, err, "cannot unmarshal number 1.5 into Go value of type int")
+	})
+
+	t.Run("type errors don't stop decoding", func(t *testing.T) {
+		t.Parallel()
+		data := []byte(`{"b": "1", "a": "x", "d": ["y", 2, "z"], "int_keys": {"x": true, "1": true}, "c": 2.5}`)
+		var want, got unmarshalTarget
+		var wantErr *json.UnmarshalTypeError
+		require.True(t, errors.As(json.Unmarshal(data, &want), &wantErr))
+		err := Unmarshal(data, &got)
+		require.Equal(t, want, got)
+		require.Equal(t, "x", got.A)
+		var typeErr *UnmarshalTypeError
+		require.True(t, errors.As(err, &typeErr))
+		require.EqualError(t, err, "cannot unmarshal string into Go struct field unmarshalTarget.b of type int")
+	})
+
+	t.Run("number out of range in an interface", func(t *testing.T) {
+		t.Parallel()
+		var got interface{}
+		err := Unmarshal([]byte(`{"a": [1, 1e400], "b": "x"}`), &got)
+		require.Equal(t, map[string]interface{}{"a": []interface{}{1.0, math.Inf(1)}, "b": "x"}, got)
+		var typeErr *UnmarshalTypeError
+		require.True(t, errors.As(err, &typeErr))
+		require.Equal(t, &UnmarshalTypeError{
+			Value:  "number 1e400",
+			Type:   reflect.TypeOf(0.0),
+			Offset: 10,
+		}, typeErr)
+	})
+
+	t.Run("syntax error after a type error", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalTarget
+		err := Unmarshal([]byte(`{"b": "1", "a": }`), &got)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr))
+		require.Equal(t, 16, syntaxErr.Offset)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalTarget
+		err := Unmarshal([]byte(`{"a": "x", "b": }`), &got)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr))
+		require.Equal(t, 16, syntaxErr.Offset)
+		// data is validated while it is decoded, so fields before the error are set
+		require.Equal(t, "x", got.A)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		t.Parallel()
+		for _, depth := range []int{skipMaxDepth, skipMaxDepth + 1} {
+			// the innermost arrays are skipped by the machine for the unknown field "x"
+			data := []byte(strings.Repeat(`{"p":`, depth/2) + `{"x":` + strings.Repeat("[", depth-depth/2-1) +
+				strings.Repeat("]", depth-depth/2-1) + "}" + strings.Repeat("}", depth/2))
+			var want, got unmarshalTarget
+			wantErr := json.Unmarshal(data, &want)
+			err := Unmarshal(data, &got)
+			if depth > skipMaxDepth {
+				require.Error(t, wantErr)
+				require.True(t, errors.Is(err, ErrMaxDepth))
+				continue
+			}
+			require.NoError(t, wantErr)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalTarget
+		require.Equal(t, errUnmarshalTarget, Unmarshal([]byte(`{}`), got))
+		require.Equal(t, errUnmarshalTarget, Unmarshal([]byte(`{}`), (*unmarshalTarget)(nil)))
+		require.Equal(t, errUnmarshalTarget, Unmarshal([]byte(`{}`), nil))
+	})
+}
+
+type testUnmarshaler struct {
+	value  string
+	buffer *Buffer
+}
+
+func (u *testUnmarshaler) UnmarshalRJSON(data []byte, buffer *Buffer) (int, error) {
+	u.buffer = buffer
+	var p int
+	var err error
+	u.value, p, err = ReadString(data, nil)
+	return p, err
+}
+
+func TestDecodeValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Unmarshaler", func(t *testing.T) {
+		t.Parallel()
+		var got testUnmarshaler
+		buf := &Buffer{}
+		p, err := DecodeValue([]byte(`"foo", "bar"`), &got, buf)
+		require.NoError(t, err)
+		require.Equal(t, 5, p)
+		require.Equal(t, "foo", got.value)
+		require.Same(t, buf, got.buffer)
+	})
+
+	t.Run("json.Unmarshaler", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalJSON
+		p, err := DecodeValue([]byte(` {"a": [1, 2]} , "bar"`), &got, nil)
+		require.NoError(t, err)
+		require.Equal(t, 14, p)
+		require.Equal(t, `{"a": [1, 2]}`, got.raw)
+	})
+
+	t.Run("TextUnmarshaler", func(t *testing.T) {
+		t.Parallel()
+		var got unmarshalText
+		p, err := DecodeValue([]byte(`"f\u006fo"]`), &got, nil)
+		require.NoError(t, err)
+		require.Equal(t, 10, p)
+		require.Equal(t, unmarshalText("text:foo"), got)
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		t.Parallel()
+		var got map[string]unmarshalTarget
+		p, err := DecodeValue([]byte(`{"x": {"a": "foo", "t": "bar", "u": true}}, {}`), &got, nil)
+		require.NoError(t, err)
+		require.Equal(t, 42, p)
+		require.Equal(t, map[string]unmarshalTarget{
+			"x": {A: "foo", T: "text:bar", U: unmarshalJSON{raw: "true"}},
+		}, got)
+	})
+
+	t.Run("nested Unmarshaler", func(t *testing.T) {
+		t.Parallel()
+		var got []testUnmarshaler
+		p, err := DecodeValue([]byte(`["a", "b"]`), &got, nil)
+		require.NoError(t, err)
+		require.Equal(t, 10, p)
+		require.Len(t, got, 2)
+		require.Equal(t, "a", got[0].value)
+		require.Equal(t, "b", got[1].value)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		t.Parallel()
+		got := unmarshalJSON{raw: "untouched"}
+		_, err := DecodeValue([]byte(`{"a": }`), &got, nil)
+		require.Error(t, err)
+		require.Equal(t, "untouched", got.raw)
+	})
+
+	t.Run("unmarshaler error", func(t *testing.T) {
+		t.Parallel()
+		var got []unmarshalText
+		_, err := DecodeValue([]byte(`["ok", "bad"]`), &got, nil)
+		require.EqualError(t, err, "bad text")
+	})
+}
+
+func TestUnmarshal_allocs(t *testing.T) {
+	data := getTestdataJSONGz(t, "github_repo.json")
+	var repo benchRepo
+	require.NoError(t, Unmarshal(data, &repo))
+	allocs := testing.AllocsPerRun(10, func() {
+		repo = benchRepo{}
+		_ = Unmarshal(data, &repo) //nolint:errcheck // checked above
+	})
+	assert.LessOrEqual(t, allocs, 5.0)
+}